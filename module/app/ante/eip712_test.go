@@ -0,0 +1,215 @@
+package ante
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	client "github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/ethereum/eip712"
+	testtx "github.com/evmos/ethermint/tests"
+	etherminttypes "github.com/evmos/ethermint/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	auctiontypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Fully populated examples of every gravity and auction Msg, signed by addr
+// nolint: exhaustruct
+func eip712ExampleMsgs(addr sdk.AccAddress) []sdk.Msg {
+	ethAddr := keeper.EthAddrs[0].String()
+	token := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	ethDest, err := types.NewEthAddress(ethAddr)
+	if err != nil {
+		panic(err)
+	}
+	signature := hex.EncodeToString(make([]byte, 65))
+	return []sdk.Msg{
+		types.NewMsgSetOrchestratorAddress(sdk.ValAddress(addr), addr, *ethDest, make([]byte, 65)),
+		&types.MsgValsetConfirm{Nonce: 1, Orchestrator: addr.String(), EthAddress: ethAddr, Signature: signature, EvmChainPrefix: keeper.EthChainPrefix},
+		types.NewMsgSendToEth(addr, *ethDest, sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("stake", 1)),
+		&types.MsgRequestBatch{Sender: addr.String(), Denom: "stake", EvmChainPrefix: keeper.EthChainPrefix},
+		&types.MsgConfirmBatch{Nonce: 1, TokenContract: token, EthSigner: ethAddr, Orchestrator: addr.String(), Signature: signature, EvmChainPrefix: keeper.EthChainPrefix},
+		&types.MsgConfirmLogicCall{InvalidationId: "ab", InvalidationNonce: 1, EthSigner: ethAddr, Orchestrator: addr.String(), Signature: signature, EvmChainPrefix: keeper.EthChainPrefix},
+		&types.MsgSendToCosmosClaim{EventNonce: 1, EthBlockHeight: 1, TokenContract: token, Amount: sdk.NewInt(10), EthereumSender: ethAddr, CosmosReceiver: addr.String(), Orchestrator: addr.String(), EvmChainPrefix: keeper.EthChainPrefix},
+		&types.MsgExecuteIbcAutoForwards{ForwardsToClear: 1, Executor: addr.String(), EvmChainPrefix: keeper.EthChainPrefix},
+		types.NewMsgClaimAirdrop(addr, 1, 10, [][]byte{crypto.Keccak256([]byte("sibling"))}),
+		&types.MsgBatchSendToEthClaim{EventNonce: 1, EthBlockHeight: 1, BatchNonce: 1, TokenContract: token, Orchestrator: addr.String(), EvmChainPrefix: keeper.EthChainPrefix},
+		&types.MsgERC20DeployedClaim{EventNonce: 1, EthBlockHeight: 1, CosmosDenom: "stake", TokenContract: token, Name: "Stake", Symbol: "STK", Decimals: 6, Orchestrator: addr.String(), EvmChainPrefix: keeper.EthChainPrefix},
+		&types.MsgLogicCallExecutedClaim{EventNonce: 1, EthBlockHeight: 1, InvalidationId: []byte{0xab}, InvalidationNonce: 1, Orchestrator: addr.String()},
+		&types.MsgValsetUpdatedClaim{EventNonce: 1, ValsetNonce: 1, EthBlockHeight: 1, Members: []types.BridgeValidator{{Power: 1, EthereumAddress: ethAddr}}, RewardAmount: sdk.NewInt(1), RewardToken: token, Orchestrator: addr.String(), EvmChainPrefix: keeper.EthChainPrefix},
+		types.NewMsgCancelSendToEth(addr, 1),
+		&types.MsgSubmitBadSignatureEvidence{Subject: nil, Signature: signature, Sender: addr.String()},
+		auctiontypes.NewMsgBid(1, addr.String(), 10, 1),
+	}
+}
+
+// Every gravity and auction Msg must either be registered with its EIP-712 types or explicitly unsupported
+func TestEIP712RegistryCoversAllMsgs(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	auctiontypes.RegisterInterfaces(registry)
+
+	examples := map[string]bool{}
+	for _, msg := range eip712ExampleMsgs(keeper.AccAddrs[0]) {
+		examples[sdk.MsgTypeURL(msg)] = true
+	}
+
+	found := 0
+	for _, typeURL := range registry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		if !strings.HasPrefix(typeURL, "/gravity.") && !strings.HasPrefix(typeURL, "/auction.") {
+			continue
+		}
+		found++
+		_, supported := types.EIP712MsgTypes[typeURL]
+		_, unsupported := types.EIP712UnsupportedMsgs[typeURL]
+		require.True(t, supported != unsupported, "%s must be in exactly one of EIP712MsgTypes and EIP712UnsupportedMsgs", typeURL)
+		require.True(t, examples[typeURL], "%s has no example", typeURL)
+	}
+	require.Equal(t, len(types.EIP712MsgTypes)+len(types.EIP712UnsupportedMsgs), found)
+}
+
+// Signs each example Msg the way a wallet would, from the typed data JSON produced by types.EIP712TypedData, and
+// checks that the ante handler accepts every supported Msg
+func TestEIP712SignedMsgs(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	ah := MakeAnteHandler(t, input)
+
+	ethPrivkey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	address := sdk.AccAddress(ethPrivkey.PubKey().Address())
+	ethBalance := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, input.BankKeeper.MintCoins(input.Context, types.ModuleName, ethBalance))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(input.Context, types.ModuleName, address, ethBalance))
+
+	for _, msg := range eip712ExampleMsgs(address) {
+		typeURL := sdk.MsgTypeURL(msg)
+		tx, err := SignEip712TypedData(t, input.AccountKeeper, ctx, ethPrivkey, []sdk.Msg{msg}, input.EncodingConfig.TxConfig)
+		if _, unsupported := types.EIP712UnsupportedMsgs[typeURL]; unsupported {
+			require.Error(t, err, typeURL)
+			// signing the typed data ethermint derives directly must not work either
+			chainId, err := strconv.ParseUint(config.GravityEvmChainID, 10, 64)
+			require.NoError(t, err)
+			builder, _, err := SignEip712(t, input.AccountKeeper, ctx, ethPrivkey, chainId, 2000000, sdk.NewCoins(), []sdk.Msg{msg}, input.EncodingConfig.TxConfig)
+			if err == nil {
+				cacheCtx, _ := ctx.CacheContext()
+				_, err = ah(cacheCtx, builder.GetTx(), false)
+			}
+			require.Error(t, err, typeURL)
+			continue
+		}
+		require.NoError(t, err, typeURL)
+
+		// the typed data must use exactly the registered types
+		typedData, err := eip712TypedDataForTx(input.AccountKeeper, ctx, address, []sdk.Msg{msg})
+		require.NoError(t, err, typeURL)
+		for name, fields := range types.EIP712MsgTypes[typeURL] {
+			require.Equal(t, fields, typedData.Types[name], "%s %s", typeURL, name)
+		}
+
+		// the sequence is incremented by the ante handler, use a cache so that each Msg is signed with the same one
+		cacheCtx, _ := ctx.CacheContext()
+		_, err = ah(cacheCtx, tx, false)
+		require.NoError(t, err, typeURL)
+	}
+}
+
+// Msgs with fields omitted by amino JSON can not be hashed and must be rejected before a wallet is asked to sign
+func TestEIP712TypedDataRejectsInvalidTxs(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	address := keeper.AccAddrs[0]
+
+	// MsgRequestBatch passes ValidateBasic without a denom
+	_, err := eip712TypedDataForTx(input.AccountKeeper, ctx, address, []sdk.Msg{&types.MsgRequestBatch{Sender: address.String()}})
+	require.Error(t, err)
+
+	// only the types of the first Msg are included in the typed data
+	mixed := []sdk.Msg{&types.MsgRequestBatch{Sender: address.String(), Denom: "stake", EvmChainPrefix: keeper.EthChainPrefix}, types.NewMsgCancelSendToEth(address, 1)}
+	_, err = eip712TypedDataForTx(input.AccountKeeper, ctx, address, mixed)
+	require.Error(t, err)
+}
+
+// Builds the typed data for a tx of msgs signed by from with zero fees and a fixed gas limit
+func eip712TypedDataForTx(ak authkeeper.AccountKeeper, ctx sdk.Context, from sdk.AccAddress, msgs []sdk.Msg) (apitypes.TypedData, error) {
+	chainId, err := strconv.ParseUint(config.GravityEvmChainID, 10, 64)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	acc := ak.GetAccount(ctx, from)
+	fee := legacytx.NewStdFee(2000000, sdk.NewCoins()) //nolint: staticcheck
+	data := legacytx.StdSignBytes(ctx.ChainID(), acc.GetAccountNumber(), acc.GetSequence(), 0, fee, msgs, "")
+	return types.EIP712TypedData(chainId, data, msgs, from)
+}
+
+// Creates a Tx holding the given `msgs` with an EIP712 signature over the typed data JSON from types.EIP712TypedData
+func SignEip712TypedData(
+	t *testing.T,
+	ak authkeeper.AccountKeeper,
+	ctx sdk.Context,
+	privKey cryptotypes.PrivKey,
+	msgs []sdk.Msg,
+	txCfg client.TxConfig,
+) (sdk.Tx, error) {
+	from := sdk.AccAddress(privKey.PubKey().Address())
+	typedData, err := eip712TypedDataForTx(ak, ctx, from, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// wallets receive the typed data as JSON
+	typedDataJSON, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	var walletTypedData apitypes.TypedData
+	require.NoError(t, json.Unmarshal(typedDataJSON, &walletTypedData))
+
+	sigHash, err := eip712.ComputeTypedDataHash(walletTypedData)
+	require.NoError(t, err)
+	signature, pubKey, err := testtx.NewSigner(privKey).SignByAddress(from, sigHash)
+	require.NoError(t, err)
+	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+
+	option, err := codectypes.NewAnyWithValue(&etherminttypes.ExtensionOptionsWeb3Tx{
+		FeePayer:         from.String(),
+		TypedDataChainID: (*big.Int)(typedData.Domain.ChainId).Uint64(),
+		FeePayerSig:      signature,
+	})
+	require.NoError(t, err)
+
+	builder, ok := txCfg.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+	require.True(t, ok)
+	builder.SetExtensionOptions(option)
+	builder.SetFeeAmount(sdk.NewCoins())
+	builder.SetGasLimit(2000000)
+	require.NoError(t, builder.SetMsgs(msgs...))
+
+	sequence, err := ak.GetSequence(ctx, from)
+	require.NoError(t, err)
+	// nolint: exhaustruct
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+		Sequence: sequence,
+	}))
+
+	return builder.GetTx(), nil
+}
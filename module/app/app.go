@@ -567,7 +567,7 @@ func NewGravityApp(
 
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(paramsKeeper)).
+		AddRoute(paramsproposal.RouterKey, keeper.NewParamChangeProposalHandler(gravityKeeper, params.NewParamChangeProposalHandler(paramsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(upgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(ibcKeeper.ClientKeeper)).
@@ -679,10 +679,13 @@ func NewGravityApp(
 		params.NewAppModule(paramsKeeper),
 		ibcTransferAppModule,
 		gravity.NewAppModule(
+			appCodec,
 			gravityKeeper,
 			bankKeeper,
+			accountKeeper,
 		),
 		auction.NewAppModule(
+			appCodec,
 			auctionKeeper,
 			bankKeeper,
 			accountKeeper,
@@ -785,6 +788,8 @@ func NewGravityApp(
 		evidence.NewAppModule(evidenceKeeper),
 		ibc.NewAppModule(&ibcKeeper),
 		ibcTransferAppModule,
+		gravity.NewAppModule(appCodec, gravityKeeper, bankKeeper, accountKeeper),
+		auction.NewAppModule(appCodec, auctionKeeper, bankKeeper, accountKeeper),
 	)
 	app.sm = &sm
 
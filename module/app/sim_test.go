@@ -31,6 +31,9 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+
+	auctiontypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
 func init() {
@@ -171,7 +174,10 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[ibchost.StoreKey], newApp.keys[ibchost.StoreKey], [][]byte{}},
 		{app.keys[ibctransfertypes.StoreKey], newApp.keys[ibctransfertypes.StoreKey], [][]byte{}},
-		// TODO: Add gravity module once simulation code is write
+		{app.keys[gravitytypes.StoreKey], newApp.keys[gravitytypes.StoreKey],
+			[][]byte{gravitytypes.BatchFeeRecordKey, gravitytypes.BatchFeeRecordCountKey}}, // the batch fee history is not part of the gravity genesis, it restarts empty
+		{app.keys[auctiontypes.StoreKey], newApp.keys[auctiontypes.StoreKey],
+			[][]byte{[]byte(auctiontypes.KeyAuctionNonce)}}, // the auction nonce is not part of the auction genesis
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -14,12 +14,20 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-)
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	bech32ibctypes "github.com/althea-net/bech32-ibc/x/bech32ibc/types"
 
-// TODO: audit this code when we hook up simulations
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	auctiontypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
 
 // AppStateFn returns the initial application state using a genesis or the simulation parameters.
 // It panics if the user provides files for both of them.
@@ -70,10 +78,116 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams)
 		}
 
+		appState = fundModuleAccounts(cdc, appState)
+
 		return appState, simAccs, chainID, genesisTimestamp
 	}
 }
 
+// fundModuleAccounts gives the staking not bonded pool, the gravity module and the auction module the balances backing
+// the tokens of unbonded validators, of the unbatched transfers and of the active auctions in the randomized genesis,
+// which are generated independently of the bank genesis
+func fundModuleAccounts(cdc codec.JSONCodec, appState json.RawMessage) json.RawMessage {
+	rawState := make(map[string]json.RawMessage)
+	err := json.Unmarshal(appState, &rawState)
+	if err != nil {
+		panic(err)
+	}
+
+	stakingStateBz, ok := rawState[stakingtypes.ModuleName]
+	if !ok {
+		panic("staking genesis state is missing")
+	}
+	stakingState := new(stakingtypes.GenesisState)
+	cdc.MustUnmarshalJSON(stakingStateBz, stakingState)
+
+	gravityStateBz, ok := rawState[gravitytypes.ModuleName]
+	if !ok {
+		panic("gravity genesis state is missing")
+	}
+	gravityState := new(gravitytypes.GenesisState)
+	cdc.MustUnmarshalJSON(gravityStateBz, gravityState)
+
+	auctionStateBz, ok := rawState[auctiontypes.ModuleName]
+	if !ok {
+		panic("auction genesis state is missing")
+	}
+	auctionState := new(auctiontypes.GenesisState)
+	cdc.MustUnmarshalJSON(auctionStateBz, auctionState)
+
+	bankStateBz, ok := rawState[banktypes.ModuleName]
+	if !ok {
+		panic("bank genesis state is missing")
+	}
+	bankState := new(banktypes.GenesisState)
+	cdc.MustUnmarshalJSON(bankStateBz, bankState)
+
+	// compute not bonded balance
+	notBondedTokens := sdk.ZeroInt()
+	for _, val := range stakingState.Validators {
+		if val.Status != stakingtypes.Unbonded {
+			continue
+		}
+		notBondedTokens = notBondedTokens.Add(val.GetTokens())
+	}
+	notBondedCoins := sdk.NewCoins(sdk.NewCoin(stakingState.Params.BondDenom, notBondedTokens))
+
+	// the pending pool holds the vouchers of every unbatched transfer's amount and fee
+	gravityCoins := sdk.NewCoins()
+	for _, tx := range gravityState.UnbatchedTransfers {
+		for _, token := range []gravitytypes.ERC20Token{tx.Erc20Token, tx.Erc20Fee} {
+			internal, err := token.ToInternal()
+			if err != nil {
+				panic(err)
+			}
+			gravityCoins = gravityCoins.Add(internal.GravityCoin(gravitytypes.DefaultEvmChainPrefix))
+		}
+	}
+
+	// the auction module holds every auctioned amount and every highest bid
+	auctionCoins := sdk.NewCoins()
+	for _, auction := range auctionState.ActiveAuctions {
+		auctionCoins = auctionCoins.Add(auction.Amount)
+		if auction.HighestBid != nil {
+			auctionCoins = auctionCoins.Add(sdk.NewCoin(config.NativeTokenDenom, sdk.NewIntFromUint64(auction.HighestBid.BidAmount)))
+		}
+	}
+
+	// the randomized bank supply already counts the staked tokens, but not the vouchers of the unbatched transfers
+	// nor the auctioned tokens
+	addModuleBalance(bankState, stakingtypes.NotBondedPoolName, notBondedCoins)
+	if addModuleBalance(bankState, gravitytypes.ModuleName, gravityCoins) && !bankState.Supply.Empty() {
+		bankState.Supply = bankState.Supply.Add(gravityCoins...)
+	}
+	if addModuleBalance(bankState, auctiontypes.ModuleName, auctionCoins) && !bankState.Supply.Empty() {
+		bankState.Supply = bankState.Supply.Add(auctionCoins...)
+	}
+
+	rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+
+	appState, err = json.Marshal(rawState)
+	if err != nil {
+		panic(err)
+	}
+	return appState
+}
+
+// addModuleBalance adds the balance of a module account to the bank genesis, unless it is empty or the module
+// account already has a balance, returning true if the balance was added
+func addModuleBalance(bankState *banktypes.GenesisState, moduleName string, coins sdk.Coins) bool {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	for _, balance := range bankState.Balances {
+		if balance.Address == addr {
+			return false
+		}
+	}
+	if coins.Empty() {
+		return false
+	}
+	bankState.Balances = append(bankState.Balances, banktypes.Balance{Address: addr, Coins: coins})
+	return true
+}
+
 // AppStateRandomizedFn creates calls each module's GenesisState generator function
 // and creates the simulation params
 func AppStateRandomizedFn(
@@ -124,6 +238,29 @@ func AppStateRandomizedFn(
 
 	simManager.GenerateGenesisStates(simState)
 
+	// bech32ibc has no simulation, its default genesis must still agree with the account prefix checked on startup
+	bech32ibcGenesis := bech32ibctypes.DefaultGenesis()
+	bech32ibcGenesis.NativeHRP = sdk.GetConfig().GetBech32AccountAddrPrefix()
+	genesisState[bech32ibctypes.ModuleName] = cdc.MustMarshalJSON(bech32ibcGenesis)
+
+	// the app also asserts on startup that it mints the hard-coded native token, unlike the simulated bond denom
+	var mintGenesis minttypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[minttypes.ModuleName], &mintGenesis)
+	mintGenesis.Params.MintDenom = config.NativeTokenDenom
+	genesisState[minttypes.ModuleName] = cdc.MustMarshalJSON(&mintGenesis)
+
+	// the native token is only minted after genesis, so the simulated accounts are given some of it to bid in auctions
+	var bankGenesis banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	nativeCoins := sdk.NewCoins(sdk.NewCoin(config.NativeTokenDenom, sdk.NewInt(initialStake)))
+	for i := range bankGenesis.Balances {
+		bankGenesis.Balances[i].Coins = bankGenesis.Balances[i].Coins.Add(nativeCoins...)
+		if !bankGenesis.Supply.Empty() {
+			bankGenesis.Supply = bankGenesis.Supply.Add(nativeCoins...)
+		}
+	}
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+
 	appState, err := json.Marshal(genesisState)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,3 @@
+package artemis
+
+var ApolloToArtemisPlanName = "artemis"
@@ -14,6 +14,7 @@ import (
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/antares"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/apollo"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/artemis"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/orion"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/pleiades"
 	polaris "github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/polaris"
@@ -80,4 +81,10 @@ func RegisterUpgradeHandlers(
 		apollo.AntaresToApolloPlanName,
 		apollo.GetApolloUpgradeHandler(mm, configurator, crisisKeeper, auctionKeeper),
 	)
+
+	// Artemis upgrade handler
+	upgradeKeeper.SetUpgradeHandler(
+		artemis.ApolloToArtemisPlanName,
+		artemis.GetArtemisUpgradeHandler(mm, configurator, crisisKeeper),
+	)
 }
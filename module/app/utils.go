@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
 )
 
 // SetupSimulation creates the config, db (levelDB), temporary directory and logger for
@@ -94,9 +95,25 @@ func SimulationOperations(app Gravity, cdc codec.JSONCodec, config simtypes.Conf
 		}
 	}
 
+	// the MinCommissionDecorator rejects the validators below the minimum commission which the staking simulation
+	// creates and edits, those operations are left out unless the params file weighs them
+	for _, key := range []string{stakingsim.OpWeightMsgCreateValidator, stakingsim.OpWeightMsgEditValidator} {
+		if _, ok := simState.AppParams[key]; !ok {
+			simState.AppParams[key] = json.RawMessage("0")
+		}
+	}
+
 	simState.ParamChanges = app.SimulationManager().GenerateParamChanges(config.Seed)
 	simState.Contents = app.SimulationManager().GetProposalContents(simState)
-	return app.SimulationManager().WeightedOperations(simState)
+
+	// operations without weight could still be picked by the simulation, so they are removed
+	var ops []simtypes.WeightedOperation
+	for _, op := range app.SimulationManager().WeightedOperations(simState) {
+		if op.Weight() > 0 {
+			ops = append(ops, op)
+		}
+	}
+	return ops
 }
 
 // CheckExportSimulation exports the app state and simulation parameters to JSON
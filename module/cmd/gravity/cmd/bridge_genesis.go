@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// BridgeGenesisCmd bundles the commands which cross-check the gravity bridge state of a genesis file
+func BridgeGenesisCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "bridge-genesis",
+		Short: "Inspect and cross-check the gravity bridge state of a genesis file",
+		Long: `Inspect and cross-check the gravity bridge state of a genesis file.
+
+The sections of the gravity genesis state are checked against each other: batches and transfers must use
+tokens and ids issued by the gravity_nonces counters, confirms must reference existing valsets, batches
+and logic calls and be signed with delegate keys, and the gravity module balance in the bank genesis
+state must hold every token on its way out of the bridge as the module balance invariant expects.
+`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		BridgeGenesisInspectCmd(),
+		BridgeGenesisValidateCmd(),
+	)
+	return cmd
+}
+
+// BridgeGenesisInspectCmd prints a summary of the bridge state of every token and the findings of the cross-checks
+func BridgeGenesisInspectCmd() *cobra.Command {
+	// nolint: exhaustruct
+	return &cobra.Command{
+		Use:   "inspect [genesis.json]",
+		Short: "Print a summary of the bridge state of every token in a genesis file",
+		Long:  "Print a summary of the bridge state of every token in a genesis file, the genesis file of the node home by default.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, chainID, err := checkBridgeGenesis(cmd, args)
+			if err != nil {
+				return err
+			}
+			printBridgeGenesisSummary(cmd.OutOrStdout(), chainID, report)
+			printBridgeGenesisFindings(cmd.OutOrStdout(), report)
+			return nil
+		},
+	}
+}
+
+// BridgeGenesisValidateCmd fails if the bridge state of a genesis file is inconsistent
+func BridgeGenesisValidateCmd() *cobra.Command {
+	// nolint: exhaustruct
+	return &cobra.Command{
+		Use:   "validate [genesis.json]",
+		Short: "Cross-check the bridge state of a genesis file, failing on any inconsistency",
+		Long:  "Cross-check the bridge state of a genesis file, the genesis file of the node home by default, failing on any inconsistency.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, _, err := checkBridgeGenesis(cmd, args)
+			if err != nil {
+				return err
+			}
+			printBridgeGenesisFindings(cmd.OutOrStdout(), report)
+			if len(report.Problems) > 0 {
+				return fmt.Errorf("the bridge genesis state has %d problems", len(report.Problems))
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "The bridge genesis state is consistent")
+			return nil
+		},
+	}
+}
+
+// checkBridgeGenesis reads the genesis file given in args, or of the node home, and cross-checks its bridge state
+func checkBridgeGenesis(cmd *cobra.Command, args []string) (types.GenesisConsistencyReport, string, error) {
+	var report types.GenesisConsistencyReport
+	clientCtx := client.GetClientContextFromCmd(cmd)
+
+	genesis := server.GetServerContextFromCmd(cmd).Config.GenesisFile()
+	if len(args) == 1 {
+		genesis = args[0]
+	}
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genesis)
+	if err != nil {
+		return report, "", errors.Wrapf(err, "failed to read genesis file %s", genesis)
+	}
+
+	var gravityGenesis types.GenesisState
+	if err := clientCtx.Codec.UnmarshalJSON(appState[types.ModuleName], &gravityGenesis); err != nil {
+		return report, "", errors.Wrap(err, "failed to unmarshal gravity genesis state")
+	}
+	bankGenesis := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName).String()
+	for _, balance := range bankGenesis.Balances {
+		if balance.Address == moduleAddress {
+			return types.CheckGenesisConsistency(gravityGenesis, clientCtx.InterfaceRegistry, balance.Coins), genDoc.ChainID, nil
+		}
+	}
+	return types.CheckGenesisConsistency(gravityGenesis, clientCtx.InterfaceRegistry, nil), genDoc.ChainID, nil
+}
+
+func printBridgeGenesisSummary(w io.Writer, chainID string, report types.GenesisConsistencyReport) {
+	fmt.Fprintf(w, "Bridge state of %s\n\n", chainID)
+	if len(report.Tokens) == 0 {
+		fmt.Fprintln(w, "No tokens are on their way out of the bridge")
+	}
+	for _, token := range report.Tokens {
+		origin := token.EvmChainPrefix + " originated"
+		if token.CosmosOriginated {
+			origin = "cosmos originated"
+		}
+		fmt.Fprintf(w, "%s %s (erc20 %s, %s)\n", token.EvmChainPrefix, token.Denom, token.Erc20, origin)
+		fmt.Fprintf(w, "  unbatched:            %d txs, %s\n", token.UnbatchedTxs, token.UnbatchedAmount)
+		fmt.Fprintf(w, "  batches:              %d batches (%d confirmed, highest nonce %d), %d txs, %s\n",
+			token.Batches, token.ConfirmedBatches, token.HighestBatchNonce, token.BatchedTxs, token.BatchedAmount)
+		fmt.Fprintf(w, "  pending ibc forwards: %d forwards, %s\n", token.PendingForwards, token.PendingAmount)
+		fmt.Fprintf(w, "  total:                %s\n", token.Total())
+	}
+
+	fmt.Fprintf(w, "\nGravity module balance\n")
+	if len(report.Escrow) == 0 {
+		fmt.Fprintln(w, "  holds nothing and nothing is expected")
+	}
+	for _, escrow := range report.Escrow {
+		status := "ok"
+		if !escrow.Matches() {
+			status = "MISMATCH"
+		}
+		expectation := "covering"
+		if escrow.Exact {
+			expectation = "exactly"
+		}
+		fmt.Fprintf(w, "  %s: holds %s, expected %s %s: %s\n", escrow.Denom, escrow.ModuleBalance, expectation, escrow.Expected, status)
+	}
+	fmt.Fprintln(w)
+}
+
+func printBridgeGenesisFindings(w io.Writer, report types.GenesisConsistencyReport) {
+	if len(report.Problems) > 0 {
+		fmt.Fprintf(w, "Problems\n")
+		for _, problem := range report.Problems {
+			fmt.Fprintf(w, "  - %s\n", problem)
+		}
+	}
+	if len(report.Warnings) > 0 {
+		fmt.Fprintf(w, "Warnings\n")
+		for _, warning := range report.Warnings {
+			fmt.Fprintf(w, "  - %s\n", warning)
+		}
+	}
+}
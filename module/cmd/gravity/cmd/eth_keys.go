@@ -1,22 +1,43 @@
 package cmd
 
 import (
+	"bufio"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	ethermint "github.com/evmos/ethermint/crypto/hd"
+	etherminttypes "github.com/evmos/ethermint/types"
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
+
+	gravitycli "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/client/cli"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
-const flagPassphrase = "passphrase"
+const (
+	flagHDPath      = "hd-path"
+	flagRecover     = "recover"
+	flagKeystore    = "keystore"
+	flagAddressOnly = "address"
+	flagYes         = "yes"
+)
 
 // Commands registers a sub-tree of commands to interact with
 // local private key storage.
@@ -25,17 +46,34 @@ func Commands(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "eth_keys",
 		Short: "Manage your application's ethereum keys",
-		Long: `Keyring management commands. Generated by the official Ethereum go library.
+		Long: `Keyring management commands for the Ethereum keys your orchestrator signs with.
 
-The keyring supports the following backends:
+The Ethereum keys are kept apart from the Cosmos keys managed by the keys command, in
+the eth_keys directory of the keyring directory. The keyring supports the following backends:
+    os          Uses the operating system's default credentials store.
+    file        Uses encrypted file-based keystore within the app's configuration directory.
+                This keyring will request a password each time it is accessed, which may occur
+                multiple times in a single command resulting in repeated password prompts.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+
+Keys generated by earlier versions of this command are Ethereum keystore files in the keyring
+directory, they can be moved into the keyring with the import command and its --keystore flag.
 `,
 	}
 
 	cmd.AddCommand(
 		AddKeyCommand(),
+		ImportKeyCommand(),
+		ExportKeyCommand(),
+		ListKeysCommand(),
+		ShowKeyCommand(),
+		DeleteKeyCommand(),
+		SignCommand(),
+		SignDelegateKeysCommand(),
 	)
+	// print to stdout rather than cobra's default of stderr, so that the output can be piped
+	cmd.SetOut(os.Stdout)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
@@ -49,67 +87,525 @@ The keyring supports the following backends:
 func AddKeyCommand() *cobra.Command {
 	// nolint: exhaustruct
 	cmd := &cobra.Command{
-		Use:   "add",
+		Use:   "add <name>",
 		Short: "Add an encrypted private ethereum key",
-		Long: `Derive a new private key and encrypt to disk.
+		Long: `Derive a new private key from a new mnemonic and store it in the keyring under the given name.
+The mnemonic is printed to stderr, it is the only way to recover the key if the keyring is lost.
 `,
+		Args: cobra.ExactArgs(1),
 		RunE: runAddCmd,
 	}
 
-	cmd.Flags().String(flagPassphrase, "default", "Password used for ethereum key generation")
+	cmd.Flags().String(flagHDPath, etherminttypes.BIP44HDPath, "BIP44 derivation path of the key")
 	cmd.Flags().Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
 
-	cmd.SetOut(cmd.OutOrStdout())
-	cmd.SetErr(cmd.ErrOrStderr())
+	return cmd
+}
+
+// ImportKeyCommand defines a keys command to import an existing key
+func ImportKeyCommand() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "import <name>",
+		Short: "Import a private ethereum key into the keyring",
+		Long: `Import a private ethereum key into the keyring under the given name.
+By default the hex encoded private key is read from the input. With --recover the key is derived
+from a bip39 mnemonic read from the input, at the path given by --hd-path. With --keystore the key
+is decrypted from an Ethereum keystore JSON file, using the passphrase read from the input.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runImportCmd,
+	}
+
+	cmd.Flags().Bool(flagRecover, false, "Derive the key from a bip39 mnemonic")
+	cmd.Flags().String(flagHDPath, etherminttypes.BIP44HDPath, "BIP44 derivation path of the key recovered from a mnemonic")
+	cmd.Flags().String(flagKeystore, "", "Path to an Ethereum keystore JSON file holding the key")
+
+	return cmd
+}
+
+// ExportKeyCommand defines a keys command to export a key as an Ethereum keystore
+func ExportKeyCommand() *cobra.Command {
+	// nolint: exhaustruct
+	return &cobra.Command{
+		Use:   "export <name>",
+		Short: "Export a private ethereum key as an encrypted Ethereum keystore JSON",
+		Long: `Export a private ethereum key as an Ethereum keystore JSON, encrypted with a passphrase read from
+the input. The keystore can be used by Ethereum wallets or imported back with import --keystore.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExportCmd,
+	}
+}
+
+// ListKeysCommand defines a keys command to list the stored keys
+func ListKeysCommand() *cobra.Command {
+	// nolint: exhaustruct
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all ethereum keys",
+		Args:  cobra.NoArgs,
+		RunE:  runListCmd,
+	}
+}
+
+// ShowKeyCommand defines a keys command to show a stored key
+func ShowKeyCommand() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "show <name>",
+		Short: "Show the address and public key of an ethereum key",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runShowCmd,
+	}
+
+	cmd.Flags().BoolP(flagAddressOnly, "a", false, "Output the address only")
 
 	return cmd
 }
 
+// DeleteKeyCommand defines a keys command to delete stored keys
+func DeleteKeyCommand() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "delete <name>...",
+		Short: "Delete the given ethereum keys",
+		Long: `Delete the given ethereum keys from the keyring, asking for confirmation unless --yes is given.
+Make sure the keys are backed up first, deleted keys cannot be recovered.
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runDeleteCmd,
+	}
+
+	cmd.Flags().BoolP(flagYes, "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// SignCommand defines a keys command to sign a payload the way orchestrators sign checkpoints
+func SignCommand() *cobra.Command {
+	// nolint: exhaustruct
+	return &cobra.Command{
+		Use:   "sign <name> <hex payload>",
+		Short: "Sign a hex encoded payload, like a valset or batch checkpoint, with an ethereum key",
+		Long: `Sign a hex encoded payload with an ethereum key, prefixing it with "\x19Ethereum Signed Message:\n32"
+exactly like orchestrators sign valset, batch and logic call checkpoints. The signature is printed hex
+encoded like in confirm messages, which helps to debug confirms rejected by signature verification.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: runSignCmd,
+	}
+}
+
+// SignDelegateKeysCommand defines a keys command to sign the proof of possession of set-orchestrator-address and gentx
+func SignDelegateKeysCommand() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "sign-delegate-keys <name> <validator-address>",
+		Short: "Sign the delegate keys registration of a validator with an ethereum key",
+		Long: `Sign the proof that the validator controls the ethereum key, which MsgSetOrchestratorAddress carries and
+the chain verifies. set-orchestrator-address and gentx sign it themselves with the keys of eth_keys, the
+signature printed here can be passed to them with --eth-signature when the key is kept elsewhere.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: runSignDelegateKeysCmd,
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "The chain the validator registers its delegate keys on")
+	return cmd
+}
+
 type EthereumKeyOutput struct {
+	Name       string `json:"name,omitempty"`
 	PublicKey  string `json:"public_key"`
-	PrivateKey string `json:"private_key"`
+	PrivateKey string `json:"private_key,omitempty"`
 	Address    string `json:"address"`
+	Mnemonic   string `json:"mnemonic,omitempty"`
+}
+
+type EthereumSignatureOutput struct {
+	Payload   string `json:"payload"`
+	Signature string `json:"signature"`
+	Address   string `json:"address"`
 }
 
 func runAddCmd(cmd *cobra.Command, args []string) error {
-	privateKey, err := crypto.GenerateKey()
+	buf := bufio.NewReader(cmd.InOrStdin())
+	dryRun, err := cmd.Flags().GetBool(flags.FlagDryRun)
 	if err != nil {
 		return err
 	}
-	privateKeyBytes := crypto.FromECDSA(privateKey)
+	hdPath, err := cmd.Flags().GetString(flagHDPath)
+	if err != nil {
+		return err
+	}
+
+	var kr keyring.Keyring
+	if dryRun {
+		kr = keyring.NewInMemory(ethermint.EthSecp256k1Option())
+	} else if kr, err = ethKeyring(cmd, buf); err != nil {
+		return err
+	}
+	if _, err := kr.Key(args[0]); err == nil {
+		return fmt.Errorf("key %s already exists", args[0])
+	}
 
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return errors.New("error casting public key to ECDSA")
+	info, mnemonic, err := kr.NewMnemonic(args[0], keyring.English, hdPath, keyring.DefaultBIP39Passphrase, ethermint.EthSecp256k1)
+	if err != nil {
+		return err
+	}
+	privateKey, err := gravitycli.EthPrivateKey(kr, info.GetName())
+	if err != nil {
+		return err
+	}
+
+	keyOutput := newEthereumKeyOutput(info.GetName(), privateKey)
+	keyOutput.PrivateKey = hexutil.Encode(crypto.FromECDSA(privateKey))
+	keyOutput.Mnemonic = mnemonic
+	return printCreate(cmd, keyOutput)
+}
+
+func runImportCmd(cmd *cobra.Command, args []string) error {
+	buf := bufio.NewReader(cmd.InOrStdin())
+	recoverKey, err := cmd.Flags().GetBool(flagRecover)
+	if err != nil {
+		return err
+	}
+	keystorePath, err := cmd.Flags().GetString(flagKeystore)
+	if err != nil {
+		return err
+	}
+	if recoverKey && keystorePath != "" {
+		return fmt.Errorf("--%s and --%s are mutually exclusive", flagRecover, flagKeystore)
 	}
-	publicKeyBytes := crypto.FromECDSAPub(publicKeyECDSA)
 
-	keyOutput := EthereumKeyOutput{
-		PrivateKey: hexutil.Encode(privateKeyBytes),
-		PublicKey:  hexutil.Encode(publicKeyBytes),
-		Address:    crypto.PubkeyToAddress(*publicKeyECDSA).Hex(),
+	kr, err := ethKeyring(cmd, buf)
+	if err != nil {
+		return err
+	}
+	name := args[0]
+	if _, err := kr.Key(name); err == nil {
+		return fmt.Errorf("key %s already exists", name)
 	}
 
-	if dryRun, errDryRun := cmd.Flags().GetBool(flags.FlagDryRun); !dryRun {
-		if errDryRun != nil {
-			fmt.Printf("ErrDryRun issue: %v", errDryRun)
+	switch {
+	case recoverKey:
+		hdPath, err := cmd.Flags().GetString(flagHDPath)
+		if err != nil {
+			return err
+		}
+		mnemonic, err := input.GetString("Enter your bip39 mnemonic", buf)
+		if err != nil {
+			return err
+		}
+		if _, err := kr.NewAccount(name, mnemonic, keyring.DefaultBIP39Passphrase, hdPath, ethermint.EthSecp256k1); err != nil {
+			return err
+		}
+
+	case keystorePath != "":
+		keyJSON, err := os.ReadFile(keystorePath)
+		if err != nil {
+			return err
 		}
-		clientCtx, err := client.GetClientQueryContext(cmd)
+		// keystores made by earlier versions of the add command are encrypted with the short passphrase "default",
+		// so the minimum length of new passphrases does not apply here
+		passphrase, err := input.GetPassword("Enter the passphrase of the keystore:", buf)
+		if err != nil && passphrase == "" {
+			return err
+		}
+		key, err := keystore.DecryptKey(keyJSON, passphrase)
 		if err != nil {
 			return err
 		}
-		ks := keystore.NewKeyStore(clientCtx.KeyringDir, keystore.StandardScryptN, keystore.StandardScryptP)
-		passphrase, err := cmd.Flags().GetString(flagPassphrase)
+		if err := importPrivateKey(kr, name, key.PrivateKey); err != nil {
+			return err
+		}
+
+	default:
+		hexKey, err := input.GetString("Enter the hex encoded private key", buf)
 		if err != nil {
 			return err
 		}
-		if _, err := ks.ImportECDSA(privateKey, passphrase); err != nil {
+		privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil {
+			return fmt.Errorf("invalid private key: %w", err)
+		}
+		if err := importPrivateKey(kr, name, privateKey); err != nil {
 			return err
 		}
 	}
 
-	return printCreate(cmd, keyOutput)
+	privateKey, err := gravitycli.EthPrivateKey(kr, name)
+	if err != nil {
+		return err
+	}
+	return printKey(cmd, newEthereumKeyOutput(name, privateKey))
+}
+
+func runExportCmd(cmd *cobra.Command, args []string) error {
+	buf := bufio.NewReader(cmd.InOrStdin())
+	kr, err := ethKeyring(cmd, buf)
+	if err != nil {
+		return err
+	}
+	privateKey, err := gravitycli.EthPrivateKey(kr, args[0])
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
+	if err != nil {
+		return err
+	}
+	repeated, err := input.GetPassword("Repeat the passphrase:", buf)
+	if err != nil {
+		return err
+	}
+	if passphrase != repeated {
+		return fmt.Errorf("passphrases don't match")
+	}
+	// nolint: exhaustruct
+	key := &keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+	keyJSON, err := keystore.EncryptKey(key, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(string(keyJSON))
+	return nil
+}
+
+func runListCmd(cmd *cobra.Command, _ []string) error {
+	kr, err := ethKeyring(cmd, bufio.NewReader(cmd.InOrStdin()))
+	if err != nil {
+		return err
+	}
+	infos, err := kr.List()
+	if err != nil {
+		return err
+	}
+
+	keyOutputs := make([]EthereumKeyOutput, 0, len(infos))
+	for _, info := range infos {
+		keyOutput, err := ethereumKeyOutputFromInfo(info)
+		if err != nil {
+			return err
+		}
+		keyOutputs = append(keyOutputs, keyOutput)
+	}
+
+	output, err := cmd.Flags().GetString(cli.OutputFlag)
+	if err != nil {
+		return err
+	}
+	switch output {
+	case keys.OutputFormatText:
+		for _, keyOutput := range keyOutputs {
+			cmd.Printf("- name: %s\n  address: %s\n  public: %s\n", keyOutput.Name, keyOutput.Address, keyOutput.PublicKey)
+		}
+
+	case keys.OutputFormatJSON:
+		outputBytes, err := json.Marshal(keyOutputs)
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(outputBytes))
+
+	default:
+		return fmt.Errorf("invalid output format %s", output)
+	}
+
+	return nil
+}
+
+func runShowCmd(cmd *cobra.Command, args []string) error {
+	kr, err := ethKeyring(cmd, bufio.NewReader(cmd.InOrStdin()))
+	if err != nil {
+		return err
+	}
+	info, err := kr.Key(args[0])
+	if err != nil {
+		return err
+	}
+	keyOutput, err := ethereumKeyOutputFromInfo(info)
+	if err != nil {
+		return err
+	}
+
+	if addressOnly, _ := cmd.Flags().GetBool(flagAddressOnly); addressOnly {
+		cmd.Println(keyOutput.Address)
+		return nil
+	}
+	return printKey(cmd, keyOutput)
+}
+
+func runDeleteCmd(cmd *cobra.Command, args []string) error {
+	buf := bufio.NewReader(cmd.InOrStdin())
+	kr, err := ethKeyring(cmd, buf)
+	if err != nil {
+		return err
+	}
+	skipConfirmation, err := cmd.Flags().GetBool(flagYes)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		info, err := kr.Key(name)
+		if err != nil {
+			return err
+		}
+		keyOutput, err := ethereumKeyOutputFromInfo(info)
+		if err != nil {
+			return err
+		}
+
+		if !skipConfirmation {
+			prompt := fmt.Sprintf("Key %s (%s) will be deleted. Continue?", name, keyOutput.Address)
+			if yes, err := input.GetConfirmation(prompt, buf, cmd.ErrOrStderr()); err != nil {
+				return err
+			} else if !yes {
+				continue
+			}
+		}
+
+		if err := kr.Delete(name); err != nil {
+			return err
+		}
+		cmd.PrintErrf("Key %s (%s) deleted\n", name, keyOutput.Address)
+	}
+
+	return nil
+}
+
+func runSignCmd(cmd *cobra.Command, args []string) error {
+	kr, err := ethKeyring(cmd, bufio.NewReader(cmd.InOrStdin()))
+	if err != nil {
+		return err
+	}
+	privateKey, err := gravitycli.EthPrivateKey(kr, args[0])
+	if err != nil {
+		return err
+	}
+	payload, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid hex payload: %w", err)
+	}
+	if len(payload) != 32 {
+		cmd.PrintErrf("Warning: checkpoints are 32 bytes long, the payload is %d bytes\n", len(payload))
+	}
+
+	return printSignature(cmd, payload, privateKey)
+}
+
+func runSignDelegateKeysCmd(cmd *cobra.Command, args []string) error {
+	val, err := sdk.ValAddressFromBech32(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+	chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+	if err != nil {
+		return err
+	}
+	if chainID == "" {
+		return fmt.Errorf("the --%s flag is required", flags.FlagChainID)
+	}
+	kr, err := ethKeyring(cmd, bufio.NewReader(cmd.InOrStdin()))
+	if err != nil {
+		return err
+	}
+	privateKey, err := gravitycli.EthPrivateKey(kr, args[0])
+	if err != nil {
+		return err
+	}
+
+	return printSignature(cmd, types.GetDelegateKeysSignBytes(val, chainID), privateKey)
+}
+
+// printSignature signs the payload like the orchestrator signs checkpoints and prints the signature in the output format
+func printSignature(cmd *cobra.Command, payload []byte, privateKey *ecdsa.PrivateKey) error {
+	signature, err := types.NewEthereumSignature(payload, privateKey)
+	if err != nil {
+		return err
+	}
+	signatureOutput := EthereumSignatureOutput{
+		Payload:   hex.EncodeToString(payload),
+		Signature: hex.EncodeToString(signature),
+		Address:   crypto.PubkeyToAddress(privateKey.PublicKey).Hex(),
+	}
+
+	output, err := cmd.Flags().GetString(cli.OutputFlag)
+	if err != nil {
+		return err
+	}
+	switch output {
+	case keys.OutputFormatText:
+		cmd.Printf("payload: %s\nsignature: %s\naddress: %s\n", signatureOutput.Payload, signatureOutput.Signature, signatureOutput.Address)
+
+	case keys.OutputFormatJSON:
+		outputBytes, err := json.Marshal(signatureOutput)
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(outputBytes))
+
+	default:
+		return fmt.Errorf("invalid output format %s", output)
+	}
+
+	return nil
+}
+
+// ethKeyring opens the keyring of the configured backend holding the ethereum keys
+func ethKeyring(cmd *cobra.Command, buf io.Reader) (keyring.Keyring, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	backend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+	if err != nil {
+		return nil, err
+	}
+	return gravitycli.NewEthKeyring(backend, clientCtx.KeyringDir, buf)
+}
+
+// importPrivateKey stores the private key under the name, the keyring only imports armored keys so the key is
+// armored with a throwaway passphrase first
+func importPrivateKey(kr keyring.Keyring, name string, privateKey *ecdsa.PrivateKey) error {
+	const armorPassphrase = "eth_keys import"
+	armor := sdkcrypto.EncryptArmorPrivKey(
+		&ethsecp256k1.PrivKey{Key: crypto.FromECDSA(privateKey)}, armorPassphrase, string(ethermint.EthSecp256k1Type),
+	)
+	return kr.ImportPrivKey(name, armor, armorPassphrase)
+}
+
+func newEthereumKeyOutput(name string, privateKey *ecdsa.PrivateKey) EthereumKeyOutput {
+	// nolint: exhaustruct
+	return EthereumKeyOutput{
+		Name:      name,
+		PublicKey: hexutil.Encode(crypto.FromECDSAPub(&privateKey.PublicKey)),
+		Address:   crypto.PubkeyToAddress(privateKey.PublicKey).Hex(),
+	}
+}
+
+func ethereumKeyOutputFromInfo(info keyring.Info) (EthereumKeyOutput, error) {
+	if info.GetAlgo() != ethermint.EthSecp256k1Type {
+		return EthereumKeyOutput{}, fmt.Errorf("key %s is a %s key, not an ethereum key", info.GetName(), info.GetAlgo())
+	}
+	publicKey, err := crypto.DecompressPubkey(info.GetPubKey().Bytes())
+	if err != nil {
+		return EthereumKeyOutput{}, err
+	}
+	// nolint: exhaustruct
+	return EthereumKeyOutput{
+		Name:      info.GetName(),
+		PublicKey: hexutil.Encode(crypto.FromECDSAPub(publicKey)),
+		Address:   crypto.PubkeyToAddress(*publicKey).Hex(),
+	}, nil
 }
 
 func printCreate(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
@@ -122,6 +618,35 @@ func printCreate(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
 	case keys.OutputFormatText:
 		cmd.PrintErrln()
 		cmd.Printf("private: %s \npublic: %s \naddress: %s\n", keyOutput.PrivateKey, keyOutput.PublicKey, keyOutput.Address)
+		// the mnemonic goes to stderr, scripts collecting the keys from stdout expect the three lines above only
+		cmd.PrintErrln("\n**Important** write this mnemonic phrase in a safe place.")
+		cmd.PrintErrln("It is the only way to recover your ethereum key if you ever forget your password.")
+		cmd.PrintErrln()
+		cmd.PrintErrln(keyOutput.Mnemonic)
+
+	case keys.OutputFormatJSON:
+		outputBytes, err := json.Marshal(keyOutput)
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(outputBytes))
+
+	default:
+		return fmt.Errorf("invalid output format %s", output)
+	}
+
+	return nil
+}
+
+func printKey(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
+	output, err := cmd.Flags().GetString(cli.OutputFlag)
+	if err != nil {
+		return err
+	}
+
+	switch output {
+	case keys.OutputFormatText:
+		cmd.Printf("name: %s\npublic: %s\naddress: %s\n", keyOutput.Name, keyOutput.PublicKey, keyOutput.Address)
 
 	case keys.OutputFormatJSON:
 		outputBytes, err := json.Marshal(keyOutput)
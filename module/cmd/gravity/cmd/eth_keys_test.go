@@ -0,0 +1,140 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/cmd/gravity/cmd"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// runEthKeys runs an eth_keys command against the test keyring in home, with the given input, returning its output
+func runEthKeys(t *testing.T, home string, in string, args ...string) (string, error) {
+	t.Helper()
+	ethKeysCmd := cmd.Commands(home)
+	out := &bytes.Buffer{}
+	ethKeysCmd.SetOut(out)
+	ethKeysCmd.SetErr(io.Discard)
+	ethKeysCmd.SetIn(strings.NewReader(in))
+	ethKeysCmd.SetArgs(append(args, "--keyring-backend", "test", "--output", "json"))
+	// nolint: exhaustruct
+	err := ethKeysCmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{}))
+	return out.String(), err
+}
+
+// TestEthKeysCmd tests the full life cycle of ethereum keys in the keyring
+func TestEthKeysCmd(t *testing.T) {
+	// registers the ethereum key types with the amino codec the keyring uses
+	app.MakeEncodingConfig()
+	home := t.TempDir()
+
+	// add prints the new key and its mnemonic
+	out, err := runEthKeys(t, home, "", "add", "generated")
+	require.NoError(t, err)
+	var added cmd.EthereumKeyOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &added))
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(added.PrivateKey, "0x"))
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(privateKey.PublicKey).Hex(), added.Address)
+	require.Len(t, strings.Fields(added.Mnemonic), 24)
+	_, err = runEthKeys(t, home, "", "add", "generated")
+	require.Error(t, err)
+
+	out, err = runEthKeys(t, home, "", "show", "generated", "-a")
+	require.NoError(t, err)
+	require.Equal(t, added.Address, strings.TrimSpace(out))
+
+	// the keyring holds every key once, the mnemonic recovers the key after it is deleted, which must be confirmed
+	_, err = runEthKeys(t, home, added.Mnemonic+"\n", "import", "recovered", "--recover")
+	require.Error(t, err)
+	_, err = runEthKeys(t, home, "n\n", "delete", "generated")
+	require.NoError(t, err)
+	_, err = runEthKeys(t, home, "", "show", "generated")
+	require.NoError(t, err)
+	_, err = runEthKeys(t, home, "y\n", "delete", "generated")
+	require.NoError(t, err)
+	_, err = runEthKeys(t, home, "", "show", "generated")
+	require.Error(t, err)
+	out, err = runEthKeys(t, home, added.Mnemonic+"\n", "import", "recovered", "--recover")
+	require.NoError(t, err)
+	var recovered cmd.EthereumKeyOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &recovered))
+	require.Equal(t, added.Address, recovered.Address)
+	require.Equal(t, added.PublicKey, recovered.PublicKey)
+
+	// a hex private key is imported as it is
+	hexKey := "0x" + strings.Repeat("ab", 32)
+	out, err = runEthKeys(t, home, hexKey+"\n", "import", "hex")
+	require.NoError(t, err)
+	var imported cmd.EthereumKeyOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &imported))
+	importedKey, err := crypto.HexToECDSA(strings.Repeat("ab", 32))
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(importedKey.PublicKey).Hex(), imported.Address)
+	_, err = runEthKeys(t, home, "not a key\n", "import", "invalid")
+	require.Error(t, err)
+
+	// an exported keystore can be imported back, but only with its passphrase
+	_, err = runEthKeys(t, home, "password1\npassword2\n", "export", "hex")
+	require.Error(t, err)
+	out, err = runEthKeys(t, home, "password1\npassword1\n", "export", "hex")
+	require.NoError(t, err)
+	keystoreFile := filepath.Join(t.TempDir(), "keystore.json")
+	require.NoError(t, os.WriteFile(keystoreFile, []byte(out), 0o600))
+	_, err = runEthKeys(t, home, "", "delete", "hex", "--yes")
+	require.NoError(t, err)
+	_, err = runEthKeys(t, home, "password2\n", "import", "keystore", "--keystore", keystoreFile)
+	require.Error(t, err)
+	out, err = runEthKeys(t, home, "password1\n", "import", "keystore", "--keystore", keystoreFile)
+	require.NoError(t, err)
+	var fromKeystore cmd.EthereumKeyOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &fromKeystore))
+	require.Equal(t, imported.Address, fromKeystore.Address)
+
+	// signatures are made like the orchestrator's confirms
+	checkpoint := crypto.Keccak256([]byte("checkpoint"))
+	out, err = runEthKeys(t, home, "", "sign", "keystore", hex.EncodeToString(checkpoint))
+	require.NoError(t, err)
+	var signed cmd.EthereumSignatureOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &signed))
+	signature, err := hex.DecodeString(signed.Signature)
+	require.NoError(t, err)
+	signer, err := types.NewEthAddress(imported.Address)
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateEthereumSignature(checkpoint, signature, *signer))
+
+	// the delegate keys proof of possession is accepted by the msg server checks for this validator and chain only
+	valAddress := sdk.ValAddress(bytes.Repeat([]byte{0x1}, 20))
+	_, err = runEthKeys(t, home, "", "sign-delegate-keys", "keystore", valAddress.String())
+	require.Error(t, err)
+	out, err = runEthKeys(t, home, "", "sign-delegate-keys", "keystore", valAddress.String(), "--chain-id", "gravity-test-1")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(out), &signed))
+	signature, err = hex.DecodeString(signed.Signature)
+	require.NoError(t, err)
+	msg := types.NewMsgSetOrchestratorAddress(valAddress, sdk.AccAddress(valAddress), *signer, signature)
+	require.NoError(t, msg.VerifyEthSignature("gravity-test-1"))
+	require.Error(t, msg.VerifyEthSignature("gravity-test-2"))
+
+	out, err = runEthKeys(t, home, "", "list")
+	require.NoError(t, err)
+	var listed []cmd.EthereumKeyOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &listed))
+	require.Len(t, listed, 2)
+	for _, key := range listed {
+		require.Empty(t, key.PrivateKey)
+	}
+}
@@ -0,0 +1,293 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+const (
+	flagExportHeight    = "height"
+	flagExportFormat    = "format"
+	flagExportOutputDir = "output-dir"
+)
+
+// ExportBridgeStateCmd dumps the bridge state at a height from the application database of the node home, without
+// exporting the state of every other module like the export command does
+func ExportBridgeStateCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "export-bridge-state",
+		Short: "Dump the bridge state at a height from the application database for forensic analysis",
+		Long: `Dump the pool, batch, logic call, attestation and valset state of every bridged EVM chain at a height.
+
+The application database of the node home is opened read-only and the gravity store is read at the requested
+version, which must not be pruned. The node must be stopped, or the command pointed with --home at a copy of its
+data directory. The dump is written as JSON to stdout or --output-dir, or as one CSV file per section to --output-dir.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			height, err := cmd.Flags().GetInt64(flagExportHeight)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(flagExportFormat)
+			if err != nil {
+				return err
+			}
+			outputDir, err := cmd.Flags().GetString(flagExportOutputDir)
+			if err != nil {
+				return err
+			}
+			if format != "json" && format != "csv" {
+				return fmt.Errorf("unknown format %s, expected json or csv", format)
+			}
+			if format == "csv" && outputDir == "" {
+				return fmt.Errorf("the csv format writes one file per section, --%s is required", flagExportOutputDir)
+			}
+
+			dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+			db, err := openApplicationDBReadOnly(dataDir)
+			if err != nil {
+				return errors.Wrapf(err, "failed to open the application database in %s read-only", dataDir)
+			}
+			defer db.Close()
+
+			dump, err := exportBridgeState(serverCtx, db, height)
+			if err != nil {
+				return err
+			}
+
+			if format == "csv" {
+				return writeBridgeStateCSV(outputDir, dump)
+			}
+			bz, err := json.MarshalIndent(dump, "", "  ")
+			if err != nil {
+				return err
+			}
+			if outputDir == "" {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return err
+			}
+			if err := os.MkdirAll(outputDir, 0o755); err != nil {
+				return err
+			}
+			return os.WriteFile(filepath.Join(outputDir, fmt.Sprintf("bridge_state_%d.json", dump.Height)), bz, 0o644)
+		},
+	}
+
+	cmd.Flags().Int64(flagExportHeight, -1, "Height to dump the bridge state at, the latest committed height by default")
+	cmd.Flags().String(flagExportFormat, "json", "Format of the dump, json or csv")
+	cmd.Flags().String(flagExportOutputDir, "", "Directory to write the dump to, required by the csv format")
+	return cmd
+}
+
+// errReadOnlyDB is returned by every write to a database opened by openApplicationDBReadOnly
+var errReadOnlyDB = errors.New("the application database is opened read-only")
+
+// openApplicationDBReadOnly opens the application database in dataDir with the database backend the node was built
+// with (sdk.DBBackend). goleveldb is opened in its read-only mode, the other backends have no read-only mode in tm-db
+// so they are wrapped to reject every write instead
+func openApplicationDBReadOnly(dataDir string) (dbm.DB, error) {
+	backend := dbm.GoLevelDBBackend
+	if sdk.DBBackend != "" {
+		backend = dbm.BackendType(sdk.DBBackend)
+	}
+	if backend == dbm.GoLevelDBBackend {
+		// nolint: exhaustruct
+		return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+	}
+	db, err := dbm.NewDB("application", backend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	return readOnlyDB{DB: db}, nil
+}
+
+// readOnlyDB is a dbm.DB which fails every write
+type readOnlyDB struct {
+	dbm.DB
+}
+
+func (readOnlyDB) Set([]byte, []byte) error     { return errReadOnlyDB }
+func (readOnlyDB) SetSync([]byte, []byte) error { return errReadOnlyDB }
+func (readOnlyDB) Delete([]byte) error          { return errReadOnlyDB }
+func (readOnlyDB) DeleteSync([]byte) error      { return errReadOnlyDB }
+
+func (db readOnlyDB) NewBatch() dbm.Batch {
+	return readOnlyBatch{Batch: db.DB.NewBatch()}
+}
+
+// readOnlyBatch is a dbm.Batch which can not be written
+type readOnlyBatch struct {
+	dbm.Batch
+}
+
+func (readOnlyBatch) Set([]byte, []byte) error { return errReadOnlyDB }
+func (readOnlyBatch) Delete([]byte) error      { return errReadOnlyDB }
+func (readOnlyBatch) Write() error             { return errReadOnlyDB }
+func (readOnlyBatch) WriteSync() error         { return errReadOnlyDB }
+
+// exportBridgeState loads the application from db and dumps the bridge state of the store at height, or at the latest
+// committed height if height is -1
+func exportBridgeState(serverCtx *server.Context, db dbm.DB, height int64) (types.BridgeStateDump, error) {
+	// the store is loaded here rather than by NewGravityApp, which exits the process if loading fails
+	gravity := app.NewGravityApp(
+		serverCtx.Logger, db, nil, false, map[int64]bool{}, serverCtx.Config.RootDir, uint(1),
+		app.MakeEncodingConfig(), serverCtx.Viper,
+	)
+	if err := gravity.LoadLatestVersion(); err != nil {
+		return types.BridgeStateDump{}, errors.Wrap(err, "failed to load the application state")
+	}
+	latest := gravity.LastBlockHeight()
+	if height == -1 {
+		height = latest
+	}
+	if height <= 0 || height > latest {
+		return types.BridgeStateDump{}, fmt.Errorf("height %d is not committed, the latest height is %d", height, latest)
+	}
+	store, err := gravity.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return types.BridgeStateDump{}, errors.Wrapf(err, "failed to load the state at height %d, it may be pruned", height)
+	}
+	// nolint: exhaustruct
+	ctx := sdk.NewContext(store, tmproto.Header{Height: height}, false, serverCtx.Logger)
+	return keeper.ExportBridgeState(ctx, *gravity.GravityKeeper)
+}
+
+// bridgeStateTable is a section of a bridge state dump as written to a CSV file
+type bridgeStateTable struct {
+	name   string
+	header []string
+	rows   [][]string
+}
+
+func (t *bridgeStateTable) add(row ...string) {
+	t.rows = append(t.rows, row)
+}
+
+// writeBridgeStateCSV writes every section of the dump into its own CSV file in dir, with a row per record
+func writeBridgeStateCSV(dir string, dump types.BridgeStateDump) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, table := range bridgeStateTables(dump) {
+		file, err := os.Create(filepath.Join(dir, fmt.Sprintf("%s_%d.csv", table.name, dump.Height)))
+		if err != nil {
+			return err
+		}
+		w := csv.NewWriter(file)
+		if err := w.Write(table.header); err != nil {
+			file.Close()
+			return err
+		}
+		if err := w.WriteAll(table.rows); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bridgeStateTables flattens the dump into tables, transfers and confirms of every kind share a table so that the
+// life of a transfer or the signatures of a validator can be followed in one place
+// nolint: exhaustruct
+func bridgeStateTables(dump types.BridgeStateDump) []*bridgeStateTable {
+	var (
+		u         = func(n uint64) string { return strconv.FormatUint(n, 10) }
+		nonces    = &bridgeStateTable{name: "nonces", header: []string{"evm_chain_prefix", "last_tx_pool_id", "last_batch_id", "last_observed_nonce", "last_observed_ethereum_block_height", "last_observed_cosmos_block_height", "latest_valset_nonce", "last_slashed_valset_nonce", "last_slashed_batch_block", "last_slashed_logic_call_block"}}
+		delegates = &bridgeStateTable{name: "delegate_keys", header: []string{"validator", "orchestrator", "eth_address"}}
+		eventNons = &bridgeStateTable{name: "validator_event_nonces", header: []string{"evm_chain_prefix", "validator", "event_nonce"}}
+		transfers = &bridgeStateTable{name: "transfers", header: []string{"evm_chain_prefix", "status", "batch_nonce", "batch_timeout", "batch_cosmos_block_created", "id", "sender", "dest_address", "token_contract", "amount", "fee_contract", "fee"}}
+		confirms  = &bridgeStateTable{name: "confirms", header: []string{"evm_chain_prefix", "kind", "nonce", "subject", "orchestrator", "eth_signer", "signature"}}
+		calls     = &bridgeStateTable{name: "logic_calls", header: []string{"evm_chain_prefix", "invalidation_id", "invalidation_nonce", "logic_contract_address", "timeout", "cosmos_block_created", "transfers", "fees", "payload"}}
+		atts      = &bridgeStateTable{name: "attestations", header: []string{"evm_chain_prefix", "event_nonce", "eth_block_height", "claim_type", "claim_hash", "observed", "height", "votes", "claim"}}
+		valsets   = &bridgeStateTable{name: "valsets", header: []string{"evm_chain_prefix", "nonce", "height", "reward_amount", "reward_token", "member_eth_address", "member_power"}}
+		forwards  = &bridgeStateTable{name: "pending_ibc_auto_forwards", header: []string{"evm_chain_prefix", "event_nonce", "foreign_receiver", "token", "ibc_channel"}}
+		denoms    = &bridgeStateTable{name: "erc20_to_denoms", header: []string{"evm_chain_prefix", "erc20", "denom"}}
+	)
+	tokens := func(tokens []types.ERC20Token) string {
+		var s []string
+		for _, token := range tokens {
+			s = append(s, token.Amount.String()+" "+token.Contract)
+		}
+		return strings.Join(s, ";")
+	}
+	transfer := func(chain, status string, batchNonce, batchTimeout, created uint64, tx types.OutgoingTransferTx) {
+		transfers.add(chain, status, u(batchNonce), u(batchTimeout), u(created), u(tx.Id), tx.Sender, tx.DestAddress,
+			tx.Erc20Token.Contract, tx.Erc20Token.Amount.String(), tx.Erc20Fee.Contract, tx.Erc20Fee.Amount.String())
+	}
+
+	for _, key := range dump.DelegateKeys {
+		delegates.add(key.Validator, key.Orchestrator, key.EthAddress)
+	}
+	for _, c := range dump.EvmChains {
+		chain := c.EvmChainPrefix
+		nonces.add(chain, u(dump.LastTxPoolId), u(dump.LastBatchId), u(c.LastObservedNonce),
+			u(c.LastObservedEthereumBlockHeight.EthereumBlockHeight), u(c.LastObservedEthereumBlockHeight.CosmosBlockHeight),
+			u(c.LatestValsetNonce), u(c.LastSlashedValsetNonce), u(c.LastSlashedBatchBlock), u(c.LastSlashedLogicCallBlock))
+		for _, n := range c.ValidatorEventNonces {
+			eventNons.add(chain, n.Validator, u(n.EventNonce))
+		}
+		for _, tx := range c.UnbatchedTransfers {
+			transfer(chain, "unbatched", 0, 0, 0, tx)
+		}
+		for _, batch := range c.Batches {
+			for _, tx := range batch.Transactions {
+				transfer(chain, "batch", batch.BatchNonce, batch.BatchTimeout, batch.CosmosBlockCreated, tx)
+			}
+		}
+		for _, confirm := range c.ValsetConfirms {
+			confirms.add(chain, "valset", u(confirm.Nonce), "", confirm.Orchestrator, confirm.EthAddress, confirm.Signature)
+		}
+		for _, confirm := range c.BatchConfirms {
+			confirms.add(chain, "batch", u(confirm.Nonce), confirm.TokenContract, confirm.Orchestrator, confirm.EthSigner, confirm.Signature)
+		}
+		for _, confirm := range c.LogicCallConfirms {
+			confirms.add(chain, "logic_call", u(confirm.InvalidationNonce), confirm.InvalidationId, confirm.Orchestrator, confirm.EthSigner, confirm.Signature)
+		}
+		for _, call := range c.LogicCalls {
+			calls.add(chain, hex.EncodeToString(call.InvalidationId), u(call.InvalidationNonce), call.LogicContractAddress,
+				u(call.Timeout), u(call.CosmosBlockCreated), tokens(call.Transfers), tokens(call.Fees), hex.EncodeToString(call.Payload))
+		}
+		for _, att := range c.Attestations {
+			atts.add(chain, u(att.EventNonce), u(att.EthBlockHeight), att.ClaimType, att.ClaimHash, strconv.FormatBool(att.Observed),
+				u(att.Height), strings.Join(att.Votes, ";"), string(att.Claim))
+		}
+		for _, valset := range c.Valsets {
+			for _, member := range valset.Members {
+				valsets.add(chain, u(valset.Nonce), u(valset.Height), valset.RewardAmount.String(), valset.RewardToken,
+					member.EthereumAddress, u(member.Power))
+			}
+		}
+		for _, forward := range c.PendingIbcAutoForwards {
+			forwards.add(chain, u(forward.EventNonce), forward.ForeignReceiver, forward.Token.String(), forward.IbcChannel)
+		}
+		for _, erc20ToDenom := range c.Erc20ToDenoms {
+			denoms.add(chain, erc20ToDenom.Erc20, erc20ToDenom.Denom)
+		}
+	}
+	return []*bridgeStateTable{nonces, delegates, eventNons, transfers, confirms, calls, atts, valsets, forwards, denoms}
+}
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Tests that the application database is opened without allowing writes
+func TestOpenApplicationDBReadOnly(t *testing.T) {
+	dataDir := t.TempDir()
+	writable, err := dbm.NewGoLevelDB("application", dataDir)
+	require.NoError(t, err)
+	require.NoError(t, writable.Set([]byte("key"), []byte("value")))
+	require.NoError(t, writable.Close())
+
+	db, err := openApplicationDBReadOnly(dataDir)
+	require.NoError(t, err)
+	defer db.Close()
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Error(t, db.Set([]byte("key"), []byte("other")))
+
+	// backends without a read-only mode are wrapped
+	wrapped := readOnlyDB{DB: dbm.NewMemDB()}
+	require.ErrorIs(t, wrapped.Set([]byte("key"), []byte("value")), errReadOnlyDB)
+	require.ErrorIs(t, wrapped.Delete([]byte("key")), errReadOnlyDB)
+	batch := wrapped.NewBatch()
+	defer batch.Close()
+	require.ErrorIs(t, batch.Set([]byte("key"), []byte("value")), errReadOnlyDB)
+	require.ErrorIs(t, batch.Write(), errReadOnlyDB)
+}
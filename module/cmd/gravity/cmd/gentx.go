@@ -20,6 +20,7 @@ import (
 	tmos "github.com/tendermint/tendermint/libs/os"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	gravitycli "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/client/cli"
 	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -55,7 +56,9 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation, oracle key 
 delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. A node 
 ID and Bech32 consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the 
-priv_validator.json file. The following default parameters are included:
+priv_validator.json file. The ethereum key signs the orchestrator key delegation to prove that the validator controls 
+it, the key holding the eth-address is taken from eth_keys unless --eth-key or --eth-signature is given. The following 
+default parameters are included:
     %s
 
 Example:
@@ -135,9 +138,8 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrapf(err, "failed to fetch '%s' from the keyring", name)
 			}
 
-			ethAddress := args[2]
-
-			if err := gravitytypes.ValidateEthAddress(ethAddress); err != nil {
+			ethAddress, err := gravitytypes.NewEthAddress(args[2])
+			if err != nil {
 				return errors.Wrapf(err, "invalid ethereum address")
 			}
 
@@ -197,11 +199,13 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
-			delegateKeySetMsg := &gravitytypes.MsgSetOrchestratorAddress{
-				Validator:    sdk.ValAddress(key.GetAddress()).String(),
-				Orchestrator: orchAddress.String(),
-				EthAddress:   ethAddress,
+			// the ethereum key proves that the validator controls it, or the genesis transaction is rejected
+			valAddress := sdk.ValAddress(key.GetAddress())
+			ethSignature, err := gravitycli.SignDelegateKeys(cmd, clientCtx, valAddress, genDoc.ChainID, *ethAddress)
+			if err != nil {
+				return errors.Wrap(err, "failed to sign the delegate keys with the ethereum key")
 			}
+			delegateKeySetMsg := gravitytypes.NewMsgSetOrchestratorAddress(valAddress, orchAddress, *ethAddress, ethSignature)
 
 			msgs := []sdk.Msg{msg, delegateKeySetMsg}
 
@@ -260,6 +264,7 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
 	cmd.Flags().AddFlagSet(fsCreateValidator)
 	flags.AddTxFlagsToCmd(cmd)
+	gravitycli.AddDelegateKeysFlags(cmd)
 
 	return cmd
 }
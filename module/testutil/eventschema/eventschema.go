@@ -0,0 +1,72 @@
+// Package eventschema locks the schema of the versioned event packages of the modules, indexers decode the events
+// with it
+package eventschema
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/gogoproto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/stretchr/testify/require"
+)
+
+// RequireGoldenSchema asserts that the events of the file keep the schema rendered in the golden file. New events and
+// new fields of an event are added to the golden file with them, renaming, renumbering, retyping or removing a field
+// breaks indexers and belongs in a new version of the events package
+func RequireGoldenSchema(t *testing.T, file *descriptor.FileDescriptorProto, golden string) {
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), RenderSchema(file),
+		"the %s schema changed, only new events and fields may be added to %s", file.GetPackage(), golden)
+}
+
+// RequireNumericFields asserts that the nonces, ids and heights of the events of the file are numbers rather than
+// strings, the exempt field names are skipped
+func RequireNumericFields(t *testing.T, file *descriptor.FileDescriptorProto, exempt ...string) {
+	for _, msg := range file.MessageType {
+		for _, field := range msg.Field {
+			name := field.GetName()
+			if isExempt(name, exempt) {
+				continue
+			}
+			if strings.HasSuffix(name, "nonce") || strings.HasSuffix(name, "height") ||
+				strings.HasSuffix(name, "_id") || strings.HasSuffix(name, "_ids") {
+				require.Equal(t, descriptor.FieldDescriptorProto_TYPE_UINT64, field.GetType(), "%s.%s", msg.GetName(), name)
+			}
+		}
+	}
+}
+
+// RenderSchema renders the name, number and type of every field of every message of the file, in declaration order
+func RenderSchema(file *descriptor.FileDescriptorProto) string {
+	var b strings.Builder
+	for _, msg := range file.MessageType {
+		fmt.Fprintf(&b, "%s.%s\n", file.GetPackage(), msg.GetName())
+		for _, field := range msg.Field {
+			typ := strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+			if field.GetTypeName() != "" {
+				typ = strings.TrimPrefix(field.GetTypeName(), ".")
+			}
+			if field.IsRepeated() {
+				typ = "repeated " + typ
+			}
+			if customType := gogoproto.GetCustomType(field); customType != "" {
+				typ += " (" + customType + ")"
+			}
+			fmt.Fprintf(&b, "  %d %s %s\n", field.GetNumber(), field.GetName(), typ)
+		}
+	}
+	return b.String()
+}
+
+func isExempt(name string, exempt []string) bool {
+	for _, e := range exempt {
+		if name == e {
+			return true
+		}
+	}
+	return false
+}
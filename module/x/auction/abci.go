@@ -2,16 +2,21 @@ package auction
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/keeper"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
 
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // EndBlocker resolves a finished AuctionPeriod and schedules a new one
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 	// Take a snapshot of the total token supply and Auction account balances for assertions at the end of EndBlocker
 	startSupplies := getBankSupplies(ctx, k)
 	startModuleBalance := k.BankKeeper.GetAllBalances(ctx, k.AccountKeeper.GetModuleAddress(types.ModuleName))
@@ -68,9 +73,11 @@ func endAuctionPeriod(ctx sdk.Context, k keeper.Keeper) {
 	var closeError error = nil
 	// Resolve the open auctions
 	k.IterateAuctions(ctx, func(_ []byte, auction types.Auction) (stop bool) {
+		result := types.MetricResultAwarded
 		if auction.HighestBid != nil {
 			closeError = k.CloseAuctionWithWinner(ctx, auction.Id)
 		} else {
+			result = types.MetricResultNoWinner
 			closeError = k.CloseAuctionNoWinner(ctx, auction.Id)
 		}
 
@@ -79,6 +86,10 @@ func endAuctionPeriod(ctx sdk.Context, k keeper.Keeper) {
 			ctx.Logger().Error(errMsg)
 			panic(errMsg)
 		} else {
+			telemetry.IncrCounterWithLabels([]string{types.ModuleName, types.MetricKeyAuctionsClosed}, 1, []metrics.Label{
+				telemetry.NewLabel(types.MetricLabelDenom, auction.Amount.Denom),
+				telemetry.NewLabel(types.MetricLabelResult, result),
+			})
 			return false // Continue iterating through all of them
 		}
 	})
@@ -87,6 +98,12 @@ func endAuctionPeriod(ctx sdk.Context, k keeper.Keeper) {
 	k.DeleteAllAuctions(ctx)
 
 	ctx.EventManager().EmitEvent(types.NewEventPeriodEnd(endingPeriod.StartBlockHeight, endingPeriod.EndBlockHeight))
+	if err := ctx.EventManager().EmitTypedEvent(&eventsv1.EventAuctionPeriodEnded{
+		StartBlockHeight: endingPeriod.StartBlockHeight,
+		EndBlockHeight:   endingPeriod.EndBlockHeight,
+	}); err != nil {
+		panic(fmt.Sprintf("unable to emit auction period end event: %v", err))
+	}
 }
 
 // scheduleNextAuctionPeriod will create a new AuctionPeriod starting on the next block
@@ -105,6 +122,12 @@ func scheduleNextAuctionPeriod(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	ctx.EventManager().EmitEvent(types.NewEventPeriodStart(auctionPeriod.StartBlockHeight, auctionPeriod.EndBlockHeight))
+	if err := ctx.EventManager().EmitTypedEvent(&eventsv1.EventAuctionPeriodStarted{
+		StartBlockHeight: auctionPeriod.StartBlockHeight,
+		EndBlockHeight:   auctionPeriod.EndBlockHeight,
+	}); err != nil {
+		panic(fmt.Sprintf("unable to emit auction period start event: %v", err))
+	}
 }
 
 // getBankSupplies gets the whole list of all coins that exist according to the bank module as of this moment
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/testutil/eventschema"
+)
+
+// schemaGolden holds the rendered schema of every auction.events.v1 event, indexers decode the events with it
+const schemaGolden = "testdata/schema.golden"
+
+// Tests that the events keep the schema indexers were promised, changes other than additions belong in a new
+// auction.events.v2 package
+func TestEventSchema(t *testing.T) {
+	// nolint: exhaustruct
+	file, _ := descriptor.ForMessage(&EventAuctionCreated{})
+	eventschema.RequireGoldenSchema(t, file, schemaGolden)
+}
+
+// Tests that ids and heights are numbers rather than strings
+func TestEventSchemaNumericFields(t *testing.T) {
+	// nolint: exhaustruct
+	file, _ := descriptor.ForMessage(&EventAuctionCreated{})
+	eventschema.RequireNumericFields(t, file)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -272,7 +273,13 @@ func (k Keeper) CloseAuctionWithWinner(ctx sdk.Context, auction_id uint64) error
 
 	ctx.EventManager().EmitEvent(types.NewEventAuctionAward(auction.Id, highestBidInt, highestBidder, auction.Amount.Denom, auction.Amount.Amount))
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&eventsv1.EventAuctionAwarded{
+		AuctionId:  auction.Id,
+		Winner:     highestBidder.String(),
+		WinningBid: highestBidCoin,
+		Amount:     auction.Amount,
+		BidBurned:  burnWinningBids,
+	})
 }
 
 // CloseAuctionNoWinner will transfer auction funds to the auction pool, and emit a related event
@@ -300,5 +307,5 @@ func (k Keeper) CloseAuctionNoWinner(ctx sdk.Context, auction_id uint64) error {
 
 	ctx.EventManager().EmitEvent(types.NewEventAuctionFailure(auction.Id, auction.Amount.Denom, auction.Amount.Amount))
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&eventsv1.EventAuctionFailed{AuctionId: auction.Id, Amount: auction.Amount})
 }
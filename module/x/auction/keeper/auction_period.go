@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
 )
 
@@ -132,6 +133,9 @@ func (k Keeper) CreateAuctionsForAuctionPeriod(ctx sdk.Context) error {
 		}
 
 		ctx.EventManager().EmitEvent(types.NewEventAuction(id, poolCoin.Denom, poolCoin.Amount))
+		if err := ctx.EventManager().EmitTypedEvent(&eventsv1.EventAuctionCreated{AuctionId: id, Amount: poolCoin}); err != nil {
+			return err
+		}
 	}
 
 	return nil
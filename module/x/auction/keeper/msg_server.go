@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
 )
 
@@ -165,6 +168,18 @@ func (m msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (res *types.Msg
 
 	// Emit an event to mark a new highest bidder
 	ctx.EventManager().EmitEvent(types.NewEventNewHighestBidder(msg.AuctionId, sdk.NewIntFromUint64(msg.Amount), oldBidder))
+	if err := ctx.EventManager().EmitTypedEvent(&eventsv1.EventNewHighestBid{
+		AuctionId:      msg.AuctionId,
+		Bidder:         msg.Bidder,
+		Bid:            transferToModule,
+		BidFee:         bidFee,
+		PreviousBidder: oldBidder,
+	}); err != nil {
+		return nil, err
+	}
+	labels := []metrics.Label{telemetry.NewLabel(types.MetricLabelDenom, currentAuction.Amount.Denom)}
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, types.MetricKeyBids}, 1, labels)
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, types.MetricKeyBidAmount}, float32(msg.Amount), labels)
 
 	successfulBid = true
 
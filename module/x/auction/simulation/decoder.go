@@ -0,0 +1,41 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding auction type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		// the period and nonce keys share the "KeyAuction" prefix with the auctions, so they are matched exactly first
+		case bytes.Equal(kvA.Key, []byte(types.KeyAuctionPeriod)):
+			var periodA, periodB types.AuctionPeriod
+			cdc.MustUnmarshal(kvA.Value, &periodA)
+			cdc.MustUnmarshal(kvB.Value, &periodB)
+			return fmt.Sprintf("%v\n%v", periodA, periodB)
+
+		case bytes.Equal(kvA.Key, []byte(types.KeyAuctionNonce)):
+			var nonceA, nonceB types.AuctionId
+			cdc.MustUnmarshal(kvA.Value, &nonceA)
+			cdc.MustUnmarshal(kvB.Value, &nonceB)
+			return fmt.Sprintf("%v\n%v", nonceA, nonceB)
+
+		case bytes.HasPrefix(kvA.Key, []byte(types.KeyAuction)):
+			var auctionA, auctionB types.Auction
+			cdc.MustUnmarshal(kvA.Value, &auctionA)
+			cdc.MustUnmarshal(kvB.Value, &auctionB)
+			return fmt.Sprintf("%v\n%v", auctionA, auctionB)
+
+		default:
+			panic(fmt.Sprintf("invalid auction key prefix %X", kvA.Key))
+		}
+	}
+}
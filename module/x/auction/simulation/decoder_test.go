@@ -0,0 +1,57 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/simulation"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	bidder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	period := types.AuctionPeriod{StartBlockHeight: 10, EndBlockHeight: 20}
+	nonce := types.AuctionId{Id: 3}
+	auction := types.NewAuction(3, sdk.NewInt64Coin("stake", 1000))
+	auction.HighestBid = &types.Bid{BidAmount: 50, BidderAddress: bidder.String()}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: []byte(types.KeyAuctionPeriod), Value: cdc.MustMarshal(&period)},
+			{Key: []byte(types.KeyAuctionNonce), Value: cdc.MustMarshal(&nonce)},
+			{Key: types.GetAuctionKey(auction.Id), Value: cdc.MustMarshal(&auction)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"AuctionPeriod", fmt.Sprintf("%v\n%v", period, period)},
+		{"AuctionNonce", fmt.Sprintf("%v\n%v", nonce, nonce)},
+		{"Auction", fmt.Sprintf("%v\n%v", auction, auction)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
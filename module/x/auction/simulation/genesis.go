@@ -0,0 +1,127 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
+)
+
+// Simulation parameter constants
+const (
+	AuctionLength        = "auction_length"
+	MinBidFee            = "min_bid_fee"
+	NonAuctionableTokens = "non_auctionable_tokens"
+	BurnWinningBids      = "burn_winning_bids"
+	Enabled              = "enabled"
+)
+
+// GenAuctionLength randomized AuctionLength, short enough that several auction periods roll over in a simulation
+func GenAuctionLength(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 2, 50))
+}
+
+// GenMinBidFee randomized MinBidFee
+func GenMinBidFee(r *rand.Rand) uint64 {
+	return uint64(r.Intn(5000))
+}
+
+// GenNonAuctionableTokens randomized NonAuctionableTokens, which always contain the native token and sometimes the
+// bond denom
+func GenNonAuctionableTokens(r *rand.Rand) []string {
+	if r.Intn(4) == 0 {
+		return []string{config.NativeTokenDenom, sdk.DefaultBondDenom}
+	}
+	return []string{config.NativeTokenDenom}
+}
+
+// GenBurnWinningBids randomized BurnWinningBids
+func GenBurnWinningBids(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenEnabled randomized Enabled, the module is usually enabled so that bids can be placed
+func GenEnabled(r *rand.Rand) bool {
+	return r.Intn(10) != 0
+}
+
+// GenActiveAuctions randomized auctions of the first auction period, for the bond denom and a few IBC tokens
+// which are not in nonAuctionableTokens
+func GenActiveAuctions(r *rand.Rand, nonAuctionableTokens []string) []types.Auction {
+	denoms := []string{sdk.DefaultBondDenom}
+	for i := r.Intn(3); i > 0; i-- {
+		hash := make([]byte, 32)
+		r.Read(hash) // nolint: errcheck
+		denoms = append(denoms, fmt.Sprintf("ibc/%X", hash))
+	}
+	// auctions are normally created from the sorted auction pool balances, the module balance invariant expects the
+	// ids to follow the denom order
+	sort.Strings(denoms)
+
+	auctions := []types.Auction{}
+	for _, denom := range denoms {
+		if contains(nonAuctionableTokens, denom) {
+			continue
+		}
+		// auction ids must follow each other from the first one for InitGenesis to accept them
+		id := uint64(len(auctions) + 1)
+		amount := sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000000000)))
+		auctions = append(auctions, types.NewAuction(id, sdk.NewCoin(denom, amount)))
+	}
+	return auctions
+}
+
+// RandomizedGenState generates a random GenesisState for auction, the first auction period starts with the chain and
+// auctions tokens which the simulated app credits to the auction module account
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AuctionLength, &params.AuctionLength, simState.Rand,
+		func(r *rand.Rand) { params.AuctionLength = GenAuctionLength(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinBidFee, &params.MinBidFee, simState.Rand,
+		func(r *rand.Rand) { params.MinBidFee = GenMinBidFee(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, NonAuctionableTokens, &params.NonAuctionableTokens, simState.Rand,
+		func(r *rand.Rand) { params.NonAuctionableTokens = GenNonAuctionableTokens(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BurnWinningBids, &params.BurnWinningBids, simState.Rand,
+		func(r *rand.Rand) { params.BurnWinningBids = GenBurnWinningBids(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Enabled, &params.Enabled, simState.Rand,
+		func(r *rand.Rand) { params.Enabled = GenEnabled(r) },
+	)
+
+	auctionGenesis := types.DefaultGenesis()
+	auctionGenesis.Params = params
+	auctionGenesis.ActivePeriod = &types.AuctionPeriod{StartBlockHeight: 1, EndBlockHeight: 1 + params.AuctionLength}
+	auctionGenesis.ActiveAuctions = GenActiveAuctions(simState.Rand, params.NonAuctionableTokens)
+
+	bz, err := json.MarshalIndent(&auctionGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated auction parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(auctionGenesis)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/simulation"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState, the generated genesis must be
+// valid and only auction auctionable tokens, in the order InitGenesis stores them
+// nolint: exhaustruct
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: 1000,
+			GenState:     make(map[string]json.RawMessage),
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var auctionGenesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &auctionGenesis)
+
+		require.NoError(t, auctionGenesis.ValidateBasic())
+		require.Contains(t, auctionGenesis.Params.NonAuctionableTokens, config.NativeTokenDenom)
+		require.NotNil(t, auctionGenesis.ActivePeriod)
+		require.Equal(t, auctionGenesis.Params.AuctionLength, auctionGenesis.ActivePeriod.EndBlockHeight-auctionGenesis.ActivePeriod.StartBlockHeight)
+		for i, auction := range auctionGenesis.ActiveAuctions {
+			require.Equal(t, uint64(i+1), auction.Id)
+			require.NotContains(t, auctionGenesis.Params.NonAuctionableTokens, auction.Amount.Denom)
+			require.Nil(t, auction.HighestBid)
+			if i > 0 {
+				require.Less(t, auctionGenesis.ActiveAuctions[i-1].Amount.Denom, auction.Amount.Denom)
+			}
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
+)
+
+// Simulation operation weights constants
+// nolint: gosec
+const (
+	OpWeightMsgBid        = "op_weight_msg_bid"
+	OpWeightMsgInvalidBid = "op_weight_msg_invalid_bid"
+
+	DefaultWeightMsgBid        = 100
+	DefaultWeightMsgInvalidBid = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgBid        int
+		weightMsgInvalidBid int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBid, &weightMsgBid, nil,
+		func(_ *rand.Rand) { weightMsgBid = DefaultWeightMsgBid },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgInvalidBid, &weightMsgInvalidBid, nil,
+		func(_ *rand.Rand) { weightMsgInvalidBid = DefaultWeightMsgInvalidBid },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgBid, SimulateMsgBid(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgInvalidBid, SimulateMsgInvalidBid(ak, bk, k)),
+	}
+}
+
+// SimulateMsgBid generates a MsgBid on a random active auction, outbidding the current highest bid (if any) with an
+// account other than the highest bidder which can afford the bid and fee
+func SimulateMsgBid(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		if !params.Enabled {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "auction module is disabled"), nil, nil
+		}
+		auctions := k.GetAllAuctions(ctx)
+		if len(auctions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "no active auctions"), nil, nil
+		}
+		auction := auctions[r.Intn(len(auctions))]
+		bidToken := k.MintKeeper.GetParams(ctx).MintDenom
+
+		minBid := sdk.OneInt()
+		highestBidder := ""
+		if auction.HighestBid != nil {
+			minBid = sdk.NewIntFromUint64(auction.HighestBid.BidAmount)
+			highestBidder = auction.HighestBid.BidderAddress
+		}
+		minBidFee := sdk.NewIntFromUint64(params.MinBidFee)
+
+		var bidder simtypes.Account
+		var available sdk.Int
+		found := false
+		for _, i := range r.Perm(len(accs)) {
+			if accs[i].Address.String() == highestBidder {
+				continue
+			}
+			balance := bk.SpendableCoins(ctx, accs[i].Address).AmountOf(bidToken)
+			if balance.GTE(minBid.Add(minBidFee)) {
+				bidder, available, found = accs[i], balance.Sub(minBid).Sub(minBidFee), true
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "no account can afford to outbid"), nil, nil
+		}
+
+		// raise the bid by a small part of the spare balance, so that other accounts are still able to outbid it
+		extraFee := simtypes.RandomAmount(r, sdk.MinInt(minBidFee, available))
+		extraBid := simtypes.RandomAmount(r, available.Sub(extraFee).QuoRaw(100))
+		amount, fee := minBid.Add(extraBid), minBidFee.Add(extraFee)
+		if !amount.IsUint64() || !fee.IsUint64() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "bid overflows uint64"), nil, nil
+		}
+
+		msg := types.NewMsgBid(auction.Id, bidder.Address.String(), amount.Uint64(), fee.Uint64())
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      bidder,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(sdk.NewCoin(bidToken, amount.Add(fee))),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgInvalidBid generates a MsgBid which passes ValidateBasic but must be rejected by the msg server, and
+// fails the simulation if it is accepted
+func SimulateMsgInvalidBid(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParams(ctx)
+		bidder, _ := simtypes.RandomAcc(r, accs)
+		auctions := k.GetAllAuctions(ctx)
+		bidToken := k.MintKeeper.GetParams(ctx).MintDenom
+
+		var msg *types.MsgBid
+		var reason string
+		switch {
+		case !params.Enabled:
+			msg = types.NewMsgBid(k.GetAuctionNonce(ctx).Id, bidder.Address.String(), 1, params.MinBidFee)
+			reason = "auction module is disabled"
+
+		case r.Intn(4) == 0 && params.MinBidFee > 0:
+			fee := uint64(r.Int63n(int64(params.MinBidFee)))
+			msg = types.NewMsgBid(k.GetAuctionNonce(ctx).Id, bidder.Address.String(), 1, fee)
+			reason = "bid fee below the minimum"
+
+		case r.Intn(3) == 0 || len(auctions) == 0:
+			// auction ids are never reused, so ids past the nonce have never been stored
+			id := k.GetNextAuctionId(ctx) + uint64(r.Intn(100))
+			msg = types.NewMsgBid(id, bidder.Address.String(), 1, params.MinBidFee)
+			reason = "auction does not exist"
+
+		default:
+			auction := auctions[r.Intn(len(auctions))]
+			if auction.HighestBid == nil {
+				// nobody can afford more than the whole supply of the bid token
+				supply := bk.GetSupply(ctx, bidToken).Amount
+				if !supply.IsUint64() || supply.Uint64() == ^uint64(0) {
+					return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "bid token supply overflows uint64"), nil, nil
+				}
+				msg = types.NewMsgBid(auction.Id, bidder.Address.String(), supply.Uint64()+1, params.MinBidFee)
+				reason = "insufficient balance"
+				break
+			}
+
+			highestBid := auction.HighestBid
+			if r.Intn(2) == 0 {
+				if highestAcc, ok := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(highestBid.BidderAddress)); ok {
+					msg = types.NewMsgBid(auction.Id, highestAcc.Address.String(), highestBid.BidAmount, params.MinBidFee)
+					bidder, reason = highestAcc, "highest bidder bidding again"
+					break
+				}
+			}
+			if highestBid.BidAmount <= 1 {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBid, "highest bid cannot be undercut"), nil, nil
+			}
+			amount := uint64(simtypes.RandIntBetween(r, 1, int(highestBid.BidAmount)))
+			msg = types.NewMsgBid(auction.Id, bidder.Address.String(), amount, params.MinBidFee)
+			reason = "bid below the highest bid"
+		}
+
+		account := ak.GetAccount(ctx, bidder.Address)
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, bidder.Address))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenSignedMockTx(
+			r,
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			bidder.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		if _, _, err := app.Deliver(txGen.TxEncoder(), tx); err == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), reason), nil, fmt.Errorf("invalid bid accepted (%s): %v", reason, msg)
+		}
+
+		return simtypes.NewOperationMsg(msg, false, reason, nil), nil, nil
+	}
+}
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// The legacy untyped events below are emitted next to the typed auction.events.v1 events which supersede them, they
+// will be removed in a future release
 const (
 	// When a new auction period starts
 	EventTypePeriodStart         = "auction_period_start"
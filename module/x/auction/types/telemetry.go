@@ -0,0 +1,19 @@
+package types
+
+// Keys of the metrics reported through the node telemetry, every key is prefixed with the module name
+const (
+	// MetricKeyBids counts the bids placed on the auction of a denom
+	MetricKeyBids = "bids"
+	// MetricKeyBidAmount counts the native tokens bid on the auction of a denom, outbid amounts included
+	MetricKeyBidAmount = "bid_amount"
+	// MetricKeyAuctionsClosed counts the auctions of a denom closed at the end of an auction period, by result
+	MetricKeyAuctionsClosed = "auctions_closed"
+
+	MetricLabelDenom  = "denom"
+	MetricLabelResult = "result"
+
+	// MetricResultAwarded labels an auction closed with a winner
+	MetricResultAwarded = "awarded"
+	// MetricResultNoWinner labels an auction closed without bids
+	MetricResultNoWinner = "no_winner"
+)
@@ -2,112 +2,184 @@ package gravity
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 	params := k.GetParams(ctx)
-	slashing(ctx, k)
-	attestationTally(ctx, k)
-	cleanupTimedOutBatches(ctx, k)
-	cleanupTimedOutLogicCalls(ctx, k)
-	createValsets(ctx, k)
-	pruneValsets(ctx, k, params)
-	pruneAttestations(ctx, k)
+	// Every bridged EVM chain has its own validator sets, batches, logic calls and attestations
+	for _, evmChain := range params.AllEvmChains() {
+		// chains added by governance after genesis start from zero nonces
+		k.InitEvmChain(ctx, evmChain.EvmChainPrefix)
+		slashing(ctx, k, evmChain.EvmChainPrefix)
+		attestationTally(ctx, k, evmChain)
+		cleanupTimedOutBatches(ctx, k, evmChain.EvmChainPrefix)
+		cleanupTimedOutLogicCalls(ctx, k, evmChain.EvmChainPrefix)
+		createValsets(ctx, k, evmChain.EvmChainPrefix, params)
+		pruneValsets(ctx, k, evmChain.EvmChainPrefix, params)
+		pruneAttestations(ctx, k, evmChain.EvmChainPrefix)
+		if ctx.BlockHeight()%keeper.PoolMetricsInterval == 0 {
+			k.SetPoolMetrics(ctx, evmChain.EvmChainPrefix)
+		}
+	}
+	k.ExpireMerkleAirdrops(ctx)
 }
 
-func createValsets(ctx sdk.Context, k keeper.Keeper) {
+func createValsets(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, params types.Params) {
 	// Auto ValsetRequest Creation.
 	// WARNING: do not use k.GetLastObservedValset in this function, it *will* result in losing control of the bridge
 	// 1. If there are no valset requests, create a new one.
-	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
+	// 2. If at least one validator started unbonding since the latest valset request. (we persist last unbonded block height in hooks.go)
 	// This will make sure the unbonding validator has to provide an attestation to a new Valset
 	// that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of CurrentValset and latest valset request is > 5%
+	// 3. If power change between validators of CurrentValset and latest valset request is > ValsetPowerDiffThreshold
+	// 4. If the latest valset request is ValsetMaxAge blocks old, keeping the Ethereum keys of the valset fresh
+	// Triggers 2-4 are deferred until the latest valset request is ValsetMinInterval blocks old
 
 	// get the last valsets to compare against
-	latestValset := k.GetLatestValset(ctx)
-	lastUnbondingHeight := k.GetLastUnBondingBlockHeight(ctx)
+	latestValset := k.GetLatestValset(ctx, evmChainPrefix)
+	if latestValset == nil {
+		requestValset(ctx, k, evmChainPrefix, []string{types.ValsetTriggerNoValset}, 0, "")
+		return
+	}
 
-	significantPowerDiff := false
-	if latestValset != nil {
-		vs, err := k.GetCurrentValset(ctx)
-		if err != nil {
-			// this condition should only occur in the simulator
-			// ref : https://github.com/Gravity-Bridge/Gravity-Bridge/issues/35
-			if err == types.ErrNoValidators {
-				ctx.Logger().Error("no bonded validators",
-					"cause", err.Error(),
-				)
-				return
-			}
-			panic(err)
-		}
-		intCurrMembers, err := types.BridgeValidators(vs.Members).ToInternal()
-		if err != nil {
-			panic(sdkerrors.Wrap(err, "invalid current valset members"))
-		}
-		intLatestMembers, err := types.BridgeValidators(latestValset.Members).ToInternal()
-		if err != nil {
-			panic(sdkerrors.Wrap(err, "invalid latest valset members"))
+	vs, err := k.GetCurrentValset(ctx, evmChainPrefix)
+	if err != nil {
+		// this condition should only occur in the simulator
+		// ref : https://github.com/Gravity-Bridge/Gravity-Bridge/issues/35
+		if err == types.ErrNoValidators {
+			ctx.Logger().Error("no bonded validators",
+				"cause", err.Error(),
+			)
+			return
 		}
+		panic(err)
+	}
+	intCurrMembers, err := types.BridgeValidators(vs.Members).ToInternal()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid current valset members"))
+	}
+	intLatestMembers, err := types.BridgeValidators(latestValset.Members).ToInternal()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid latest valset members"))
+	}
+	powerDiff := intCurrMembers.PowerDiff(*intLatestMembers)
+
+	currentHeight := uint64(ctx.BlockHeight())
+	var triggers []string
+	if k.GetLastUnBondingBlockHeight(ctx) > latestValset.Height {
+		triggers = append(triggers, types.ValsetTriggerUnbonding)
+	}
+	if powerDiff > params.ValsetPowerDiffThreshold.MustFloat64() {
+		triggers = append(triggers, types.ValsetTriggerPowerDiff)
+	}
+	if params.ValsetMaxAge != 0 && currentHeight >= latestValset.Height+params.ValsetMaxAge {
+		triggers = append(triggers, types.ValsetTriggerMaxAge)
+	}
+	if len(triggers) == 0 {
+		return
+	}
 
-		significantPowerDiff = intCurrMembers.PowerDiff(*intLatestMembers) > 0.05
+	formattedPowerDiff := strconv.FormatFloat(powerDiff, 'f', -1, 64)
+	nextHeight := latestValset.Height + params.ValsetMinInterval
+	if currentHeight < nextHeight {
+		if err := ctx.EventManager().EmitTypedEvents(
+			&types.EventValsetUpdateDeferred{
+				EvmChainPrefix: evmChainPrefix,
+				Triggers:       triggers,
+				PowerDiff:      formattedPowerDiff,
+				NextHeight:     fmt.Sprint(nextHeight),
+			},
+			&eventsv1.EventValsetUpdateDeferred{
+				EvmChainPrefix: evmChainPrefix,
+				Triggers:       triggers,
+				PowerDiff:      powerDiff,
+				NextHeight:     nextHeight,
+			},
+		); err != nil {
+			panic(err)
+		}
+		return
 	}
+	requestValset(ctx, k, evmChainPrefix, triggers, powerDiff, formattedPowerDiff)
+}
 
-	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || significantPowerDiff {
-		// if the conditions are true, put in a new validator set request to be signed and submitted to Ethereum
-		k.SetValsetRequest(ctx)
+// requestValset puts in a new validator set request to be signed and submitted to the EVM chain, emitting the
+// triggers which caused it. The legacy event reports the power difference as legacyPowerDiff, which is empty when there
+// was no valset to compare against
+func requestValset(
+	ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, triggers []string, powerDiff float64, legacyPowerDiff string,
+) {
+	valset := k.SetValsetRequest(ctx, evmChainPrefix)
+	if err := ctx.EventManager().EmitTypedEvents(
+		&types.EventValsetUpdateTriggered{
+			EvmChainPrefix: evmChainPrefix,
+			Nonce:          fmt.Sprint(valset.Nonce),
+			Triggers:       triggers,
+			PowerDiff:      legacyPowerDiff,
+		},
+		&eventsv1.EventValsetUpdateTriggered{
+			EvmChainPrefix: evmChainPrefix,
+			ValsetNonce:    valset.Nonce,
+			Triggers:       triggers,
+			PowerDiff:      powerDiff,
+		},
+	); err != nil {
+		panic(err)
 	}
 }
 
-func pruneValsets(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+func pruneValsets(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, params types.Params) {
 	// Validator set pruning
 	// prune all validator sets with a nonce less than the
 	// last observed nonce, they can't be submitted any longer
 	// Only prune valsets after the signed valsets window has passed
 	// so that slashing can occur the block before we remove them
-	lastObserved := k.GetLastObservedValset(ctx)
+	lastObserved := k.GetLastObservedValset(ctx, evmChainPrefix)
 	currentBlock := uint64(ctx.BlockHeight())
 	tooEarly := currentBlock < params.SignedValsetsWindow
 	if lastObserved != nil && !tooEarly {
 		earliestToPrune := currentBlock - params.SignedValsetsWindow
-		sets := k.GetValsets(ctx)
+		sets := k.GetValsets(ctx, evmChainPrefix)
 		for _, set := range sets {
 			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune {
-				k.DeleteValset(ctx, set.Nonce)
-				k.DeleteValsetConfirms(ctx, set.Nonce)
+				k.DeleteValset(ctx, evmChainPrefix, set.Nonce)
+				k.DeleteValsetConfirms(ctx, evmChainPrefix, set.Nonce)
 			}
 		}
 	}
 }
 
-func slashing(ctx sdk.Context, k keeper.Keeper) {
+func slashing(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string) {
 	params := k.GetParams(ctx)
 
 	// Slash validator for not confirming valset requests, batch requests, logic call requests
-	valsetSlashing(ctx, k, params)
-	batchSlashing(ctx, k, params)
-	logicCallSlashing(ctx, k, params)
+	valsetSlashing(ctx, k, evmChainPrefix, params)
+	batchSlashing(ctx, k, evmChainPrefix, params)
+	logicCallSlashing(ctx, k, evmChainPrefix, params)
 }
 
 // Iterate over all attestations currently being voted on in order of nonce and
 // "Observe" those who have passed the threshold. Break the loop once we see
 // an attestation that has not passed the threshold
-func attestationTally(ctx sdk.Context, k keeper.Keeper) {
-	params := k.GetParams(ctx)
+func attestationTally(ctx sdk.Context, k keeper.Keeper, evmChain types.EvmChainParams) {
 	// bridge is currently disabled, do not process attestations from Ethereum
-	if !params.BridgeActive {
+	if !evmChain.BridgeActive {
 		return
 	}
+	evmChainPrefix := evmChain.EvmChainPrefix
 
-	attmap, keys := k.GetAttestationMapping(ctx)
+	attmap, keys := k.GetAttestationMapping(ctx, evmChainPrefix)
 
 	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
 	// a slice with one or more attestations at that event nonce. There can be multiple attestations
@@ -133,7 +205,7 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 			// we skip the other attestations and move on to the next nonce again.
 			// If no attestation becomes observed, when we get to the next nonce, every attestation in
 			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
+			if nonce == uint64(k.GetLastObservedEventNonce(ctx, evmChainPrefix))+1 {
 				k.TryAttestation(ctx, &att)
 			}
 		}
@@ -149,15 +221,17 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 // here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
 // project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
 // AND any deposit or withdraw has occurred to update the Ethereum block height.
-func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
-	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
-	batches := k.GetOutgoingTxBatches(ctx)
+func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string) {
+	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx, evmChainPrefix).EthereumBlockHeight
+	batches := k.GetOutgoingTxBatches(ctx, evmChainPrefix)
 	for _, batch := range batches {
 		if batch.BatchTimeout < ethereumHeight {
-			err := k.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce)
+			err := k.CancelOutgoingTXBatch(ctx, evmChainPrefix, batch.TokenContract, batch.BatchNonce)
 			if err != nil {
 				panic("Failed to cancel outgoing txbatch!")
 			}
+			telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyBatchesTimedOut), 1,
+				types.EvmChainMetricLabels(evmChainPrefix, types.TokenMetricLabel(batch.TokenContract)))
 		}
 	}
 }
@@ -171,12 +245,12 @@ func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper) {
 // here is the Ethereum block height at the time of the last Deposit or Withdraw to be observed. It's very important we do not
 // project, if we do a slowdown on ethereum could cause a double spend. Instead timeouts will *only* occur after the timeout period
 // AND any deposit or withdraw has occurred to update the Ethereum block height.
-func cleanupTimedOutLogicCalls(ctx sdk.Context, k keeper.Keeper) {
-	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
-	calls := k.GetOutgoingLogicCalls(ctx)
+func cleanupTimedOutLogicCalls(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string) {
+	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx, evmChainPrefix).EthereumBlockHeight
+	calls := k.GetOutgoingLogicCalls(ctx, evmChainPrefix)
 	for _, call := range calls {
 		if call.Timeout < ethereumHeight {
-			err := k.CancelOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
+			err := k.CancelOutgoingLogicCall(ctx, evmChainPrefix, call.InvalidationId, call.InvalidationNonce)
 			if err != nil {
 				panic("Failed to cancel outgoing logic call!")
 			}
@@ -187,8 +261,8 @@ func cleanupTimedOutLogicCalls(ctx sdk.Context, k keeper.Keeper) {
 // prepValsetConfirms loads all confirmations into a hashmap indexed by validatorAddr
 // reducing the lookup time dramatically and separating out the task of looking up
 // the orchestrator for each validator
-func prepValsetConfirms(ctx sdk.Context, k keeper.Keeper, nonce uint64) map[string]types.MsgValsetConfirm {
-	confirms := k.GetValsetConfirms(ctx, nonce)
+func prepValsetConfirms(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, nonce uint64) map[string]types.MsgValsetConfirm {
+	confirms := k.GetValsetConfirms(ctx, evmChainPrefix, nonce)
 	// bytes are incomparable in go, so we convert the sdk.ValAddr bytes to a string
 	ret := make(map[string]types.MsgValsetConfirm)
 	for _, confirm := range confirms {
@@ -208,19 +282,19 @@ func prepValsetConfirms(ctx sdk.Context, k keeper.Keeper, nonce uint64) map[stri
 }
 
 // valsetSlashing slashes validators who have not signed validator sets during the signing window
-func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+func valsetSlashing(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, params types.Params) {
 	// don't slash in the beginning before there aren't even SignedValsetsWindow blocks yet
 	if uint64(ctx.BlockHeight()) <= params.SignedValsetsWindow {
 		return
 	}
 
-	unslashedValsets := k.GetUnSlashedValsets(ctx, params.SignedValsetsWindow)
+	unslashedValsets := k.GetUnSlashedValsets(ctx, evmChainPrefix, params.SignedValsetsWindow)
 
 	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	unbondingValidators := getUnbondingValidators(ctx, k)
 
 	for _, vs := range unslashedValsets {
-		confirms := prepValsetConfirms(ctx, k, vs.Nonce)
+		confirms := prepValsetConfirms(ctx, k, evmChainPrefix, vs.Nonce)
 
 		// SLASH BONDED VALIDTORS who didn't attest valset request
 
@@ -237,24 +311,10 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if exist && startedBeforeValsetCreated {
 				// Check if validator has confirmed valset or not
 				_, found := confirms[val.GetOperator().String()]
+				k.RecordBridgeDuty(ctx, val.GetOperator(), types.BRIDGE_DUTY_VALSET, found)
 				// slash validators for not confirming valsets
 				if !found {
-					// refresh validator before slashing/jailing
-					val = updateValidator(ctx, k, val.GetOperator())
-					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
-						if err := ctx.EventManager().EmitTypedEvent(
-							&types.EventSignatureSlashing{
-								Type:    types.AttributeKeyValsetSignatureSlashing,
-								Address: consAddr.String(),
-							},
-						); err != nil {
-							panic(fmt.Errorf("Unable to emit slashing event: %v", err))
-						}
-
-						k.StakingKeeper.Jail(ctx, consAddr)
-					}
-
+					k.PunishMissedSignature(ctx, val, params.SlashFractionValset, types.AttributeKeyValsetSignatureSlashing)
 				}
 			}
 		}
@@ -283,44 +343,19 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if exist && startedBeforeValsetCreated && validator.IsUnbonding() && unbondingPeriodEndsAfterSlashingPeriod {
 				// Check if validator has confirmed valset or not
 				_, found := confirms[validator.GetOperator().String()]
+				k.RecordBridgeDuty(ctx, validator.GetOperator(), types.BRIDGE_DUTY_VALSET, found)
 
 				// slash validators for not confirming valsets
 				if !found {
-					// refresh validator before slashing/jailing
-					validator = updateValidator(ctx, k, validator.GetOperator())
-					if !validator.IsJailed() {
-						k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
-						if err := ctx.EventManager().EmitTypedEvent(
-							&types.EventSignatureSlashing{
-								Type:    types.AttributeKeyValsetSignatureSlashing,
-								Address: valConsAddr.String(),
-							},
-						); err != nil {
-							panic(fmt.Errorf("Unable to emit slashing event: %v", err))
-						}
-						k.StakingKeeper.Jail(ctx, valConsAddr)
-					}
+					k.PunishMissedSignature(ctx, validator, params.SlashFractionValset, types.AttributeKeyValsetSignatureSlashing)
 				}
 			}
 		}
 		// then we set the latest slashed valset  nonce
-		k.SetLastSlashedValsetNonce(ctx, vs.Nonce)
+		k.SetLastSlashedValsetNonce(ctx, evmChainPrefix, vs.Nonce)
 	}
 }
 
-// updateValidator is a very specific utility function, used to update the validator object during
-// slashing loops. This allows us to load the validators list at the start of our slashing and only
-// pull in individual validators as needed to check that we are not jailing them twice, or slashing
-// them improperly
-func updateValidator(ctx sdk.Context, k keeper.Keeper, val sdk.ValAddress) stakingtypes.Validator {
-	valObj, found := k.StakingKeeper.GetValidator(ctx, val)
-	if !found {
-		// this should be impossible, we haven't even progressed a single block since we got the list
-		panic("Validator exited set during endblocker?")
-	}
-	return valObj
-}
-
 // getUnbondingValidators gets all currently unbonding validators in groups based on
 // the block at which they will finish validating.
 func getUnbondingValidators(ctx sdk.Context, k keeper.Keeper) (addresses []string) {
@@ -340,8 +375,8 @@ func getUnbondingValidators(ctx sdk.Context, k keeper.Keeper) (addresses []strin
 // prepBatchConfirms loads all confirmations into a hashmap indexed by validatorAddr
 // reducing the lookup time dramatically and separating out the task of looking up
 // the orchestrator for each validator
-func prepBatchConfirms(ctx sdk.Context, k keeper.Keeper, batch types.InternalOutgoingTxBatch) map[string]types.MsgConfirmBatch {
-	confirms := k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)
+func prepBatchConfirms(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, batch types.InternalOutgoingTxBatch) map[string]types.MsgConfirmBatch {
+	confirms := k.GetBatchConfirmByNonceAndTokenContract(ctx, evmChainPrefix, batch.BatchNonce, batch.TokenContract)
 	// bytes are incomparable in go, so we convert the sdk.ValAddr bytes to a string (note this is NOT bech32)
 	ret := make(map[string]types.MsgConfirmBatch)
 	for _, confirm := range confirms {
@@ -363,7 +398,7 @@ func prepBatchConfirms(ctx sdk.Context, k keeper.Keeper, batch types.InternalOut
 // batchSlashing slashes currently bonded validators who have not submitted batch
 // signatures. This is distinct from validator sets, which includes unbonding validators
 // because validator set updates must succeed as validators leave the set, batches will just be re-created
-func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+func batchSlashing(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, params types.Params) {
 	// We look through the full bonded set (the active set)
 	// and we slash users who haven't signed a batch confirmation that is >15hrs in blocks old
 	var maxHeight uint64
@@ -377,10 +412,10 @@ func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 
 	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-	unslashedBatches := k.GetUnSlashedBatches(ctx, maxHeight)
+	unslashedBatches := k.GetUnSlashedBatches(ctx, evmChainPrefix, maxHeight)
 	for _, batch := range unslashedBatches {
 		// SLASH BONDED VALIDTORS who didn't attest batch requests
-		confirms := prepBatchConfirms(ctx, k, batch)
+		confirms := prepBatchConfirms(ctx, k, evmChainPrefix, batch)
 		for _, val := range currentBondedSet {
 			consAddr, err := val.GetConsAddr()
 			if err != nil {
@@ -393,35 +428,23 @@ func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if exist && startedBeforeBatchCreated {
 				// check if validator confirmed the batch
 				_, found := confirms[val.GetOperator().String()]
+				k.RecordBridgeDuty(ctx, val.GetOperator(), types.BRIDGE_DUTY_BATCH, found)
 				// slashing for not confirming the batch
 				if !found {
-					// refresh validator before slashing/jailing
-					val = updateValidator(ctx, k, val.GetOperator())
-					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBatch)
-						if err := ctx.EventManager().EmitTypedEvent(
-							&types.EventSignatureSlashing{
-								Type:    types.AttributeKeyBatchSignatureSlashing,
-								Address: consAddr.String(),
-							},
-						); err != nil {
-							panic(fmt.Errorf("Unable to emit slashing event: %v", err))
-						}
-						k.StakingKeeper.Jail(ctx, consAddr)
-					}
+					k.PunishMissedSignature(ctx, val, params.SlashFractionBatch, types.AttributeKeyBatchSignatureSlashing)
 				}
 			}
 		}
 		// then we set the latest slashed batch block
-		k.SetLastSlashedBatchBlock(ctx, batch.CosmosBlockCreated)
+		k.SetLastSlashedBatchBlock(ctx, evmChainPrefix, batch.CosmosBlockCreated)
 	}
 }
 
 // prepLogicCallConfirms loads all confirmations into a hashmap indexed by validatorAddr
 // reducing the lookup time dramatically and separating out the task of looking up
 // the orchestrator for each validator
-func prepLogicCallConfirms(ctx sdk.Context, k keeper.Keeper, call types.OutgoingLogicCall) map[string]*types.MsgConfirmLogicCall {
-	confirms := k.GetLogicConfirmsByInvalidationIdAndNonce(ctx, call.InvalidationId, call.InvalidationNonce)
+func prepLogicCallConfirms(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, call types.OutgoingLogicCall) map[string]*types.MsgConfirmLogicCall {
+	confirms := k.GetLogicConfirmsByInvalidationIdAndNonce(ctx, evmChainPrefix, call.InvalidationId, call.InvalidationNonce)
 	// bytes are incomparable in go, so we convert the sdk.ValAddr bytes to a string (note this is NOT bech32)
 	ret := make(map[string]*types.MsgConfirmLogicCall)
 	for _, confirm := range confirms {
@@ -443,7 +466,7 @@ func prepLogicCallConfirms(ctx sdk.Context, k keeper.Keeper, call types.Outgoing
 // logicCallSlashing slashes currently bonded validators who have not submitted logicCall
 // signatures. This is distinct from validator sets, which includes unbonding validators
 // because validator set updates must succeed as validators leave the set, logicCalls will just be re-created
-func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, params types.Params) {
 	// We look through the full bonded set (the active set)
 	// and we slash users who haven't signed a batch confirmation that is >15hrs in blocks old
 	var maxHeight uint64
@@ -457,11 +480,11 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 
 	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
-	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, maxHeight)
+	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, evmChainPrefix, maxHeight)
 	for _, call := range unslashedLogicCalls {
 
 		// SLASH BONDED VALIDTORS who didn't attest batch requests
-		confirms := prepLogicCallConfirms(ctx, k, call)
+		confirms := prepLogicCallConfirms(ctx, k, evmChainPrefix, call)
 		for _, val := range currentBondedSet {
 			// Don't slash validators who joined after batch is created
 			consAddr, err := val.GetConsAddr()
@@ -473,26 +496,14 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if exist && startedBeforeCallCreated {
 				// check that the validator confirmed the logic call
 				_, found := confirms[val.GetOperator().String()]
+				k.RecordBridgeDuty(ctx, val.GetOperator(), types.BRIDGE_DUTY_LOGIC_CALL, found)
 				if !found {
-					// refresh validator before slashing/jailing
-					val = updateValidator(ctx, k, val.GetOperator())
-					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionLogicCall)
-						if err := ctx.EventManager().EmitTypedEvent(
-							&types.EventSignatureSlashing{
-								Type:    types.AttributeKeyLogicCallSignatureSlashing,
-								Address: consAddr.String(),
-							},
-						); err != nil {
-							panic(fmt.Errorf("Unable to emit slashing event: %v", err))
-						}
-						k.StakingKeeper.Jail(ctx, consAddr)
-					}
+					k.PunishMissedSignature(ctx, val, params.SlashFractionLogicCall, types.AttributeKeyLogicCallSignatureSlashing)
 				}
 			}
 		}
 		// then we set the latest slashed logic call block
-		k.SetLastSlashedLogicCallBlock(ctx, call.CosmosBlockCreated)
+		k.SetLastSlashedLogicCallBlock(ctx, evmChainPrefix, call.CosmosBlockCreated)
 	}
 }
 
@@ -501,14 +512,14 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 // use. This could be combined with create attestation and save some computation
 // but (A) pruning keeps the iteration small in the first place and (B) there is
 // already enough nuance in the other handler that it's best not to complicate it further
-func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
-	attmap, keys := k.GetAttestationMapping(ctx)
+func pruneAttestations(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string) {
+	attmap, keys := k.GetAttestationMapping(ctx, evmChainPrefix)
 
 	// we delete all attestations earlier than the current event nonce
 	// minus some buffer value. This buffer value is purely to allow
 	// frontends and other UI components to view recent oracle history
 	const eventsToKeep = 1000
-	lastNonce := uint64(k.GetLastObservedEventNonce(ctx))
+	lastNonce := uint64(k.GetLastObservedEventNonce(ctx, evmChainPrefix))
 	var cutoff uint64
 	if lastNonce <= eventsToKeep {
 		return
@@ -524,8 +535,11 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 		// They are ordered by when the first attestation at the event nonce was received.
 		// This order is not important.
 		for _, att := range attmap[nonce] {
-			// delete all before the cutoff
+			// delete all before the cutoff, by then every orchestrator has had its chance to vote on the observed ones
 			if nonce < cutoff {
+				if att.Observed {
+					k.RecordClaimParticipation(ctx, att)
+				}
 				k.DeleteAttestation(ctx, att)
 			}
 		}
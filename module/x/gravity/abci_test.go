@@ -1,6 +1,7 @@
 package gravity
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -25,8 +26,8 @@ func TestValsetCreationIfNotAvailable(t *testing.T) {
 
 	// EndBlocker should set a new validator set if not available
 	EndBlocker(ctx, pk)
-	require.NotNil(t, pk.GetValset(ctx, uint64(pk.GetLatestValsetNonce(ctx))))
-	valsets := pk.GetValsets(ctx)
+	require.NotNil(t, pk.GetValset(ctx, keeper.EthChainPrefix, uint64(pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))))
+	valsets := pk.GetValsets(ctx, keeper.EthChainPrefix)
 	require.True(t, len(valsets) == 1)
 }
 
@@ -35,8 +36,8 @@ func TestValsetCreationUponUnbonding(t *testing.T) {
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
 	pk := input.GravityKeeper
 
-	currentValsetNonce := pk.GetLatestValsetNonce(ctx)
-	pk.SetValsetRequest(ctx)
+	currentValsetNonce := pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix)
+	pk.SetValsetRequest(ctx, keeper.EthChainPrefix)
 
 	input.Context = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 	// begin unbonding
@@ -50,7 +51,7 @@ func TestValsetCreationUponUnbonding(t *testing.T) {
 	EndBlocker(input.Context, pk)
 
 	// TODO: Is this the right check to replace blockHeight == latestValsetNonce with?
-	assert.NotEqual(t, currentValsetNonce, pk.GetLatestValsetNonce(ctx))
+	assert.NotEqual(t, currentValsetNonce, pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))
 }
 
 func TestValsetSlashing_ValsetCreated_Before_ValidatorBonded(t *testing.T) {
@@ -62,13 +63,13 @@ func TestValsetSlashing_ValsetCreated_Before_ValidatorBonded(t *testing.T) {
 	pk := input.GravityKeeper
 	params := input.GravityKeeper.GetParams(ctx)
 
-	vs, err := pk.GetCurrentValset(ctx)
+	vs, err := pk.GetCurrentValset(ctx, keeper.EthChainPrefix)
 	require.NoError(t, err)
 	height := uint64(ctx.BlockHeight()) - (params.SignedValsetsWindow + 1)
 	vs.Height = height
 	vs.Nonce = height
-	pk.StoreValset(ctx, vs)
-	pk.SetLatestValsetNonce(ctx, vs.Nonce)
+	pk.StoreValset(ctx, keeper.EthChainPrefix, vs)
+	pk.SetLatestValsetNonce(ctx, keeper.EthChainPrefix, vs.Nonce)
 
 	EndBlocker(ctx, pk)
 
@@ -87,14 +88,14 @@ func TestValsetSlashing_ValsetCreated_After_ValidatorBonded(t *testing.T) {
 	params := input.GravityKeeper.GetParams(ctx)
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 2)
-	vs, err := pk.GetCurrentValset(ctx)
+	vs, err := pk.GetCurrentValset(ctx, keeper.EthChainPrefix)
 	require.NoError(t, err)
 	height := uint64(ctx.BlockHeight()) - (params.SignedValsetsWindow + 1)
 	vs.Height = height
 
-	vs.Nonce = pk.GetLatestValsetNonce(ctx) + 1
-	pk.StoreValset(ctx, vs)
-	pk.SetLatestValsetNonce(ctx, vs.Nonce)
+	vs.Nonce = pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix) + 1
+	pk.StoreValset(ctx, keeper.EthChainPrefix, vs)
+	pk.SetLatestValsetNonce(ctx, keeper.EthChainPrefix, vs.Nonce)
 
 	for i, orch := range keeper.OrchAddrs {
 		if i == 0 {
@@ -105,7 +106,7 @@ func TestValsetSlashing_ValsetCreated_After_ValidatorBonded(t *testing.T) {
 		require.NoError(t, err)
 
 		conf := types.NewMsgValsetConfirm(vs.Nonce, *ethAddr, orch, "dummysig")
-		pk.SetValsetConfirm(ctx, *conf)
+		pk.SetValsetConfirm(ctx, keeper.EthChainPrefix, *conf)
 	}
 
 	EndBlocker(ctx, pk)
@@ -118,6 +119,11 @@ func TestValsetSlashing_ValsetCreated_After_ValidatorBonded(t *testing.T) {
 	val = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1])
 	require.False(t, val.IsJailed())
 
+	// both validators have the valset recorded in their bridge performance
+	perf := pk.GetValidatorBridgePerformance(ctx, keeper.ValAddrs[0])
+	require.Equal(t, types.BridgeDutyCounts{Expected: 1, Performed: 0}, perf.Current.Valsets)
+	perf = pk.GetValidatorBridgePerformance(ctx, keeper.ValAddrs[1])
+	require.Equal(t, types.BridgeDutyCounts{Expected: 1, Performed: 1}, perf.Current.Valsets)
 }
 
 func TestNonValidatorValsetConfirm(t *testing.T) {
@@ -176,14 +182,14 @@ func TestNonValidatorValsetConfirm(t *testing.T) {
 	require.Equal(t, notNiceVal.Status, stakingtypes.Unbonded)
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 2)
-	vs, err := pk.GetCurrentValset(ctx)
+	vs, err := pk.GetCurrentValset(ctx, keeper.EthChainPrefix)
 	require.NoError(t, err)
 	height := uint64(ctx.BlockHeight()) - (params.SignedValsetsWindow + 1)
 	vs.Height = height
 
-	vs.Nonce = pk.GetLatestValsetNonce(ctx) + 1
-	pk.StoreValset(ctx, vs)
-	pk.SetLatestValsetNonce(ctx, vs.Nonce)
+	vs.Nonce = pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix) + 1
+	pk.StoreValset(ctx, keeper.EthChainPrefix, vs)
+	pk.SetLatestValsetNonce(ctx, keeper.EthChainPrefix, vs.Nonce)
 
 	for i, orch := range keeper.OrchAddrs {
 		if i == 0 {
@@ -194,11 +200,11 @@ func TestNonValidatorValsetConfirm(t *testing.T) {
 		require.NoError(t, err)
 
 		conf := types.NewMsgValsetConfirm(vs.Nonce, *ethAddr, orch, "dummysig")
-		pk.SetValsetConfirm(ctx, *conf)
+		pk.SetValsetConfirm(ctx, keeper.EthChainPrefix, *conf)
 	}
 
 	conf := types.NewMsgValsetConfirm(vs.Nonce, *ethAddr, accAddr, "dummysig")
-	pk.SetValsetConfirm(ctx, *conf)
+	pk.SetValsetConfirm(ctx, keeper.EthChainPrefix, *conf)
 
 	// Now remove all the stake
 	_, err = sh(
@@ -233,7 +239,7 @@ func TestValsetSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testing.T)
 
 	// Create Valset request
 	ctx = ctx.WithBlockHeight(valsetRequestHeight)
-	vs := pk.SetValsetRequest(ctx)
+	vs := pk.SetValsetRequest(ctx, keeper.EthChainPrefix)
 
 	// Start Unbonding validators
 	// Validator-1  Unbond slash window is not expired. if not attested, slash
@@ -256,7 +262,7 @@ func TestValsetSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testing.T)
 		require.NoError(t, err)
 
 		conf := types.NewMsgValsetConfirm(vs.Nonce, *ethAddr, orch, "dummysig")
-		pk.SetValsetConfirm(ctx, *conf)
+		pk.SetValsetConfirm(ctx, keeper.EthChainPrefix, *conf)
 	}
 	staking.EndBlocker(input.Context, input.StakingKeeper)
 
@@ -340,12 +346,12 @@ func TestNonValidatorBatchConfirm(t *testing.T) {
 		CosmosBlockCreated: uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1)),
 	})
 	require.NoError(t, err)
-	pk.StoreBatch(ctx, *batch)
-	unslashedBatches := pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()))
+	pk.StoreBatch(ctx, keeper.EthChainPrefix, *batch)
+	unslashedBatches := pk.GetUnSlashedBatches(ctx, keeper.EthChainPrefix, uint64(ctx.BlockHeight()))
 	assert.True(t, len(unslashedBatches) == 1 && unslashedBatches[0].BatchNonce == 1)
 
 	for i, orch := range keeper.OrchAddrs {
-		pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		pk.SetBatchConfirm(ctx, keeper.EthChainPrefix, &types.MsgConfirmBatch{
 			Nonce:         batch.BatchNonce,
 			TokenContract: keeper.TokenContractAddrs[0],
 			EthSigner:     keeper.EthAddrs[i].String(),
@@ -356,7 +362,7 @@ func TestNonValidatorBatchConfirm(t *testing.T) {
 
 	// Sign using our not nice validator
 	// This is not really possible if we use confirmHandlerCommon
-	pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+	pk.SetBatchConfirm(ctx, keeper.EthChainPrefix, &types.MsgConfirmBatch{
 		Nonce:         batch.BatchNonce,
 		TokenContract: keeper.TokenContractAddrs[0],
 		EthSigner:     ethAddr.GetAddress().Hex(),
@@ -393,8 +399,8 @@ func TestBatchSlashing(t *testing.T) {
 		CosmosBlockCreated: uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1)),
 	})
 	require.NoError(t, err)
-	pk.StoreBatch(ctx, *batch)
-	unslashedBatches := pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()))
+	pk.StoreBatch(ctx, keeper.EthChainPrefix, *batch)
+	unslashedBatches := pk.GetUnSlashedBatches(ctx, keeper.EthChainPrefix, uint64(ctx.BlockHeight()))
 	assert.True(t, len(unslashedBatches) == 1 && unslashedBatches[0].BatchNonce == 1)
 
 	for i, orch := range keeper.OrchAddrs {
@@ -419,7 +425,7 @@ func TestBatchSlashing(t *testing.T) {
 			continue
 		}
 
-		pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		pk.SetBatchConfirm(ctx, keeper.EthChainPrefix, &types.MsgConfirmBatch{
 			Nonce:         batch.BatchNonce,
 			TokenContract: keeper.TokenContractAddrs[0],
 			EthSigner:     keeper.EthAddrs[i].String(),
@@ -439,9 +445,9 @@ func TestBatchSlashing(t *testing.T) {
 	require.False(t, val2.IsJailed())
 
 	// Ensure that the last slashed valset nonce is set properly
-	lastSlashedBatchBlock := input.GravityKeeper.GetLastSlashedBatchBlock(ctx)
+	lastSlashedBatchBlock := input.GravityKeeper.GetLastSlashedBatchBlock(ctx, keeper.EthChainPrefix)
 	assert.Equal(t, lastSlashedBatchBlock, batch.CosmosBlockCreated)
-	assert.True(t, len(pk.GetUnSlashedBatches(ctx, uint64(ctx.BlockHeight()))) == 0)
+	assert.True(t, len(pk.GetUnSlashedBatches(ctx, keeper.EthChainPrefix, uint64(ctx.BlockHeight()))) == 0)
 
 }
 
@@ -452,7 +458,7 @@ func TestValsetEmission(t *testing.T) {
 	pk := input.GravityKeeper
 
 	// Store a validator set with a power change as the most recent validator set
-	vs, err := pk.GetCurrentValset(ctx)
+	vs, err := pk.GetCurrentValset(ctx, keeper.EthChainPrefix)
 	require.NoError(t, err)
 	vs.Nonce--
 	internalMembers, err := types.BridgeValidators(vs.Members).ToInternal()
@@ -460,13 +466,13 @@ func TestValsetEmission(t *testing.T) {
 	delta := float64(internalMembers.TotalPower()) * 0.05
 	vs.Members[0].Power = uint64(float64(vs.Members[0].Power) - delta/2)
 	vs.Members[1].Power = uint64(float64(vs.Members[1].Power) + delta/2)
-	pk.StoreValset(ctx, vs)
-	pk.SetLatestValsetNonce(ctx, vs.Nonce)
+	pk.StoreValset(ctx, keeper.EthChainPrefix, vs)
+	pk.SetLatestValsetNonce(ctx, keeper.EthChainPrefix, vs.Nonce)
 
 	// EndBlocker should set a new validator set
 	EndBlocker(ctx, pk)
-	require.NotNil(t, pk.GetValset(ctx, uint64(pk.GetLatestValsetNonce(ctx))))
-	valsets := pk.GetValsets(ctx)
+	require.NotNil(t, pk.GetValset(ctx, keeper.EthChainPrefix, uint64(pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))))
+	valsets := pk.GetValsets(ctx, keeper.EthChainPrefix)
 	require.True(t, len(valsets) == 2)
 }
 
@@ -475,8 +481,8 @@ func TestValsetSetting(t *testing.T) {
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
 
 	pk := input.GravityKeeper
-	pk.SetValsetRequest(ctx)
-	valsets := pk.GetValsets(ctx)
+	pk.SetValsetRequest(ctx, keeper.EthChainPrefix)
+	valsets := pk.GetValsets(ctx, keeper.EthChainPrefix)
 	require.True(t, len(valsets) == 1)
 }
 
@@ -493,7 +499,7 @@ func TestBatchTimeout(t *testing.T) {
 		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
 		token, e2           = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
-		allVouchers         = sdk.NewCoins(token.GravityCoin())
+		allVouchers         = sdk.NewCoins(token.GravityCoin(keeper.EthChainPrefix))
 	)
 	require.NoError(t, e1)
 	require.NoError(t, e2)
@@ -515,12 +521,12 @@ func TestBatchTimeout(t *testing.T) {
 	for i, v := range []uint64{4, 3, 3, 4, 5, 6} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(keeper.EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(keeper.EthChainPrefix)
 
-		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, keeper.EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err)
 	}
 
@@ -529,22 +535,22 @@ func TestBatchTimeout(t *testing.T) {
 	ctx = ctx.WithBlockHeight(250)
 
 	// check that we can make a batch without first setting an ethereum block height
-	b1, err1 := pk.BuildOutgoingTXBatch(ctx, *tokenContract, 1)
+	b1, err1 := pk.BuildOutgoingTXBatch(ctx, keeper.EthChainPrefix, *tokenContract, 1)
 	require.NoError(t, err1)
 	require.Equal(t, b1.BatchTimeout, uint64(0))
 
-	pk.SetLastObservedEthereumBlockHeight(ctx, 500)
+	pk.SetLastObservedEthereumBlockHeight(ctx, keeper.EthChainPrefix, 500)
 
 	// increase number of max txs to create more profitable batch
-	b2, err2 := pk.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	b2, err2 := pk.BuildOutgoingTXBatch(ctx, keeper.EthChainPrefix, *tokenContract, 2)
 	require.NoError(t, err2)
 	// this is exactly block 500 plus twelve hours
 	require.Equal(t, b2.BatchTimeout, uint64(504))
 
 	// make sure the batches got stored in the first place
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, b1.TokenContract, b1.BatchNonce)
+	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, keeper.EthChainPrefix, b1.TokenContract, b1.BatchNonce)
 	require.NotNil(t, gotFirstBatch)
-	gotSecondBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, b2.TokenContract, b2.BatchNonce)
+	gotSecondBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, keeper.EthChainPrefix, b2.TokenContract, b2.BatchNonce)
 	require.NotNil(t, gotSecondBatch)
 
 	// persist confirmations for second batch to test their deletion on batch timeout
@@ -560,45 +566,45 @@ func TestBatchTimeout(t *testing.T) {
 			Signature:     "dummysig",
 		}
 
-		input.GravityKeeper.SetBatchConfirm(ctx, conf)
+		input.GravityKeeper.SetBatchConfirm(ctx, keeper.EthChainPrefix, conf)
 	}
 
 	// verify that confirms are persisted
-	secondBatchConfirms := input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, b2.BatchNonce, b2.TokenContract)
+	secondBatchConfirms := input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, keeper.EthChainPrefix, b2.BatchNonce, b2.TokenContract)
 	require.Equal(t, len(keeper.OrchAddrs), len(secondBatchConfirms))
 
 	// when, way into the future
 	ctx = ctx.WithBlockTime(now)
 	ctx = ctx.WithBlockHeight(9)
 
-	b3, err2 := pk.BuildOutgoingTXBatch(ctx, *tokenContract, 3)
+	b3, err2 := pk.BuildOutgoingTXBatch(ctx, keeper.EthChainPrefix, *tokenContract, 3)
 	require.NoError(t, err2)
 
 	EndBlocker(ctx, pk)
 
 	// this had a timeout of zero should be deleted.
-	gotFirstBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, b1.TokenContract, b1.BatchNonce)
+	gotFirstBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, keeper.EthChainPrefix, b1.TokenContract, b1.BatchNonce)
 	require.Nil(t, gotFirstBatch)
 	// make sure the end blocker does not delete these, as the block height has not officially
 	// been updated by a relay event
-	gotSecondBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, b2.TokenContract, b2.BatchNonce)
+	gotSecondBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, keeper.EthChainPrefix, b2.TokenContract, b2.BatchNonce)
 	require.NotNil(t, gotSecondBatch)
-	gotThirdBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, b3.TokenContract, b3.BatchNonce)
+	gotThirdBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, keeper.EthChainPrefix, b3.TokenContract, b3.BatchNonce)
 	require.NotNil(t, gotThirdBatch)
 
-	pk.SetLastObservedEthereumBlockHeight(ctx, 5000)
+	pk.SetLastObservedEthereumBlockHeight(ctx, keeper.EthChainPrefix, 5000)
 	EndBlocker(ctx, pk)
 
 	// make sure the end blocker does delete these, as we've got a new Ethereum block height
-	gotFirstBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, b1.TokenContract, b1.BatchNonce)
+	gotFirstBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, keeper.EthChainPrefix, b1.TokenContract, b1.BatchNonce)
 	require.Nil(t, gotFirstBatch)
-	gotSecondBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, b2.TokenContract, b2.BatchNonce)
+	gotSecondBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, keeper.EthChainPrefix, b2.TokenContract, b2.BatchNonce)
 	require.Nil(t, gotSecondBatch)
-	gotThirdBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, b3.TokenContract, b3.BatchNonce)
+	gotThirdBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, keeper.EthChainPrefix, b3.TokenContract, b3.BatchNonce)
 	require.NotNil(t, gotThirdBatch)
 
 	// verify that second batch confirms are deleted
-	secondBatchConfirms = input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, b2.BatchNonce, b2.TokenContract)
+	secondBatchConfirms = input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, keeper.EthChainPrefix, b2.BatchNonce, b2.TokenContract)
 	require.Equal(t, 0, len(secondBatchConfirms))
 }
 
@@ -610,10 +616,10 @@ func TestValsetPruning(t *testing.T) {
 	params := pk.GetParams(ctx)
 
 	// Create new validator set with nonce 1
-	pk.SetValsetRequest(ctx)
-	firstValsetNonce := pk.GetLatestValsetNonce(ctx)
-	require.NotNil(t, pk.GetValset(ctx, firstValsetNonce))
-	require.True(t, len(pk.GetValsets(ctx)) == 1)
+	pk.SetValsetRequest(ctx, keeper.EthChainPrefix)
+	firstValsetNonce := pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix)
+	require.NotNil(t, pk.GetValset(ctx, keeper.EthChainPrefix, firstValsetNonce))
+	require.True(t, len(pk.GetValsets(ctx, keeper.EthChainPrefix)) == 1)
 
 	// Create validator set confirmations
 	for i, orch := range keeper.OrchAddrs {
@@ -621,26 +627,100 @@ func TestValsetPruning(t *testing.T) {
 		require.NoError(t, err)
 
 		conf := types.NewMsgValsetConfirm(firstValsetNonce, *ethAddr, orch, "dummysig")
-		pk.SetValsetConfirm(ctx, *conf)
+		pk.SetValsetConfirm(ctx, keeper.EthChainPrefix, *conf)
 	}
 
-	require.True(t, len(pk.GetValsetConfirms(ctx, firstValsetNonce)) == len(keeper.OrchAddrs))
+	require.True(t, len(pk.GetValsetConfirms(ctx, keeper.EthChainPrefix, firstValsetNonce)) == len(keeper.OrchAddrs))
 
 	// Create new validator set with nonce 2
-	pk.SetValsetRequest(ctx)
-	require.True(t, len(pk.GetValsets(ctx)) == 2)
-	valset := pk.GetValset(ctx, pk.GetLatestValsetNonce(ctx))
+	pk.SetValsetRequest(ctx, keeper.EthChainPrefix)
+	require.True(t, len(pk.GetValsets(ctx, keeper.EthChainPrefix)) == 2)
+	valset := pk.GetValset(ctx, keeper.EthChainPrefix, pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))
 	require.NotNil(t, valset)
 
 	// Set validator set with nonce 2 as last observed
-	pk.SetLastObservedValset(ctx, *valset)
-	require.Equal(t, valset.Nonce, pk.GetLastObservedValset(ctx).Nonce)
+	pk.SetLastObservedValset(ctx, keeper.EthChainPrefix, *valset)
+	require.Equal(t, valset.Nonce, pk.GetLastObservedValset(ctx, keeper.EthChainPrefix).Nonce)
 
 	// Advance enough blocks so that old validator set gets removed in EndBlocker
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow+1)).WithBlockTime(time.Now().UTC())
 
 	// EndBlocker should cleanup validator set with nonce 1 and it's confirmations
 	EndBlocker(ctx, pk)
-	require.Nil(t, pk.GetValset(ctx, firstValsetNonce))
-	require.Equal(t, 0, len(pk.GetValsetConfirms(ctx, firstValsetNonce)))
+	require.Nil(t, pk.GetValset(ctx, keeper.EthChainPrefix, firstValsetNonce))
+	require.Equal(t, 0, len(pk.GetValsetConfirms(ctx, keeper.EthChainPrefix, firstValsetNonce)))
+}
+
+// A valset is requested once the latest one reaches ValsetMaxAge even though nothing changed
+func TestValsetCreationUponMaxAge(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.ValsetMaxAge = 100
+	pk.SetParams(ctx, params)
+
+	latest := pk.SetValsetRequest(ctx, keeper.EthChainPrefix)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 99)
+	EndBlocker(ctx, pk)
+	require.Equal(t, latest.Nonce, pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, pk)
+	require.Equal(t, latest.Nonce+1, pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))
+	requireValsetTriggers(t, ctx, "EventValsetUpdateTriggered", types.ValsetTriggerMaxAge)
+}
+
+// Triggers firing within ValsetMinInterval blocks of the latest valset request are deferred
+func TestValsetCreationDeferredByMinInterval(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	params.ValsetMinInterval = 50
+	// nobody signs the valsets in this test, keep them from being slashed
+	params.SignedValsetsWindow = 1000
+	pk.SetParams(ctx, params)
+
+	latest := pk.SetValsetRequest(ctx, keeper.EthChainPrefix)
+
+	// begin unbonding
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	sh := staking.NewHandler(input.StakingKeeper)
+	_, err := sh(ctx, keeper.NewTestMsgUnDelegateValidator(keeper.ValAddrs[0], keeper.StakingAmount))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	EndBlocker(ctx, pk)
+	require.Equal(t, latest.Nonce, pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))
+	requireValsetTriggers(t, ctx, "EventValsetUpdateDeferred", types.ValsetTriggerUnbonding, types.ValsetTriggerPowerDiff)
+
+	// the unbonding is still remembered once the interval has passed
+	ctx = ctx.WithBlockHeight(int64(latest.Height + params.ValsetMinInterval)).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, pk)
+	require.Equal(t, latest.Nonce+1, pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))
+	requireValsetTriggers(t, ctx, "EventValsetUpdateTriggered", types.ValsetTriggerUnbonding, types.ValsetTriggerPowerDiff)
+
+	// and does not trigger again
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.ValsetMinInterval))
+	EndBlocker(ctx, pk)
+	require.Equal(t, latest.Nonce+1, pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))
+}
+
+// requireValsetTriggers checks that ctx emitted the valset event eventName with exactly the given triggers
+func requireValsetTriggers(t *testing.T, ctx sdk.Context, eventName string, triggers ...string) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "gravity.v1."+eventName {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "triggers" {
+				bz, err := json.Marshal(triggers)
+				require.NoError(t, err)
+				require.Equal(t, string(bz), string(attr.Value))
+				return
+			}
+		}
+	}
+	t.Fatalf("no %s event emitted", eventName)
 }
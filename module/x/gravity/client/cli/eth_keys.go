@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethermint "github.com/evmos/ethermint/crypto/hd"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+const (
+	// EthKeyringSubDir is the directory under the keyring directory which holds the ethereum keys of eth_keys
+	EthKeyringSubDir = "eth_keys"
+
+	FlagEthKey       = "eth-key"
+	FlagEthSignature = "eth-signature"
+)
+
+// NewEthKeyring opens the keyring of the given backend holding the ethereum keys, which lives in its own directory
+// under the keyring directory so that the ethereum and Cosmos keys do not mix
+func NewEthKeyring(backend string, keyringDir string, buf io.Reader) (keyring.Keyring, error) {
+	return keyring.New(
+		sdk.KeyringServiceName()+"-eth",
+		backend,
+		filepath.Join(keyringDir, EthKeyringSubDir),
+		buf,
+		ethermint.EthSecp256k1Option(),
+	)
+}
+
+// EthPrivateKey returns the private key stored under the name, which must be an ethereum key
+func EthPrivateKey(kr keyring.Keyring, name string) (*ecdsa.PrivateKey, error) {
+	info, err := kr.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.GetAlgo() != ethermint.EthSecp256k1Type {
+		return nil, fmt.Errorf("key %s is a %s key, not an ethereum key", name, info.GetAlgo())
+	}
+	hexKey, err := keyring.NewUnsafe(kr).UnsafeExportPrivKeyHex(name)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.HexToECDSA(hexKey)
+}
+
+// AddDelegateKeysFlags adds the flags choosing how SignDelegateKeys proves possession of the ethereum key
+func AddDelegateKeysFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagEthKey, "", "Name of the eth_keys key to sign the delegate keys with, by default the key holding the ethereum address")
+	cmd.Flags().String(FlagEthSignature, "", "Hex signature of the delegate keys made elsewhere, e.g. by eth_keys sign-delegate-keys, instead of signing with eth_keys")
+}
+
+// SignDelegateKeys returns the signature proving that the validator controls the ethereum address on the chain. The
+// signature is either given by --eth-signature or made with the eth_keys key named by --eth-key, falling back to the
+// eth_keys key holding the address, using the keyring backend of the command
+func SignDelegateKeys(
+	cmd *cobra.Command, clientCtx client.Context, val sdk.ValAddress, chainID string, ethAddress types.EthAddress,
+) ([]byte, error) {
+	if chainID == "" {
+		return nil, fmt.Errorf("the chain id is required to sign the delegate keys")
+	}
+	signBytes := types.GetDelegateKeysSignBytes(val, chainID)
+
+	var signature []byte
+	if sigHex, err := cmd.Flags().GetString(FlagEthSignature); err != nil {
+		return nil, err
+	} else if sigHex != "" {
+		signature, err = hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex signature: %w", err)
+		}
+		if len(signature) != crypto.SignatureLength {
+			return nil, fmt.Errorf("signatures are %d bytes long, the signature is %d bytes", crypto.SignatureLength, len(signature))
+		}
+	} else {
+		backend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+		if err != nil {
+			return nil, err
+		}
+		kr, err := NewEthKeyring(backend, clientCtx.KeyringDir, clientCtx.Input)
+		if err != nil {
+			return nil, err
+		}
+		name, err := cmd.Flags().GetString(FlagEthKey)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			name, err = findEthKey(kr, ethAddress)
+			if err != nil {
+				return nil, err
+			}
+		}
+		privateKey, err := EthPrivateKey(kr, name)
+		if err != nil {
+			return nil, err
+		}
+		signature, err = types.NewEthereumSignature(signBytes, privateKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// catch a mismatched key or signature before it is rejected on chain, the check modifies the signature so it
+	// is given a copy
+	if err := types.ValidateEthereumSignature(signBytes, append([]byte{}, signature...), ethAddress); err != nil {
+		return nil, fmt.Errorf("the signature is not made by %s for %s on %s: %w", ethAddress.GetAddress().Hex(), val, chainID, err)
+	}
+	return signature, nil
+}
+
+// findEthKey returns the name of the eth_keys key holding the ethereum address
+func findEthKey(kr keyring.Keyring, ethAddress types.EthAddress) (string, error) {
+	infos, err := kr.List()
+	if err != nil {
+		return "", err
+	}
+	for _, info := range infos {
+		if gethcommon.BytesToAddress(info.GetAddress()) == ethAddress.GetAddress() {
+			return info.GetName(), nil
+		}
+	}
+	return "", fmt.Errorf("no eth_keys key holds %s, add it with eth_keys import or pass --%s", ethAddress.GetAddress().Hex(), FlagEthSignature)
+}
@@ -1,10 +1,16 @@
 package cli
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/spf13/cobra"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,6 +24,8 @@ const (
 	FlagNonce     = "nonce"
 	FlagEthHeight = "eth-height"
 	FlagUseV1Key  = "use-v1-key"
+
+	FlagEvmChainPrefix = "evm-chain-prefix"
 )
 
 // GetQueryCmd bundles all the query subcmds together so they appear under `gravity query` or `gravity q`
@@ -36,12 +44,23 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetValsetConfirm(),
 		CmdGetPendingValsetRequest(),
 		CmdGetPendingOutgoingTXBatchRequest(),
+		CmdGetPendingWork(),
+		CmdFeeStatistics(),
+		CmdEstimatedBatchGas(),
 		CmdGetPendingSendToEth(),
 		GetCmdPendingIbcAutoForwards(),
+		CmdGetMerkleAirdrops(),
 		CmdGetAttestations(),
 		CmdGetLastObservedEthBlock(),
 		CmdGetLastObservedEthNonce(),
+		CmdBridgeStatus(),
+		CmdGetEvmChains(),
+		CmdValidatorBridgePerformance(),
+		CmdSimulateSignatureCheck(),
+		CmdEthereumCalldata(),
 		GetCmdQueryParams(),
+		CmdEIP712TypedData(),
+		CmdEIP712Schemas(),
 	}...)
 
 	return gravityQueryCmd
@@ -61,7 +80,11 @@ func CmdGetCurrentValset() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryCurrentValsetRequest{}
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryCurrentValsetRequest{EvmChainPrefix: evmChainPrefix}
 
 			res, err := queryClient.CurrentValset(cmd.Context(), req)
 			if err != nil {
@@ -72,6 +95,7 @@ func CmdGetCurrentValset() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
 	return cmd
 }
 
@@ -94,8 +118,13 @@ func CmdGetValsetRequest() *cobra.Command {
 				return err
 			}
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
 			req := &types.QueryValsetRequestRequest{
-				Nonce: nonce,
+				Nonce:          nonce,
+				EvmChainPrefix: evmChainPrefix,
 			}
 
 			res, err := queryClient.ValsetRequest(cmd.Context(), req)
@@ -107,6 +136,7 @@ func CmdGetValsetRequest() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
 	return cmd
 }
 
@@ -129,9 +159,14 @@ func CmdGetValsetConfirm() *cobra.Command {
 				return err
 			}
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
 			req := &types.QueryValsetConfirmRequest{
-				Nonce:   nonce,
-				Address: args[1],
+				Nonce:          nonce,
+				Address:        args[1],
+				EvmChainPrefix: evmChainPrefix,
 			}
 
 			res, err := queryClient.ValsetConfirm(cmd.Context(), req)
@@ -143,6 +178,7 @@ func CmdGetValsetConfirm() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
 	return cmd
 }
 
@@ -160,8 +196,13 @@ func CmdGetPendingValsetRequest() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
 			req := &types.QueryLastPendingValsetRequestByAddrRequest{
-				Address: args[0],
+				Address:        args[0],
+				EvmChainPrefix: evmChainPrefix,
 			}
 
 			res, err := queryClient.LastPendingValsetRequestByAddr(cmd.Context(), req)
@@ -173,6 +214,43 @@ func CmdGetPendingValsetRequest() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
+	return cmd
+}
+
+// CmdGetPendingWork fetches the oldest valsets, batches and logic calls the given orchestrator has not signed yet
+func CmdGetPendingWork() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "pending-work [bech32 orchestrator address]",
+		Short: "Get the oldest 100 valsets, batches and logic calls which have not been signed by a particular orchestrator, with the checkpoints to sign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryPendingWorkByOrchestratorRequest{
+				Address:        args[0],
+				EvmChainPrefix: evmChainPrefix,
+			}
+
+			res, err := queryClient.PendingWorkByOrchestrator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
 	return cmd
 }
 
@@ -190,8 +268,13 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
 			req := &types.QueryLastPendingBatchRequestByAddrRequest{
-				Address: args[0],
+				Address:        args[0],
+				EvmChainPrefix: evmChainPrefix,
 			}
 
 			res, err := queryClient.LastPendingBatchRequestByAddr(cmd.Context(), req)
@@ -203,6 +286,7 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
 	return cmd
 }
 
@@ -220,8 +304,13 @@ func CmdGetPendingSendToEth() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
 			req := &types.QueryPendingSendToEth{
-				SenderAddress: args[0],
+				SenderAddress:  args[0],
+				EvmChainPrefix: evmChainPrefix,
 			}
 
 			res, err := queryClient.GetPendingSendToEth(cmd.Context(), req)
@@ -233,6 +322,7 @@ func CmdGetPendingSendToEth() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
 	return cmd
 }
 
@@ -259,7 +349,11 @@ func GetCmdPendingIbcAutoForwards() *cobra.Command {
 				}
 			}
 
-			req := &types.QueryPendingIbcAutoForwards{Limit: limit}
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryPendingIbcAutoForwards{Limit: limit, EvmChainPrefix: evmChainPrefix}
 			res, err := queryClient.GetPendingIbcAutoForwards(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -269,6 +363,7 @@ func GetCmdPendingIbcAutoForwards() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
 	return cmd
 }
 
@@ -323,13 +418,18 @@ func CmdGetAttestations() *cobra.Command {
 				return err
 			}
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
 			req := &types.QueryAttestationsRequest{
-				Limit:     limit,
-				OrderBy:   orderBy,
-				ClaimType: claimType,
-				Nonce:     nonce,
-				Height:    height,
-				UseV1Key:  useV1Key,
+				Limit:          limit,
+				OrderBy:        orderBy,
+				ClaimType:      claimType,
+				Nonce:          nonce,
+				Height:         height,
+				UseV1Key:       useV1Key,
+				EvmChainPrefix: evmChainPrefix,
 			}
 			res, err := queryClient.GetAttestations(cmd.Context(), req)
 			if err != nil {
@@ -349,6 +449,7 @@ func CmdGetAttestations() *cobra.Command {
 	cmd.Flags().Uint64(FlagEthHeight, 0, "the exact ethereum block height an event happened at, 0 for any")
 	cmd.Flags().Bool(FlagUseV1Key, false, "if querying with --height less than 1282013 this flag must be provided to locate the attestations")
 
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
 	return cmd
 }
 
@@ -379,8 +480,13 @@ func CmdGetLastObservedEthBlock() *cobra.Command {
 				return err
 			}
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
 			req := &types.QueryLastObservedEthBlockRequest{
-				UseV1Key: useV1Key,
+				UseV1Key:       useV1Key,
+				EvmChainPrefix: evmChainPrefix,
 			}
 			res, err := queryClient.GetLastObservedEthBlock(cmd.Context(), req)
 			if err != nil {
@@ -392,6 +498,7 @@ func CmdGetLastObservedEthBlock() *cobra.Command {
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	cmd.Flags().Bool(FlagUseV1Key, false, "if querying with --height less than 1282013 this flag must be provided to locate the Last Observed Ethereum Height")
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
 	return cmd
 }
 
@@ -422,8 +529,13 @@ func CmdGetLastObservedEthNonce() *cobra.Command {
 				return err
 			}
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
 			req := &types.QueryLastObservedEthNonceRequest{
-				UseV1Key: useV1Key,
+				UseV1Key:       useV1Key,
+				EvmChainPrefix: evmChainPrefix,
 			}
 			res, err := queryClient.GetLastObservedEthNonce(cmd.Context(), req)
 			if err != nil {
@@ -435,6 +547,289 @@ func CmdGetLastObservedEthNonce() *cobra.Command {
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	cmd.Flags().Bool(FlagUseV1Key, false, "if querying with --height less than 1282013 this must be set to true to locate the Last Observed Ethereum Event Nonce")
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
+	return cmd
+}
+
+// CmdGetEvmChains fetches the EVM chains bridged by the module, including the default chain
+func CmdGetEvmChains() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "evm-chains",
+		Args:  cobra.NoArgs,
+		Short: "Query the EVM chains bridged by gravity",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetEvmChains(cmd.Context(), &types.QueryEvmChainsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdValidatorBridgePerformance fetches the bridge duty records of validators and the items they are at risk of
+// being slashed for
+func CmdValidatorBridgePerformance() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "bridge-performance [optional validator address]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the bridge signing performance of a validator, or of all validators if none is given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.AllValidatorsBridgePerformance(cmd.Context(), &types.QueryAllValidatorsBridgePerformanceRequest{})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			req := &types.QueryValidatorBridgePerformanceRequest{ValidatorAddress: args[0]}
+			res, err := queryClient.ValidatorBridgePerformance(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdFeeStatistics fetches statistics of the fees paid by the recently executed batches of a token
+func CmdFeeStatistics() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "fee-statistics [token contract]",
+		Short: "Get the percentile fees and average confirmation latency of the recently executed batches of a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryFeeStatisticsRequest{
+				TokenContract:  args[0],
+				EvmChainPrefix: evmChainPrefix,
+			}
+
+			res, err := queryClient.FeeStatistics(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
+	return cmd
+}
+
+// CmdBridgeStatus fetches the status and computed health level of the bridge to an EVM chain
+func CmdBridgeStatus() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "bridge-status",
+		Short: "Get the last observed event, the oldest waiting transfer, batch and valset, the lagging validators and the health of the bridge",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryBridgeStatusRequest{
+				EvmChainPrefix: evmChainPrefix,
+			}
+
+			res, err := queryClient.BridgeStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
+	return cmd
+}
+
+// CmdEstimatedBatchGas fetches the estimated EVM gas of executing a stored batch
+func CmdEstimatedBatchGas() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "estimated-batch-gas [token contract] [nonce]",
+		Short: "Get the estimated EVM gas of executing a batch, based on the gas params of its token",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryEstimatedBatchGasRequest{
+				Nonce:          nonce,
+				TokenContract:  args[0],
+				EvmChainPrefix: evmChainPrefix,
+			}
+
+			res, err := queryClient.EstimatedBatchGas(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
+	return cmd
+}
+
+// CmdSimulateSignatureCheck simulates the Gravity.sol signature check of a valset update or batch
+func CmdSimulateSignatureCheck() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "simulate-signature-check [valset-nonce | batch-nonce token-contract]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Simulate the signature check Gravity.sol performs on a valset update or batch",
+		Long: "Simulate the signature check Gravity.sol performs when a valset update (given just a nonce) or batch " +
+			"(given a nonce and token contract) is submitted, against the last observed valset. Prints the " +
+			"signatures to submit in the order the contract expects and whether the check would pass",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid nonce")
+			}
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+			// nolint: exhaustruct
+			req := &types.QuerySimulateSignatureCheckRequest{EvmChainPrefix: evmChainPrefix}
+			if len(args) == 1 {
+				req.ValsetNonce = nonce
+			} else {
+				req.BatchNonce = nonce
+				req.TokenContract = args[1]
+			}
+
+			res, err := queryClient.SimulateSignatureCheck(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
+	return cmd
+}
+
+// CmdEthereumCalldata fetches the Gravity.sol calldata submitting a valset update, batch or logic call
+func CmdEthereumCalldata() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "ethereum-calldata [valset valset-nonce | batch batch-nonce token-contract | logic-call invalidation-id invalidation-nonce]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Get the ABI encoded Gravity.sol calldata submitting a valset update, batch or logic call",
+		Long: "Get the ABI encoded calldata of the Gravity.sol updateValset, submitBatch or submitLogicCall call " +
+			"submitting the given item with the signatures of the last observed valset. The invalidation id of " +
+			"a logic call is given in hex",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+			// nolint: exhaustruct
+			req := &types.QueryEthereumCalldataRequest{EvmChainPrefix: evmChainPrefix}
+			switch {
+			case args[0] == "valset" && len(args) == 2:
+				req.ValsetNonce, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid valset nonce")
+				}
+			case args[0] == "batch" && len(args) == 3:
+				req.BatchNonce, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid batch nonce")
+				}
+				req.TokenContract = args[2]
+			case args[0] == "logic-call" && len(args) == 3:
+				req.InvalidationId, err = hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid invalidation id")
+				}
+				req.InvalidationNonce, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid invalidation nonce")
+				}
+			default:
+				return fmt.Errorf("unknown item %s or wrong number of arguments", args[0])
+			}
+
+			res, err := queryClient.EthereumCalldata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to query, empty for the default chain")
 	return cmd
 }
 
@@ -464,3 +859,94 @@ func GetCmdQueryParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdGetMerkleAirdrops fetches every Merkle airdrop which has not yet expired
+func CmdGetMerkleAirdrops() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "merkle-airdrops",
+		Short: "Query Merkle airdrops which may still be claimed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetMerkleAirdrops(cmd.Context(), &types.QueryMerkleAirdropsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdEIP712TypedData fetches the EIP-712 typed data a wallet must sign for an unsigned tx
+func CmdEIP712TypedData() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "eip712-typed-data [path-to-unsigned-tx-json]",
+		Short: "Query the EIP-712 typed data which must be signed to submit the given unsigned tx",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read unsigned tx")
+			}
+			tx, err := clientCtx.TxConfig.TxJSONDecoder()(contents)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to decode unsigned tx")
+			}
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to encode unsigned tx")
+			}
+
+			res, err := queryClient.EIP712TypedData(cmd.Context(), &types.QueryEIP712TypedDataRequest{TxBytes: txBytes})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(res.TypedData + "\n")
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdEIP712Schemas prints the EIP-712 types of every gravity and auction Msg, this command does not query the chain
+func CmdEIP712Schemas() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "eip712-schemas",
+		Short: "Print the EIP-712 types of every gravity and auction Msg and the Msgs which can not be EIP-712 signed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			// nolint: exhaustruct
+			schemas := struct {
+				Supported   map[string]apitypes.Types `json:"supported"`
+				Unsupported map[string]string         `json:"unsupported"`
+			}{types.EIP712MsgTypes, types.EIP712UnsupportedMsgs}
+			bz, err := json.MarshalIndent(schemas, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+	return cmd
+}
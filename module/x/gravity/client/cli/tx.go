@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -43,9 +44,14 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
 		CmdGovIbcMetadataProposal(),
+		CmdGovERC20MetadataProposal(),
+		CmdGovERC20MigrationProposal(),
 		CmdGovAirdropProposal(),
+		CmdGovMerkleAirdropProposal(),
+		CmdGovVestingAirdropProposal(),
 		CmdGovUnhaltBridgeProposal(),
 		CmdExecutePendingIbcAutoForwards(),
+		CmdClaimAirdrop(),
 	}...)
 
 	return gravityTxCmd
@@ -163,6 +169,217 @@ func CmdGovIbcMetadataProposal() *cobra.Command {
 	return cmd
 }
 
+// CmdGovERC20MetadataProposal enables users to easily submit json file proposals for ERC20 Metadata registration, used
+// by wallets and explorers to display Ethereum originated tokens
+func CmdGovERC20MetadataProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-erc20-metadata [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to set the Metadata of the given Ethereum originated token (gravity0x... denom)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.ERC20MetadataProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+
+			// check various fields for obvious omissions
+			if proposal.GravityDenom == "" {
+				return sdkerrors.Wrap(types.ErrInvalid, "The GravityDenom field must be set in the proposal.json file")
+			}
+			if proposal.Title == "" {
+				return sdkerrors.Wrap(types.ErrInvalid, "Title field must be set in the proposal.json file")
+			}
+			if proposal.Description == "" {
+				return sdkerrors.Wrap(types.ErrInvalid, "Description field must be set in the proposal.json file")
+			}
+			if proposal.Metadata.Base == "" {
+				return sdkerrors.Wrap(types.ErrInvalid, "Metadata.Base field must be set in the proposal.json file")
+			}
+			if proposal.Metadata.Name == "" {
+				return sdkerrors.Wrap(types.ErrInvalid, "Metadata.Name field must be set in the proposal.json file")
+			}
+			if proposal.Metadata.Display == "" {
+				return sdkerrors.Wrap(types.ErrInvalid, "Metadata.Display field must be set in the proposal.json file")
+			}
+			if proposal.Metadata.Symbol == "" {
+				return sdkerrors.Wrap(types.ErrInvalid, "Metadata.Symbol field must be set in the proposal.json file")
+			}
+
+			// checks if the provided token denom is a gravity voucher containing a valid token contract
+			evmChainPrefix, tokenContract, err := types.GravityDenomToERC20(proposal.GravityDenom)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Target denom is not an Ethereum originated token")
+			}
+
+			// check that our base unit is the gravity voucher denom. This makes setting/loading denom
+			// metadata work out, as SetDenomMetadata uses the base denom as an index
+			if proposal.Metadata.Base != proposal.GravityDenom {
+				return sdkerrors.Wrap(types.ErrInvalid, "Metadata base must be the same as the Gravity denom!")
+			}
+
+			metadataErr := proposal.Metadata.Validate()
+			if metadataErr != nil {
+				return sdkerrors.Wrap(metadataErr, "invalid metadata or proposal details!")
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			denomRes, err := queryClient.ERC20ToDenom(cmd.Context(), &types.QueryERC20ToDenomRequest{Erc20: tokenContract.GetAddress().Hex(), EvmChainPrefix: evmChainPrefix})
+			if err != nil {
+				return sdkerrors.Wrap(types.ErrInternal, "Failed to look up the token contract")
+			}
+			if denomRes.CosmosOriginated {
+				return sdkerrors.Wrap(types.ErrInvalid, "This token contract represents a Cosmos originated token")
+			}
+
+			queryClientBank := banktypes.NewQueryClient(cliCtx)
+			supply, err := queryClientBank.SupplyOf(cmd.Context(), &banktypes.QuerySupplyOfRequest{Denom: proposal.GravityDenom})
+			if err != nil {
+				return sdkerrors.Wrap(types.ErrInternal, "Failed to get supply data?")
+			}
+			if supply.GetAmount().Amount.Equal(sdk.ZeroInt()) {
+				return sdkerrors.Wrap(types.ErrInvalid, "This token does not seem to exist on Gravity, are you sure you have the right contract?")
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid metadata or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(err, "Your proposal.json is not valid, please correct it")
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGovERC20MigrationProposal enables users to easily submit json file proposals migrating a Cosmos originated token
+// to a newly deployed ERC20, used to recover from a faulty ERC20 or from incorrect metadata
+func CmdGovERC20MigrationProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-erc20-migration [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to migrate a Cosmos originated token to a newly deployed ERC20",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.ERC20MigrationProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+
+			// check various fields for obvious omissions
+			if proposal.CosmosDenom == "" {
+				return sdkerrors.Wrap(types.ErrInvalid, "The CosmosDenom field must be set in the proposal.json file")
+			}
+			if proposal.Title == "" {
+				return sdkerrors.Wrap(types.ErrInvalid, "Title field must be set in the proposal.json file")
+			}
+			if proposal.Description == "" {
+				return sdkerrors.Wrap(types.ErrInvalid, "Description field must be set in the proposal.json file")
+			}
+			newErc20, err := types.NewEthAddress(proposal.NewErc20)
+			if err != nil {
+				return sdkerrors.Wrap(err, "NewErc20 field must be a valid Ethereum address")
+			}
+			if proposal.Metadata != nil {
+				if proposal.Metadata.Base != proposal.CosmosDenom {
+					return sdkerrors.Wrap(types.ErrInvalid, "Metadata base must be the same as the Cosmos denom!")
+				}
+				if metadataErr := proposal.Metadata.Validate(); metadataErr != nil {
+					return sdkerrors.Wrap(metadataErr, "invalid metadata or proposal details!")
+				}
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+			erc20Res, err := queryClient.DenomToERC20(cmd.Context(), &types.QueryDenomToERC20Request{Denom: proposal.CosmosDenom})
+			if err != nil {
+				return sdkerrors.Wrap(err, "Failed to look up the current ERC20 for this denom")
+			}
+			if !erc20Res.CosmosOriginated {
+				return sdkerrors.Wrap(types.ErrInvalid, "This denom is not a Cosmos originated token")
+			}
+			if erc20Res.Erc20 == newErc20.GetAddress().Hex() {
+				return sdkerrors.Wrap(types.ErrInvalid, "NewErc20 is already the ERC20 for this denom")
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid metadata or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(err, "Your proposal.json is not valid, please correct it")
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // AirdropProposalPlain is a struct with plaintext recipients so that the proposal.json can be readable
 // and not subject to the strange encoding of the airdrop proposal tx where the recipients are packed as 20
 // byte sets
@@ -255,6 +472,285 @@ func CmdGovAirdropProposal() *cobra.Command {
 	return cmd
 }
 
+// VestingAirdropProposalPlain is the readable json form of a VestingAirdropProposal, see AirdropProposalPlain.
+// StartTime and EndTime are unix timestamps in seconds, NumPeriods 0 selects continuous vesting
+type VestingAirdropProposalPlain struct {
+	Title       string
+	Description string
+	Denom       string
+	Recipients  []string
+	Amounts     []uint64
+	StartTime   int64
+	EndTime     int64
+	NumPeriods  uint64
+}
+
+// CmdGovVestingAirdropProposal enables users to submit json file proposals for airdrops which vest over time
+func CmdGovVestingAirdropProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-vesting-airdrop [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal for an airdrop into vesting accounts",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &VestingAirdropProposalPlain{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if proposal.EndTime <= proposal.StartTime {
+				return fmt.Errorf("EndTime must be after StartTime")
+			}
+
+			// convert the plaintext proposal to the actual type
+			byteEncodedRecipients := []byte{}
+			for _, v := range proposal.Recipients {
+				parsed, err := sdk.AccAddressFromBech32(v)
+				if err != nil {
+					return sdkerrors.Wrap(err, "Address not valid!")
+				}
+				byteEncodedRecipients = append(byteEncodedRecipients, parsed.Bytes()...)
+			}
+
+			finalProposal := &types.VestingAirdropProposal{
+				Title:       proposal.Title,
+				Description: proposal.Description,
+				Denom:       proposal.Denom,
+				Recipients:  byteEncodedRecipients,
+				Amounts:     proposal.Amounts,
+				StartTime:   proposal.StartTime,
+				EndTime:     proposal.EndTime,
+				NumPeriods:  proposal.NumPeriods,
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(finalProposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid metadata or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MerkleAirdropProposalPlain is a struct with plaintext recipients so that the proposal.json can be readable, the
+// same file is used both to create the Merkle root for the proposal and to create the proof for each claim
+type MerkleAirdropProposalPlain struct {
+	Title        string
+	Description  string
+	Denom        string
+	ExpiryHeight uint64
+	Recipients   []string
+	Amounts      []uint64
+}
+
+// merkleAirdropTree parses a MerkleAirdropProposalPlain and builds its Merkle tree, returning the parsed recipients,
+// the tree root, a proof for each recipient and the airdrop total
+func merkleAirdropTree(proposal MerkleAirdropProposalPlain) ([]sdk.AccAddress, []byte, [][][]byte, uint64, error) {
+	if len(proposal.Recipients) == 0 || len(proposal.Recipients) != len(proposal.Amounts) {
+		return nil, nil, nil, 0, sdkerrors.Wrap(types.ErrInvalid, "Recipients and Amounts must be non-empty and of the same length")
+	}
+	parsedRecipients := make([]sdk.AccAddress, len(proposal.Recipients))
+	leaves := make([][]byte, len(proposal.Recipients))
+	seen := make(map[string]struct{}, len(proposal.Recipients))
+	total := uint64(0)
+	for i, v := range proposal.Recipients {
+		parsed, err := sdk.AccAddressFromBech32(v)
+		if err != nil {
+			return nil, nil, nil, 0, sdkerrors.Wrap(err, "Address not valid!")
+		}
+		if _, duplicate := seen[parsed.String()]; duplicate {
+			return nil, nil, nil, 0, sdkerrors.Wrapf(types.ErrDuplicate, "Recipient %s is listed more than once", v)
+		}
+		seen[parsed.String()] = struct{}{}
+		parsedRecipients[i] = parsed
+		leaves[i] = types.MerkleAirdropLeaf(parsed, proposal.Amounts[i])
+		total += proposal.Amounts[i]
+	}
+	root, proofs := types.MerkleAirdropTree(leaves)
+	return parsedRecipients, root, proofs, total, nil
+}
+
+// CmdGovMerkleAirdropProposal enables users to submit a Merkle airdrop proposal from the same readable json format as
+// CmdGovAirdropProposal, only the Merkle root of the recipient list is placed on chain
+func CmdGovMerkleAirdropProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-merkle-airdrop [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal for an airdrop which recipients claim with a Merkle proof",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &MerkleAirdropProposalPlain{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+
+			// convert the plaintext proposal to the actual type
+			_, root, _, total, err := merkleAirdropTree(*proposal)
+			if err != nil {
+				return err
+			}
+
+			finalProposal := &types.MerkleAirdropProposal{
+				Title:        proposal.Title,
+				Description:  proposal.Description,
+				Denom:        proposal.Denom,
+				MerkleRoot:   root,
+				Total:        total,
+				ExpiryHeight: proposal.ExpiryHeight,
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(finalProposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid metadata or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdClaimAirdrop claims the sender's share of a Merkle airdrop, the proof is computed from the proposal json the
+// airdrop was created with
+func CmdClaimAirdrop() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "claim-airdrop [airdrop-id] [path-to-proposal-json]",
+		Short: "Claims the sender's share of a Merkle airdrop, using the proposal json the airdrop was created from",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := cliCtx.GetFromAddress()
+			if sender.String() == "" {
+				return fmt.Errorf("from address must be specified")
+			}
+			airdropId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "Unable to parse airdrop-id as a non-negative integer")
+			}
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+			proposal := &MerkleAirdropProposalPlain{}
+			if err := json.Unmarshal(contents, proposal); err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			recipients, root, proofs, _, err := merkleAirdropTree(*proposal)
+			if err != nil {
+				return err
+			}
+
+			// make sure the file matches the airdrop on chain before spending fees on a claim that will fail
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.GetMerkleAirdrops(cmd.Context(), &types.QueryMerkleAirdropsRequest{})
+			if err != nil {
+				return sdkerrors.Wrap(err, "Failed to look up merkle airdrops")
+			}
+			found := false
+			for _, airdrop := range res.MerkleAirdrops {
+				if airdrop.Id == airdropId {
+					if !bytes.Equal(airdrop.MerkleRoot, root) {
+						return sdkerrors.Wrap(types.ErrInvalid, "The proposal json does not match the merkle root of this airdrop")
+					}
+					found = true
+				}
+			}
+			if !found {
+				return sdkerrors.Wrapf(types.ErrUnknown, "merkle airdrop %d does not exist or has expired", airdropId)
+			}
+
+			for i, recipient := range recipients {
+				if !recipient.Equals(sender) {
+					continue
+				}
+				msg := types.NewMsgClaimAirdrop(sender, airdropId, proposal.Amounts[i], proofs[i])
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				// Send it
+				return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+			}
+			return sdkerrors.Wrapf(types.ErrInvalid, "%s is not a recipient of this airdrop", sender)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdGovUnhaltBridgeProposal enables users to easily submit json file proposals to set the Gravity module parameters
 // which account for Ethereum forks, "rewinding" state and letting the chain achieve consensus after the fork is settled
 func CmdGovUnhaltBridgeProposal() *cobra.Command {
@@ -350,13 +846,19 @@ func CmdSendToEth() *cobra.Command {
 				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for both amount and bridgeFee")
 			}
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+
 			// Make the message
 			msg := types.MsgSendToEth{
-				Sender:    cosmosAddr.String(),
-				EthDest:   ethAddr.GetAddress().Hex(),
-				Amount:    amount[0],
-				BridgeFee: bridgeFee[0],
-				ChainFee:  chainFee[0],
+				Sender:         cosmosAddr.String(),
+				EthDest:        ethAddr.GetAddress().Hex(),
+				Amount:         amount[0],
+				BridgeFee:      bridgeFee[0],
+				ChainFee:       chainFee[0],
+				EvmChainPrefix: evmChainPrefix,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -366,6 +868,7 @@ func CmdSendToEth() *cobra.Command {
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to use, empty for the default chain")
 	return cmd
 }
 
@@ -389,10 +892,16 @@ func CmdCancelSendToEth() *cobra.Command {
 				return sdkerrors.Wrap(err, "failed to parse transaction id")
 			}
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+
 			// Make the message
 			msg := types.MsgCancelSendToEth{
-				Sender:        cosmosAddr.String(),
-				TransactionId: txId,
+				Sender:         cosmosAddr.String(),
+				TransactionId:  txId,
+				EvmChainPrefix: evmChainPrefix,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -402,6 +911,7 @@ func CmdCancelSendToEth() *cobra.Command {
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to use, empty for the default chain")
 	return cmd
 }
 
@@ -420,10 +930,21 @@ func CmdRequestBatch() *cobra.Command {
 			}
 			cosmosAddr := cliCtx.GetFromAddress()
 
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
+
+			tokenContract, err := types.NewEthAddress(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid token contract address")
+			}
+
 			// TODO: better denom searching
 			msg := types.MsgRequestBatch{
-				Sender: cosmosAddr.String(),
-				Denom:  fmt.Sprintf("gravity%s", args[0]),
+				Sender:         cosmosAddr.String(),
+				Denom:          types.GravityDenom(types.EvmChainPrefixOrDefault(evmChainPrefix), *tokenContract),
+				EvmChainPrefix: evmChainPrefix,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -433,35 +954,52 @@ func CmdRequestBatch() *cobra.Command {
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to use, empty for the default chain")
 	return cmd
 }
 
 // CmdSetOrchestratorAddress registers delegate keys for a validator so that their Orchestrator has authority to perform
-// its responsibility
+// its responsibility, the ethereum key proves that the validator controls it by signing the registration
 func CmdSetOrchestratorAddress() *cobra.Command {
 	// nolint: exhaustruct
 	cmd := &cobra.Command{
 		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address]",
 		Short: "Allows validators to delegate their voting responsibilities to a given key.",
-		Args:  cobra.ExactArgs(3),
+		Long: `Allows validators to delegate their voting responsibilities to a given key.
+The ethereum key signs the registration to prove that the validator controls it, the key is taken from
+eth_keys in the same keyring backend, see --eth-key and --eth-signature.`,
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			msg := types.MsgSetOrchestratorAddress{
-				Validator:    args[0],
-				Orchestrator: args[1],
-				EthAddress:   args[2],
+			val, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid validator address")
 			}
+			orch, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid orchestrator address")
+			}
+			ethAddr, err := types.NewEthAddress(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid ethereum address")
+			}
+			signature, err := SignDelegateKeys(cmd, cliCtx, val, cliCtx.ChainID, *ethAddr)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetOrchestratorAddress(val, orch, *ethAddr, signature)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			// Send it
-			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	AddDelegateKeysFlags(cmd)
 	return cmd
 }
 
@@ -489,9 +1027,14 @@ func CmdExecutePendingIbcAutoForwards() *cobra.Command {
 			if err != nil {
 				return sdkerrors.Wrap(err, "Unable to parse forwards-to-execute as an non-negative integer")
 			}
+			evmChainPrefix, err := cmd.Flags().GetString(FlagEvmChainPrefix)
+			if err != nil {
+				return err
+			}
 			msg := types.MsgExecuteIbcAutoForwards{
 				ForwardsToClear: forwardsToClear,
 				Executor:        cliCtx.GetFromAddress().String(),
+				EvmChainPrefix:  evmChainPrefix,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -501,5 +1044,6 @@ func CmdExecutePendingIbcAutoForwards() *cobra.Command {
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagEvmChainPrefix, "", "the EVM chain to use, empty for the default chain")
 	return cmd
 }
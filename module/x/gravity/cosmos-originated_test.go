@@ -92,20 +92,20 @@ func addDenomToERC20Relation(tv *testingVars) {
 		// check if attestations persisted
 		hash, err := ethClaim.ClaimHash()
 		require.NoError(tv.t, err)
-		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, myNonce, hash)
+		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, keeper.EthChainPrefix, myNonce, hash)
 		require.NotNil(tv.t, a)
 	}
 
 	EndBlocker(tv.ctx, tv.input.GravityKeeper)
 
 	// check if erc20<>denom relation added to db
-	isCosmosOriginated, gotERC20, err := tv.input.GravityKeeper.DenomToERC20Lookup(tv.ctx, tv.denom)
+	isCosmosOriginated, gotERC20, err := tv.input.GravityKeeper.DenomToERC20Lookup(tv.ctx, keeper.EthChainPrefix, tv.denom)
 	require.NoError(tv.t, err)
 	assert.True(tv.t, isCosmosOriginated)
 
 	ethAddr, err := types.NewEthAddress(tv.erc20)
 	require.NoError(tv.t, err)
-	isCosmosOriginated, gotDenom := tv.input.GravityKeeper.ERC20ToDenomLookup(tv.ctx, *ethAddr)
+	isCosmosOriginated, gotDenom := tv.input.GravityKeeper.ERC20ToDenomLookup(tv.ctx, keeper.EthChainPrefix, *ethAddr)
 	assert.True(tv.t, isCosmosOriginated)
 
 	assert.Equal(tv.t, tv.denom, gotDenom)
@@ -191,7 +191,7 @@ func acceptDepositEvent(tv *testingVars) {
 		// check that attestation persisted
 		hash, err := ethClaim.ClaimHash()
 		require.NoError(tv.t, err)
-		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, myNonce, hash)
+		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, keeper.EthChainPrefix, myNonce, hash)
 		require.NotNil(tv.t, a)
 	}
 
@@ -253,20 +253,20 @@ func addIbcDenomToERC20Relation(tv *testingVars) {
 		// check if attestations persisted
 		hash, err := ethClaim.ClaimHash()
 		require.NoError(tv.t, err)
-		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, myNonce, hash)
+		a := tv.input.GravityKeeper.GetAttestation(tv.ctx, keeper.EthChainPrefix, myNonce, hash)
 		require.NotNil(tv.t, a)
 	}
 
 	EndBlocker(tv.ctx, tv.input.GravityKeeper)
 
 	// check if erc20<>denom relation added to db
-	isCosmosOriginated, gotERC20, err := tv.input.GravityKeeper.DenomToERC20Lookup(tv.ctx, tv.denom)
+	isCosmosOriginated, gotERC20, err := tv.input.GravityKeeper.DenomToERC20Lookup(tv.ctx, keeper.EthChainPrefix, tv.denom)
 	require.NoError(tv.t, err)
 	assert.True(tv.t, isCosmosOriginated)
 
 	ethAddr, err := types.NewEthAddress(tv.erc20)
 	require.NoError(tv.t, err)
-	isCosmosOriginated, gotDenom := tv.input.GravityKeeper.ERC20ToDenomLookup(tv.ctx, *ethAddr)
+	isCosmosOriginated, gotDenom := tv.input.GravityKeeper.ERC20ToDenomLookup(tv.ctx, keeper.EthChainPrefix, *ethAddr)
 	assert.True(tv.t, isCosmosOriginated)
 
 	assert.Equal(tv.t, tv.denom, gotDenom)
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/testutil/eventschema"
+)
+
+// schemaGolden holds the rendered schema of every gravity.events.v1 event, indexers decode the events with it
+const schemaGolden = "testdata/schema.golden"
+
+// Tests that the events keep the schema indexers were promised, changes other than additions belong in a new
+// gravity.events.v2 package
+func TestEventSchema(t *testing.T) {
+	// nolint: exhaustruct
+	file, _ := descriptor.ForMessage(&EventSendToEthQueued{})
+	eventschema.RequireGoldenSchema(t, file, schemaGolden)
+}
+
+// Tests that nonces, ids and heights are numbers rather than strings
+func TestEventSchemaNumericFields(t *testing.T) {
+	// nolint: exhaustruct
+	file, _ := descriptor.ForMessage(&EventSendToEthQueued{})
+	// the invalidation id of a logic call is an arbitrary byte string, hex encoded
+	eventschema.RequireNumericFields(t, file, "invalidation_id")
+}
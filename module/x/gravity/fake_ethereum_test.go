@@ -0,0 +1,211 @@
+package gravity
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// fakeEthereumAddrs returns the token contract and the Ethereum accounts used by the fake Ethereum scenarios
+func fakeEthereumAddrs(t *testing.T) (token, ethSender, ethDest, relayer types.EthAddress) {
+	addrs := make([]types.EthAddress, 4)
+	for i, addr := range []string{keeper.TokenContractAddrs[0], keeper.EthAddrs[0].Hex(), keeper.EthAddrs[1].Hex(), keeper.EthAddrs[2].Hex()} {
+		ethAddr, err := types.NewEthAddress(addr)
+		require.NoError(t, err)
+		addrs[i] = *ethAddr
+	}
+	return addrs[0], addrs[1], addrs[2], addrs[3]
+}
+
+// deposit sends amount of token from ethSender to receiver through the fake contract
+func deposit(t *testing.T, env *keeper.FakeEthereumEnv, token, ethSender types.EthAddress, receiver sdk.AccAddress, amount int64) {
+	env.Contract.Mint(token, ethSender, sdk.NewInt(amount))
+	require.NoError(t, env.Contract.SendToCosmos(token, ethSender, receiver.String(), sdk.NewInt(amount)))
+}
+
+// sendToEth adds a transfer of amount vouchers of token from sender to ethDest to the pool
+func sendToEth(t *testing.T, env *keeper.FakeEthereumEnv, token, ethDest types.EthAddress, sender sdk.AccAddress, amount, fee int64) {
+	denom := types.GravityDenom(keeper.EthChainPrefix, token)
+	msgServer := keeper.NewMsgServerImpl(env.Input.GravityKeeper)
+	_, err := msgServer.SendToEth(sdk.WrapSDKContext(env.Context()), &types.MsgSendToEth{
+		Sender:         sender.String(),
+		EthDest:        ethDest.GetAddress().Hex(),
+		Amount:         sdk.NewInt64Coin(denom, amount),
+		BridgeFee:      sdk.NewInt64Coin(denom, fee),
+		ChainFee:       sdk.NewInt64Coin(denom, 0),
+		EvmChainPrefix: keeper.EthChainPrefix,
+	})
+	require.NoError(t, err)
+}
+
+// Tests a deposit from Ethereum, followed by a withdrawal of part of it in a batch relayed to the contract
+func TestFakeEthereumRoundTrip(t *testing.T) {
+	env := keeper.SetupFakeEthereumChain(t, []int64{10, 10, 10, 10}, EndBlocker)
+	defer func() { env.Input.AssertInvariants() }()
+	k := env.Input.GravityKeeper
+	token, ethSender, ethDest, relayer := fakeEthereumAddrs(t)
+	receiver := keeper.AccAddrs[0]
+	denom := types.GravityDenom(keeper.EthChainPrefix, token)
+
+	// the deployment of the contract is the first event
+	env.Step()
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(env.Context(), keeper.EthChainPrefix))
+	require.Equal(t, uint64(0), k.GetLastObservedValset(env.Context(), keeper.EthChainPrefix).Nonce)
+
+	deposit(t, env, token, ethSender, receiver, 1000)
+	env.Step()
+	require.Equal(t, sdk.NewInt(1000), env.Input.BankKeeper.GetBalance(env.Context(), receiver, denom).Amount)
+	require.Equal(t, sdk.NewInt(1000), env.Contract.BalanceOf(token, env.Contract.Address))
+
+	sendToEth(t, env, token, ethDest, receiver, 600, 10)
+	batch, err := k.BuildOutgoingTXBatch(env.Context(), keeper.EthChainPrefix, token, keeper.OutgoingTxBatchSize)
+	require.NoError(t, err)
+
+	// the batch can only be relayed once it is confirmed
+	require.ErrorIs(t, env.RelayBatch(token, batch.BatchNonce, relayer), keeper.ErrFakeInsufficientPower)
+	env.Step()
+	require.NoError(t, env.RelayBatch(token, batch.BatchNonce, relayer))
+	require.Equal(t, sdk.NewInt(600), env.Contract.BalanceOf(token, ethDest))
+	require.Equal(t, sdk.NewInt(10), env.Contract.BalanceOf(token, relayer))
+	require.Equal(t, sdk.NewInt(390), env.Contract.BalanceOf(token, env.Contract.Address))
+
+	// once observed the batch is removed and the withdrawn vouchers are burnt
+	env.Step()
+	require.Nil(t, k.GetOutgoingTXBatch(env.Context(), keeper.EthChainPrefix, token, batch.BatchNonce))
+	require.Equal(t, sdk.NewInt(390), env.Input.BankKeeper.GetBalance(env.Context(), receiver, denom).Amount)
+	require.Equal(t, sdk.NewInt(390), env.Input.BankKeeper.GetSupply(env.Context(), denom).Amount)
+
+	// the contract refuses to execute the batch again
+	require.ErrorIs(t, env.Contract.SubmitBatch(*batch, nil, relayer), keeper.ErrFakeInvalidBatchNonce)
+}
+
+// Tests that a new validator holding most of the power is brought into the contract's valset, after which the
+// remaining validators can no longer sign for the bridge on their own
+func TestFakeEthereumValsetRotation(t *testing.T) {
+	env := keeper.SetupFakeEthereumChain(t, []int64{10, 10, 10, 10}, EndBlocker)
+	defer func() { env.Input.AssertInvariants() }()
+	k := env.Input.GravityKeeper
+	token, _, ethDest, relayer := fakeEthereumAddrs(t)
+
+	// observe the deployment, which creates the first valset, then confirm it
+	env.Step()
+	env.Step()
+	require.NoError(t, env.RelayValset(1))
+	env.Step()
+	require.Equal(t, uint64(1), k.GetLastObservedValset(env.Context(), keeper.EthChainPrefix).Nonce)
+
+	newcomer := env.AddValidator(100)
+	env.NextBlock()
+	latest := k.GetLatestValset(env.Context(), keeper.EthChainPrefix)
+	require.Equal(t, uint64(2), latest.Nonce)
+	require.Len(t, latest.Members, 5)
+
+	// the new valset needs the signatures of the old one, and the contract never goes back to an older valset
+	require.ErrorIs(t, env.RelayValset(2), keeper.ErrFakeInsufficientPower)
+	env.Step()
+	require.NoError(t, env.RelayValset(2))
+	require.ErrorIs(t, env.RelayValset(1), keeper.ErrFakeInvalidValsetNonce)
+	env.Step()
+	require.Equal(t, uint64(2), env.Contract.Valset.Nonce)
+	require.Equal(t, uint64(2), k.GetLastObservedValset(env.Context(), keeper.EthChainPrefix).Nonce)
+
+	// without the newcomer, the old validators do not hold enough power to relay a batch
+	newcomer.Behavior = keeper.OfflineOrchestrator
+	sender := keeper.AccAddrs[0]
+	keeper.MintVouchersFromAir(t, env.Context(), k, sender, types.InternalERC20Token{Amount: sdk.NewInt(100), Contract: token})
+	sendToEth(t, env, token, ethDest, sender, 90, 10)
+	batch, err := k.BuildOutgoingTXBatch(env.Context(), keeper.EthChainPrefix, token, keeper.OutgoingTxBatchSize)
+	require.NoError(t, err)
+	env.Step()
+	require.Len(t, k.GetBatchConfirmByNonceAndTokenContract(env.Context(), keeper.EthChainPrefix, batch.BatchNonce, token), 4)
+	require.ErrorIs(t, env.RelayBatch(token, batch.BatchNonce, relayer), keeper.ErrFakeInsufficientPower)
+}
+
+// Tests that a batch relayed after its timeout is refused by the contract and cancelled once the chain learns of
+// a later Ethereum block, while a batch relayed in the last block before its timeout is executed, even when the
+// chain observes later blocks in the same block as the batch
+func TestFakeEthereumBatchTimeoutRace(t *testing.T) {
+	env := keeper.SetupFakeEthereumChain(t, []int64{10, 10, 10, 10}, EndBlocker)
+	defer func() { env.Input.AssertInvariants() }()
+	k := env.Input.GravityKeeper
+	token, ethSender, ethDest, relayer := fakeEthereumAddrs(t)
+	receiver := keeper.AccAddrs[0]
+	denom := types.GravityDenom(keeper.EthChainPrefix, token)
+
+	env.Step()
+	deposit(t, env, token, ethSender, receiver, 1000)
+	env.Step()
+
+	sendToEth(t, env, token, ethDest, receiver, 500, 10)
+	late, err := k.BuildOutgoingTXBatch(env.Context(), keeper.EthChainPrefix, token, keeper.OutgoingTxBatchSize)
+	require.NoError(t, err)
+	env.Step()
+	env.Contract.AdvanceBlocks(late.BatchTimeout - env.Contract.BlockHeight)
+	require.ErrorIs(t, env.RelayBatch(token, late.BatchNonce, relayer), keeper.ErrFakeBatchTimedOut)
+
+	// a deposit tells the chain that Ethereum has moved past the timeout
+	deposit(t, env, token, ethSender, receiver, 1)
+	env.Step()
+	require.Nil(t, k.GetOutgoingTXBatch(env.Context(), keeper.EthChainPrefix, token, late.BatchNonce))
+	require.Len(t, k.GetUnbatchedTransactionsByContract(env.Context(), keeper.EthChainPrefix, token), 1)
+
+	onTime, err := k.BuildOutgoingTXBatch(env.Context(), keeper.EthChainPrefix, token, keeper.OutgoingTxBatchSize)
+	require.NoError(t, err)
+	env.Step()
+	env.Contract.AdvanceBlocks(onTime.BatchTimeout - env.Contract.BlockHeight - 2)
+	require.NoError(t, env.RelayBatch(token, onTime.BatchNonce, relayer))
+	require.Equal(t, onTime.BatchTimeout-1, env.Contract.BlockHeight)
+
+	// the execution is observed together with a deposit made after the timeout, the batch must not be cancelled
+	env.Contract.AdvanceBlocks(5)
+	deposit(t, env, token, ethSender, receiver, 1)
+	env.Step()
+	require.Greater(t, k.GetLastObservedEthereumBlockHeight(env.Context(), keeper.EthChainPrefix).EthereumBlockHeight, onTime.BatchTimeout)
+	require.Nil(t, k.GetOutgoingTXBatch(env.Context(), keeper.EthChainPrefix, token, onTime.BatchNonce))
+	require.Empty(t, k.GetUnbatchedTransactionsByContract(env.Context(), keeper.EthChainPrefix, token))
+	require.Equal(t, sdk.NewInt(500), env.Contract.BalanceOf(token, ethDest))
+	require.Equal(t, sdk.NewInt(492), env.Input.BankKeeper.GetSupply(env.Context(), denom).Amount)
+}
+
+// Tests that a lying minority cannot prevent the true version of an event from being observed, and that a lying
+// minority large enough to block the threshold halts the bridge rather than letting any version through
+func TestFakeEthereumConflictingClaims(t *testing.T) {
+	env := keeper.SetupFakeEthereumChain(t, []int64{10, 10, 10, 10}, EndBlocker)
+	defer func() { env.Input.AssertInvariants() }()
+	k := env.Input.GravityKeeper
+	token, ethSender, _, _ := fakeEthereumAddrs(t)
+	receiver := keeper.AccAddrs[0]
+	denom := types.GravityDenom(keeper.EthChainPrefix, token)
+	liar := env.Orchestrators[3]
+	liar.Behavior = keeper.LyingOrchestrator
+
+	env.Step()
+	deposit(t, env, token, ethSender, receiver, 1000)
+	env.Step()
+	require.Equal(t, uint64(2), k.GetLastObservedEventNonce(env.Context(), keeper.EthChainPrefix))
+	require.Equal(t, sdk.NewInt(1000), env.Input.BankKeeper.GetBalance(env.Context(), receiver, denom).Amount)
+	require.True(t, env.Input.BankKeeper.GetBalance(env.Context(), liar.Address, denom).IsZero())
+
+	attestations, _ := k.GetAttestationMapping(env.Context(), keeper.EthChainPrefix)
+	require.Len(t, attestations[2], 2)
+	observed := 0
+	for _, att := range attestations[2] {
+		if att.Observed {
+			observed++
+			require.Len(t, att.Votes, 3)
+		}
+	}
+	require.Equal(t, 1, observed)
+
+	env.Orchestrators[2].Behavior = keeper.LyingOrchestrator
+	deposit(t, env, token, ethSender, receiver, 500)
+	env.Step()
+	env.Step()
+	require.Equal(t, uint64(2), k.GetLastObservedEventNonce(env.Context(), keeper.EthChainPrefix))
+	require.Equal(t, sdk.NewInt(1000), env.Input.BankKeeper.GetBalance(env.Context(), receiver, denom).Amount)
+	require.True(t, env.Input.BankKeeper.GetBalance(env.Context(), liar.Address, denom).IsZero())
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -138,7 +139,7 @@ func TestMsgSendToCosmosClaim(t *testing.T) {
 		// and attestation persisted
 		hash, err := ethClaim.ClaimHash()
 		require.NoError(t, err)
-		a := input.GravityKeeper.GetAttestation(ctx, uint64(1), hash)
+		a := input.GravityKeeper.GetAttestation(ctx, keeper.EthChainPrefix, uint64(1), hash)
 		require.NotNil(t, a)
 
 		// Test to reject duplicate deposit
@@ -252,7 +253,7 @@ func TestEthereumBlacklist(t *testing.T) {
 		// and attestation persisted
 		hash, err := ethClaim.ClaimHash()
 		require.NoError(t, err)
-		a := input.GravityKeeper.GetAttestation(ctx, uint64(1), hash)
+		a := input.GravityKeeper.GetAttestation(ctx, keeper.EthChainPrefix, uint64(1), hash)
 		require.NotNil(t, a)
 
 		// Test to reject duplicate deposit
@@ -311,8 +312,8 @@ func TestMsgSendToCosmosOverflow(t *testing.T) {
 		myBlockTime          = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
 		tokenEthAddress1, e2 = types.NewEthAddress(tokenETHAddr1)
 		tokenEthAddress2, e3 = types.NewEthAddress(tokenETHAddr2)
-		denom1               = types.GravityDenom(*tokenEthAddress1)
-		denom2               = types.GravityDenom(*tokenEthAddress2)
+		denom1               = types.GravityDenom(keeper.EthChainPrefix, *tokenEthAddress1)
+		denom2               = types.GravityDenom(keeper.EthChainPrefix, *tokenEthAddress2)
 	)
 	require.NoError(t, e1)
 	require.NoError(t, e2)
@@ -445,7 +446,7 @@ func TestMsgSendToCosmosClaimSpreadVotes(t *testing.T) {
 		// and attestation persisted
 		hash, err := ethClaim.ClaimHash()
 		require.NoError(t, err)
-		a1 := input.GravityKeeper.GetAttestation(ctx, myNonce, hash)
+		a1 := input.GravityKeeper.GetAttestation(ctx, keeper.EthChainPrefix, myNonce, hash)
 		require.NotNil(t, a1)
 		// and vouchers not yet added to the account
 		balance1 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
@@ -462,7 +463,7 @@ func TestMsgSendToCosmosClaimSpreadVotes(t *testing.T) {
 	// and attestation persisted
 	hash, err := ethClaim.ClaimHash()
 	require.NoError(t, err)
-	a2 := input.GravityKeeper.GetAttestation(ctx, myNonce, hash)
+	a2 := input.GravityKeeper.GetAttestation(ctx, keeper.EthChainPrefix, myNonce, hash)
 	require.NotNil(t, a2)
 	// and vouchers now added to the account
 	balance2 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
@@ -478,7 +479,7 @@ func TestMsgSendToCosmosClaimSpreadVotes(t *testing.T) {
 	// and attestation persisted
 	hash, err = ethClaim.ClaimHash()
 	require.NoError(t, err)
-	a3 := input.GravityKeeper.GetAttestation(ctx, myNonce, hash)
+	a3 := input.GravityKeeper.GetAttestation(ctx, keeper.EthChainPrefix, myNonce, hash)
 	require.NotNil(t, a3)
 	// and no additional added to the account
 	balance3 := input.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
@@ -515,7 +516,7 @@ func TestMsgSendToCosmosForeignPrefixedAddress(t *testing.T) {
 
 	myTokenAddress, err := types.NewEthAddress(myErc20.Contract)
 	require.NoError(t, err)
-	_, erc20Denom := k.ERC20ToDenomLookup(ctx, *myTokenAddress)
+	_, erc20Denom := k.ERC20ToDenomLookup(ctx, keeper.EthChainPrefix, *myTokenAddress)
 
 	foreignEthClaim := types.MsgSendToCosmosClaim{
 		EventNonce:     myNonce + 0,
@@ -558,9 +559,11 @@ func TestMsgSendToCosmosForeignPrefixedAddress(t *testing.T) {
 // nolint: exhaustruct
 func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	var (
-		ethAddress, e1                 = types.NewEthAddress("0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255")
+		ethKey, e1                     = crypto.HexToECDSA("b1bab011e03a9862664706fc3bbaa1b16651528e5f0e7fbfcbfdd8be302a13e7")
+		ethAddress, e2                 = types.NewEthAddress(crypto.PubkeyToAddress(ethKey.PublicKey).Hex())
 		cosmosAddress   sdk.AccAddress = bytes.Repeat([]byte{0x1}, 20)
-		ethAddress2, e2                = types.NewEthAddress("0x26126048c706fB45a5a6De8432F428e794d0b952")
+		ethKey2, e3                    = crypto.HexToECDSA("ce9b6a3ea4fa2a4b6f1c6e1ef6c4d7e06b9e4b4d4d2b3b1b9b6a3ea4fa2a4b6f")
+		ethAddress2, e4                = types.NewEthAddress(crypto.PubkeyToAddress(ethKey2.PublicKey).Hex())
 		cosmosAddress2  sdk.AccAddress = bytes.Repeat([]byte{0x2}, 20)
 		blockTime                      = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
 		blockTime2                     = time.Date(2020, 9, 15, 15, 20, 10, 0, time.UTC)
@@ -569,19 +572,36 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	)
 	require.NoError(t, e1)
 	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
 	input, ctx := keeper.SetupTestChain(t, []uint64{1000000000}, false)
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
 
 	wctx := sdk.WrapSDKContext(ctx)
 	k := input.GravityKeeper
 	h := NewHandler(input.GravityKeeper)
-	ctx = ctx.WithBlockTime(blockTime)
+	ctx = ctx.WithBlockTime(blockTime).WithChainID("gravity-test-1")
 	valAddress, err := sdk.ValAddressFromBech32(input.StakingKeeper.GetValidators(ctx, 10)[0].OperatorAddress)
 	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(blockHeight)
+
+	// the ethereum key must prove possession with a signature for this validator on this chain
+	signature, err := types.NewEthereumSignature(types.GetDelegateKeysSignBytes(valAddress, ctx.ChainID()), ethKey)
+	require.NoError(t, err)
+	otherChainSignature, err := types.NewEthereumSignature(types.GetDelegateKeysSignBytes(valAddress, "other-chain"), ethKey)
+	require.NoError(t, err)
+	otherKeySignature, err := types.NewEthereumSignature(types.GetDelegateKeysSignBytes(valAddress, ctx.ChainID()), ethKey2)
+	require.NoError(t, err)
+	for _, badSignature := range [][]byte{nil, otherChainSignature, otherKeySignature} {
+		msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress, badSignature)
+		_, err = h(ctx, msg)
+		require.Error(t, err)
+		_, found := k.GetEthAddressByValidator(ctx, valAddress)
+		require.False(t, found)
+	}
 
 	// test setting keys
-	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress)
-	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(blockHeight)
+	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress, signature)
 	_, err = h(ctx, msg)
 	require.NoError(t, err)
 
@@ -611,7 +631,7 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 
 	// try to set values again. This should fail see issue #344 for why allowing this
 	// would require keeping a history of all validators delegate keys forever
-	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress2, *ethAddress2)
+	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress2, *ethAddress2, otherKeySignature)
 	ctx = ctx.WithBlockTime(blockTime2).WithBlockHeight(blockHeight2)
 	_, err = h(ctx, msg)
 	require.Error(t, err)
@@ -638,12 +658,12 @@ func TestMsgValsetConfirm(t *testing.T) {
 	h := NewHandler(input.GravityKeeper)
 
 	// set a validator set in the store
-	vs, err := k.GetCurrentValset(ctx)
+	vs, err := k.GetCurrentValset(ctx, keeper.EthChainPrefix)
 	require.NoError(t, err)
 	vs.Height = uint64(1)
 	vs.Nonce = uint64(1)
-	k.StoreValset(ctx, vs)
-	k.SetLatestValsetNonce(ctx, vs.Nonce)
+	k.StoreValset(ctx, keeper.EthChainPrefix, vs)
+	k.SetLatestValsetNonce(ctx, keeper.EthChainPrefix, vs.Nonce)
 	k.SetEthAddressForValidator(input.Context, keeper.ValAddrs[0], *ethAddressParsed)
 
 	// try wrong eth address
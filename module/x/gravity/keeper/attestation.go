@@ -1,15 +1,18 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strconv"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 )
@@ -28,13 +31,14 @@ func (k Keeper) Attest(
 	if err := sdk.VerifyAddressFormat(valAddr); err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid orchestrator validator address")
 	}
+	evmChainPrefix := types.ClaimEvmChainPrefix(claim)
 	// Check that the nonce of this event is exactly one higher than the last nonce stored by this validator.
 	// We check the event nonce in processAttestation as well,
 	// but checking it here gives individual eth signers a chance to retry,
 	// and prevents validators from submitting two claims with the same nonce.
 	// This prevents there being two attestations with the same nonce that get 2/3s of the votes
 	// in the endBlocker.
-	lastEventNonce := k.GetLastEventNonceByValidator(ctx, valAddr)
+	lastEventNonce := k.GetLastEventNonceByValidator(ctx, evmChainPrefix, valAddr)
 	if claim.GetEventNonce() != lastEventNonce+1 {
 		return nil, fmt.Errorf(types.ErrNonContiguousEventNonce.Error(), lastEventNonce+1, claim.GetEventNonce())
 	}
@@ -44,7 +48,7 @@ func (k Keeper) Attest(
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "unable to compute claim hash")
 	}
-	att := k.GetAttestation(ctx, claim.GetEventNonce(), hash)
+	att := k.GetAttestation(ctx, evmChainPrefix, claim.GetEventNonce(), hash)
 
 	// If it does not exist, create a new one.
 	if att == nil {
@@ -66,8 +70,10 @@ func (k Keeper) Attest(
 		// Add the validator's vote to this attestation
 		att.Votes = append(att.Votes, valAddr.String())
 
-		k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
-		k.SetLastEventNonceByValidator(ctx, valAddr, claim.GetEventNonce())
+		k.SetAttestation(ctx, evmChainPrefix, claim.GetEventNonce(), hash, att)
+		k.SetLastEventNonceByValidator(ctx, evmChainPrefix, valAddr, claim.GetEventNonce())
+		telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyAttestationVotes), 1,
+			types.EvmChainMetricLabels(evmChainPrefix, telemetry.NewLabel(types.MetricLabelClaimType, claim.GetType().String())))
 
 		return att, nil
 	} else {
@@ -87,6 +93,7 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	if err != nil {
 		panic("unable to compute claim hash")
 	}
+	evmChainPrefix := types.ClaimEvmChainPrefix(claim)
 	// If the attestation has not yet been Observed, sum up the votes and see if it is ready to apply to the state.
 	// This conditional stops the attestation from accidentally being applied twice.
 	if !att.Observed {
@@ -106,20 +113,24 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
 			// process the attestation, set Observed to true, and break
 			if attestationPower.GT(requiredPower) {
-				lastEventNonce := k.GetLastObservedEventNonce(ctx)
+				lastEventNonce := k.GetLastObservedEventNonce(ctx, evmChainPrefix)
 				// this check is performed at the next level up so this should never panic
 				// outside of programmer error.
 				if claim.GetEventNonce() != lastEventNonce+1 {
 					panic("attempting to apply events to state out of order")
 				}
-				k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
-				k.SetLastObservedEthereumBlockHeight(ctx, claim.GetEthBlockHeight())
+				k.setLastObservedEventNonce(ctx, evmChainPrefix, claim.GetEventNonce())
+				k.SetLastObservedEthereumBlockHeight(ctx, evmChainPrefix, claim.GetEthBlockHeight())
 
 				att.Observed = true
-				k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
+				k.SetAttestation(ctx, evmChainPrefix, claim.GetEventNonce(), hash, att)
 
 				k.processAttestation(ctx, att, claim)
 				k.emitObservedEvent(ctx, att, claim)
+				telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyAttestationsObserved), 1,
+					types.EvmChainMetricLabels(evmChainPrefix, telemetry.NewLabel(types.MetricLabelClaimType, claim.GetType().String())))
+				telemetry.SetGaugeWithLabels(types.MetricKeys(types.MetricKeyLastObservedEventNonce),
+					float32(claim.GetEventNonce()), types.EvmChainMetricLabels(evmChainPrefix))
 
 				break
 			}
@@ -144,7 +155,8 @@ func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, clai
 		k.logger(ctx).Error("attestation failed",
 			"cause", err.Error(),
 			"claim type", claim.GetType(),
-			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"evm chain", types.ClaimEvmChainPrefix(claim),
+			"id", types.GetAttestationKey(types.ClaimEvmChainPrefix(claim), claim.GetEventNonce(), hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
 	} else {
@@ -160,13 +172,22 @@ func (k Keeper) emitObservedEvent(ctx sdk.Context, att *types.Attestation, claim
 		panic(sdkerrors.Wrap(err, "unable to compute claim hash"))
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(
+	evmChainPrefix := types.ClaimEvmChainPrefix(claim)
+	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventObservation{
 			AttestationType: string(claim.GetType()),
-			BridgeContract:  k.GetBridgeContractAddress(ctx).GetAddress().Hex(),
-			BridgeChainId:   strconv.Itoa(int(k.GetBridgeChainID(ctx))),
-			AttestationId:   string(types.GetAttestationKey(claim.GetEventNonce(), hash)),
+			BridgeContract:  k.GetBridgeContractAddress(ctx, evmChainPrefix).GetAddress().Hex(),
+			BridgeChainId:   strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
+			AttestationId:   string(types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash)),
 			Nonce:           fmt.Sprint(claim.GetEventNonce()),
+			EvmChainPrefix:  evmChainPrefix,
+		},
+		&eventsv1.EventAttestationObserved{
+			EvmChainPrefix: evmChainPrefix,
+			ClaimType:      claim.GetType(),
+			EventNonce:     claim.GetEventNonce(),
+			EthBlockHeight: claim.GetEthBlockHeight(),
+			ClaimHash:      hex.EncodeToString(hash),
 		},
 	)
 	if err != nil {
@@ -175,16 +196,16 @@ func (k Keeper) emitObservedEvent(ctx sdk.Context, att *types.Attestation, claim
 }
 
 // SetAttestation sets the attestation in the store
-func (k Keeper) SetAttestation(ctx sdk.Context, eventNonce uint64, claimHash []byte, att *types.Attestation) {
+func (k Keeper) SetAttestation(ctx sdk.Context, evmChainPrefix string, eventNonce uint64, claimHash []byte, att *types.Attestation) {
 	store := ctx.KVStore(k.storeKey)
-	aKey := types.GetAttestationKey(eventNonce, claimHash)
+	aKey := types.GetAttestationKey(evmChainPrefix, eventNonce, claimHash)
 	store.Set(aKey, k.cdc.MustMarshal(att))
 }
 
 // GetAttestation return an attestation given a nonce
-func (k Keeper) GetAttestation(ctx sdk.Context, eventNonce uint64, claimHash []byte) *types.Attestation {
+func (k Keeper) GetAttestation(ctx sdk.Context, evmChainPrefix string, eventNonce uint64, claimHash []byte) *types.Attestation {
 	store := ctx.KVStore(k.storeKey)
-	aKey := types.GetAttestationKey(eventNonce, claimHash)
+	aKey := types.GetAttestationKey(evmChainPrefix, eventNonce, claimHash)
 	bz := store.Get(aKey)
 	if len(bz) == 0 {
 		return nil
@@ -206,16 +227,16 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, att types.Attestation) {
 	}
 	store := ctx.KVStore(k.storeKey)
 
-	store.Delete(types.GetAttestationKey(claim.GetEventNonce(), hash))
+	store.Delete(types.GetAttestationKey(types.ClaimEvmChainPrefix(claim), claim.GetEventNonce(), hash))
 }
 
 // GetAttestationMapping returns a mapping of eventnonce -> attestations at that nonce
 // it also returns a pre-sorted array of the keys, this assists callers of this function
 // by providing a deterministic iteration order. You should always iterate over ordered keys
 // if you are iterating this map at all.
-func (k Keeper) GetAttestationMapping(ctx sdk.Context) (attestationMapping map[uint64][]types.Attestation, orderedKeys []uint64) {
+func (k Keeper) GetAttestationMapping(ctx sdk.Context, evmChainPrefix string) (attestationMapping map[uint64][]types.Attestation, orderedKeys []uint64) {
 	attestationMapping = make(map[uint64][]types.Attestation)
-	k.IterateAttestations(ctx, false, func(_ []byte, att types.Attestation) bool {
+	k.IterateAttestations(ctx, evmChainPrefix, false, func(_ []byte, att types.Attestation) bool {
 		claim, err := k.UnpackAttestationClaim(&att)
 		if err != nil {
 			panic("couldn't cast to claim")
@@ -240,9 +261,9 @@ func (k Keeper) GetAttestationMapping(ctx sdk.Context) (attestationMapping map[u
 // IterateAttestations iterates through all attestations executing a given callback on each discovered attestation
 // If reverse is true, attestations will be returned in descending order by key (aka by event nonce and then claim hash)
 // cb should return true to stop iteration, false to continue
-func (k Keeper) IterateAttestations(ctx sdk.Context, reverse bool, cb func(key []byte, att types.Attestation) (stop bool)) {
+func (k Keeper) IterateAttestations(ctx sdk.Context, evmChainPrefix string, reverse bool, cb func(key []byte, att types.Attestation) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	keyPrefix := types.OracleAttestationKey
+	keyPrefix := types.AppendEvmChainPrefix(types.OracleAttestationKey, evmChainPrefix)
 
 	var iter storetypes.Iterator
 	if reverse {
@@ -281,10 +302,10 @@ func (k Keeper) IterateAttestations(ctx sdk.Context, reverse bool, cb func(key [
 // IterateClaims iterates through all attestations, filtering them for claims of a given type
 // If reverse is true, attestations will be returned in descending order by key (aka by event nonce and then claim hash)
 // cb should return true to stop iteration, false to continue
-func (k Keeper) IterateClaims(ctx sdk.Context, reverse bool, claimType types.ClaimType, cb func(key []byte, att types.Attestation, claim types.EthereumClaim) (stop bool)) {
+func (k Keeper) IterateClaims(ctx sdk.Context, evmChainPrefix string, reverse bool, claimType types.ClaimType, cb func(key []byte, att types.Attestation, claim types.EthereumClaim) (stop bool)) {
 	typeUrl := types.ClaimTypeToTypeUrl(claimType) // Used to avoid unpacking undesired attestations
 
-	k.IterateAttestations(ctx, reverse, func(key []byte, att types.Attestation) bool {
+	k.IterateAttestations(ctx, evmChainPrefix, reverse, func(key []byte, att types.Attestation) bool {
 		if att.Claim.TypeUrl == typeUrl {
 			claim, err := k.UnpackAttestationClaim(&att)
 			if err != nil {
@@ -300,8 +321,8 @@ func (k Keeper) IterateClaims(ctx sdk.Context, reverse bool, claimType types.Cla
 // GetMostRecentAttestations returns sorted (by nonce) attestations up to a provided limit number of attestations
 // Note: calls GetAttestationMapping in the hopes that there are potentially many attestations
 // which are distributed between few nonces to minimize sorting time
-func (k Keeper) GetMostRecentAttestations(ctx sdk.Context, limit uint64) []types.Attestation {
-	attestationMapping, keys := k.GetAttestationMapping(ctx)
+func (k Keeper) GetMostRecentAttestations(ctx sdk.Context, evmChainPrefix string, limit uint64) []types.Attestation {
+	attestationMapping, keys := k.GetAttestationMapping(ctx, evmChainPrefix)
 	attestations := make([]types.Attestation, 0, limit)
 
 	// Iterate the nonces and collect the attestations
@@ -323,9 +344,9 @@ func (k Keeper) GetMostRecentAttestations(ctx sdk.Context, limit uint64) []types
 }
 
 // GetLastObservedEventNonce returns the latest observed event nonce
-func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
+func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context, evmChainPrefix string) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.LastObservedEventNonceKey)
+	bytes := store.Get(types.AppendEvmChainPrefix(types.LastObservedEventNonceKey, evmChainPrefix))
 
 	if len(bytes) == 0 {
 		return 0
@@ -338,9 +359,9 @@ func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 
 // GetLastObservedEthereumBlockHeight height gets the block height to of the last observed attestation from
 // the store
-func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastObservedEthereumBlockHeight {
+func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context, evmChainPrefix string) types.LastObservedEthereumBlockHeight {
 	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.LastObservedEthereumBlockHeightKey)
+	bytes := store.Get(types.AppendEvmChainPrefix(types.LastObservedEthereumBlockHeightKey, evmChainPrefix))
 
 	if len(bytes) == 0 {
 		return types.LastObservedEthereumBlockHeight{
@@ -357,9 +378,9 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastOb
 }
 
 // SetLastObservedEthereumBlockHeight sets the block height in the store.
-func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeight uint64) {
+func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, evmChainPrefix string, ethereumHeight uint64) {
 	store := ctx.KVStore(k.storeKey)
-	previous := k.GetLastObservedEthereumBlockHeight(ctx)
+	previous := k.GetLastObservedEthereumBlockHeight(ctx, evmChainPrefix)
 	if previous.EthereumBlockHeight > ethereumHeight {
 		panic("Attempt to roll back Ethereum block height!")
 	}
@@ -367,16 +388,16 @@ func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeig
 		EthereumBlockHeight: ethereumHeight,
 		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
 	}
-	store.Set(types.LastObservedEthereumBlockHeightKey, k.cdc.MustMarshal(&height))
+	store.Set(types.AppendEvmChainPrefix(types.LastObservedEthereumBlockHeightKey, evmChainPrefix), k.cdc.MustMarshal(&height))
 }
 
 // GetLastObservedValset retrieves the last observed validator set from the store
 // WARNING: This value is not an up to date validator set on Ethereum, it is a validator set
 // that AT ONE POINT was the one in the Gravity bridge on Ethereum. If you assume that it's up
 // to date you may break the bridge
-func (k Keeper) GetLastObservedValset(ctx sdk.Context) *types.Valset {
+func (k Keeper) GetLastObservedValset(ctx sdk.Context, evmChainPrefix string) *types.Valset {
 	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.LastObservedValsetKey)
+	bytes := store.Get(types.AppendEvmChainPrefix(types.LastObservedValsetKey, evmChainPrefix))
 
 	if len(bytes) == 0 {
 		return nil
@@ -393,38 +414,38 @@ func (k Keeper) GetLastObservedValset(ctx sdk.Context) *types.Valset {
 }
 
 // SetLastObservedValset updates the last observed validator set in the store
-func (k Keeper) SetLastObservedValset(ctx sdk.Context, valset types.Valset) {
+func (k Keeper) SetLastObservedValset(ctx sdk.Context, evmChainPrefix string, valset types.Valset) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.LastObservedValsetKey, k.cdc.MustMarshal(&valset))
+	store.Set(types.AppendEvmChainPrefix(types.LastObservedValsetKey, evmChainPrefix), k.cdc.MustMarshal(&valset))
 }
 
 // setLastObservedEventNonce sets the latest observed event nonce
-func (k Keeper) setLastObservedEventNonce(ctx sdk.Context, nonce uint64) {
+func (k Keeper) setLastObservedEventNonce(ctx sdk.Context, evmChainPrefix string, nonce uint64) {
 	store := ctx.KVStore(k.storeKey)
-	last := k.GetLastObservedEventNonce(ctx)
+	last := k.GetLastObservedEventNonce(ctx, evmChainPrefix)
 	// event nonce must increase, unless it's zero at which point allow zero to be set
 	// as many times as needed (genesis test setup etc)
 	zeroCase := last == 0 && nonce == 0
 	if last >= nonce && !zeroCase {
 		panic("Event nonce going backwards or replay!")
 	}
-	store.Set(types.LastObservedEventNonceKey, types.UInt64Bytes(nonce))
+	store.Set(types.AppendEvmChainPrefix(types.LastObservedEventNonceKey, evmChainPrefix), types.UInt64Bytes(nonce))
 }
 
 // GetLastEventNonceByValidator returns the latest event nonce for a given validator
-func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, evmChainPrefix string, validator sdk.ValAddress) uint64 {
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
 		panic(sdkerrors.Wrap(err, "invalid validator address"))
 	}
 	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.GetLastEventNonceByValidatorKey(validator))
+	bytes := store.Get(types.GetLastEventNonceByValidatorKey(evmChainPrefix, validator))
 
 	if len(bytes) == 0 {
 		// in the case that we have no existing value this is the first
 		// time a validator is submitting a claim. Since we don't want to force
 		// them to replay the entire history of all events ever we can't start
 		// at zero
-		lastEventNonce := k.GetLastObservedEventNonce(ctx)
+		lastEventNonce := k.GetLastObservedEventNonce(ctx, evmChainPrefix)
 		if lastEventNonce >= 1 {
 			return lastEventNonce - 1
 		} else {
@@ -435,18 +456,18 @@ func (k Keeper) GetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 }
 
 // SetLastEventNonceByValidator sets the latest event nonce for a give validator
-func (k Keeper) SetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) {
+func (k Keeper) SetLastEventNonceByValidator(ctx sdk.Context, evmChainPrefix string, validator sdk.ValAddress, nonce uint64) {
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
 		panic(sdkerrors.Wrap(err, "invalid validator address"))
 	}
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetLastEventNonceByValidatorKey(validator), types.UInt64Bytes(nonce))
+	store.Set(types.GetLastEventNonceByValidatorKey(evmChainPrefix, validator), types.UInt64Bytes(nonce))
 }
 
 // IterateValidatorLastEventNonces iterates through all batch confirmations
-func (k Keeper) IterateValidatorLastEventNonces(ctx sdk.Context, cb func(key []byte, nonce uint64) (stop bool)) {
+func (k Keeper) IterateValidatorLastEventNonces(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, nonce uint64) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	prefixStore := prefix.NewStore(store, types.LastEventNonceByValidatorKey)
+	prefixStore := prefix.NewStore(store, types.AppendEvmChainPrefix(types.LastEventNonceByValidatorKey, evmChainPrefix))
 	iter := prefixStore.Iterator(nil, nil)
 
 	defer iter.Close()
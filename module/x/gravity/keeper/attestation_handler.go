@@ -8,8 +8,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
@@ -32,20 +34,21 @@ func (a AttestationHandler) ValidateMembers() {
 // Handle is the entry point for Attestation processing, only attestations with sufficient validator submissions
 // should be processed through this function, solidifying their effect in chain state
 func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim types.EthereumClaim) error {
+	evmChainPrefix := types.ClaimEvmChainPrefix(claim)
 	switch claim := claim.(type) {
 
 	case *types.MsgSendToCosmosClaim:
-		return a.handleSendToCosmos(ctx, *claim)
+		return a.handleSendToCosmos(ctx, evmChainPrefix, *claim)
 
 	case *types.MsgBatchSendToEthClaim:
-		return a.handleBatchSendToEth(ctx, *claim)
+		return a.handleBatchSendToEth(ctx, evmChainPrefix, *claim)
 
 	case *types.MsgERC20DeployedClaim:
 
-		return a.handleErc20Deployed(ctx, *claim)
+		return a.handleErc20Deployed(ctx, evmChainPrefix, *claim)
 
 	case *types.MsgValsetUpdatedClaim:
-		return a.handleValsetUpdated(ctx, *claim)
+		return a.handleValsetUpdated(ctx, evmChainPrefix, *claim)
 
 	default:
 		panic(fmt.Sprintf("Invalid event type for attestations %s", claim.GetType()))
@@ -56,7 +59,7 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 // The cosmos receiver can be a native account (e.g. gravity1abc...) or a foreign account (e.g. cosmos1abc...)
 // In the event of a native receiver, bank module handles the transfer, otherwise an IBC transfer is initiated
 // Note: Previously SendToCosmos was referred to as a bridge "Deposit", as tokens are deposited into the gravity contract
-func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgSendToCosmosClaim) error {
+func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, evmChainPrefix string, claim types.MsgSendToCosmosClaim) error {
 	invalidAddress := false
 	// Validate the receiver as a valid bech32 address
 	receiverAddress, addressErr := types.IBCAddressFromBech32(claim.CosmosReceiver)
@@ -72,7 +75,7 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 			"address", receiverAddress,
 			"cause", addressErr.Error(),
 			"claim type", claim.GetType(),
-			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"id", types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
 	}
@@ -89,7 +92,7 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 		a.keeper.logger(ctx).Error("Invalid token contract",
 			"cause", errTokenAddress.Error(),
 			"claim type", claim.GetType(),
-			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"id", types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
 		return sdkerrors.Wrap(errTokenAddress, "invalid token contract on claim")
@@ -103,7 +106,7 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 		a.keeper.logger(ctx).Error("Invalid ethereum sender",
 			"cause", errEthereumSender.Error(),
 			"claim type", claim.GetType(),
-			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"id", types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
 		return sdkerrors.Wrap(errTokenAddress, "invalid ethereum sender on claim")
@@ -111,7 +114,7 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 
 	// Block blacklisted asset transfers
 	// (these funds are unrecoverable for the blacklisted sender, they will instead be sent to community pool)
-	if a.keeper.IsOnBlacklist(ctx, *ethereumSender) {
+	if a.keeper.IsOnBlacklist(ctx, evmChainPrefix, *ethereumSender) {
 		hash, er := claim.ClaimHash()
 		if er != nil {
 			return sdkerrors.Wrapf(er, "Unable to log blacklisted error, could not compute ClaimHash for claim %v: %v", claim, er)
@@ -119,20 +122,37 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 		a.keeper.logger(ctx).Error("Invalid SendToCosmos: receiver is blacklisted",
 			"address", receiverAddress,
 			"claim type", claim.GetType(),
-			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"id", types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash),
+			"nonce", fmt.Sprint(claim.GetEventNonce()),
+		)
+		invalidAddress = true
+	}
+
+	// Deposits of an ERC20 which a Cosmos originated denom has been migrated away from are only credited until the
+	// end of the migration window, afterwards they are treated like any other invalid deposit
+	if migration, found := a.keeper.GetERC20Migration(ctx, evmChainPrefix, *tokenAddress); found && claim.EthBlockHeight > migration.DepositsAcceptedUntil {
+		hash, er := claim.ClaimHash()
+		if er != nil {
+			return sdkerrors.Wrapf(er, "Unable to log migrated ERC20 error, could not compute ClaimHash for claim %v: %v", claim, er)
+		}
+		a.keeper.logger(ctx).Error("Invalid SendToCosmos: ERC20 migration window has closed",
+			"token", tokenAddress.GetAddress().Hex(),
+			"deposits accepted until", fmt.Sprint(migration.DepositsAcceptedUntil),
+			"claim type", claim.GetType(),
+			"id", types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
 		invalidAddress = true
 	}
 
 	// Check if coin is Cosmos-originated asset and get denom
-	isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, *tokenAddress)
+	isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, evmChainPrefix, *tokenAddress)
 	coin := sdk.NewCoin(denom, claim.Amount)
 	coins := sdk.Coins{coin}
 
 	moduleAddr := a.keeper.accountKeeper.GetModuleAddress(types.ModuleName)
 	if !isCosmosOriginated { // We need to mint eth-originated coins (aka vouchers)
-		if err := a.mintEthereumOriginatedVouchers(ctx, moduleAddr, claim, coin); err != nil {
+		if err := a.mintEthereumOriginatedVouchers(ctx, evmChainPrefix, moduleAddr, claim, coin); err != nil {
 			// TODO: Evaluate closely, if we can't mint an ethereum voucher, what should we do?
 			return err
 		}
@@ -141,7 +161,7 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 	if !invalidAddress { // address appears valid, attempt to send minted/locked coins to receiver
 		preSendBalance := a.keeper.bankKeeper.GetBalance(ctx, moduleAddr, denom)
 		// Failure to send will result in funds transfer to community pool
-		ibcForwardQueued, err := a.sendCoinToCosmosAccount(ctx, claim, receiverAddress, coin)
+		ibcForwardQueued, err := a.sendCoinToCosmosAccount(ctx, evmChainPrefix, claim, receiverAddress, coin)
 
 		// Perform module balance assertions
 		if err != nil || ibcForwardQueued { // ibc forward enqueue and errors should not send tokens to anyone
@@ -168,30 +188,46 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 			a.keeper.logger(ctx).Error("Failed community pool send",
 				"cause", err.Error(),
 				"claim type", claim.GetType(),
-				"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+				"id", types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash),
 				"nonce", fmt.Sprint(claim.GetEventNonce()),
 			)
 			return sdkerrors.Wrap(err, "failed to send to Community pool")
 		}
 
-		if err := ctx.EventManager().EmitTypedEvent(
+		if err := ctx.EventManager().EmitTypedEvents(
 			&types.EventInvalidSendToCosmosReceiver{
 				Amount: claim.Amount.String(),
 				Nonce:  strconv.Itoa(int(claim.GetEventNonce())),
 				Token:  tokenAddress.GetAddress().Hex(),
 				Sender: claim.EthereumSender,
 			},
+			&eventsv1.EventSendToCosmosInvalid{
+				EvmChainPrefix: evmChainPrefix,
+				EventNonce:     claim.GetEventNonce(),
+				EthSender:      ethereumSender.GetAddress().Hex(),
+				CosmosReceiver: claim.CosmosReceiver,
+				TokenContract:  tokenAddress.GetAddress().Hex(),
+				Amount:         coin,
+			},
 		); err != nil {
 			return err
 		}
 
 	} else {
-		if err := ctx.EventManager().EmitTypedEvent(
+		if err := ctx.EventManager().EmitTypedEvents(
 			&types.EventSendToCosmos{
 				Amount: claim.Amount.String(),
 				Nonce:  strconv.Itoa(int(claim.GetEventNonce())),
 				Token:  tokenAddress.GetAddress().Hex(),
 			},
+			&eventsv1.EventSendToCosmos{
+				EvmChainPrefix: evmChainPrefix,
+				EventNonce:     claim.GetEventNonce(),
+				EthSender:      ethereumSender.GetAddress().Hex(),
+				CosmosReceiver: claim.CosmosReceiver,
+				TokenContract:  tokenAddress.GetAddress().Hex(),
+				Amount:         coin,
+			},
 		); err != nil {
 			return err
 		}
@@ -203,17 +239,24 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 // Upon acceptance of sufficient validator BatchSendToEth claims: burn ethereum originated vouchers, invalidate pending
 // batches with lower claim.BatchNonce, and clean up state
 // Note: Previously SendToEth was referred to as a bridge "Withdrawal", as tokens are withdrawn from the gravity contract
-func (a AttestationHandler) handleBatchSendToEth(ctx sdk.Context, claim types.MsgBatchSendToEthClaim) error {
+func (a AttestationHandler) handleBatchSendToEth(ctx sdk.Context, evmChainPrefix string, claim types.MsgBatchSendToEthClaim) error {
 	contract, err := types.NewEthAddress(claim.TokenContract)
 	if err != nil {
 		return sdkerrors.Wrap(err, "invalid token contract on batch")
 	}
-	a.keeper.OutgoingTxBatchExecuted(ctx, *contract, claim)
+	a.keeper.OutgoingTxBatchExecuted(ctx, evmChainPrefix, *contract, claim)
 
-	err = ctx.EventManager().EmitTypedEvent(
+	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventBatchSendToEthClaim{
 			Nonce: strconv.Itoa(int(claim.BatchNonce)),
 		},
+		&eventsv1.EventBatchExecuted{
+			EvmChainPrefix: evmChainPrefix,
+			BatchNonce:     claim.BatchNonce,
+			TokenContract:  contract.GetAddress().Hex(),
+			EventNonce:     claim.GetEventNonce(),
+			EthBlockHeight: claim.GetEthBlockHeight(),
+		},
 	)
 
 	return err
@@ -221,17 +264,36 @@ func (a AttestationHandler) handleBatchSendToEth(ctx sdk.Context, claim types.Ms
 
 // Upon acceptance of sufficient ERC20 Deployed claims, register claim.TokenContract as the canonical ethereum
 // representation of the metadata governance previously voted for
-func (a AttestationHandler) handleErc20Deployed(ctx sdk.Context, claim types.MsgERC20DeployedClaim) error {
+func (a AttestationHandler) handleErc20Deployed(ctx sdk.Context, evmChainPrefix string, claim types.MsgERC20DeployedClaim) error {
 	tokenAddress, err := types.NewEthAddress(claim.TokenContract)
 	if err != nil {
 		return sdkerrors.Wrap(err, "invalid token contract on claim")
 	}
-	// Disallow re-registration when a token already has a canonical representation
-	existingERC20, exists := a.keeper.GetCosmosOriginatedERC20(ctx, claim.CosmosDenom)
-	if exists {
+	// An ERC20 may only ever represent a single denom
+	if existingDenom, exists := a.keeper.GetCosmosOriginatedDenom(ctx, evmChainPrefix, *tokenAddress); exists {
 		return sdkerrors.Wrap(
 			types.ErrInvalid,
-			fmt.Sprintf("ERC20 %s already exists for denom %s", existingERC20.GetAddress().Hex(), claim.CosmosDenom))
+			fmt.Sprintf("ERC20 %s is already registered for denom %s", tokenAddress.GetAddress().Hex(), existingDenom))
+	}
+
+	// Disallow re-registration when a token already has a canonical representation, instead the deployment
+	// is recorded so that governance may migrate the denom to it with an ERC20MigrationProposal. The
+	// deployment is checked against the denom metadata by the proposal, which may also correct the metadata
+	existingERC20, exists := a.keeper.GetCosmosOriginatedERC20(ctx, evmChainPrefix, claim.CosmosDenom)
+	if exists {
+		a.keeper.logger(ctx).Info("Recording ERC20 deployment for denom with an existing ERC20",
+			"denom", claim.CosmosDenom,
+			"existing", existingERC20.GetAddress().Hex(),
+			"deployed", tokenAddress.GetAddress().Hex(),
+		)
+		a.keeper.setAttestedERC20Deployment(ctx, evmChainPrefix, types.AttestedERC20Deployment{
+			CosmosDenom:   claim.CosmosDenom,
+			TokenContract: tokenAddress.GetAddress().Hex(),
+			Name:          claim.Name,
+			Symbol:        claim.Symbol,
+			Decimals:      claim.Decimals,
+		})
+		return nil
 	}
 
 	// Check if denom metadata has been accepted by governance
@@ -240,17 +302,42 @@ func (a AttestationHandler) handleErc20Deployed(ctx sdk.Context, claim types.Msg
 		return sdkerrors.Wrap(types.ErrUnknown, fmt.Sprintf("denom not found %s", claim.CosmosDenom))
 	}
 
+	if err := validateERC20AgainstMetadata(claim.Name, claim.Symbol, claim.Decimals, metadata); err != nil {
+		return err
+	}
+
+	// Add to denom-erc20 mapping
+	a.keeper.setCosmosOriginatedDenomToERC20(ctx, evmChainPrefix, claim.CosmosDenom, *tokenAddress)
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventERC20DeployedClaim{
+			Token: tokenAddress.GetAddress().Hex(),
+			Nonce: strconv.Itoa(int(claim.GetEventNonce())),
+		},
+		&eventsv1.EventERC20Registered{
+			EvmChainPrefix: evmChainPrefix,
+			EventNonce:     claim.GetEventNonce(),
+			CosmosDenom:    claim.CosmosDenom,
+			TokenContract:  tokenAddress.GetAddress().Hex(),
+		},
+	)
+	return err
+}
+
+// validateERC20AgainstMetadata checks that the name, symbol and decimals of a deployed ERC20 match the bank metadata
+// of the Cosmos denom it is meant to represent
+func validateERC20AgainstMetadata(name string, symbol string, erc20Decimals uint64, metadata banktypes.Metadata) error {
 	// Check if attributes of ERC20 match Cosmos denom
-	if claim.Name != metadata.Name {
+	if name != metadata.Name {
 		return sdkerrors.Wrap(
 			types.ErrInvalid,
-			fmt.Sprintf("ERC20 name %s does not match denom name %s", claim.Name, metadata.Description))
+			fmt.Sprintf("ERC20 name %s does not match denom name %s", name, metadata.Description))
 	}
 
-	if claim.Symbol != metadata.Symbol {
+	if symbol != metadata.Symbol {
 		return sdkerrors.Wrap(
 			types.ErrInvalid,
-			fmt.Sprintf("ERC20 symbol %s does not match denom symbol %s", claim.Symbol, metadata.Display))
+			fmt.Sprintf("ERC20 symbol %s does not match denom symbol %s", symbol, metadata.Display))
 	}
 
 	// ERC20 tokens use a very simple mechanism to tell you where to display the decimal point.
@@ -274,27 +361,18 @@ func (a AttestationHandler) handleErc20Deployed(ctx sdk.Context, claim types.Msg
 		}
 	}
 
-	if decimals != uint32(claim.Decimals) {
+	if decimals != uint32(erc20Decimals) {
 		return sdkerrors.Wrap(
 			types.ErrInvalid,
-			fmt.Sprintf("ERC20 decimals %d does not match denom decimals %d", claim.Decimals, decimals))
+			fmt.Sprintf("ERC20 decimals %d does not match denom decimals %d", erc20Decimals, decimals))
 	}
 
-	// Add to denom-erc20 mapping
-	a.keeper.setCosmosOriginatedDenomToERC20(ctx, claim.CosmosDenom, *tokenAddress)
-
-	err = ctx.EventManager().EmitTypedEvent(
-		&types.EventERC20DeployedClaim{
-			Token: tokenAddress.GetAddress().Hex(),
-			Nonce: strconv.Itoa(int(claim.GetEventNonce())),
-		},
-	)
-	return err
+	return nil
 }
 
 // Upon acceptance of sufficient ValsetUpdated claims: update LastObservedValset, mint cosmos-originated relayer rewards
 // so that the reward holder can send them to cosmos
-func (a AttestationHandler) handleValsetUpdated(ctx sdk.Context, claim types.MsgValsetUpdatedClaim) error {
+func (a AttestationHandler) handleValsetUpdated(ctx sdk.Context, evmChainPrefix string, claim types.MsgValsetUpdatedClaim) error {
 	rewardAddress, err := types.NewEthAddress(claim.RewardToken)
 	if err != nil {
 		return sdkerrors.Wrap(err, "invalid reward token on claim")
@@ -310,7 +388,7 @@ func (a AttestationHandler) handleValsetUpdated(ctx sdk.Context, claim types.Msg
 	// check the contents of the validator set against the store, if they differ we know that the bridge has been
 	// highjacked
 	if claim.ValsetNonce != 0 { // Handle regular valsets
-		trustedValset := a.keeper.GetValset(ctx, claim.ValsetNonce)
+		trustedValset := a.keeper.GetValset(ctx, evmChainPrefix, claim.ValsetNonce)
 		if trustedValset == nil {
 			ctx.Logger().Error("Received attestation for a valset which does not exist in store", "nonce", claim.ValsetNonce, "claim", claim)
 			return sdkerrors.Wrapf(types.ErrInvalidValset, "attested valset (%v) does not exist in store", claim.ValsetNonce)
@@ -322,10 +400,10 @@ func (a AttestationHandler) handleValsetUpdated(ctx sdk.Context, claim types.Msg
 			panic(fmt.Sprintf("Potential bridge highjacking: observed valset (%+v) does not match stored valset (%+v)! %s", observedValset, trustedValset, err.Error()))
 		}
 
-		a.keeper.SetLastObservedValset(ctx, observedValset)
+		a.keeper.SetLastObservedValset(ctx, evmChainPrefix, observedValset)
 	} else { // The 0th valset is not stored on chain init, but we need to set it as the last one
 		// Do not update Height, it's the first valset
-		a.keeper.SetLastObservedValset(ctx, claimSet)
+		a.keeper.SetLastObservedValset(ctx, evmChainPrefix, claimSet)
 	}
 
 	// if the reward is greater than zero and the reward token
@@ -334,7 +412,7 @@ func (a AttestationHandler) handleValsetUpdated(ctx sdk.Context, claim types.Msg
 	// token, or burn non cosmos native tokens
 	if claim.RewardAmount.GT(sdk.ZeroInt()) && claim.RewardToken != types.ZeroAddressString {
 		// Check if coin is Cosmos-originated asset and get denom
-		isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, *rewardAddress)
+		isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, evmChainPrefix, *rewardAddress)
 		if isCosmosOriginated {
 			// If it is cosmos originated, mint some coins to account
 			// for coins that now exist on Ethereum and may eventually come
@@ -373,10 +451,17 @@ func (a AttestationHandler) handleValsetUpdated(ctx sdk.Context, claim types.Msg
 			panic("Can not use Ethereum originated token as reward!")
 		}
 	}
-	err = ctx.EventManager().EmitTypedEvent(
+	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventValsetUpdatedClaim{
 			Nonce: strconv.Itoa(int(claim.GetEventNonce())),
 		},
+		&eventsv1.EventValsetObserved{
+			EvmChainPrefix: evmChainPrefix,
+			ValsetNonce:    claim.ValsetNonce,
+			EventNonce:     claim.GetEventNonce(),
+			RewardToken:    rewardAddress.GetAddress().Hex(),
+			RewardAmount:   claim.RewardAmount,
+		},
 	)
 
 	return err
@@ -408,7 +493,7 @@ func (a AttestationHandler) assertSentAmount(ctx sdk.Context, moduleAddr sdk.Acc
 // mintEthereumOriginatedVouchers creates new "gravity0x..." vouchers for ethereum tokens and asserts both that the
 // supply of that voucher does not exceed Uint256 max value, and the minted balance is correct
 func (a AttestationHandler) mintEthereumOriginatedVouchers(
-	ctx sdk.Context, moduleAddr sdk.AccAddress, claim types.MsgSendToCosmosClaim, coin sdk.Coin,
+	ctx sdk.Context, evmChainPrefix string, moduleAddr sdk.AccAddress, claim types.MsgSendToCosmosClaim, coin sdk.Coin,
 ) error {
 	preMintBalance := a.keeper.bankKeeper.GetBalance(ctx, moduleAddr, coin.Denom)
 	// Ensure that users are not bridging an impossible amount, only 2^256 - 1 tokens can exist on ethereum
@@ -435,7 +520,7 @@ func (a AttestationHandler) mintEthereumOriginatedVouchers(
 		a.keeper.logger(ctx).Error("Failed minting",
 			"cause", err.Error(),
 			"claim type", claim.GetType(),
-			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"id", types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
 		return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
@@ -459,7 +544,7 @@ func (a AttestationHandler) mintEthereumOriginatedVouchers(
 // send tokens to gravity1... re-prefixed account e.g. claim.CosmosReceiver = "cosmos1<account><cosmos-suffix>",
 // tokens will be received by gravity1<account><gravity-suffix>
 func (a AttestationHandler) sendCoinToCosmosAccount(
-	ctx sdk.Context, claim types.MsgSendToCosmosClaim, receiver sdk.AccAddress, coin sdk.Coin,
+	ctx sdk.Context, evmChainPrefix string, claim types.MsgSendToCosmosClaim, receiver sdk.AccAddress, coin sdk.Coin,
 ) (ibcForwardQueued bool, err error) {
 	accountPrefix, err := types.GetPrefixFromBech32(claim.CosmosReceiver)
 	if err != nil {
@@ -471,7 +556,7 @@ func (a AttestationHandler) sendCoinToCosmosAccount(
 		a.keeper.logger(ctx).Error("Invalid bech32 CosmosReceiver",
 			"cause", err.Error(), "address", receiver,
 			"claimType", claim.GetType(),
-			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"id", types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
 		return false, err
@@ -485,7 +570,7 @@ func (a AttestationHandler) sendCoinToCosmosAccount(
 	}
 
 	if accountPrefix == nativePrefix { // Send to a native gravity account
-		return false, a.sendCoinToLocalAddress(ctx, claim, receiver, coin)
+		return false, a.sendCoinToLocalAddress(ctx, evmChainPrefix, claim, receiver, coin)
 	} else { // Try to send tokens to IBC chain, fall back to native send on errors
 		hrpIbcRecord, err := a.keeper.bech32IbcKeeper.GetHrpIbcRecord(ctx, accountPrefix)
 		if err != nil {
@@ -497,20 +582,20 @@ func (a AttestationHandler) sendCoinToCosmosAccount(
 			a.keeper.logger(ctx).Error("Unregistered foreign prefix",
 				"cause", err.Error(), "address", receiver,
 				"claim type", claim.GetType(),
-				"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+				"id", types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash),
 				"nonce", fmt.Sprint(claim.GetEventNonce()),
 			)
 
 			// Fall back to sending tokens to native account
 			return false, sdkerrors.Wrap(
-				a.sendCoinToLocalAddress(ctx, claim, receiver, coin),
+				a.sendCoinToLocalAddress(ctx, evmChainPrefix, claim, receiver, coin),
 				"Unregistered foreign prefix, send via x/bank",
 			)
 		}
 
 		// Add the SendToCosmos to the Pending IBC Auto-Forward Queue, which when processed will send the funds to a
 		// local address before sending via IBC
-		err = a.addToIbcAutoForwardQueue(ctx, receiver, accountPrefix, coin, hrpIbcRecord.SourceChannel, claim)
+		err = a.addToIbcAutoForwardQueue(ctx, evmChainPrefix, receiver, accountPrefix, coin, hrpIbcRecord.SourceChannel, claim)
 
 		if err != nil {
 			a.keeper.logger(ctx).Error(
@@ -520,7 +605,7 @@ func (a AttestationHandler) sendCoinToCosmosAccount(
 			)
 			// Fall back to sending tokens to native account
 			return false, sdkerrors.Wrap(
-				a.sendCoinToLocalAddress(ctx, claim, receiver, coin),
+				a.sendCoinToLocalAddress(ctx, evmChainPrefix, claim, receiver, coin),
 				"IBC Transfer failure, send via x/bank",
 			)
 		}
@@ -531,7 +616,7 @@ func (a AttestationHandler) sendCoinToCosmosAccount(
 // Send tokens via bank keeper to a native gravity address, re-prefixing receiver to a gravity native address if necessary
 // Note: This should only be used as part of SendToCosmos attestation handling and is not a good solution for general use
 func (a AttestationHandler) sendCoinToLocalAddress(
-	ctx sdk.Context, claim types.MsgSendToCosmosClaim, receiver sdk.AccAddress, coin sdk.Coin) (err error) {
+	ctx sdk.Context, evmChainPrefix string, claim types.MsgSendToCosmosClaim, receiver sdk.AccAddress, coin sdk.Coin) (err error) {
 
 	err = a.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(coin))
 	if err != nil {
@@ -543,7 +628,7 @@ func (a AttestationHandler) sendCoinToLocalAddress(
 		a.keeper.logger(ctx).Error("Blacklisted deposit",
 			"cause", err.Error(),
 			"claim type", claim.GetType(),
-			"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
+			"id", types.GetAttestationKey(evmChainPrefix, claim.GetEventNonce(), hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
 	} else { // no error
@@ -552,12 +637,20 @@ func (a AttestationHandler) sendCoinToLocalAddress(
 			"ethContract", claim.TokenContract, "ethBlockHeight", claim.EthBlockHeight,
 			"cosmosBlockHeight", ctx.BlockHeight(),
 		)
-		if err := ctx.EventManager().EmitTypedEvent(&types.EventSendToCosmosLocal{
-			Nonce:    fmt.Sprint(claim.EventNonce),
-			Receiver: receiver.String(),
-			Token:    coin.Denom,
-			Amount:   coin.Amount.String(),
-		}); err != nil {
+		if err := ctx.EventManager().EmitTypedEvents(
+			&types.EventSendToCosmosLocal{
+				Nonce:    fmt.Sprint(claim.EventNonce),
+				Receiver: receiver.String(),
+				Token:    coin.Denom,
+				Amount:   coin.Amount.String(),
+			},
+			&eventsv1.EventSendToCosmosLocal{
+				EvmChainPrefix: evmChainPrefix,
+				EventNonce:     claim.EventNonce,
+				Receiver:       receiver.String(),
+				Amount:         coin,
+			},
+		); err != nil {
 			return err
 		}
 	}
@@ -569,7 +662,7 @@ func (a AttestationHandler) sendCoinToLocalAddress(
 // The ibc MsgTransfer is sent with all zero timeouts, as retrying a failed send is not an easy option
 // Note: This should only be used as part of SendToCosmos attestation handling and is not a good solution for general use
 func (a AttestationHandler) addToIbcAutoForwardQueue(
-	ctx sdk.Context,
+	ctx sdk.Context, evmChainPrefix string,
 	receiver sdk.AccAddress,
 	accountPrefix string,
 	coin sdk.Coin,
@@ -592,5 +685,5 @@ func (a AttestationHandler) addToIbcAutoForwardQueue(
 	}
 
 	// forward will be validated when adding to queue, error only returned if unable to send funds to local user
-	return a.keeper.addPendingIbcAutoForward(ctx, forward, claim.TokenContract)
+	return a.keeper.addPendingIbcAutoForward(ctx, evmChainPrefix, forward, claim.TokenContract)
 }
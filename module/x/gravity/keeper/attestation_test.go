@@ -25,35 +25,35 @@ func TestGetAndDeleteAttestation(t *testing.T) {
 	// Get created attestations
 	for i := 0; i < length; i++ {
 		nonce := uint64(1 + i)
-		att := k.GetAttestation(ctx, nonce, hashes[i])
+		att := k.GetAttestation(ctx, EthChainPrefix, nonce, hashes[i])
 		require.NotNil(t, att)
 	}
 
-	recentAttestations := k.GetMostRecentAttestations(ctx, uint64(length))
+	recentAttestations := k.GetMostRecentAttestations(ctx, EthChainPrefix, uint64(length))
 	require.True(t, len(recentAttestations) == length)
 
 	// Delete last 3 attestations
 	var nilAtt *types.Attestation
 	for i := 7; i < length; i++ {
 		nonce := uint64(1 + i)
-		att := k.GetAttestation(ctx, nonce, hashes[i])
+		att := k.GetAttestation(ctx, EthChainPrefix, nonce, hashes[i])
 		k.DeleteAttestation(ctx, *att)
 
-		att = k.GetAttestation(ctx, nonce, hashes[i])
+		att = k.GetAttestation(ctx, EthChainPrefix, nonce, hashes[i])
 		require.Equal(t, nilAtt, att)
 	}
-	recentAttestations = k.GetMostRecentAttestations(ctx, uint64(10))
+	recentAttestations = k.GetMostRecentAttestations(ctx, EthChainPrefix, uint64(10))
 	require.True(t, len(recentAttestations) == 7)
 
 	// Check all attestations again
 	for i := 0; i < 7; i++ {
 		nonce := uint64(1 + i)
-		att := k.GetAttestation(ctx, nonce, hashes[i])
+		att := k.GetAttestation(ctx, EthChainPrefix, nonce, hashes[i])
 		require.NotNil(t, att)
 	}
 	for i := 7; i < length; i++ {
 		nonce := uint64(1 + i)
-		att := k.GetAttestation(ctx, nonce, hashes[i])
+		att := k.GetAttestation(ctx, EthChainPrefix, nonce, hashes[i])
 		require.Equal(t, nilAtt, att)
 	}
 }
@@ -69,7 +69,7 @@ func TestGetMostRecentAttestations(t *testing.T) {
 	length := 10
 	msgs, anys, _ := createAttestations(t, length, k, ctx)
 
-	recentAttestations := k.GetMostRecentAttestations(ctx, uint64(length))
+	recentAttestations := k.GetMostRecentAttestations(ctx, EthChainPrefix, uint64(length))
 	require.True(t, len(recentAttestations) == length,
 		"recentAttestations should have len %v but instead has %v", length, len(recentAttestations))
 	for n, attest := range recentAttestations {
@@ -120,7 +120,7 @@ func createAttestations(t *testing.T, length int, k Keeper, ctx sdktypes.Context
 		hash, err := msg.ClaimHash()
 		hashes = append(hashes, hash)
 		require.NoError(t, err)
-		k.SetAttestation(ctx, nonce, hash, att)
+		k.SetAttestation(ctx, EthChainPrefix, nonce, hash, att)
 	}
 
 	return msgs, anys, hashes
@@ -133,9 +133,9 @@ func TestGetSetLastObservedEthereumBlockHeight(t *testing.T) {
 
 	ethereumHeight := uint64(7654321)
 
-	require.NotPanics(t, func() { k.SetLastObservedEthereumBlockHeight(ctx, ethereumHeight) })
+	require.NotPanics(t, func() { k.SetLastObservedEthereumBlockHeight(ctx, EthChainPrefix, ethereumHeight) })
 
-	ethHeight := k.GetLastObservedEthereumBlockHeight(ctx)
+	ethHeight := k.GetLastObservedEthereumBlockHeight(ctx, EthChainPrefix)
 	require.Equal(t, uint64(ctx.BlockHeight()), ethHeight.CosmosBlockHeight)
 	require.Equal(t, ethereumHeight, ethHeight.EthereumBlockHeight)
 }
@@ -166,9 +166,9 @@ func TestGetSetLastObservedValset(t *testing.T) {
 		RewardToken:  "footoken",
 	}
 
-	require.NotPanics(t, func() { k.SetLastObservedValset(ctx, setValset) })
+	require.NotPanics(t, func() { k.SetLastObservedValset(ctx, EthChainPrefix, setValset) })
 
-	getValset := k.GetLastObservedValset(ctx)
+	getValset := k.GetLastObservedValset(ctx, EthChainPrefix)
 	require.EqualValues(t, setValset, *getValset)
 }
 
@@ -187,13 +187,13 @@ func TestGetSetLastEventNonceByValidator(t *testing.T) {
 	addrInBytes := valAccount.GetAddress().Bytes()
 
 	// In case this is first time validator is submiting claim, nonce is expected to be LastObservedNonce-1
-	k.setLastObservedEventNonce(ctx, nonce)
-	getEventNonce := k.GetLastEventNonceByValidator(ctx, addrInBytes)
+	k.setLastObservedEventNonce(ctx, EthChainPrefix, nonce)
+	getEventNonce := k.GetLastEventNonceByValidator(ctx, EthChainPrefix, addrInBytes)
 	require.Equal(t, nonce-1, getEventNonce)
 
-	require.NotPanics(t, func() { k.SetLastEventNonceByValidator(ctx, addrInBytes, nonce) })
+	require.NotPanics(t, func() { k.SetLastEventNonceByValidator(ctx, EthChainPrefix, addrInBytes, nonce) })
 
-	getEventNonce = k.GetLastEventNonceByValidator(ctx, addrInBytes)
+	getEventNonce = k.GetLastEventNonceByValidator(ctx, EthChainPrefix, addrInBytes)
 	require.Equal(t, nonce, getEventNonce)
 }
 
@@ -208,8 +208,8 @@ func TestInvalidHeight(t *testing.T) {
 	orch0 := OrchAddrs[0]
 	sender := AccAddrs[0]
 	receiver := EthAddrs[0]
-	lastNonce := pk.GetLastObservedEventNonce(ctx)
-	lastEthHeight := pk.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
+	lastNonce := pk.GetLastObservedEventNonce(ctx, EthChainPrefix)
+	lastEthHeight := pk.GetLastObservedEthereumBlockHeight(ctx, EthChainPrefix).EthereumBlockHeight
 	lastBatchNonce := 0
 	tokenContract := "0x2260FAC5E5542a773Aa44fBCfeDf7C193bc2C599"
 	goodHeight := lastEthHeight + 1
@@ -238,7 +238,7 @@ func TestInvalidHeight(t *testing.T) {
 	}
 	b, err := batch.ToInternal()
 	require.NoError(t, err)
-	pk.StoreBatch(ctx, *b)
+	pk.StoreBatch(ctx, EthChainPrefix, *b)
 
 	// Submit a bad claim with EthBlockHeight >= timeout
 
@@ -266,7 +266,7 @@ func TestInvalidHeight(t *testing.T) {
 	// Assert that there is no attestation since the above panicked
 	badHash, err := bad.ClaimHash()
 	require.NoError(t, err)
-	att := pk.GetAttestation(ctx, bad.GetEventNonce(), badHash)
+	att := pk.GetAttestation(ctx, EthChainPrefix, bad.GetEventNonce(), badHash)
 	require.Nil(t, att)
 
 	// Attest the actual batch, and assert the votes are correct
@@ -286,7 +286,7 @@ func TestInvalidHeight(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, badHash, goodHash) // The hash should be the same, even though that's wrong
 
-		att := pk.GetAttestation(ctx, good.GetEventNonce(), goodHash)
+		att := pk.GetAttestation(ctx, EthChainPrefix, good.GetEventNonce(), goodHash)
 		require.NotNil(t, att)
 		log.Info("Asserting that the bad attestation only has one claimer", "attVotes", att.Votes)
 		require.Equal(t, len(att.Votes), i+1) // Only these good orchestrators votes should be counted
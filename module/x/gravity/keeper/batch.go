@@ -5,9 +5,11 @@ import (
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
@@ -17,11 +19,12 @@ const OutgoingTxBatchSize = 100
 // - find bridged denominator for given voucher type
 // - determine if an unexecuted batch is already waiting for this token type, if so confirm the new batch would
 // have a higher total fees. If not exit without creating a batch
-// - select available transactions from the outgoing transaction pool sorted by fee desc
+// - select available transactions from the outgoing transaction pool sorted by fee desc, as many as fit within
+// maxElements and the gas budget of the token
 // - persist an outgoing batch object with an incrementing ID = nonce
 // - emit an event
 func (k Keeper) BuildOutgoingTXBatch(
-	ctx sdk.Context,
+	ctx sdk.Context, evmChainPrefix string,
 	contract types.EthAddress,
 	maxElements uint) (*types.InternalOutgoingTxBatch, error) {
 	if maxElements == 0 {
@@ -31,15 +34,21 @@ func (k Keeper) BuildOutgoingTXBatch(
 	if !params.BridgeActive {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "bridge paused")
 	}
+	if _, migrated := k.GetERC20Migration(ctx, evmChainPrefix, contract); migrated {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "token contract has been migrated, outgoing transfers are frozen")
+	}
+	// the pool is ordered by fee and every transfer of a token is estimated to cost the same gas, so the batch with the
+	// highest fees within the gas budget of the token is made of the first transfers which fit
+	maxElements = params.TokenGasParamsFor(evmChainPrefix, contract).MaxBatchTransfers(maxElements)
 
-	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, contract)
+	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, evmChainPrefix, contract)
 
 	// lastBatch may be nil if there are no existing batches, we only need
 	// to perform this check if a previous batch exists
 	if lastBatch != nil {
 		// this traverses the current tx pool for this token type and determines what
 		// fees a hypothetical batch would have if created
-		currentFees := k.GetBatchFeeByTokenType(ctx, contract, maxElements)
+		currentFees := k.GetBatchFeeByTokenType(ctx, evmChainPrefix, contract, maxElements)
 		if currentFees == nil {
 			return nil, sdkerrors.Wrap(types.ErrInvalid, "error getting fees from tx pool")
 		}
@@ -50,61 +59,83 @@ func (k Keeper) BuildOutgoingTXBatch(
 		}
 	}
 
-	selectedTxs, err := k.pickUnbatchedTxs(ctx, contract, maxElements)
+	selectedTxs, err := k.pickUnbatchedTxs(ctx, evmChainPrefix, contract, maxElements)
 	if err != nil {
 		return nil, err
 	} else if len(selectedTxs) == 0 {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "no transactions of this type to batch")
 	}
+	k.resetEmptyPoolMetrics(ctx, evmChainPrefix, contract)
 
 	nextID := k.autoIncrementID(ctx, types.KeyLastOutgoingBatchID)
-	batch, err := types.NewInternalOutgingTxBatch(nextID, k.getBatchTimeoutHeight(ctx), selectedTxs, contract, 0)
+	batch, err := types.NewInternalOutgingTxBatch(nextID, k.getBatchTimeoutHeight(ctx, evmChainPrefix), selectedTxs, contract, 0)
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "unable to create batch"))
 	}
 	// set the current block height when storing the batch
 	batch.CosmosBlockCreated = uint64(ctx.BlockHeight())
-	k.StoreBatch(ctx, *batch)
+	k.StoreBatch(ctx, evmChainPrefix, *batch)
 
 	// Get the checkpoint and store it as a legit past batch
-	checkpoint := batch.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	checkpoint := batch.GetCheckpoint(k.GetGravityID(ctx, evmChainPrefix))
+	k.SetPastEthSignatureCheckpoint(ctx, evmChainPrefix, checkpoint)
+	telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyBatchesCreated), 1,
+		types.EvmChainMetricLabels(evmChainPrefix, types.TokenMetricLabel(contract)))
 
-	return batch, ctx.EventManager().EmitTypedEvent(
+	return batch, ctx.EventManager().EmitTypedEvents(
 		&types.EventOutgoingBatch{
-			BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress().Hex(),
-			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx))),
-			BatchId:        string(types.GetOutgoingTxBatchKey(contract, nextID)),
+			BridgeContract: k.GetBridgeContractAddress(ctx, evmChainPrefix).GetAddress().Hex(),
+			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
+			BatchId:        string(types.GetOutgoingTxBatchKey(evmChainPrefix, contract, nextID)),
 			Nonce:          fmt.Sprint(nextID),
 		},
+		&eventsv1.EventBatchCreated{
+			EvmChainPrefix: evmChainPrefix,
+			BatchNonce:     nextID,
+			TokenContract:  contract.GetAddress().Hex(),
+			BatchTimeout:   batch.BatchTimeout,
+			TxIds:          batch.TxIds(),
+			TotalFees:      batch.ToExternal().GetFees(),
+		},
 	)
 }
 
 // This gets the batch timeout height in Ethereum blocks.
-func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
+func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context, evmChainPrefix string) uint64 {
+	params := k.GetParams(ctx)
+	// we do not concern ourselves if the projection is zero because no batch can be produced if the last Ethereum
+	// block height is not first populated by a deposit event.
+	projectedCurrentEthereumHeight := k.projectedEthereumHeight(ctx, evmChainPrefix)
+	if projectedCurrentEthereumHeight == 0 {
+		return 0
+	}
+	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
+	// place on top of our projection of the current Ethereum block height.
+	blocksToAdd := params.TargetBatchTimeout / params.AverageEthereumBlockTime
+	return projectedCurrentEthereumHeight + blocksToAdd
+}
+
+// projectedEthereumHeight estimates the current height of the EVM chain from the last observed Cosmos and Ethereum
+// heights and the average block times, it returns zero if no Ethereum height has been observed yet
+func (k Keeper) projectedEthereumHeight(ctx sdk.Context, evmChainPrefix string) uint64 {
 	params := k.GetParams(ctx)
 	currentCosmosHeight := ctx.BlockHeight()
-	// we store the last observed Cosmos and Ethereum heights, we do not concern ourselves if these values are zero because
-	// no batch can be produced if the last Ethereum block height is not first populated by a deposit event.
-	heights := k.GetLastObservedEthereumBlockHeight(ctx)
+	// we store the last observed Cosmos and Ethereum heights
+	heights := k.GetLastObservedEthereumBlockHeight(ctx, evmChainPrefix)
 	if heights.CosmosBlockHeight == 0 || heights.EthereumBlockHeight == 0 {
 		return 0
 	}
 	// we project how long it has been in milliseconds since the last Ethereum block height was observed
 	projectedMillis := (uint64(currentCosmosHeight) - heights.CosmosBlockHeight) * params.AverageBlockTime
 	// we convert that projection into the current Ethereum height using the average Ethereum block time in millis
-	projectedCurrentEthereumHeight := (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumBlockHeight
-	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
-	// place on top of our projection of the current Ethereum block height.
-	blocksToAdd := params.TargetBatchTimeout / params.AverageEthereumBlockTime
-	return projectedCurrentEthereumHeight + blocksToAdd
+	return (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumBlockHeight
 }
 
 // OutgoingTxBatchExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
 // It frees all the transactions in the batch, then cancels all earlier batches, this function panics instead
 // of returning errors because any failure will cause a double spend.
-func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.EthAddress, claim types.MsgBatchSendToEthClaim) {
-	b := k.GetOutgoingTXBatch(ctx, tokenContract, claim.BatchNonce)
+func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress, claim types.MsgBatchSendToEthClaim) {
+	b := k.GetOutgoingTXBatch(ctx, evmChainPrefix, tokenContract, claim.BatchNonce)
 	if b == nil {
 		panic(fmt.Sprintf("unknown batch nonce for outgoing tx batch %s %d", tokenContract.GetAddress().Hex(), claim.BatchNonce))
 	}
@@ -113,7 +144,7 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 	}
 	contract := b.TokenContract
 	// Burn tokens if they're Ethereum originated
-	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, contract); !isCosmosOriginated {
+	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, evmChainPrefix, contract); !isCosmosOriginated {
 		totalToBurn := sdk.NewInt(0)
 		for _, tx := range b.Transactions {
 			totalToBurn = totalToBurn.Add(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
@@ -123,17 +154,17 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "invalid ERC20 address in executed batch"))
 		}
-		burnVouchers := sdk.NewCoins(erc20.GravityCoin())
+		burnVouchers := sdk.NewCoins(erc20.GravityCoin(evmChainPrefix))
 		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnVouchers); err != nil {
 			panic(err)
 		}
 	}
 
 	// Iterate through remaining batches
-	k.IterateOutgoingTxBatches(ctx, func(key []byte, batch types.InternalOutgoingTxBatch) bool {
+	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(key []byte, batch types.InternalOutgoingTxBatch) bool {
 		// If the iterated batches nonce is lower than the one that was just executed, cancel it
 		if batch.BatchNonce < b.BatchNonce && batch.TokenContract.GetAddress() == tokenContract.GetAddress() {
-			err := k.CancelOutgoingTXBatch(ctx, tokenContract, batch.BatchNonce)
+			err := k.CancelOutgoingTXBatch(ctx, evmChainPrefix, tokenContract, batch.BatchNonce)
 			if err != nil {
 				panic(fmt.Sprintf("Failed cancel out batch %s %d while trying to execute %s %d with %s",
 					tokenContract.GetAddress().Hex(), batch.BatchNonce,
@@ -143,22 +174,35 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		return false
 	})
 
+	k.recordBatchFees(ctx, evmChainPrefix, *b)
+	for _, tx := range b.Transactions {
+		k.deleteOutgoingTxCreatedHeight(ctx, evmChainPrefix, tx.Id)
+	}
+	telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyBatchesExecuted), 1,
+		types.EvmChainMetricLabels(evmChainPrefix, types.TokenMetricLabel(contract)))
+
 	// Delete batch since it is finished
-	k.DeleteBatch(ctx, *b)
+	k.DeleteBatch(ctx, evmChainPrefix, *b)
 	// Delete it's confirmations as well
-	k.DeleteBatchConfirms(ctx, *b)
+	k.DeleteBatchConfirms(ctx, evmChainPrefix, *b)
+}
+
+// EstimateBatchGas returns the estimated gas of executing batch on the EVM chain along with the gas params of its token
+func (k Keeper) EstimateBatchGas(ctx sdk.Context, evmChainPrefix string, batch types.InternalOutgoingTxBatch) (uint64, types.TokenGasParams) {
+	gasParams := k.GetParams(ctx).TokenGasParamsFor(evmChainPrefix, batch.TokenContract)
+	return types.EstimateBatchGas(gasParams.GasPerTransfer, uint64(len(batch.Transactions))), gasParams
 }
 
 // StoreBatch stores a transaction batch, it will refuse to overwrite an existing
 // batch and panic instead, once a batch is stored in state signature collection begins
 // so no mutation of a batch in state can ever be valid
-func (k Keeper) StoreBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch) {
+func (k Keeper) StoreBatch(ctx sdk.Context, evmChainPrefix string, batch types.InternalOutgoingTxBatch) {
 	if err := batch.ValidateBasic(); err != nil {
 		panic(sdkerrors.Wrap(err, "attempted to store invalid batch"))
 	}
 	externalBatch := batch.ToExternal()
 	store := ctx.KVStore(k.storeKey)
-	key := types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce)
+	key := types.GetOutgoingTxBatchKey(evmChainPrefix, batch.TokenContract, batch.BatchNonce)
 	if store.Has(key) {
 		panic(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Should never overwrite batch!"))
 	}
@@ -166,22 +210,22 @@ func (k Keeper) StoreBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch)
 }
 
 // DeleteBatch deletes an outgoing transaction batch
-func (k Keeper) DeleteBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch) {
+func (k Keeper) DeleteBatch(ctx sdk.Context, evmChainPrefix string, batch types.InternalOutgoingTxBatch) {
 	if err := batch.ValidateBasic(); err != nil {
 		panic(sdkerrors.Wrap(err, "attempted to delete invalid batch"))
 	}
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce))
+	store.Delete(types.GetOutgoingTxBatchKey(evmChainPrefix, batch.TokenContract, batch.BatchNonce))
 }
 
 // pickUnbatchedTxs moves unbatched Txs from the pool into a collection ready for batching
 func (k Keeper) pickUnbatchedTxs(
-	ctx sdk.Context,
+	ctx sdk.Context, evmChainPrefix string,
 	contractAddress types.EthAddress,
 	maxElements uint) ([]*types.InternalOutgoingTransferTx, error) {
 	var selectedTxs []*types.InternalOutgoingTransferTx
 	var err error
-	k.IterateUnbatchedTransactionsByContract(ctx, contractAddress, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+	k.IterateUnbatchedTransactionsByContract(ctx, evmChainPrefix, contractAddress, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 		if tx != nil && tx.Erc20Fee != nil {
 			// check the blacklist before picking this tx, this was already
 			// checked on MsgSendToEth, but we want to double check. For example
@@ -189,15 +233,15 @@ func (k Keeper) pickUnbatchedTxs(
 			// batches with that tx will forever panic, blocking that erc20. With this check governance
 			// can add that address to the blacklist and quickly eliminate the issue. Note this is
 			// very inefficient, IsOnBlacklist is O(blacklist-length) and should be made faster
-			if !k.IsOnBlacklist(ctx, *tx.DestAddress) {
+			if !k.IsOnBlacklist(ctx, evmChainPrefix, *tx.DestAddress) {
 				selectedTxs = append(selectedTxs, tx)
-				err = k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Id)
+				err = k.removeUnbatchedTX(ctx, evmChainPrefix, *tx.Erc20Fee, tx.Id)
 				if err != nil {
 					panic("Failed to remote tx from unbatched queue")
 				}
 
 				// double check that no duplicates exist in the index
-				oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
+				oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, evmChainPrefix, *tx.Erc20Fee, tx.Id)
 				if oldTx != nil || oldTxErr == nil {
 					panic("picked a duplicate transaction from the pool, duplicates should never exist!")
 				}
@@ -216,9 +260,9 @@ func (k Keeper) pickUnbatchedTxs(
 }
 
 // GetOutgoingTXBatch loads a batch object. Returns nil when not exists.
-func (k Keeper) GetOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) *types.InternalOutgoingTxBatch {
+func (k Keeper) GetOutgoingTXBatch(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress, nonce uint64) *types.InternalOutgoingTxBatch {
 	store := ctx.KVStore(k.storeKey)
-	key := types.GetOutgoingTxBatchKey(tokenContract, nonce)
+	key := types.GetOutgoingTxBatchKey(evmChainPrefix, tokenContract, nonce)
 	bz := store.Get(key)
 	if len(bz) == 0 {
 		return nil
@@ -237,36 +281,47 @@ func (k Keeper) GetOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddre
 }
 
 // CancelOutgoingTXBatch releases all TX in the batch and deletes the batch
-func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) error {
-	batch := k.GetOutgoingTXBatch(ctx, tokenContract, nonce)
+func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress, nonce uint64) error {
+	batch := k.GetOutgoingTXBatch(ctx, evmChainPrefix, tokenContract, nonce)
 	if batch == nil {
 		return types.ErrUnknown
 	}
+	// Transactions of a migrated ERC20 return to the pool under the ERC20 currently representing the denom
+	migration, migrated := k.GetERC20Migration(ctx, evmChainPrefix, tokenContract)
 	for _, tx := range batch.Transactions {
-		err := k.addUnbatchedTX(ctx, tx)
+		if migrated {
+			tx = migrateOutgoingTransferTx(tx, k.currentERC20ForMigratedTx(ctx, evmChainPrefix, *migration))
+		}
+		err := k.addUnbatchedTX(ctx, evmChainPrefix, tx)
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "unable to add batched transaction back into pool %v", tx))
 		}
 	}
 
 	// Delete batch since it is finished
-	k.DeleteBatch(ctx, *batch)
+	k.DeleteBatch(ctx, evmChainPrefix, *batch)
 	// Delete it's confirmations as well
-	k.DeleteBatchConfirms(ctx, *batch)
+	k.DeleteBatchConfirms(ctx, evmChainPrefix, *batch)
 
-	return ctx.EventManager().EmitTypedEvent(
+	return ctx.EventManager().EmitTypedEvents(
 		&types.EventOutgoingBatchCanceled{
-			BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress().Hex(),
-			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx))),
-			BatchId:        string(types.GetOutgoingTxBatchKey(tokenContract, nonce)),
+			BridgeContract: k.GetBridgeContractAddress(ctx, evmChainPrefix).GetAddress().Hex(),
+			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
+			BatchId:        string(types.GetOutgoingTxBatchKey(evmChainPrefix, tokenContract, nonce)),
 			Nonce:          fmt.Sprint(nonce),
 		},
+		&eventsv1.EventBatchCanceled{
+			EvmChainPrefix: evmChainPrefix,
+			BatchNonce:     nonce,
+			TokenContract:  tokenContract.GetAddress().Hex(),
+			TxIds:          batch.TxIds(),
+		},
 	)
 }
 
 // IterateOutgoingTxBatches iterates through all outgoing batches in DESC order.
-func (k Keeper) IterateOutgoingTxBatches(ctx sdk.Context, cb func(key []byte, batch types.InternalOutgoingTxBatch) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXBatchKey)
+func (k Keeper) IterateOutgoingTxBatches(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, batch types.InternalOutgoingTxBatch) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendEvmChainPrefix(types.OutgoingTXBatchKey, evmChainPrefix))
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -284,17 +339,17 @@ func (k Keeper) IterateOutgoingTxBatches(ctx sdk.Context, cb func(key []byte, ba
 }
 
 // GetOutgoingTxBatches returns the outgoing tx batches
-func (k Keeper) GetOutgoingTxBatches(ctx sdk.Context) (out []types.InternalOutgoingTxBatch) {
-	k.IterateOutgoingTxBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+func (k Keeper) GetOutgoingTxBatches(ctx sdk.Context, evmChainPrefix string) (out []types.InternalOutgoingTxBatch) {
+	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
 		out = append(out, batch)
 		return false
 	})
 	return
 }
 
-func (k Keeper) GetOutgoingTxBatchesByNonce(ctx sdk.Context) map[uint64]types.InternalOutgoingTxBatch {
+func (k Keeper) GetOutgoingTxBatchesByNonce(ctx sdk.Context, evmChainPrefix string) map[uint64]types.InternalOutgoingTxBatch {
 	batchesByNonce := make(map[uint64]types.InternalOutgoingTxBatch)
-	k.IterateOutgoingTxBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
 		if _, exists := batchesByNonce[batch.BatchNonce]; exists {
 			panic(fmt.Sprintf("Batch with duplicate batch nonce %d in store", batch.BatchNonce))
 		}
@@ -305,8 +360,8 @@ func (k Keeper) GetOutgoingTxBatchesByNonce(ctx sdk.Context) map[uint64]types.In
 }
 
 // GetLastOutgoingBatchByTokenType gets the latest outgoing tx batch by token type
-func (k Keeper) GetLastOutgoingBatchByTokenType(ctx sdk.Context, token types.EthAddress) *types.InternalOutgoingTxBatch {
-	batches := k.GetOutgoingTxBatches(ctx)
+func (k Keeper) GetLastOutgoingBatchByTokenType(ctx sdk.Context, evmChainPrefix string, token types.EthAddress) *types.InternalOutgoingTxBatch {
+	batches := k.GetOutgoingTxBatches(ctx, evmChainPrefix)
 	var lastBatch *types.InternalOutgoingTxBatch = nil
 	lastNonce := uint64(0)
 	for i, batch := range batches {
@@ -319,28 +374,28 @@ func (k Keeper) GetLastOutgoingBatchByTokenType(ctx sdk.Context, token types.Eth
 }
 
 // HasLastSlashedBatchBlock returns true if the last slashed batch block has been set in the store
-func (k Keeper) HasLastSlashedBatchBlock(ctx sdk.Context) bool {
+func (k Keeper) HasLastSlashedBatchBlock(ctx sdk.Context, evmChainPrefix string) bool {
 	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.LastSlashedBatchBlock)
+	return store.Has(types.AppendEvmChainPrefix(types.LastSlashedBatchBlock, evmChainPrefix))
 }
 
 // SetLastSlashedBatchBlock sets the latest slashed Batch block height this is done by
 // block height instead of nonce because batches could have individual nonces for each token type
 // this function will panic if a lower last slashed block is set, this protects against programmer error
-func (k Keeper) SetLastSlashedBatchBlock(ctx sdk.Context, blockHeight uint64) {
+func (k Keeper) SetLastSlashedBatchBlock(ctx sdk.Context, evmChainPrefix string, blockHeight uint64) {
 
-	if k.HasLastSlashedBatchBlock(ctx) && k.GetLastSlashedBatchBlock(ctx) > blockHeight {
+	if k.HasLastSlashedBatchBlock(ctx, evmChainPrefix) && k.GetLastSlashedBatchBlock(ctx, evmChainPrefix) > blockHeight {
 		panic("Attempted to decrement LastSlashedBatchBlock")
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.LastSlashedBatchBlock, types.UInt64Bytes(blockHeight))
+	store.Set(types.AppendEvmChainPrefix(types.LastSlashedBatchBlock, evmChainPrefix), types.UInt64Bytes(blockHeight))
 }
 
 // GetLastSlashedBatchBlock returns the latest slashed Batch block
-func (k Keeper) GetLastSlashedBatchBlock(ctx sdk.Context) uint64 {
+func (k Keeper) GetLastSlashedBatchBlock(ctx sdk.Context, evmChainPrefix string) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.LastSlashedBatchBlock)
+	bytes := store.Get(types.AppendEvmChainPrefix(types.LastSlashedBatchBlock, evmChainPrefix))
 
 	if len(bytes) == 0 {
 		panic("Last slashed batch block not initialized from genesis")
@@ -349,9 +404,9 @@ func (k Keeper) GetLastSlashedBatchBlock(ctx sdk.Context) uint64 {
 }
 
 // GetUnSlashedBatches returns all the unslashed batches in state
-func (k Keeper) GetUnSlashedBatches(ctx sdk.Context, maxHeight uint64) (out []types.InternalOutgoingTxBatch) {
-	lastSlashedBatchBlock := k.GetLastSlashedBatchBlock(ctx)
-	batches := k.GetOutgoingTxBatches(ctx)
+func (k Keeper) GetUnSlashedBatches(ctx sdk.Context, evmChainPrefix string, maxHeight uint64) (out []types.InternalOutgoingTxBatch) {
+	lastSlashedBatchBlock := k.GetLastSlashedBatchBlock(ctx, evmChainPrefix)
+	batches := k.GetOutgoingTxBatches(ctx, evmChainPrefix)
 	for _, batch := range batches {
 		if batch.CosmosBlockCreated > lastSlashedBatchBlock && batch.CosmosBlockCreated < maxHeight {
 			out = append(out, batch)
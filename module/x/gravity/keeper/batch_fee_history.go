@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// BatchFeeHistorySize is the number of executed batches the fee history of a token holds, once full every new
+// record overwrites the oldest one. The history is not exported to genesis, a chain started from an exported genesis
+// builds it up again from the batches it executes
+const BatchFeeHistorySize uint64 = 100
+
+// FeeStatisticsPercentiles are the percentiles the FeeStatistics query reports
+var FeeStatisticsPercentiles = []uint32{25, 50, 75, 90}
+
+/////////////////////////////
+//   BATCH FEE HISTORY     //
+/////////////////////////////
+
+// recordBatchFees adds the fees of batch, executed in the current block, to the fee history of its token
+func (k Keeper) recordBatchFees(ctx sdk.Context, evmChainPrefix string, batch types.InternalOutgoingTxBatch) {
+	totalFee := sdk.ZeroInt()
+	for _, tx := range batch.Transactions {
+		totalFee = totalFee.Add(tx.Erc20Fee.Amount)
+	}
+	latency := uint64(0)
+	if uint64(ctx.BlockHeight()) > batch.CosmosBlockCreated {
+		latency = uint64(ctx.BlockHeight()) - batch.CosmosBlockCreated
+	}
+	record := types.BatchFeeRecord{
+		TokenContract: batch.TokenContract.GetAddress().Hex(),
+		BatchNonce:    batch.BatchNonce,
+		TotalFee:      totalFee,
+		TxCount:       uint64(len(batch.Transactions)),
+		CreatedHeight: batch.CosmosBlockCreated,
+		LatencyBlocks: latency,
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	countKey := types.GetBatchFeeRecordCountKey(evmChainPrefix, batch.TokenContract)
+	count := uint64(0)
+	if bz := store.Get(countKey); bz != nil {
+		count = types.UInt64FromBytesUnsafe(bz)
+	}
+	store.Set(types.GetBatchFeeRecordKey(evmChainPrefix, batch.TokenContract, count%BatchFeeHistorySize), k.cdc.MustMarshal(&record))
+	store.Set(countKey, types.UInt64Bytes(count+1))
+}
+
+// GetBatchFeeHistory returns the fee records of the last BatchFeeHistorySize batches of tokenContract to be
+// executed, in no particular order
+func (k Keeper) GetBatchFeeHistory(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress) []types.BatchFeeRecord {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetBatchFeeRecordContractPrefix(evmChainPrefix, tokenContract))
+	defer iter.Close()
+
+	records := []types.BatchFeeRecord{}
+	for ; iter.Valid(); iter.Next() {
+		var record types.BatchFeeRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetFeeStatistics summarizes the fee history of tokenContract
+func (k Keeper) GetFeeStatistics(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress) types.QueryFeeStatisticsResponse {
+	records := k.GetBatchFeeHistory(ctx, evmChainPrefix, tokenContract)
+	res := types.QueryFeeStatisticsResponse{
+		TokenContract:        tokenContract.GetAddress().Hex(),
+		SampleCount:          uint64(len(records)),
+		Percentiles:          []types.FeePercentile{},
+		AverageLatencyBlocks: sdk.ZeroDec(),
+	}
+	if len(records) == 0 {
+		return res
+	}
+
+	totalFees := make([]sdk.Int, len(records))
+	feesPerTx := make([]sdk.Int, len(records))
+	latency := uint64(0)
+	for i, record := range records {
+		totalFees[i] = record.TotalFee
+		feesPerTx[i] = sdk.ZeroInt()
+		if record.TxCount > 0 {
+			feesPerTx[i] = record.TotalFee.QuoRaw(int64(record.TxCount))
+		}
+		latency += record.LatencyBlocks
+	}
+	sortInts(totalFees)
+	sortInts(feesPerTx)
+
+	for _, p := range FeeStatisticsPercentiles {
+		i := percentileIndex(p, len(records))
+		res.Percentiles = append(res.Percentiles, types.FeePercentile{
+			Percentile: p,
+			TotalFee:   totalFees[i],
+			FeePerTx:   feesPerTx[i],
+		})
+	}
+	res.AverageLatencyBlocks = sdk.NewDec(int64(latency)).QuoInt64(int64(len(records)))
+	return res
+}
+
+func sortInts(ints []sdk.Int) {
+	sort.Slice(ints, func(i, j int) bool { return ints[i].LT(ints[j]) })
+}
+
+// percentileIndex returns the index of percentile p in a sorted list of n values using the nearest rank method
+func percentileIndex(p uint32, n int) int {
+	// the smallest rank covering p percent of the values, rounded up
+	rank := (int(p)*n + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return rank - 1
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// nolint: exhaustruct
+func TestFeeStatistics(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+	tokenContract, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+
+	// a token without executed batches has no statistics
+	res, err := k.FeeStatistics(sdk.WrapSDKContext(ctx), &types.QueryFeeStatisticsRequest{TokenContract: tokenContract.GetAddress().Hex()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.SampleCount)
+	require.Empty(t, res.Percentiles)
+	require.Equal(t, sdk.ZeroDec(), res.AverageLatencyBlocks)
+
+	// execute batches of two transactions paying 1, 2, ... 110 in fees each, the batch paying i is executed i blocks
+	// after it was created
+	for i := int64(1); i <= int64(BatchFeeHistorySize)+10; i++ {
+		ctx = ctx.WithBlockHeight(2 * i)
+		tx, err := types.NewInternalOutgoingTransferTx(uint64(i), AccAddrs[0].String(), EthAddrs[0].String(),
+			types.ERC20Token{Contract: tokenContract.GetAddress().Hex(), Amount: sdk.NewInt(1)},
+			types.ERC20Token{Contract: tokenContract.GetAddress().Hex(), Amount: sdk.NewInt(i)})
+		require.NoError(t, err)
+		batch, err := types.NewInternalOutgingTxBatch(uint64(i), 1000, []*types.InternalOutgoingTransferTx{tx, tx}, *tokenContract, uint64(i))
+		require.NoError(t, err)
+		k.recordBatchFees(ctx, EthChainPrefix, *batch)
+	}
+
+	// only the last BatchFeeHistorySize batches, paying 11 to 110, are kept
+	history := k.GetBatchFeeHistory(ctx, EthChainPrefix, *tokenContract)
+	require.Len(t, history, int(BatchFeeHistorySize))
+	for _, record := range history {
+		require.True(t, record.BatchNonce > 10)
+		require.Equal(t, uint64(2), record.TxCount)
+		require.Equal(t, record.BatchNonce, record.LatencyBlocks)
+	}
+
+	res, err = k.FeeStatistics(sdk.WrapSDKContext(ctx), &types.QueryFeeStatisticsRequest{TokenContract: tokenContract.GetAddress().Hex()})
+	require.NoError(t, err)
+	require.Equal(t, BatchFeeHistorySize, res.SampleCount)
+	require.Equal(t, []types.FeePercentile{
+		{Percentile: 25, TotalFee: sdk.NewInt(2 * 35), FeePerTx: sdk.NewInt(35)},
+		{Percentile: 50, TotalFee: sdk.NewInt(2 * 60), FeePerTx: sdk.NewInt(60)},
+		{Percentile: 75, TotalFee: sdk.NewInt(2 * 85), FeePerTx: sdk.NewInt(85)},
+		{Percentile: 90, TotalFee: sdk.NewInt(2 * 100), FeePerTx: sdk.NewInt(100)},
+	}, res.Percentiles)
+	require.Equal(t, sdk.NewDecWithPrec(605, 1), res.AverageLatencyBlocks)
+
+	// other tokens are not affected
+	otherContract := "0x17c1736CcF692F653c433d7aa2aB45148C016F68"
+	res, err = k.FeeStatistics(sdk.WrapSDKContext(ctx), &types.QueryFeeStatisticsRequest{TokenContract: otherContract})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.SampleCount)
+
+	_, err = k.FeeStatistics(sdk.WrapSDKContext(ctx), &types.QueryFeeStatisticsRequest{TokenContract: "0x"})
+	require.Error(t, err)
+}
+
+func TestPercentileIndex(t *testing.T) {
+	require.Equal(t, 0, percentileIndex(50, 1))
+	require.Equal(t, 0, percentileIndex(25, 4))
+	require.Equal(t, 1, percentileIndex(50, 4))
+	require.Equal(t, 3, percentileIndex(90, 4))
+	require.Equal(t, 89, percentileIndex(90, 100))
+}
@@ -25,7 +25,7 @@ func TestBatches(t *testing.T) {
 		myReceiver, e2          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
 		myTokenContractAddr, e3 = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
 		token, e4               = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress().Hex())
-		allVouchers             = sdk.NewCoins(token.GravityCoin())
+		allVouchers             = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
 	)
 	require.NoError(t, e1)
 	require.NoError(t, e2)
@@ -42,7 +42,7 @@ func TestBatches(t *testing.T) {
 	// ==================
 
 	// batch should not be created if there is no txs of the given token type in tx pool
-	noBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	noBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *myTokenContractAddr, 2)
 	require.Nil(t, noBatch)
 	require.Error(t, err)
 
@@ -50,12 +50,12 @@ func TestBatches(t *testing.T) {
 	for i, v := range []uint64{2, 3, 2, 1} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr.GetAddress().Hex())
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr.GetAddress().Hex())
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amount, fee)
 		require.NoError(t, err)
 		ctx.Logger().Info(fmt.Sprintf("Created transaction %v with amount %v and fee %v", i, amount, fee))
 		// Should create:
@@ -67,18 +67,18 @@ func TestBatches(t *testing.T) {
 
 	// when
 	ctx = ctx.WithBlockTime(now)
-	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 1234567)
+	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, EthChainPrefix, 1234567)
 	// maxElements must be greater then 0, otherwise the batch would not be created
-	noBatch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 0)
+	noBatch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *myTokenContractAddr, 0)
 	require.Nil(t, noBatch)
 	require.Error(t, err)
 
 	// tx batch size is 2, so that some of them stay behind
-	firstBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	firstBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *myTokenContractAddr, 2)
 	require.NoError(t, err)
 
 	// then batch is persisted
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, firstBatch.TokenContract, firstBatch.BatchNonce)
+	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, firstBatch.TokenContract, firstBatch.BatchNonce)
 	require.NotNil(t, gotFirstBatch)
 	// Should have txs 2: and 3: from above, as ties in fees are broken by transaction index
 	ctx.Logger().Info(fmt.Sprintf("found batch %+v", gotFirstBatch))
@@ -103,7 +103,7 @@ func TestBatches(t *testing.T) {
 		},
 		TokenContract:      myTokenContractAddr.GetAddress().Hex(),
 		CosmosBlockCreated: 1234567,
-		BatchTimeout:       input.GravityKeeper.getBatchTimeoutHeight(ctx),
+		BatchTimeout:       input.GravityKeeper.getBatchTimeoutHeight(ctx, EthChainPrefix),
 	}
 	assert.Equal(t, expFirstBatch.BatchTimeout, gotFirstBatch.BatchTimeout)
 	assert.Equal(t, expFirstBatch.BatchNonce, gotFirstBatch.BatchNonce)
@@ -127,16 +127,16 @@ func TestBatches(t *testing.T) {
 			Signature:     "dummysig",
 		}
 
-		input.GravityKeeper.SetBatchConfirm(ctx, conf)
+		input.GravityKeeper.SetBatchConfirm(ctx, EthChainPrefix, conf)
 	}
 
 	// verify that confirms are persisted
-	firstBatchConfirms := input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, firstBatch.BatchNonce, firstBatch.TokenContract)
+	firstBatchConfirms := input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, EthChainPrefix, firstBatch.BatchNonce, firstBatch.TokenContract)
 	require.Equal(t, len(OrchAddrs), len(firstBatchConfirms))
 
 	// and verify remaining available Tx in the pool
 	// Should still have 1: and 4: above
-	gotUnbatchedTx := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *myTokenContractAddr)
+	gotUnbatchedTx := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, EthChainPrefix, *myTokenContractAddr)
 	oneFee, err := types.NewInternalERC20Token(sdk.NewInt(1), myTokenContractAddr.GetAddress().Hex())
 	require.NoError(t, err)
 	oneHundredTok, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr.GetAddress().Hex())
@@ -167,7 +167,7 @@ func TestBatches(t *testing.T) {
 	// ====================================
 
 	// first check that less profitable batch cannot be created
-	noBatch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	noBatch, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *myTokenContractAddr, 2)
 	require.Nil(t, noBatch)
 	require.Error(t, err)
 
@@ -175,12 +175,12 @@ func TestBatches(t *testing.T) {
 	for i, v := range []uint64{4, 5} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr.GetAddress().Hex())
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr.GetAddress().Hex())
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amount, fee)
 		require.NoError(t, err)
 		// Creates the following:
 		// 5: amount 100, fee 4, id 5
@@ -190,10 +190,10 @@ func TestBatches(t *testing.T) {
 	// create the more profitable batch
 	ctx = ctx.WithBlockTime(now)
 	// tx batch size is 2, so that some of them stay behind
-	secondBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	secondBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *myTokenContractAddr, 2)
 	require.NoError(t, err)
 
-	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 1234567)
+	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, EthChainPrefix, 1234567)
 	// check that the more profitable batch has the right txs in it
 	// Should only have 5: and 6: above
 	expSecondBatch := types.OutgoingTxBatch{
@@ -216,7 +216,7 @@ func TestBatches(t *testing.T) {
 		},
 		TokenContract:      myTokenContractAddr.GetAddress().Hex(),
 		CosmosBlockCreated: 1234567,
-		BatchTimeout:       input.GravityKeeper.getBatchTimeoutHeight(ctx),
+		BatchTimeout:       input.GravityKeeper.getBatchTimeoutHeight(ctx, EthChainPrefix),
 	}
 
 	assert.Equal(t, expSecondBatch.BatchTimeout, secondBatch.BatchTimeout)
@@ -241,15 +241,15 @@ func TestBatches(t *testing.T) {
 			Signature:     "dummysig",
 		}
 
-		input.GravityKeeper.SetBatchConfirm(ctx, conf)
+		input.GravityKeeper.SetBatchConfirm(ctx, EthChainPrefix, conf)
 	}
 
 	// verify that confirms are persisted
-	secondBatchConfirms := input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, secondBatch.BatchNonce, secondBatch.TokenContract)
+	secondBatchConfirms := input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, EthChainPrefix, secondBatch.BatchNonce, secondBatch.TokenContract)
 	require.Equal(t, len(OrchAddrs), len(secondBatchConfirms))
 
 	// check that last added batch is the one with the biggest nonce
-	lastOutgoingBatch := input.GravityKeeper.GetLastOutgoingBatchByTokenType(ctx, *myTokenContractAddr)
+	lastOutgoingBatch := input.GravityKeeper.GetLastOutgoingBatchByTokenType(ctx, EthChainPrefix, *myTokenContractAddr)
 	require.NotNil(t, lastOutgoingBatch)
 	assert.Equal(t, lastOutgoingBatch.BatchNonce, secondBatch.BatchNonce)
 
@@ -259,17 +259,22 @@ func TestBatches(t *testing.T) {
 	// Execute the batch
 	fakeBlock := secondBatch.CosmosBlockCreated // A fake ethereum block used for testing only
 	msg := types.MsgBatchSendToEthClaim{EthBlockHeight: fakeBlock, BatchNonce: secondBatch.BatchNonce}
-	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, secondBatch.TokenContract, msg)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, EthChainPrefix, secondBatch.TokenContract, msg)
 
 	// check batch has been deleted
-	gotSecondBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, secondBatch.TokenContract, secondBatch.BatchNonce)
+	gotSecondBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, secondBatch.TokenContract, secondBatch.BatchNonce)
 	require.Nil(t, gotSecondBatch)
 	// check batch confirmations have been deleted
-	secondBatchConfirms = input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, secondBatch.BatchNonce, secondBatch.TokenContract)
+	secondBatchConfirms = input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, EthChainPrefix, secondBatch.BatchNonce, secondBatch.TokenContract)
 	require.Equal(t, 0, len(secondBatchConfirms))
+	// check the fees of the batch have been recorded
+	feeHistory := input.GravityKeeper.GetBatchFeeHistory(ctx, EthChainPrefix, secondBatch.TokenContract)
+	require.Len(t, feeHistory, 1)
+	require.Equal(t, secondBatch.BatchNonce, feeHistory[0].BatchNonce)
+	require.Equal(t, uint64(len(secondBatch.Transactions)), feeHistory[0].TxCount)
 
 	// check that txs from first batch have been freed
-	gotUnbatchedTx = input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *myTokenContractAddr)
+	gotUnbatchedTx = input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, EthChainPrefix, *myTokenContractAddr)
 	threeFee, err := types.NewInternalERC20Token(sdk.NewInt(3), myTokenContractAddr.GetAddress().Hex())
 	require.NoError(t, err)
 	oneHundredOneTok, err := types.NewInternalERC20Token(sdk.NewInt(101), myTokenContractAddr.GetAddress().Hex())
@@ -309,10 +314,10 @@ func TestBatches(t *testing.T) {
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
 
 	// check that first batch has been deleted
-	gotFirstBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, firstBatch.TokenContract, firstBatch.BatchNonce)
+	gotFirstBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, firstBatch.TokenContract, firstBatch.BatchNonce)
 	require.Nil(t, gotFirstBatch)
 	// check that first batch confirmations have been deleted
-	firstBatchConfirms = input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, firstBatch.BatchNonce, firstBatch.TokenContract)
+	firstBatchConfirms = input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, EthChainPrefix, firstBatch.BatchNonce, firstBatch.TokenContract)
 	require.Equal(t, 0, len(firstBatchConfirms))
 }
 
@@ -333,7 +338,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		totalCoins, _       = sdk.NewIntFromString("1500000000000000000000") // 1,500 ETH worth
 		oneEth, _           = sdk.NewIntFromString("1000000000000000000")
 		token, e3           = types.NewInternalERC20Token(totalCoins, myTokenContractAddr)
-		allVouchers         = sdk.NewCoins(token.GravityCoin())
+		allVouchers         = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
 	)
 	require.NoError(t, e1)
 	require.NoError(t, e2)
@@ -355,12 +360,12 @@ func TestBatchesFullCoins(t *testing.T) {
 		vAsSDKInt := sdk.NewIntFromUint64(v)
 		amountToken, err := types.NewInternalERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiverAddr, amount, fee)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiverAddr, amount, fee)
 		require.NoError(t, err)
 	}
 
@@ -368,11 +373,11 @@ func TestBatchesFullCoins(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	firstBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	firstBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *tokenContract, 2)
 	require.NoError(t, err)
 
 	// then batch is persisted
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, firstBatch.TokenContract, firstBatch.BatchNonce)
+	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, firstBatch.TokenContract, firstBatch.BatchNonce)
 	require.NotNil(t, gotFirstBatch)
 
 	expFirstBatch := &types.OutgoingTxBatch{
@@ -406,7 +411,7 @@ func TestBatchesFullCoins(t *testing.T) {
 	}
 
 	// and verify remaining available Tx in the pool
-	gotUnbatchedTx := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *tokenContract)
+	gotUnbatchedTx := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, EthChainPrefix, *tokenContract)
 	twentyTok, err := types.NewInternalERC20Token(oneEth.Mul(sdk.NewIntFromUint64(20)), myTokenContractAddr)
 	require.NoError(t, err)
 	tenTok, err := types.NewInternalERC20Token(oneEth.Mul(sdk.NewIntFromUint64(10)), myTokenContractAddr)
@@ -437,20 +442,20 @@ func TestBatchesFullCoins(t *testing.T) {
 		vAsSDKInt := sdk.NewIntFromUint64(v)
 		amountToken, err := types.NewInternalERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(oneEth.Mul(vAsSDKInt), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiverAddr, amount, fee)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiverAddr, amount, fee)
 		require.NoError(t, err)
 	}
 
 	// create the more profitable batch
 	ctx = ctx.WithBlockTime(now)
-	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 1234567)
+	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, EthChainPrefix, 1234567)
 	// tx batch size is 2, so that some of them stay behind
-	secondBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	secondBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *tokenContract, 2)
 	require.NoError(t, err)
 
 	// check that the more profitable batch has the right txs in it
@@ -474,7 +479,7 @@ func TestBatchesFullCoins(t *testing.T) {
 		},
 		TokenContract:      myTokenContractAddr,
 		CosmosBlockCreated: 1234567,
-		BatchTimeout:       input.GravityKeeper.getBatchTimeoutHeight(ctx),
+		BatchTimeout:       input.GravityKeeper.getBatchTimeoutHeight(ctx, EthChainPrefix),
 	}
 
 	assert.Equal(t, expSecondBatch.BatchTimeout, secondBatch.BatchTimeout)
@@ -492,14 +497,14 @@ func TestBatchesFullCoins(t *testing.T) {
 	// Execute the batch
 	fakeBlock := secondBatch.CosmosBlockCreated // A fake ethereum block used for testing only
 	msg := types.MsgBatchSendToEthClaim{EthBlockHeight: fakeBlock, BatchNonce: secondBatch.BatchNonce}
-	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, secondBatch.TokenContract, msg)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, EthChainPrefix, secondBatch.TokenContract, msg)
 
 	// check batch has been deleted
-	gotSecondBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, secondBatch.TokenContract, secondBatch.BatchNonce)
+	gotSecondBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, secondBatch.TokenContract, secondBatch.BatchNonce)
 	require.Nil(t, gotSecondBatch)
 
 	// check that txs from first batch have been freed
-	gotUnbatchedTx = input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *tokenContract)
+	gotUnbatchedTx = input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, EthChainPrefix, *tokenContract)
 	threeHundredTok, err := types.NewInternalERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr)
 	require.NoError(t, err)
 	twentyFiveTok, err := types.NewInternalERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr)
@@ -560,10 +565,10 @@ func TestManyBatches(t *testing.T) {
 		token3, e4         = types.NewInternalERC20Token(totalCoins, tokenContractAddr3)
 		token4, e5         = types.NewInternalERC20Token(totalCoins, tokenContractAddr4)
 		allVouchers        = sdk.NewCoins(
-			token1.GravityCoin(),
-			token2.GravityCoin(),
-			token3.GravityCoin(),
-			token4.GravityCoin(),
+			token1.GravityCoin(EthChainPrefix),
+			token2.GravityCoin(EthChainPrefix),
+			token3.GravityCoin(EthChainPrefix),
+			token4.GravityCoin(EthChainPrefix),
 		)
 	)
 	require.NoError(t, e1)
@@ -579,7 +584,7 @@ func TestManyBatches(t *testing.T) {
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
-	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 1234567)
+	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, EthChainPrefix, 1234567)
 
 	// CREATE FIRST BATCH
 	// ==================
@@ -597,16 +602,16 @@ func TestManyBatches(t *testing.T) {
 			vAsSDKInt := sdk.NewIntFromUint64(uint64(v))
 			amountToken, err := types.NewInternalERC20Token(oneEth.Mul(vAsSDKInt), contract)
 			require.NoError(t, err)
-			amount := amountToken.GravityCoin()
+			amount := amountToken.GravityCoin(EthChainPrefix)
 			feeToken, err := types.NewInternalERC20Token(oneEth.Mul(vAsSDKInt), contract)
 			require.NoError(t, err)
-			fee := feeToken.GravityCoin()
+			fee := feeToken.GravityCoin(EthChainPrefix)
 
-			_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+			_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 			require.NoError(t, err)
 			// create batch after every 100 txs to be able to create more profitable batches
 			if (v+1)%100 == 0 {
-				batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *contractAddr, 100)
+				batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *contractAddr, 100)
 				require.NoError(t, err)
 				batches = append(batches, batch.ToExternal())
 			}
@@ -617,7 +622,7 @@ func TestManyBatches(t *testing.T) {
 		// then batch is persisted
 		contractAddr, err := types.NewEthAddress(batch.TokenContract)
 		require.NoError(t, err)
-		gotBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, *contractAddr, batch.BatchNonce)
+		gotBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, *contractAddr, batch.BatchNonce)
 		require.NotNil(t, gotBatch)
 	}
 
@@ -632,12 +637,12 @@ func TestManyBatches(t *testing.T) {
 	for _, batch := range batches {
 		contractAddr, err := types.NewEthAddress(batch.TokenContract)
 		require.NoError(t, err)
-		gotBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, *contractAddr, batch.BatchNonce)
+		gotBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, *contractAddr, batch.BatchNonce)
 		// we may have already deleted some of the batches in this list by executing later ones
 		if gotBatch != nil {
 			fakeBlock := batch.CosmosBlockCreated // A fake ethereum block used for testing only
 			msg := types.MsgBatchSendToEthClaim{EthBlockHeight: fakeBlock, BatchNonce: batch.BatchNonce}
-			input.GravityKeeper.OutgoingTxBatchExecuted(ctx, *contractAddr, msg)
+			input.GravityKeeper.OutgoingTxBatchExecuted(ctx, EthChainPrefix, *contractAddr, msg)
 		}
 	}
 }
@@ -655,9 +660,9 @@ func TestPoolTxRefund(t *testing.T) {
 		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
 		token, e3           = types.NewInternalERC20Token(sdk.NewInt(414), myTokenContractAddr)
-		allVouchers         = sdk.NewCoins(token.GravityCoin())
+		allVouchers         = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
 		denomToken, e4      = types.NewInternalERC20Token(sdk.NewInt(1), myTokenContractAddr)
-		myDenom             = denomToken.GravityCoin().Denom
+		myDenom             = denomToken.GravityCoin(EthChainPrefix).Denom
 	)
 	require.NoError(t, e1)
 	require.NoError(t, e2)
@@ -681,12 +686,12 @@ func TestPoolTxRefund(t *testing.T) {
 	for i, v := range []uint64{2, 3, 2, 1} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err)
 		// Should have created:
 		// 1: amount 100, fee 2
@@ -700,19 +705,19 @@ func TestPoolTxRefund(t *testing.T) {
 
 	// tx batch size is 2, so that some of them stay behind
 	// Should have 2: and 3: from above
-	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *contract, 2)
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *contract, 2)
 	require.NoError(t, err)
 
 	// try to refund a tx that's in a batch
-	err1 := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 3, mySender)
+	err1 := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, 3, mySender)
 	require.Error(t, err1)
 
 	// try to refund somebody else's tx
-	err2 := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 4, notMySender)
+	err2 := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, 4, notMySender)
 	require.Error(t, err2)
 
 	// try to refund a tx that's in the pool
-	err3 := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 4, mySender)
+	err3 := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, 4, mySender)
 	require.NoError(t, err3)
 
 	// make sure refund was issued
@@ -738,7 +743,7 @@ func TestBatchesNotCreatedWhenBridgePaused(t *testing.T) {
 		myReceiver, e2          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
 		myTokenContractAddr, e3 = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
 		token, e4               = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress().Hex())
-		allVouchers             = sdk.NewCoins(token.GravityCoin())
+		allVouchers             = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
 	)
 	require.NoError(t, e1)
 	require.NoError(t, e2)
@@ -758,12 +763,12 @@ func TestBatchesNotCreatedWhenBridgePaused(t *testing.T) {
 	for i, v := range []uint64{2, 3, 2, 1} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr.GetAddress().Hex())
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr.GetAddress().Hex())
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amount, fee)
 		require.NoError(t, err)
 		ctx.Logger().Info(fmt.Sprintf("Created transaction %v with amount %v and fee %v", i, amount, fee))
 		// Should create:
@@ -777,11 +782,11 @@ func TestBatchesNotCreatedWhenBridgePaused(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	_, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	_, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *myTokenContractAddr, 2)
 	require.Error(t, err)
 
 	// then batch is persisted
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, *myTokenContractAddr, 1)
 	require.Nil(t, gotFirstBatch)
 
 	// resume the bridge
@@ -792,11 +797,11 @@ func TestBatchesNotCreatedWhenBridgePaused(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	firstBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 2)
+	firstBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *myTokenContractAddr, 2)
 	require.NoError(t, err)
 
 	// then batch is persisted
-	gotFirstBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, firstBatch.TokenContract, firstBatch.BatchNonce)
+	gotFirstBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, firstBatch.TokenContract, firstBatch.BatchNonce)
 	require.NotNil(t, gotFirstBatch)
 }
 
@@ -814,7 +819,7 @@ func TestEthereumBlacklistBatches(t *testing.T) {
 		blacklistedReceiver, e3 = types.NewEthAddress("0x4d16b9E4a27c3313440923fEfCd013178149A5bD")
 		myTokenContractAddr, e4 = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
 		token, e5               = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress().Hex())
-		allVouchers             = sdk.NewCoins(token.GravityCoin())
+		allVouchers             = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
 	)
 	require.NoError(t, e1)
 	require.NoError(t, e2)
@@ -840,16 +845,16 @@ func TestEthereumBlacklistBatches(t *testing.T) {
 	for i, v := range []uint64{2, 3, 2, 1, 5} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr.GetAddress().Hex())
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr.GetAddress().Hex())
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
 		// one of the transactions should go to the blacklisted address
 		if i == 4 {
-			_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *blacklistedReceiver, amount, fee)
+			_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *blacklistedReceiver, amount, fee)
 		} else {
-			_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+			_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amount, fee)
 		}
 		require.NoError(t, err)
 		ctx.Logger().Info(fmt.Sprintf("Created transaction %v with amount %v and fee %v", i, amount, fee))
@@ -862,7 +867,7 @@ func TestEthereumBlacklistBatches(t *testing.T) {
 	}
 
 	// check that blacklisted tx fee is not insluded in profitability calculation
-	currentFees := input.GravityKeeper.GetBatchFeeByTokenType(ctx, *myTokenContractAddr, 10)
+	currentFees := input.GravityKeeper.GetBatchFeeByTokenType(ctx, EthChainPrefix, *myTokenContractAddr, 10)
 	assert.NotNil(t, currentFees)
 	assert.True(t, currentFees.TotalFees.Equal(sdk.NewInt(8)))
 
@@ -870,11 +875,11 @@ func TestEthereumBlacklistBatches(t *testing.T) {
 	ctx = ctx.WithBlockTime(now)
 
 	// tx batch size is 10
-	firstBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 10)
+	firstBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *myTokenContractAddr, 10)
 	require.NoError(t, err)
 
 	// then batch is persisted
-	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, firstBatch.TokenContract, firstBatch.BatchNonce)
+	gotFirstBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, firstBatch.TokenContract, firstBatch.BatchNonce)
 	require.NotNil(t, gotFirstBatch)
 	// Should have all from above except the banned dest
 	ctx.Logger().Info(fmt.Sprintf("found batch %+v", gotFirstBatch))
@@ -888,7 +893,7 @@ func TestEthereumBlacklistBatches(t *testing.T) {
 
 	// and verify remaining available Tx in the pool
 	// should only be 5
-	gotUnbatchedTx := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *myTokenContractAddr)
+	gotUnbatchedTx := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, EthChainPrefix, *myTokenContractAddr)
 	assert.Equal(t, gotUnbatchedTx[0].Id, uint64(5))
 
 }
@@ -939,7 +944,7 @@ func TestBatchConfirms(t *testing.T) {
 		myReceiver, e2          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
 		myTokenContractAddr, e3 = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
 		token, e4               = types.NewInternalERC20Token(sdk.NewInt(1000000), myTokenContractAddr.GetAddress().Hex())
-		allVouchers             = sdk.NewCoins(token.GravityCoin())
+		allVouchers             = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
 	)
 	require.NoError(t, e1)
 	require.NoError(t, e2)
@@ -959,22 +964,22 @@ func TestBatchConfirms(t *testing.T) {
 	for i := 1; i < 200; i++ {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr.GetAddress().Hex())
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(uint64(i+10)), myTokenContractAddr.GetAddress().Hex())
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
 		// add tx to the pool
-		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amount, fee)
 		require.NoError(t, err)
 		ctx.Logger().Info(fmt.Sprintf("Created transaction %v with amount %v and fee %v", i, amount, fee))
 
 		// create batch
-		_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, 1)
+		_, err = input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *myTokenContractAddr, 1)
 		require.NoError(t, err)
 	}
 
-	outogoingBatches := input.GravityKeeper.GetOutgoingTxBatches(ctx)
+	outogoingBatches := input.GravityKeeper.GetOutgoingTxBatches(ctx, EthChainPrefix)
 
 	// persist confirmations
 	for i, orch := range OrchAddrs {
@@ -990,7 +995,7 @@ func TestBatchConfirms(t *testing.T) {
 				Signature:     "dummysig",
 			}
 
-			input.GravityKeeper.SetBatchConfirm(ctx, conf)
+			input.GravityKeeper.SetBatchConfirm(ctx, EthChainPrefix, conf)
 		}
 	}
 
@@ -1002,7 +1007,7 @@ func TestBatchConfirms(t *testing.T) {
 		Orchestrator:  "invalid address",
 		Signature:     "dummysig",
 	}
-	assert.Panics(t, func() { input.GravityKeeper.SetBatchConfirm(ctx, conf) })
+	assert.Panics(t, func() { input.GravityKeeper.SetBatchConfirm(ctx, EthChainPrefix, conf) })
 
 	// try to set connfirm with invalid token contract
 	conf = &types.MsgConfirmBatch{
@@ -1012,13 +1017,13 @@ func TestBatchConfirms(t *testing.T) {
 		Orchestrator:  OrchAddrs[0].String(),
 		Signature:     "dummysig",
 	}
-	assert.Panics(t, func() { input.GravityKeeper.SetBatchConfirm(ctx, conf) })
+	assert.Panics(t, func() { input.GravityKeeper.SetBatchConfirm(ctx, EthChainPrefix, conf) })
 
 	// verify that confirms are persisted for each orchestrator address
 	var batchConfirm *types.MsgConfirmBatch
 	for _, batch := range outogoingBatches {
 		for i, addr := range OrchAddrs {
-			batchConfirm = input.GravityKeeper.GetBatchConfirm(ctx, batch.BatchNonce, batch.TokenContract, addr)
+			batchConfirm = input.GravityKeeper.GetBatchConfirm(ctx, EthChainPrefix, batch.BatchNonce, batch.TokenContract, addr)
 			require.Equal(t, batch.BatchNonce, batchConfirm.Nonce)
 			require.Equal(t, batch.TokenContract.GetAddress().Hex(), batchConfirm.TokenContract)
 			require.Equal(t, EthAddrs[i].String(), batchConfirm.EthSigner)
@@ -1032,12 +1037,83 @@ func TestLastSlashedBatchBlock(t *testing.T) {
 	input := CreateTestEnv(t)
 	ctx := input.Context
 
-	assert.Equal(t, uint64(0), input.GravityKeeper.GetLastSlashedBatchBlock(ctx))
-	assert.NotPanics(t, func() { input.GravityKeeper.SetLastSlashedBatchBlock(ctx, 2) })
-	assert.Equal(t, uint64(2), input.GravityKeeper.GetLastSlashedBatchBlock(ctx))
+	assert.Equal(t, uint64(0), input.GravityKeeper.GetLastSlashedBatchBlock(ctx, EthChainPrefix))
+	assert.NotPanics(t, func() { input.GravityKeeper.SetLastSlashedBatchBlock(ctx, EthChainPrefix, 2) })
+	assert.Equal(t, uint64(2), input.GravityKeeper.GetLastSlashedBatchBlock(ctx, EthChainPrefix))
 	// LastSlashedBatchBlock cannot be set to lower than the current LastSlashedBatchBlock value
-	assert.Panics(t, func() { input.GravityKeeper.SetLastSlashedBatchBlock(ctx, 1) })
-	assert.Equal(t, uint64(2), input.GravityKeeper.GetLastSlashedBatchBlock(ctx))
-	assert.NotPanics(t, func() { input.GravityKeeper.SetLastSlashedBatchBlock(ctx, 129) })
-	assert.Equal(t, uint64(129), input.GravityKeeper.GetLastSlashedBatchBlock(ctx))
+	assert.Panics(t, func() { input.GravityKeeper.SetLastSlashedBatchBlock(ctx, EthChainPrefix, 1) })
+	assert.Equal(t, uint64(2), input.GravityKeeper.GetLastSlashedBatchBlock(ctx, EthChainPrefix))
+	assert.NotPanics(t, func() { input.GravityKeeper.SetLastSlashedBatchBlock(ctx, EthChainPrefix, 129) })
+	assert.Equal(t, uint64(129), input.GravityKeeper.GetLastSlashedBatchBlock(ctx, EthChainPrefix))
+}
+
+// tests that batches of a token with a gas budget only hold the highest fee transfers which fit in it
+// nolint: exhaustruct
+func TestBatchGasBudget(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, e1        = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, e2      = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		token, e3           = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+		allVouchers         = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+
+	// the budget fits two transfers of 100000 gas
+	params := input.GravityKeeper.GetParams(ctx)
+	params.TokenGasParams = []types.TokenGasParams{{
+		EvmChainPrefix: EthChainPrefix,
+		TokenContract:  myTokenContractAddr,
+		GasPerTransfer: 100000,
+		MaxBatchGas:    types.BatchBaseGas + 250000,
+	}}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	for _, v := range []int64{2, 3, 1, 4} {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr)
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewInt(v), myTokenContractAddr)
+		require.NoError(t, err)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amountToken.GravityCoin(EthChainPrefix), feeToken.GravityCoin(EthChainPrefix))
+		require.NoError(t, err)
+	}
+
+	// the potential batch reported to relayers is cut to the budget as well
+	batchFees := input.GravityKeeper.GetAllBatchFees(ctx, EthChainPrefix, OutgoingTxBatchSize)
+	require.Len(t, batchFees, 1)
+	require.Equal(t, uint64(2), batchFees[0].TxCount)
+	require.Equal(t, sdk.NewInt(7), batchFees[0].TotalFees)
+
+	batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *tokenContract, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 2)
+	require.Equal(t, sdk.NewInt(4), batch.Transactions[0].Erc20Fee.Amount)
+	require.Equal(t, sdk.NewInt(3), batch.Transactions[1].Erc20Fee.Amount)
+
+	res, err := input.GravityKeeper.EstimatedBatchGas(sdk.WrapSDKContext(ctx), &types.QueryEstimatedBatchGasRequest{
+		Nonce:         batch.BatchNonce,
+		TokenContract: myTokenContractAddr,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.BatchBaseGas+200000, res.EstimatedGas)
+	require.Equal(t, uint64(2), res.TxCount)
+	require.Equal(t, uint64(100000), res.GasPerTransfer)
+
+	_, err = input.GravityKeeper.EstimatedBatchGas(sdk.WrapSDKContext(ctx), &types.QueryEstimatedBatchGasRequest{
+		Nonce:         batch.BatchNonce + 1,
+		TokenContract: myTokenContractAddr,
+	})
+	require.Error(t, err)
 }
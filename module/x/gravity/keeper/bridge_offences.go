@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+/////////////////////////////
+//    BRIDGE OFFENCES      //
+/////////////////////////////
+
+// GetBridgeOffences returns the offences of validator which have not been forgiven as of the current block
+func (k Keeper) GetBridgeOffences(ctx sdk.Context, validator sdk.ValAddress) types.BridgeOffences {
+	store := ctx.KVStore(k.storeKey)
+	// nolint: exhaustruct
+	offences := types.BridgeOffences{Validator: validator.String()}
+	if bz := store.Get(types.GetBridgeOffencesKey(validator)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &offences)
+	}
+	return offences.Decayed(uint64(ctx.BlockHeight()), k.GetParams(ctx).OffenceDecayWindow)
+}
+
+// setBridgeOffences stores the offences of a validator, validators without offences are removed from the store
+func (k Keeper) setBridgeOffences(ctx sdk.Context, validator sdk.ValAddress, offences types.BridgeOffences) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetBridgeOffencesKey(validator)
+	if offences.Count == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&offences))
+}
+
+// IterateBridgeOffences iterates through the stored offences, the offences are not decayed to the current block
+func (k Keeper) IterateBridgeOffences(ctx sdk.Context, cb func(validator sdk.ValAddress, offences types.BridgeOffences) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BridgeOffencesKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var offences types.BridgeOffences
+		k.cdc.MustUnmarshal(iter.Value(), &offences)
+		validator := sdk.ValAddress(iter.Key()[len(types.BridgeOffencesKey):])
+		// cb returns true to stop early
+		if cb(validator, offences) {
+			break
+		}
+	}
+}
+
+// GetAllBridgeOffences returns the stored offences of every validator
+func (k Keeper) GetAllBridgeOffences(ctx sdk.Context) []types.BridgeOffences {
+	out := []types.BridgeOffences{}
+	k.IterateBridgeOffences(ctx, func(_ sdk.ValAddress, offences types.BridgeOffences) bool {
+		out = append(out, offences)
+		return false
+	})
+	return out
+}
+
+// PunishMissedSignature applies the graduated slashing policy to a validator which did not sign a valset, batch or
+// logic call. The offence is added to the validator's count, the first Params.OffenceJailOnlyCount offences only jail
+// the validator, the others are slashed an escalating multiple of baseFraction and reaching
+// Params.OffenceTombstoneThreshold tombstones the validator.
+// Jailed validators are left alone so a validator missing several items commits a single offence
+func (k Keeper) PunishMissedSignature(ctx sdk.Context, val stakingtypes.Validator, baseFraction sdk.Dec, slashingType string) {
+	// refresh validator before slashing/jailing
+	val, found := k.StakingKeeper.GetValidator(ctx, val.GetOperator())
+	if !found {
+		// this should be impossible, we haven't even progressed a single block since we got the list
+		panic("Validator exited set during endblocker?")
+	}
+	if val.IsJailed() {
+		return
+	}
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+	params := k.GetParams(ctx)
+
+	offences := k.GetBridgeOffences(ctx, val.GetOperator())
+	offences.Count++
+	offences.LastOffenceHeight = uint64(ctx.BlockHeight())
+	k.setBridgeOffences(ctx, val.GetOperator(), offences)
+
+	fraction := types.OffenceSlashFraction(baseFraction, params.OffenceSlashEscalation, params.OffenceJailOnlyCount, offences.Count)
+	if fraction.IsPositive() {
+		k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), fraction)
+	}
+	k.StakingKeeper.Jail(ctx, consAddr)
+
+	tombstone := params.OffenceTombstoneThreshold != 0 && offences.Count >= params.OffenceTombstoneThreshold &&
+		!k.SlashingKeeper.IsTombstoned(ctx, consAddr)
+	if tombstone {
+		k.SlashingKeeper.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime)
+		k.SlashingKeeper.Tombstone(ctx, consAddr)
+	}
+
+	telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeySlashes), 1,
+		[]metrics.Label{telemetry.NewLabel(types.MetricLabelSlashingType, slashingType)})
+
+	if err := ctx.EventManager().EmitTypedEvents(
+		&types.EventSignatureSlashing{
+			Type:          slashingType,
+			Address:       consAddr.String(),
+			Offences:      offences.Count,
+			SlashFraction: fraction.String(),
+			Tombstoned:    tombstone,
+		},
+		&eventsv1.EventValidatorPunished{
+			Validator:     val.GetOperator().String(),
+			SlashingType:  slashingType,
+			Offences:      offences.Count,
+			SlashFraction: fraction,
+			Tombstoned:    tombstone,
+		},
+	); err != nil {
+		panic(fmt.Errorf("Unable to emit slashing event: %v", err))
+	}
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Repeated missed signatures are punished more severely, up to tombstoning
+func TestPunishMissedSignature(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { ctx.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	valAddr := ValAddrs[0]
+	baseFraction := params.SlashFractionValset
+
+	punish := func() (tokensBefore sdk.Int, tokensAfter sdk.Int) {
+		val, found := input.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		if val.IsJailed() {
+			consAddr, err := val.GetConsAddr()
+			require.NoError(t, err)
+			input.StakingKeeper.Unjail(ctx, consAddr)
+			val, _ = input.StakingKeeper.GetValidator(ctx, valAddr)
+		}
+		k.PunishMissedSignature(ctx, val, baseFraction, types.AttributeKeyValsetSignatureSlashing)
+		after, _ := input.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, after.IsJailed())
+		return val.GetTokens(), after.GetTokens()
+	}
+
+	// the first offence only jails
+	before, after := punish()
+	require.Equal(t, before, after)
+	require.Equal(t, uint64(1), k.GetBridgeOffences(ctx, valAddr).Count)
+
+	// jailed validators are not punished again
+	val, _ := input.StakingKeeper.GetValidator(ctx, valAddr)
+	k.PunishMissedSignature(ctx, val, baseFraction, types.AttributeKeyValsetSignatureSlashing)
+	require.Equal(t, uint64(1), k.GetBridgeOffences(ctx, valAddr).Count)
+
+	// the second offence slashes the base fraction, the third twice as much
+	// slashing is based on the consensus power of the validator
+	slashAmount := func(fraction sdk.Dec, tokens sdk.Int) sdk.Int {
+		power := sdk.TokensToConsensusPower(tokens, sdk.DefaultPowerReduction)
+		return fraction.MulInt(sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)).TruncateInt()
+	}
+	before, after = punish()
+	require.Equal(t, slashAmount(baseFraction, before), before.Sub(after))
+	before, after = punish()
+	require.Equal(t, slashAmount(baseFraction.MulInt64(2), before), before.Sub(after))
+
+	// offences decay over time
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.OffenceDecayWindow))
+	require.Equal(t, uint64(2), k.GetBridgeOffences(ctx, valAddr).Count)
+
+	// reaching the threshold tombstones the validator
+	for i := uint64(3); i <= params.OffenceTombstoneThreshold; i++ {
+		punish()
+	}
+	require.Equal(t, params.OffenceTombstoneThreshold, k.GetBridgeOffences(ctx, valAddr).Count)
+	val, _ = input.StakingKeeper.GetValidator(ctx, valAddr)
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	require.True(t, input.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	// the other validators are untouched
+	require.Equal(t, uint64(0), k.GetBridgeOffences(ctx, ValAddrs[1]).Count)
+	require.Len(t, k.GetAllBridgeOffences(ctx), 1)
+}
+
+// With the default params every missed signature slashes the full base fraction and jails, and no validator is ever
+// tombstoned, as before graduated slashing
+func TestPunishMissedSignatureDefaultParams(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { ctx.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	defaults := types.DefaultParams()
+	params.OffenceDecayWindow = defaults.OffenceDecayWindow
+	params.OffenceSlashEscalation = defaults.OffenceSlashEscalation
+	params.OffenceTombstoneThreshold = defaults.OffenceTombstoneThreshold
+	params.OffenceJailOnlyCount = defaults.OffenceJailOnlyCount
+	k.SetParams(ctx, params)
+	valAddr := ValAddrs[0]
+	baseFraction := params.SlashFractionValset
+
+	for i := 0; i < 10; i++ {
+		val, found := input.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		consAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+		if val.IsJailed() {
+			input.StakingKeeper.Unjail(ctx, consAddr)
+			val, _ = input.StakingKeeper.GetValidator(ctx, valAddr)
+		}
+		k.PunishMissedSignature(ctx, val, baseFraction, types.AttributeKeyValsetSignatureSlashing)
+		after, _ := input.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, after.IsJailed())
+
+		power := sdk.TokensToConsensusPower(val.GetTokens(), sdk.DefaultPowerReduction)
+		expected := baseFraction.MulInt(sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)).TruncateInt()
+		require.Equal(t, expected, val.GetTokens().Sub(after.GetTokens()), "offence %d", i+1)
+		require.False(t, input.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	}
+}
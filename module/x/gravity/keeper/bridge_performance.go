@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// BridgePerformanceWindow is the length in blocks of the windows validator bridge duties are counted over, windows
+// begin at multiples of BridgePerformanceWindow so that every validator's record rolls over at the same height
+const BridgePerformanceWindow uint64 = 10000
+
+/////////////////////////////
+//  BRIDGE DUTY RECORDS    //
+/////////////////////////////
+
+// GetValidatorBridgePerformance returns the bridge duty record of validator as of the current block, validators
+// without a stored record get an empty one
+func (k Keeper) GetValidatorBridgePerformance(ctx sdk.Context, validator sdk.ValAddress) types.ValidatorBridgePerformance {
+	store := ctx.KVStore(k.storeKey)
+	// nolint: exhaustruct
+	perf := types.ValidatorBridgePerformance{Validator: validator.String()}
+	if bz := store.Get(types.GetValidatorBridgePerformanceKey(validator)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &perf)
+	}
+	return rollBridgeDutyWindows(perf, uint64(ctx.BlockHeight()))
+}
+
+// setValidatorBridgePerformance stores the bridge duty record of validator
+func (k Keeper) setValidatorBridgePerformance(ctx sdk.Context, validator sdk.ValAddress, perf types.ValidatorBridgePerformance) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorBridgePerformanceKey(validator), k.cdc.MustMarshal(&perf))
+}
+
+// IterateValidatorBridgePerformance iterates through the stored bridge duty records, the records are not rolled
+// over to the current window
+func (k Keeper) IterateValidatorBridgePerformance(ctx sdk.Context, cb func(validator sdk.ValAddress, perf types.ValidatorBridgePerformance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorBridgePerformanceKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var perf types.ValidatorBridgePerformance
+		k.cdc.MustUnmarshal(iter.Value(), &perf)
+		validator := sdk.ValAddress(iter.Key()[len(types.ValidatorBridgePerformanceKey):])
+		// cb returns true to stop early
+		if cb(validator, perf) {
+			break
+		}
+	}
+}
+
+// GetAllValidatorBridgePerformance returns the stored bridge duty records of every validator, the records are not
+// rolled over to the current window
+func (k Keeper) GetAllValidatorBridgePerformance(ctx sdk.Context) []types.ValidatorBridgePerformance {
+	out := []types.ValidatorBridgePerformance{}
+	k.IterateValidatorBridgePerformance(ctx, func(_ sdk.ValAddress, perf types.ValidatorBridgePerformance) bool {
+		out = append(out, perf)
+		return false
+	})
+	return out
+}
+
+// RecordBridgeDuty counts a duty validator was expected to perform in the current window, performed tells
+// whether the validator performed it
+func (k Keeper) RecordBridgeDuty(ctx sdk.Context, validator sdk.ValAddress, duty types.BridgeDuty, performed bool) {
+	perf := k.GetValidatorBridgePerformance(ctx, validator)
+
+	var counts *types.BridgeDutyCounts
+	switch duty {
+	case types.BRIDGE_DUTY_VALSET:
+		counts = &perf.Current.Valsets
+	case types.BRIDGE_DUTY_BATCH:
+		counts = &perf.Current.Batches
+	case types.BRIDGE_DUTY_LOGIC_CALL:
+		counts = &perf.Current.LogicCalls
+	case types.BRIDGE_DUTY_CLAIM:
+		counts = &perf.Current.Claims
+	default:
+		panic(fmt.Sprintf("unknown bridge duty %v", duty))
+	}
+	counts.Expected++
+	if performed {
+		counts.Performed++
+	}
+
+	k.setValidatorBridgePerformance(ctx, validator, perf)
+}
+
+// RecordClaimParticipation counts the observed attestation as a claim duty of every bonded validator which was
+// validating when the attestation was created. It is called as the attestation is pruned, long after it was
+// observed, so that orchestrators which vote after the threshold was reached are counted as having performed it
+func (k Keeper) RecordClaimParticipation(ctx sdk.Context, att types.Attestation) {
+	voted := make(map[string]bool, len(att.Votes))
+	for _, vote := range att.Votes {
+		voted[vote] = true
+	}
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+		// validators which joined after the attestation was created are not expected to vote on it
+		signingInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		if !exist || signingInfo.StartHeight >= int64(att.Height) {
+			continue
+		}
+		k.RecordBridgeDuty(ctx, val.GetOperator(), types.BRIDGE_DUTY_CLAIM, voted[val.GetOperator().String()])
+	}
+}
+
+// rollBridgeDutyWindows moves the windows of perf forward so that the current window contains height
+func rollBridgeDutyWindows(perf types.ValidatorBridgePerformance, height uint64) types.ValidatorBridgePerformance {
+	start := height - height%BridgePerformanceWindow
+	switch {
+	case perf.Current.StartHeight == start:
+		return perf
+	case perf.Current.StartHeight+BridgePerformanceWindow == start:
+		perf.Previous = perf.Current
+	default:
+		// both windows are out of date
+		// nolint: exhaustruct
+		perf.Previous = types.BridgeDutyWindow{StartHeight: start - BridgePerformanceWindow}
+	}
+	// nolint: exhaustruct
+	perf.Current = types.BridgeDutyWindow{StartHeight: start}
+	return perf
+}
+
+// BridgePerformanceScore returns the share of the duties in both windows of perf which were performed, a validator
+// which was not expected to perform any duty scores one
+func BridgePerformanceScore(perf types.ValidatorBridgePerformance) sdk.Dec {
+	var expected, performed uint64
+	for _, window := range []types.BridgeDutyWindow{perf.Current, perf.Previous} {
+		for _, counts := range []types.BridgeDutyCounts{window.Valsets, window.Batches, window.LogicCalls, window.Claims} {
+			expected += counts.Expected
+			performed += counts.Performed
+		}
+	}
+	if expected == 0 {
+		return sdk.OneDec()
+	}
+	return sdk.NewDec(int64(performed)).QuoInt64(int64(expected))
+}
+
+/////////////////////////////
+//    SLASHING EXPOSURE    //
+/////////////////////////////
+
+// pendingBridgeItem is a valset, batch or logic call which has not been processed by slashing yet
+type pendingBridgeItem struct {
+	item types.UnsignedBridgeItem
+	// the cosmos block the item was created at, validators which started validating later do not have to sign it
+	created uint64
+	// the validators which have signed the item, by sdk.ValAddress.String()
+	signers map[string]bool
+}
+
+// getPendingBridgeItems returns the items of every EVM chain which validators will be slashed for not signing
+func (k Keeper) getPendingBridgeItems(ctx sdk.Context) (out []pendingBridgeItem) {
+	params := k.GetParams(ctx)
+	for _, evmChain := range k.GetAllEvmChains(ctx) {
+		evmChainPrefix := evmChain.EvmChainPrefix
+
+		// a signed valset window of 0 returns every valset which has not been slashed on yet
+		for _, valset := range k.GetUnSlashedValsets(ctx, evmChainPrefix, 0) {
+			signers := make(map[string]bool)
+			for _, confirm := range k.GetValsetConfirms(ctx, evmChainPrefix, valset.Nonce) {
+				k.addBridgeItemSigner(ctx, signers, confirm.Orchestrator)
+			}
+			out = append(out, pendingBridgeItem{
+				// nolint: exhaustruct
+				item: types.UnsignedBridgeItem{
+					EvmChainPrefix:  evmChainPrefix,
+					Duty:            types.BRIDGE_DUTY_VALSET,
+					Nonce:           valset.Nonce,
+					SlashableHeight: valsetSlashableHeight(params, *valset),
+				},
+				created: valset.Height,
+				signers: signers,
+			})
+		}
+
+		for _, batch := range k.GetUnSlashedBatches(ctx, evmChainPrefix, math.MaxUint64) {
+			signers := make(map[string]bool)
+			for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, evmChainPrefix, batch.BatchNonce, batch.TokenContract) {
+				k.addBridgeItemSigner(ctx, signers, confirm.Orchestrator)
+			}
+			out = append(out, pendingBridgeItem{
+				// nolint: exhaustruct
+				item: types.UnsignedBridgeItem{
+					EvmChainPrefix:  evmChainPrefix,
+					Duty:            types.BRIDGE_DUTY_BATCH,
+					Nonce:           batch.BatchNonce,
+					TokenContract:   batch.TokenContract.GetAddress().Hex(),
+					SlashableHeight: batchSlashableHeight(params, batch.CosmosBlockCreated),
+				},
+				created: batch.CosmosBlockCreated,
+				signers: signers,
+			})
+		}
+
+		for _, call := range k.GetUnSlashedLogicCalls(ctx, evmChainPrefix, math.MaxUint64) {
+			signers := make(map[string]bool)
+			for _, confirm := range k.GetLogicConfirmsByInvalidationIdAndNonce(ctx, evmChainPrefix, call.InvalidationId, call.InvalidationNonce) {
+				k.addBridgeItemSigner(ctx, signers, confirm.Orchestrator)
+			}
+			out = append(out, pendingBridgeItem{
+				// nolint: exhaustruct
+				item: types.UnsignedBridgeItem{
+					EvmChainPrefix:  evmChainPrefix,
+					Duty:            types.BRIDGE_DUTY_LOGIC_CALL,
+					Nonce:           call.InvalidationNonce,
+					InvalidationId:  call.InvalidationId,
+					SlashableHeight: logicCallSlashableHeight(params, call.CosmosBlockCreated),
+				},
+				created: call.CosmosBlockCreated,
+				signers: signers,
+			})
+		}
+	}
+	return out
+}
+
+// valsetSlashableHeight returns the height validators are slashed at for not signing valset
+func valsetSlashableHeight(params types.Params, valset types.Valset) uint64 {
+	return valset.Height + params.SignedValsetsWindow
+}
+
+// batchSlashableHeight returns the height validators are slashed at for not signing a batch created at created
+func batchSlashableHeight(params types.Params, created uint64) uint64 {
+	return created + params.SignedBatchesWindow + 1
+}
+
+// logicCallSlashableHeight returns the height validators are slashed at for not signing a logic call created at
+// created
+func logicCallSlashableHeight(params types.Params, created uint64) uint64 {
+	return created + params.SignedLogicCallsWindow + 1
+}
+
+// addBridgeItemSigner adds the validator of orchestrator to signers
+func (k Keeper) addBridgeItemSigner(ctx sdk.Context, signers map[string]bool, orchestrator string) {
+	orch, err := sdk.AccAddressFromBech32(orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	if val, found := k.GetOrchestratorValidatorAddr(ctx, orch); found {
+		signers[val.String()] = true
+	}
+}
+
+// validatorBridgePerformanceReport returns the bridge duty record of validator along with its score and the
+// pending items it would be slashed for if they were processed now
+func (k Keeper) validatorBridgePerformanceReport(ctx sdk.Context, validator stakingtypes.Validator, pending []pendingBridgeItem) types.ValidatorBridgePerformanceReport {
+	perf := k.GetValidatorBridgePerformance(ctx, validator.GetOperator())
+	unsigned := []types.UnsignedBridgeItem{}
+
+	// jailed validators are never slashed again by the bridge
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+	signingInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if exist && !validator.IsJailed() {
+		unbondSlashingValsetsWindow := k.GetParams(ctx).UnbondSlashingValsetsWindow
+		for _, p := range pending {
+			if signingInfo.StartHeight >= int64(p.created) || p.signers[validator.GetOperator().String()] {
+				continue
+			}
+			// unbonding validators must keep signing valsets for UnbondSlashingValsetsWindow blocks
+			unbondingValsetSigner := p.item.Duty == types.BRIDGE_DUTY_VALSET && validator.IsUnbonding() &&
+				p.created < uint64(validator.UnbondingHeight)+unbondSlashingValsetsWindow
+			if validator.IsBonded() || unbondingValsetSigner {
+				unsigned = append(unsigned, p.item)
+			}
+		}
+	}
+
+	return types.ValidatorBridgePerformanceReport{
+		Performance:   perf,
+		Score:         BridgePerformanceScore(perf),
+		AtRisk:        len(unsigned) > 0,
+		UnsignedItems: unsigned,
+	}
+}
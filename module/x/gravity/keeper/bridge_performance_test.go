@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+func TestRecordBridgeDuty(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context.WithBlockHeight(int64(BridgePerformanceWindow) + 5)
+	val := ValAddrs[0]
+
+	perf := k.GetValidatorBridgePerformance(ctx, val)
+	require.Equal(t, val.String(), perf.Validator)
+	require.Equal(t, BridgePerformanceWindow, perf.Current.StartHeight)
+	require.Equal(t, sdk.OneDec(), BridgePerformanceScore(perf))
+
+	k.RecordBridgeDuty(ctx, val, types.BRIDGE_DUTY_VALSET, true)
+	k.RecordBridgeDuty(ctx, val, types.BRIDGE_DUTY_BATCH, false)
+	k.RecordBridgeDuty(ctx, val, types.BRIDGE_DUTY_CLAIM, true)
+	k.RecordBridgeDuty(ctx, val, types.BRIDGE_DUTY_CLAIM, true)
+	perf = k.GetValidatorBridgePerformance(ctx, val)
+	require.Equal(t, types.BridgeDutyCounts{Expected: 1, Performed: 1}, perf.Current.Valsets)
+	require.Equal(t, types.BridgeDutyCounts{Expected: 1, Performed: 0}, perf.Current.Batches)
+	require.Equal(t, types.BridgeDutyCounts{Expected: 2, Performed: 2}, perf.Current.Claims)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), BridgePerformanceScore(perf))
+
+	// the next window keeps the counts as the previous window
+	ctx = ctx.WithBlockHeight(int64(2 * BridgePerformanceWindow))
+	perf = k.GetValidatorBridgePerformance(ctx, val)
+	require.Equal(t, 2*BridgePerformanceWindow, perf.Current.StartHeight)
+	require.Equal(t, uint64(0), perf.Current.Claims.Expected)
+	require.Equal(t, uint64(2), perf.Previous.Claims.Expected)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), BridgePerformanceScore(perf))
+
+	// once both windows have passed nothing is left
+	ctx = ctx.WithBlockHeight(int64(4 * BridgePerformanceWindow))
+	perf = k.GetValidatorBridgePerformance(ctx, val)
+	require.Equal(t, 3*BridgePerformanceWindow, perf.Previous.StartHeight)
+	require.Equal(t, uint64(0), perf.Previous.Claims.Expected)
+	require.Equal(t, sdk.OneDec(), BridgePerformanceScore(perf))
+}
+
+// nolint: exhaustruct
+func TestValidatorBridgePerformanceAtRisk(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { ctx.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	vs, err := k.GetCurrentValset(ctx, EthChainPrefix)
+	require.NoError(t, err)
+	vs.Height = uint64(ctx.BlockHeight())
+	vs.Nonce = k.GetLatestValsetNonce(ctx, EthChainPrefix) + 1
+	k.StoreValset(ctx, EthChainPrefix, vs)
+	k.SetLatestValsetNonce(ctx, EthChainPrefix, vs.Nonce)
+	// everyone but the first validator signs
+	for i, orch := range OrchAddrs[1:] {
+		ethAddr, err := types.NewEthAddress(EthAddrs[i+1].String())
+		require.NoError(t, err)
+		k.SetValsetConfirm(ctx, EthChainPrefix, *types.NewMsgValsetConfirm(vs.Nonce, *ethAddr, orch, "dummysig"))
+	}
+
+	res, err := k.ValidatorBridgePerformance(sdk.WrapSDKContext(ctx), &types.QueryValidatorBridgePerformanceRequest{ValidatorAddress: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.True(t, res.Report.AtRisk)
+	require.Equal(t, []types.UnsignedBridgeItem{{
+		EvmChainPrefix:  EthChainPrefix,
+		Duty:            types.BRIDGE_DUTY_VALSET,
+		Nonce:           vs.Nonce,
+		SlashableHeight: vs.Height + params.SignedValsetsWindow,
+	}}, res.Report.UnsignedItems)
+
+	res, err = k.ValidatorBridgePerformance(sdk.WrapSDKContext(ctx), &types.QueryValidatorBridgePerformanceRequest{ValidatorAddress: ValAddrs[1].String()})
+	require.NoError(t, err)
+	require.False(t, res.Report.AtRisk)
+	require.Empty(t, res.Report.UnsignedItems)
+
+	_, err = k.ValidatorBridgePerformance(sdk.WrapSDKContext(ctx), &types.QueryValidatorBridgePerformanceRequest{ValidatorAddress: "gravityvaloper1"})
+	require.Error(t, err)
+
+	all, err := k.AllValidatorsBridgePerformance(sdk.WrapSDKContext(ctx), &types.QueryAllValidatorsBridgePerformanceRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Reports, len(ValAddrs))
+	atRisk := 0
+	for _, report := range all.Reports {
+		if report.AtRisk {
+			atRisk++
+			require.Equal(t, ValAddrs[0].String(), report.Performance.Validator)
+		}
+	}
+	require.Equal(t, 1, atRisk)
+}
+
+// Claim duties are counted for the validators which were validating when the attestation was created, whether or
+// not they voted before it was observed
+// nolint: exhaustruct
+func TestRecordClaimParticipation(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { ctx.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	att := types.Attestation{
+		Observed: true,
+		Votes:    []string{ValAddrs[0].String(), ValAddrs[1].String(), ValAddrs[2].String(), ValAddrs[3].String()},
+		Height:   uint64(ctx.BlockHeight()),
+	}
+	k.RecordClaimParticipation(ctx, att)
+	for i, val := range ValAddrs[:5] {
+		performed := uint64(1)
+		if i == 4 {
+			performed = 0
+		}
+		require.Equal(t, types.BridgeDutyCounts{Expected: 1, Performed: performed},
+			k.GetValidatorBridgePerformance(ctx, val).Current.Claims)
+	}
+
+	// nobody was validating yet when an attestation at the start height of the validators was created
+	att.Height = 0
+	k.RecordClaimParticipation(ctx, att)
+	require.Equal(t, uint64(1), k.GetValidatorBridgePerformance(ctx, ValAddrs[0]).Current.Claims.Expected)
+}
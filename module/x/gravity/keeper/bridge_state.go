@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// ExportBridgeState walks the gravity store prefixes of every EVM chain with the typed iterators, dumping the pool,
+// batch, logic call, attestation and valset state of the block ctx reads. Unlike ExportGenesis it touches only the
+// gravity store, so it works on any historical version of the store since the v6 store migration. Earlier versions
+// keep the bridge state outside of the EVM chain prefixes and lack the v6 params, they are refused with an error
+func ExportBridgeState(ctx sdk.Context, k Keeper) (types.BridgeStateDump, error) {
+	params, err := k.GetParamsIfSet(ctx)
+	if err != nil {
+		return types.BridgeStateDump{}, sdkerrors.Wrapf(err,
+			"the gravity store at height %d predates the v6 migration and can not be exported", ctx.BlockHeight())
+	}
+	dump := types.BridgeStateDump{
+		Height:       ctx.BlockHeight(),
+		LastTxPoolId: k.getID(ctx, types.KeyLastTXPoolID),
+		LastBatchId:  k.getID(ctx, types.KeyLastOutgoingBatchID),
+		DelegateKeys: k.GetDelegateKeys(ctx),
+		EvmChains:    []types.EvmChainStateDump{},
+	}
+	for _, chain := range params.AllEvmChains() {
+		chainDump, err := exportEvmChainState(ctx, k, chain.EvmChainPrefix)
+		if err != nil {
+			return dump, err
+		}
+		dump.EvmChains = append(dump.EvmChains, chainDump)
+	}
+	return dump, nil
+}
+
+// exportEvmChainState dumps the bridge state of a single EVM chain
+func exportEvmChainState(ctx sdk.Context, k Keeper, evmChainPrefix string) (types.EvmChainStateDump, error) {
+	dump := types.EvmChainStateDump{
+		EvmChainPrefix:                  evmChainPrefix,
+		LastObservedNonce:               k.GetLastObservedEventNonce(ctx, evmChainPrefix),
+		LastObservedEthereumBlockHeight: k.GetLastObservedEthereumBlockHeight(ctx, evmChainPrefix),
+		LatestValsetNonce:               k.GetLatestValsetNonce(ctx, evmChainPrefix),
+		LastSlashedValsetNonce:          k.GetLastSlashedValsetNonce(ctx, evmChainPrefix),
+		LastSlashedBatchBlock:           k.GetLastSlashedBatchBlock(ctx, evmChainPrefix),
+		LastSlashedLogicCallBlock:       k.GetLastSlashedLogicCallBlock(ctx, evmChainPrefix),
+		ValidatorEventNonces:            []types.ValidatorEventNonce{},
+		UnbatchedTransfers:              []types.OutgoingTransferTx{},
+		Batches:                         []types.OutgoingTxBatch{},
+		BatchConfirms:                   []types.MsgConfirmBatch{},
+		LogicCalls:                      []types.OutgoingLogicCall{},
+		LogicCallConfirms:               []types.MsgConfirmLogicCall{},
+		Attestations:                    []types.AttestationDump{},
+		Valsets:                         []types.Valset{},
+		ValsetConfirms:                  []types.MsgValsetConfirm{},
+		PendingIbcAutoForwards:          []types.PendingIbcAutoForward{},
+		Erc20ToDenoms:                   []types.ERC20ToDenom{},
+	}
+
+	k.IterateValidatorLastEventNonces(ctx, evmChainPrefix, func(key []byte, nonce uint64) bool {
+		dump.ValidatorEventNonces = append(dump.ValidatorEventNonces, types.ValidatorEventNonce{
+			Validator:  sdk.ValAddress(key).String(),
+			EventNonce: nonce,
+		})
+		return false
+	})
+	k.IterateUnbatchedTransactions(ctx, evmChainPrefix, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		dump.UnbatchedTransfers = append(dump.UnbatchedTransfers, tx.ToExternal())
+		return false
+	})
+	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+		dump.Batches = append(dump.Batches, batch.ToExternal())
+		return false
+	})
+	k.IterateBatchConfirms(ctx, evmChainPrefix, func(_ []byte, confirm types.MsgConfirmBatch) bool {
+		dump.BatchConfirms = append(dump.BatchConfirms, confirm)
+		return false
+	})
+	k.IterateOutgoingLogicCalls(ctx, evmChainPrefix, func(_ []byte, call types.OutgoingLogicCall) bool {
+		dump.LogicCalls = append(dump.LogicCalls, call)
+		return false
+	})
+	k.IterateLogicConfirms(ctx, evmChainPrefix, func(_ []byte, confirm *types.MsgConfirmLogicCall) bool {
+		dump.LogicCallConfirms = append(dump.LogicCallConfirms, *confirm)
+		return false
+	})
+	k.IterateValsets(ctx, evmChainPrefix, func(_ []byte, valset *types.Valset) bool {
+		dump.Valsets = append(dump.Valsets, *valset)
+		return false
+	})
+	k.IterateValsetConfirms(ctx, evmChainPrefix, func(_ []byte, confirms []types.MsgValsetConfirm, _ uint64) bool {
+		dump.ValsetConfirms = append(dump.ValsetConfirms, confirms...)
+		return false
+	})
+	k.IteratePendingIbcAutoForwards(ctx, evmChainPrefix, func(_ []byte, forward *types.PendingIbcAutoForward) bool {
+		dump.PendingIbcAutoForwards = append(dump.PendingIbcAutoForwards, *forward)
+		return false
+	})
+	k.IterateERC20ToDenom(ctx, evmChainPrefix, func(_ []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+		dump.Erc20ToDenoms = append(dump.Erc20ToDenoms, *erc20ToDenom)
+		return false
+	})
+
+	var err error
+	k.IterateAttestations(ctx, evmChainPrefix, false, func(_ []byte, att types.Attestation) bool {
+		var attDump types.AttestationDump
+		attDump, err = dumpAttestation(k, att)
+		if err != nil {
+			return true
+		}
+		dump.Attestations = append(dump.Attestations, attDump)
+		return false
+	})
+	return dump, err
+}
+
+// dumpAttestation decodes the claim of an attestation
+func dumpAttestation(k Keeper, att types.Attestation) (types.AttestationDump, error) {
+	claim, err := k.UnpackAttestationClaim(&att)
+	if err != nil {
+		return types.AttestationDump{}, fmt.Errorf("failed to unpack the claim of an attestation: %w", err)
+	}
+	claimHash, err := claim.ClaimHash()
+	if err != nil {
+		return types.AttestationDump{}, fmt.Errorf("failed to hash the claim of event %d: %w", claim.GetEventNonce(), err)
+	}
+	msg, ok := claim.(proto.Message)
+	if !ok {
+		return types.AttestationDump{}, fmt.Errorf("the claim of event %d is not a proto message", claim.GetEventNonce())
+	}
+	claimJSON, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return types.AttestationDump{}, fmt.Errorf("failed to encode the claim of event %d: %w", claim.GetEventNonce(), err)
+	}
+	return types.AttestationDump{
+		EventNonce:     claim.GetEventNonce(),
+		EthBlockHeight: claim.GetEthBlockHeight(),
+		ClaimType:      claim.GetType().String(),
+		ClaimHash:      hex.EncodeToString(claimHash),
+		Observed:       att.Observed,
+		Height:         att.Height,
+		Votes:          att.Votes,
+		Claim:          claimJSON,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Tests that the dump holds the pool, batch, attestation and valset state of the store
+func TestExportBridgeState(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+
+	var (
+		mySender, e1    = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, e2  = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenAddr, e3   = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		token, e4       = types.NewInternalERC20Token(sdk.NewInt(99999), tokenAddr.GetAddress().Hex())
+		allVouchers     = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
+		amount, feeCoin = sdk.NewInt64Coin(allVouchers[0].Denom, 100), sdk.NewInt64Coin(allVouchers[0].Denom, 1)
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	for i := 0; i < 3; i++ {
+		_, err := k.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amount, feeCoin)
+		require.NoError(t, err)
+	}
+	batch, err := k.BuildOutgoingTXBatch(ctx, EthChainPrefix, *tokenAddr, 2)
+	require.NoError(t, err)
+	valset := k.SetValsetRequest(ctx, EthChainPrefix)
+	_, _, hashes := createAttestations(t, 2, k, ctx)
+	k.SetLastEventNonceByValidator(ctx, EthChainPrefix, ValAddrs[0], 2)
+
+	dump, err := ExportBridgeState(ctx, k)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight(), dump.Height)
+	require.Equal(t, uint64(3), dump.LastTxPoolId)
+	require.Equal(t, batch.BatchNonce, dump.LastBatchId)
+	require.Len(t, dump.DelegateKeys, 5)
+	require.Len(t, dump.EvmChains, len(k.GetAllEvmChains(ctx)))
+
+	chain := dump.EvmChains[0]
+	require.Equal(t, EthChainPrefix, chain.EvmChainPrefix)
+	require.Len(t, chain.UnbatchedTransfers, 1)
+	require.Len(t, chain.Batches, 1)
+	require.Equal(t, batch.ToExternal(), chain.Batches[0])
+	require.Equal(t, valset.Nonce, chain.LatestValsetNonce)
+	require.Contains(t, chain.Valsets, valset)
+	require.Equal(t, []types.ValidatorEventNonce{{Validator: ValAddrs[0].String(), EventNonce: 2}}, chain.ValidatorEventNonces)
+
+	// attestations come in event nonce order with their claims decoded
+	require.Len(t, chain.Attestations, 2)
+	for i, att := range chain.Attestations {
+		require.Equal(t, uint64(i+1), att.EventNonce)
+		require.Equal(t, types.CLAIM_TYPE_SEND_TO_COSMOS.String(), att.ClaimType)
+		require.Equal(t, hex.EncodeToString(hashes[i]), att.ClaimHash)
+		var claim types.MsgSendToCosmosClaim
+		require.NoError(t, input.Marshaler.UnmarshalJSON(att.Claim, &claim))
+		require.Equal(t, att.EventNonce, claim.EventNonce)
+	}
+
+	// the dump is plain JSON, empty sections included
+	bz, err := json.Marshal(dump)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"logic_calls":[]`)
+}
+
+// A store from before the v6 migration lacks the v6 params, exporting it fails with an error instead of panicking
+func TestExportBridgeStateBeforeV6(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+
+	// point the keeper at an empty params store which only holds a v5 param
+	keyParams, tkeyParams := sdk.NewKVStoreKey(paramstypes.StoreKey), sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	k.paramSpace = paramstypes.NewSubspace(input.Marshaler, input.LegacyAmino, keyParams, tkeyParams, types.DefaultParamspace).
+		WithKeyTable(types.ParamKeyTable())
+	ctx := input.Context.WithMultiStore(ms)
+	k.paramSpace.Set(ctx, types.ParamsStoreKeyGravityID, "gravity-test")
+
+	_, err := ExportBridgeState(ctx, k)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "predates the v6 migration")
+}
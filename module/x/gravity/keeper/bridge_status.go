@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// LaggingValidatorGraceBlocks is the number of blocks validators get to submit their claim for the last observed
+// event before the bridge status counts them as lagging, an event is observed before every validator has voted on it
+const LaggingValidatorGraceBlocks uint64 = 50
+
+// GetBridgeStatus summarizes the state of the bridge to an EVM chain, computing a health level from how long
+// transfers, batches and valsets have been waiting and how many validators are behind on events
+func (k Keeper) GetBridgeStatus(ctx sdk.Context, evmChainPrefix string) types.QueryBridgeStatusResponse {
+	params := k.GetParams(ctx)
+	cosmosHeight := uint64(ctx.BlockHeight())
+	lastObservedHeights := k.GetLastObservedEthereumBlockHeight(ctx, evmChainPrefix)
+	// governance can halt batch creation on every chain or attestations on a single chain
+	// nolint: exhaustruct
+	status := types.QueryBridgeStatusResponse{
+		BridgeActive:               params.BridgeActive && k.GetEvmChainParams(ctx, evmChainPrefix).BridgeActive,
+		CosmosHeight:               cosmosHeight,
+		LastObservedEventNonce:     k.GetLastObservedEventNonce(ctx, evmChainPrefix),
+		LastObservedEthereumHeight: lastObservedHeights.EthereumBlockHeight,
+		LastObservedCosmosHeight:   lastObservedHeights.CosmosBlockHeight,
+		ProjectedEthereumHeight:    k.projectedEthereumHeight(ctx, evmChainPrefix),
+		LatestValsetNonce:          k.GetLatestValsetNonce(ctx, evmChainPrefix),
+		PendingIbcAutoForwards:     uint64(len(k.PendingIbcAutoForwards(ctx, evmChainPrefix, 0))),
+		LaggingValidators:          []types.LaggingValidator{},
+		HealthReasons:              []string{},
+	}
+
+	// the pool is ordered by fee, so the oldest transfer is the one with the lowest id
+	k.IterateUnbatchedTransactions(ctx, evmChainPrefix, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		status.UnbatchedTxCount++
+		if status.OldestUnbatchedTxId == 0 || tx.Id < status.OldestUnbatchedTxId {
+			status.OldestUnbatchedTxId = tx.Id
+		}
+		return false
+	})
+	if status.OldestUnbatchedTxId != 0 {
+		// a genesis file imported at a lower initial height may carry creation heights above the current height
+		if created, found := k.GetOutgoingTxCreatedHeight(ctx, evmChainPrefix, status.OldestUnbatchedTxId); found && created <= cosmosHeight {
+			status.OldestUnbatchedTxAge = cosmosHeight - created
+		}
+	}
+
+	var oldestUnsigned *types.InternalOutgoingTxBatch
+	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+		if oldestUnsigned != nil && batch.CosmosBlockCreated >= oldestUnsigned.CosmosBlockCreated {
+			return false
+		}
+		// the check fails when no valset has been observed yet, no batch can be relayed then either
+		res, err := k.SimulateBatchSignatureCheck(ctx, evmChainPrefix, batch.TokenContract, batch.BatchNonce)
+		if err != nil || !res.Passes {
+			batch := batch
+			oldestUnsigned = &batch
+		}
+		return false
+	})
+	if oldestUnsigned != nil {
+		status.OldestUnsignedBatchNonce = oldestUnsigned.BatchNonce
+		status.OldestUnsignedBatchTokenContract = oldestUnsigned.TokenContract.GetAddress().Hex()
+		if oldestUnsigned.CosmosBlockCreated <= cosmosHeight {
+			status.OldestUnsignedBatchAge = cosmosHeight - oldestUnsigned.CosmosBlockCreated
+		}
+	}
+
+	if lastObserved := k.GetLastObservedValset(ctx, evmChainPrefix); lastObserved != nil {
+		status.LastObservedValsetNonce = lastObserved.Nonce
+	}
+	if status.LatestValsetNonce != status.LastObservedValsetNonce {
+		if latest := k.GetValset(ctx, evmChainPrefix, status.LatestValsetNonce); latest != nil && latest.Height <= cosmosHeight {
+			status.LatestValsetAge = cosmosHeight - latest.Height
+		}
+	}
+
+	laggingPower := sdk.ZeroInt()
+	if status.LastObservedEventNonce != 0 {
+		for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+			valAddr := val.GetOperator()
+			nonce := k.GetLastEventNonceByValidator(ctx, evmChainPrefix, valAddr)
+			if nonce >= status.LastObservedEventNonce {
+				continue
+			}
+			power := k.StakingKeeper.GetLastValidatorPower(ctx, valAddr)
+			laggingPower = laggingPower.Add(sdk.NewInt(power))
+			status.LaggingValidators = append(status.LaggingValidators, types.LaggingValidator{
+				ValidatorAddress: valAddr.String(),
+				LastEventNonce:   nonce,
+				EventsBehind:     status.LastObservedEventNonce - nonce,
+				Power:            power,
+			})
+		}
+	}
+
+	status.Health, status.HealthReasons = bridgeHealth(params, status, laggingPower, k.StakingKeeper.GetLastTotalPower(ctx))
+	return status
+}
+
+// bridgeHealth computes the health level of a bridge status and the reasons it is not healthy. Validators holding
+// enough power to block attestations stall the oracle, as do batches nobody can relay before validators get slashed
+// for them, so both make the bridge unhealthy while anything else waiting too long only degrades it
+func bridgeHealth(params types.Params, status types.QueryBridgeStatusResponse, laggingPower sdk.Int, totalPower sdk.Int) (types.BridgeHealth, []string) {
+	if !status.BridgeActive {
+		return types.BRIDGE_HEALTH_HALTED, []string{"the bridge is not active"}
+	}
+
+	var unhealthy, degraded []string
+	if status.OldestUnsignedBatchNonce != 0 && status.OldestUnsignedBatchAge > params.SignedBatchesWindow {
+		unhealthy = append(unhealthy, fmt.Sprintf("batch %d has not been signed in %d blocks", status.OldestUnsignedBatchNonce, status.OldestUnsignedBatchAge))
+	}
+	lagging := len(status.LaggingValidators) != 0 &&
+		status.CosmosHeight-status.LastObservedCosmosHeight >= LaggingValidatorGraceBlocks
+	if lagging {
+		// attestations need more than AttestationVotesPowerThreshold percent of the power
+		blockingPower := sdk.NewInt(100).Sub(types.AttestationVotesPowerThreshold).Mul(totalPower)
+		if laggingPower.MulRaw(100).GTE(blockingPower) {
+			unhealthy = append(unhealthy, fmt.Sprintf("validators with %s of %s power are behind on events", laggingPower, totalPower))
+		} else {
+			degraded = append(degraded, fmt.Sprintf("%d validators are behind on events", len(status.LaggingValidators)))
+		}
+	}
+	if status.LatestValsetAge > params.SignedValsetsWindow {
+		degraded = append(degraded, fmt.Sprintf("valset %d has not been observed in %d blocks", status.LatestValsetNonce, status.LatestValsetAge))
+	}
+	if params.AverageBlockTime != 0 && status.OldestUnbatchedTxAge > params.TargetBatchTimeout/params.AverageBlockTime {
+		degraded = append(degraded, fmt.Sprintf("transfer %d has not been batched in %d blocks", status.OldestUnbatchedTxId, status.OldestUnbatchedTxAge))
+	}
+
+	switch {
+	case len(unhealthy) != 0:
+		return types.BRIDGE_HEALTH_UNHEALTHY, append(unhealthy, degraded...)
+	case len(degraded) != 0:
+		return types.BRIDGE_HEALTH_DEGRADED, degraded
+	default:
+		return types.BRIDGE_HEALTH_HEALTHY, []string{}
+	}
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Tests that the status ages the waiting transfers and batches, finds the lagging validators and grades the health
+// of the bridge from them
+// nolint: exhaustruct
+func TestBridgeStatus(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { ctx.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+
+	var (
+		mySender, e1    = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, e2  = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenAddr, e3   = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		token, e4       = types.NewInternalERC20Token(sdk.NewInt(99999), tokenAddr.GetAddress().Hex())
+		allVouchers     = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
+		amount, feeCoin = sdk.NewInt64Coin(allVouchers[0].Denom, 100), sdk.NewInt64Coin(allVouchers[0].Denom, 1)
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	// the last event is observed at height 10
+	ctx = ctx.WithBlockHeight(10)
+	k.SetLastObservedEthereumBlockHeight(ctx, EthChainPrefix, 1000)
+	k.setLastObservedEventNonce(ctx, EthChainPrefix, 3)
+	for _, val := range ValAddrs {
+		k.SetLastEventNonceByValidator(ctx, EthChainPrefix, val, 3)
+	}
+
+	// three transfers added at heights 10 to 12, the first one is refunded
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockHeight(int64(10 + i))
+		_, err := k.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amount, feeCoin)
+		require.NoError(t, err)
+	}
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, 1, mySender))
+	_, found := k.GetOutgoingTxCreatedHeight(ctx, EthChainPrefix, 1)
+	require.False(t, found)
+
+	maxUnbatchedAge := params.TargetBatchTimeout / params.AverageBlockTime
+	ctx = ctx.WithBlockHeight(int64(11 + maxUnbatchedAge + 1))
+	res, err := k.BridgeStatus(sdk.WrapSDKContext(ctx), &types.QueryBridgeStatusRequest{EvmChainPrefix: EthChainPrefix})
+	require.NoError(t, err)
+	require.True(t, res.BridgeActive)
+	require.Equal(t, uint64(3), res.LastObservedEventNonce)
+	require.Equal(t, uint64(1000), res.LastObservedEthereumHeight)
+	require.Equal(t, uint64(10), res.LastObservedCosmosHeight)
+	require.Equal(t, k.projectedEthereumHeight(ctx, EthChainPrefix), res.ProjectedEthereumHeight)
+	require.Greater(t, res.ProjectedEthereumHeight, uint64(1000))
+	require.Equal(t, uint64(2), res.UnbatchedTxCount)
+	require.Equal(t, uint64(2), res.OldestUnbatchedTxId)
+	require.Equal(t, maxUnbatchedAge+1, res.OldestUnbatchedTxAge)
+	require.Empty(t, res.LaggingValidators)
+	require.Equal(t, types.BRIDGE_HEALTH_DEGRADED, res.Health)
+	require.Len(t, res.HealthReasons, 1)
+
+	// batching the transfers leaves an unsigned batch, which is unhealthy once validators could be slashed for it
+	batch, err := k.BuildOutgoingTXBatch(ctx, EthChainPrefix, *tokenAddr, 2)
+	require.NoError(t, err)
+	status := k.GetBridgeStatus(ctx, EthChainPrefix)
+	require.Zero(t, status.UnbatchedTxCount)
+	require.Zero(t, status.OldestUnbatchedTxAge)
+	require.Equal(t, batch.BatchNonce, status.OldestUnsignedBatchNonce)
+	require.Equal(t, tokenAddr.GetAddress().Hex(), status.OldestUnsignedBatchTokenContract)
+	require.Zero(t, status.OldestUnsignedBatchAge)
+	// a genesis file imported at a lower initial height may carry creation heights above the current height
+	status = k.GetBridgeStatus(ctx.WithBlockHeight(int64(batch.CosmosBlockCreated)-1), EthChainPrefix)
+	require.Zero(t, status.OldestUnsignedBatchAge)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedBatchesWindow) + 1)
+	status = k.GetBridgeStatus(ctx, EthChainPrefix)
+	require.Equal(t, params.SignedBatchesWindow+1, status.OldestUnsignedBatchAge)
+	require.Equal(t, types.BRIDGE_HEALTH_UNHEALTHY, status.Health)
+
+	// executing the batch forgets the creation heights of its transfers
+	k.OutgoingTxBatchExecuted(ctx, EthChainPrefix, *tokenAddr, types.MsgBatchSendToEthClaim{
+		BatchNonce:     batch.BatchNonce,
+		EthBlockHeight: batch.BatchTimeout - 1,
+		TokenContract:  tokenAddr.GetAddress().Hex(),
+	})
+	for _, tx := range batch.Transactions {
+		_, found := k.GetOutgoingTxCreatedHeight(ctx, EthChainPrefix, tx.Id)
+		require.False(t, found)
+	}
+
+	// a validator behind on events only counts once the grace period is over
+	k.SetLastEventNonceByValidator(ctx, EthChainPrefix, ValAddrs[0], 1)
+	ctx = ctx.WithBlockHeight(int64(10 + LaggingValidatorGraceBlocks - 1))
+	status = k.GetBridgeStatus(ctx, EthChainPrefix)
+	require.Equal(t, []types.LaggingValidator{{
+		ValidatorAddress: ValAddrs[0].String(),
+		LastEventNonce:   1,
+		EventsBehind:     2,
+		Power:            input.StakingKeeper.GetLastValidatorPower(ctx, ValAddrs[0]),
+	}}, status.LaggingValidators)
+	require.Equal(t, types.BRIDGE_HEALTH_HEALTHY, status.Health)
+	require.Empty(t, status.HealthReasons)
+
+	ctx = ctx.WithBlockHeight(int64(10 + LaggingValidatorGraceBlocks))
+	status = k.GetBridgeStatus(ctx, EthChainPrefix)
+	require.Equal(t, types.BRIDGE_HEALTH_DEGRADED, status.Health)
+
+	// two of five equal validators hold enough power to block attestations
+	k.SetLastEventNonceByValidator(ctx, EthChainPrefix, ValAddrs[1], 2)
+	status = k.GetBridgeStatus(ctx, EthChainPrefix)
+	require.Len(t, status.LaggingValidators, 2)
+	require.Equal(t, types.BRIDGE_HEALTH_UNHEALTHY, status.Health)
+
+	// a paused bridge is halted whatever else is going on
+	params.BridgeActive = false
+	k.SetParams(ctx, params)
+	status = k.GetBridgeStatus(ctx, EthChainPrefix)
+	require.False(t, status.BridgeActive)
+	require.Equal(t, types.BRIDGE_HEALTH_HALTED, status.Health)
+}
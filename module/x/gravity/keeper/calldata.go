@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GetValsetUpdateCalldata returns the calldata of the Gravity.sol updateValset call submitting the valset with the
+// given nonce, signed by the members of the last observed valset which have confirmed it
+func (k Keeper) GetValsetUpdateCalldata(ctx sdk.Context, evmChainPrefix string, nonce uint64) (*types.QueryEthereumCalldataResponse, error) {
+	check, err := k.SimulateValsetSignatureCheck(ctx, evmChainPrefix, nonce)
+	if err != nil {
+		return nil, err
+	}
+	valset := k.GetValset(ctx, evmChainPrefix, nonce)
+	calldata, err := types.ValsetUpdateCalldata(*valset, *k.GetLastObservedValset(ctx, evmChainPrefix), checkedSignatures(check))
+	if err != nil {
+		return nil, err
+	}
+	return calldataResponse("updateValset", calldata, check), nil
+}
+
+// GetBatchCalldata returns the calldata of the Gravity.sol submitBatch call submitting the batch with the given nonce
+// and token contract, signed by the members of the last observed valset which have confirmed it
+func (k Keeper) GetBatchCalldata(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress, nonce uint64) (*types.QueryEthereumCalldataResponse, error) {
+	check, err := k.SimulateBatchSignatureCheck(ctx, evmChainPrefix, tokenContract, nonce)
+	if err != nil {
+		return nil, err
+	}
+	batch := k.GetOutgoingTXBatch(ctx, evmChainPrefix, tokenContract, nonce)
+	calldata, err := types.BatchCalldata(*batch, *k.GetLastObservedValset(ctx, evmChainPrefix), checkedSignatures(check))
+	if err != nil {
+		return nil, err
+	}
+	return calldataResponse("submitBatch", calldata, check), nil
+}
+
+// GetLogicCallCalldata returns the calldata of the Gravity.sol submitLogicCall call submitting the logic call with
+// the given invalidation id and nonce, signed by the members of the last observed valset which have confirmed it
+func (k Keeper) GetLogicCallCalldata(ctx sdk.Context, evmChainPrefix string, invalidationID []byte, invalidationNonce uint64) (*types.QueryEthereumCalldataResponse, error) {
+	check, err := k.SimulateLogicCallSignatureCheck(ctx, evmChainPrefix, invalidationID, invalidationNonce)
+	if err != nil {
+		return nil, err
+	}
+	call := k.GetOutgoingLogicCall(ctx, evmChainPrefix, invalidationID, invalidationNonce)
+	calldata, err := types.LogicCallCalldata(*call, *k.GetLastObservedValset(ctx, evmChainPrefix), checkedSignatures(check))
+	if err != nil {
+		return nil, err
+	}
+	return calldataResponse("submitLogicCall", calldata, check), nil
+}
+
+// checkedSignatures returns the signatures of a simulated signature check in the order of the valset members
+func checkedSignatures(check *types.QuerySimulateSignatureCheckResponse) []string {
+	signatures := make([]string, len(check.Signatures))
+	for i, entry := range check.Signatures {
+		signatures[i] = entry.Signature
+	}
+	return signatures
+}
+
+func calldataResponse(function string, calldata []byte, check *types.QuerySimulateSignatureCheckResponse) *types.QueryEthereumCalldataResponse {
+	return &types.QueryEthereumCalldataResponse{
+		Function:    function,
+		Calldata:    hexutil.Encode(calldata),
+		ValsetNonce: check.ValsetNonce,
+		Passes:      check.Passes,
+	}
+}
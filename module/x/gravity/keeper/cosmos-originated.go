@@ -10,9 +10,9 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
-func (k Keeper) GetCosmosOriginatedDenom(ctx sdk.Context, tokenContract types.EthAddress) (string, bool) {
+func (k Keeper) GetCosmosOriginatedDenom(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetERC20ToDenomKey(tokenContract))
+	bz := store.Get(types.GetERC20ToDenomKey(evmChainPrefix, tokenContract))
 
 	if bz != nil {
 		return string(bz), true
@@ -20,9 +20,9 @@ func (k Keeper) GetCosmosOriginatedDenom(ctx sdk.Context, tokenContract types.Et
 	return "", false
 }
 
-func (k Keeper) GetCosmosOriginatedERC20(ctx sdk.Context, denom string) (*types.EthAddress, bool) {
+func (k Keeper) GetCosmosOriginatedERC20(ctx sdk.Context, evmChainPrefix string, denom string) (*types.EthAddress, bool) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetDenomToERC20Key(denom))
+	bz := store.Get(types.GetDenomToERC20Key(evmChainPrefix, denom))
 	if bz != nil {
 		ethAddr, err := types.NewEthAddressFromBytes(bz)
 		if err != nil {
@@ -36,9 +36,9 @@ func (k Keeper) GetCosmosOriginatedERC20(ctx sdk.Context, denom string) (*types.
 
 // IterateCosmosOriginatedERC20s iterates through every erc20 under DenomToERC20Key, passing it to the given callback.
 // cb should return true to stop iteration, false to continue
-func (k Keeper) IterateCosmosOriginatedERC20s(ctx sdk.Context, cb func(key []byte, erc20 *types.EthAddress) (stop bool)) {
+func (k Keeper) IterateCosmosOriginatedERC20s(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, erc20 *types.EthAddress) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	prefixStore := prefix.NewStore(store, types.DenomToERC20Key)
+	prefixStore := prefix.NewStore(store, types.AppendEvmChainPrefix(types.DenomToERC20Key, evmChainPrefix))
 	iter := prefixStore.Iterator(nil, nil)
 
 	defer iter.Close()
@@ -55,10 +55,10 @@ func (k Keeper) IterateCosmosOriginatedERC20s(ctx sdk.Context, cb func(key []byt
 	}
 }
 
-func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, tokenContract types.EthAddress) {
+func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, evmChainPrefix string, denom string, tokenContract types.EthAddress) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetDenomToERC20Key(denom), tokenContract.GetAddress().Bytes())
-	store.Set(types.GetERC20ToDenomKey(tokenContract), []byte(denom))
+	store.Set(types.GetDenomToERC20Key(evmChainPrefix, denom), tokenContract.GetAddress().Bytes())
+	store.Set(types.GetERC20ToDenomKey(evmChainPrefix, tokenContract), []byte(denom))
 }
 
 // DenomToERC20Lookup returns (bool isCosmosOriginated, EthAddress ERC20, err)
@@ -66,13 +66,13 @@ func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, t
 // and get its corresponding ERC20 address.
 // This will return an error if it cant parse the denom as a gravity denom, and then also can't find the denom
 // in an index of ERC20 contracts deployed on Ethereum to serve as synthetic Cosmos assets.
-func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, *types.EthAddress, error) {
+func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, evmChainPrefix string, denom string) (bool, *types.EthAddress, error) {
 	// First try parsing the ERC20 out of the denom
-	tc1, err := types.GravityDenomToERC20(denom)
+	denomEvmChainPrefix, tc1, err := types.GravityDenomToERC20(denom)
 
 	if err != nil {
 		// Look up ERC20 contract in index and error if it's not in there.
-		tc2, exists := k.GetCosmosOriginatedERC20(ctx, denom)
+		tc2, exists := k.GetCosmosOriginatedERC20(ctx, evmChainPrefix, denom)
 		if !exists {
 			return false, nil,
 				sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("denom not a gravity voucher coin: %s, and also not in cosmos-originated ERC20 index", err))
@@ -81,18 +81,23 @@ func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, *types.
 		return true, tc2, nil
 	}
 
+	// Vouchers for tokens from another EVM chain can only be sent back to the chain they came from
+	if denomEvmChainPrefix != evmChainPrefix {
+		return false, nil, sdkerrors.Wrapf(types.ErrInvalid, "denom %s is from evm chain %s, not %s", denom, denomEvmChainPrefix, evmChainPrefix)
+	}
+
 	// This is an ethereum-originated asset
 	return false, tc1, nil
 }
 
 // RewardToERC20Lookup is a specialized function wrapping DenomToERC20Lookup designed to validate
 // the validator set reward any time we generate a validator set
-func (k Keeper) RewardToERC20Lookup(ctx sdk.Context, coin sdk.Coin) (*types.EthAddress, sdk.Int) {
+func (k Keeper) RewardToERC20Lookup(ctx sdk.Context, evmChainPrefix string, coin sdk.Coin) (*types.EthAddress, sdk.Int) {
 	if !coin.IsValid() || coin.IsZero() {
 		panic("Bad validator set relaying reward!")
 	} else {
 		// reward case, pass to DenomToERC20Lookup
-		_, address, err := k.DenomToERC20Lookup(ctx, coin.Denom)
+		_, address, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, coin.Denom)
 		if err != nil {
 			// This can only ever happen if governance sets a value for the reward
 			// which is not a valid ERC20 that as been bridged before (either from or to Cosmos)
@@ -109,21 +114,21 @@ func (k Keeper) RewardToERC20Lookup(ctx sdk.Context, coin sdk.Coin) (*types.EthA
 // ERC20ToDenom returns (bool isCosmosOriginated, string denom, err)
 // Using this information, you can see if an ERC20 address representing an asset is native to Cosmos or Ethereum,
 // and get its corresponding denom
-func (k Keeper) ERC20ToDenomLookup(ctx sdk.Context, tokenContract types.EthAddress) (bool, string) {
+func (k Keeper) ERC20ToDenomLookup(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress) (bool, string) {
 	// First try looking up tokenContract in index
-	dn1, exists := k.GetCosmosOriginatedDenom(ctx, tokenContract)
+	dn1, exists := k.GetCosmosOriginatedDenom(ctx, evmChainPrefix, tokenContract)
 	if exists {
 		// It is a cosmos originated asset
 		return true, dn1
 	}
 
 	// If it is not in there, it is not a cosmos originated token, turn the ERC20 into a gravity denom
-	return false, types.GravityDenom(tokenContract)
+	return false, types.GravityDenom(evmChainPrefix, tokenContract)
 }
 
 // IterateERC20ToDenom iterates over erc20 to denom relations
-func (k Keeper) IterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC20ToDenom) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ERC20ToDenomKey)
+func (k Keeper) IterateERC20ToDenom(ctx sdk.Context, evmChainPrefix string, cb func([]byte, *types.ERC20ToDenom) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendEvmChainPrefix(types.ERC20ToDenomKey, evmChainPrefix))
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+/*	ERC20 Migrations
+
+	A Cosmos originated denom is bound to the first ERC20 deployed for it through an ERC20DeployedClaim. If that ERC20
+	turns out to be faulty governance may re-point the denom to another deployment with an ERC20MigrationProposal.
+	Any further ERC20DeployedClaim for a denom which already has an ERC20 is recorded as an AttestedERC20Deployment,
+	only these attested deployments may be migrated to.
+
+	Once migrated, the old ERC20 is frozen for outgoing transfers: unbatched transactions are moved over to the new
+	ERC20 and no new batches may be created for the old one. The ERC20ToDenom entry of the old ERC20 is kept so that
+	deposits of the old ERC20 are still credited until the Ethereum block height DepositsAcceptedUntil, deposits
+	observed after that height are sent to the community pool.
+*/
+
+// GetERC20Migration returns the migration away from the given ERC20, if any
+func (k Keeper) GetERC20Migration(ctx sdk.Context, evmChainPrefix string, oldErc20 types.EthAddress) (*types.ERC20Migration, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetERC20MigrationKey(evmChainPrefix, oldErc20))
+	if bz == nil {
+		return nil, false
+	}
+	var migration types.ERC20Migration
+	k.cdc.MustUnmarshal(bz, &migration)
+	return &migration, true
+}
+
+// setERC20Migration stores the migration, indexed by the old ERC20
+func (k Keeper) setERC20Migration(ctx sdk.Context, evmChainPrefix string, migration types.ERC20Migration) {
+	oldErc20, err := types.NewEthAddress(migration.OldErc20)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid old erc20 in migration %v", migration))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetERC20MigrationKey(evmChainPrefix, *oldErc20), k.cdc.MustMarshal(&migration))
+}
+
+// IterateERC20Migrations iterates through every ERC20Migration, passing it to the given callback.
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateERC20Migrations(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, migration types.ERC20Migration) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendEvmChainPrefix(types.ERC20MigrationKey, evmChainPrefix))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var migration types.ERC20Migration
+		k.cdc.MustUnmarshal(iter.Value(), &migration)
+		// cb returns true to stop early
+		if cb(iter.Key(), migration) {
+			break
+		}
+	}
+}
+
+// GetAttestedERC20Deployment returns the attested deployment of erc20 for denom, if any
+func (k Keeper) GetAttestedERC20Deployment(ctx sdk.Context, evmChainPrefix string, erc20 types.EthAddress, denom string) (*types.AttestedERC20Deployment, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAttestedERC20DeploymentKey(evmChainPrefix, erc20, denom))
+	if bz == nil {
+		return nil, false
+	}
+	var deployment types.AttestedERC20Deployment
+	k.cdc.MustUnmarshal(bz, &deployment)
+	return &deployment, true
+}
+
+// setAttestedERC20Deployment records an observed ERC20 deployment for a denom which already has an ERC20
+func (k Keeper) setAttestedERC20Deployment(ctx sdk.Context, evmChainPrefix string, deployment types.AttestedERC20Deployment) {
+	erc20, err := types.NewEthAddress(deployment.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid token contract in attested deployment %v", deployment))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAttestedERC20DeploymentKey(evmChainPrefix, *erc20, deployment.CosmosDenom), k.cdc.MustMarshal(&deployment))
+}
+
+// deleteAttestedERC20Deployment removes an attested deployment, used once it has been migrated to
+func (k Keeper) deleteAttestedERC20Deployment(ctx sdk.Context, evmChainPrefix string, erc20 types.EthAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAttestedERC20DeploymentKey(evmChainPrefix, erc20, denom))
+}
+
+// IterateAttestedERC20Deployments iterates through every AttestedERC20Deployment, passing it to the given callback.
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateAttestedERC20Deployments(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, deployment types.AttestedERC20Deployment) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendEvmChainPrefix(types.AttestedERC20DeploymentKey, evmChainPrefix))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var deployment types.AttestedERC20Deployment
+		k.cdc.MustUnmarshal(iter.Value(), &deployment)
+		// cb returns true to stop early
+		if cb(iter.Key(), deployment) {
+			break
+		}
+	}
+}
+
+// setMigratedERC20ToDenom restores the ERC20ToDenom entry of an ERC20 which has been migrated away from, unlike
+// setCosmosOriginatedDenomToERC20 the denom is not pointed back at the old ERC20
+func (k Keeper) setMigratedERC20ToDenom(ctx sdk.Context, evmChainPrefix string, denom string, oldErc20 types.EthAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetERC20ToDenomKey(evmChainPrefix, oldErc20), []byte(denom))
+}
+
+// migrateCosmosOriginatedERC20 re-points denom from its current ERC20 to newErc20, freezing the old ERC20 for
+// outgoing transfers and moving any unbatched transactions over to the new ERC20. Deposits of the old ERC20 are
+// accepted until depositsAcceptedUntil
+func (k Keeper) migrateCosmosOriginatedERC20(
+	ctx sdk.Context, evmChainPrefix string,
+	denom string,
+	oldErc20 types.EthAddress,
+	newErc20 types.EthAddress,
+	depositsAcceptedUntil uint64,
+) error {
+	k.setERC20Migration(ctx, evmChainPrefix, types.ERC20Migration{
+		CosmosDenom:           denom,
+		OldErc20:              oldErc20.GetAddress().Hex(),
+		NewErc20:              newErc20.GetAddress().Hex(),
+		DepositsAcceptedUntil: depositsAcceptedUntil,
+	})
+	// Overwrites DenomToERC20 for denom, the ERC20ToDenom entry for the old ERC20 is left in place
+	k.setCosmosOriginatedDenomToERC20(ctx, evmChainPrefix, denom, newErc20)
+	k.deleteAttestedERC20Deployment(ctx, evmChainPrefix, newErc20, denom)
+
+	var txs []*types.InternalOutgoingTransferTx
+	k.IterateUnbatchedTransactionsByContract(ctx, evmChainPrefix, oldErc20, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		txs = append(txs, tx)
+		return false
+	})
+	for _, tx := range txs {
+		if err := k.removeUnbatchedTX(ctx, evmChainPrefix, *tx.Erc20Fee, tx.Id); err != nil {
+			return sdkerrors.Wrapf(err, "unable to remove migrated transaction %d from pool", tx.Id)
+		}
+		if err := k.addUnbatchedTX(ctx, evmChainPrefix, migrateOutgoingTransferTx(tx, newErc20)); err != nil {
+			return sdkerrors.Wrapf(err, "unable to add migrated transaction %d to pool", tx.Id)
+		}
+	}
+	k.resetEmptyPoolMetrics(ctx, evmChainPrefix, oldErc20)
+
+	return nil
+}
+
+// migrateOutgoingTransferTx returns a copy of tx with the amount and fee moved over to the given ERC20
+func migrateOutgoingTransferTx(tx *types.InternalOutgoingTransferTx, newErc20 types.EthAddress) *types.InternalOutgoingTransferTx {
+	return &types.InternalOutgoingTransferTx{
+		Id:          tx.Id,
+		Sender:      tx.Sender,
+		DestAddress: tx.DestAddress,
+		Erc20Token:  &types.InternalERC20Token{Amount: tx.Erc20Token.Amount, Contract: newErc20},
+		Erc20Fee:    &types.InternalERC20Token{Amount: tx.Erc20Fee.Amount, Contract: newErc20},
+	}
+}
+
+// currentERC20ForMigratedTx returns the ERC20 a transaction using a migrated ERC20 should be moved to, this is the
+// ERC20 currently mapped to the denom, which may differ from the migration's NewErc20 after repeated migrations
+func (k Keeper) currentERC20ForMigratedTx(ctx sdk.Context, evmChainPrefix string, migration types.ERC20Migration) types.EthAddress {
+	current, found := k.GetCosmosOriginatedERC20(ctx, evmChainPrefix, migration.CosmosDenom)
+	if !found {
+		panic(fmt.Sprintf("migrated denom %s has no ERC20", migration.CosmosDenom))
+	}
+	return *current
+}
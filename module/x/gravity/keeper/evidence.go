@@ -5,15 +5,18 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 )
 
 func (k Keeper) CheckBadSignatureEvidence(
-	ctx sdk.Context,
+	ctx sdk.Context, evmChainPrefix string,
 	msg *types.MsgSubmitBadSignatureEvidence) error {
 	var subject types.EthereumSigned
 
@@ -25,25 +28,25 @@ func (k Keeper) CheckBadSignatureEvidence(
 
 	switch subject := subject.(type) {
 	case *types.OutgoingTxBatch:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, evmChainPrefix, subject, msg.Signature)
 	case *types.Valset:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, evmChainPrefix, subject, msg.Signature)
 	case *types.OutgoingLogicCall:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, evmChainPrefix, subject, msg.Signature)
 
 	default:
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Bad signature must be over a batch, valset, or logic call got %s", subject))
 	}
 }
 
-func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types.EthereumSigned, signature string) error {
+func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, evmChainPrefix string, subject types.EthereumSigned, signature string) error {
 	// Get checkpoint of the supposed bad signature (fake valset, batch, or logic call submitted to eth)
-	gravityID := k.GetGravityID(ctx)
+	gravityID := k.GetGravityID(ctx, evmChainPrefix)
 	checkpoint := subject.GetCheckpoint(gravityID)
 
 	// Try to find the checkpoint in the archives. If it exists, we don't slash because
 	// this is not a bad signature
-	if k.GetPastEthSignatureCheckpoint(ctx, checkpoint) {
+	if k.GetPastEthSignatureCheckpoint(ctx, evmChainPrefix, checkpoint) {
 		return sdkerrors.Wrap(types.ErrInvalid, "Checkpoint exists, cannot slash")
 	}
 
@@ -77,33 +80,44 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 	}
 
 	params := k.GetParams(ctx)
-	if !val.IsJailed() {
+	slashed := !val.IsJailed()
+	if slashed {
 		k.StakingKeeper.Jail(ctx, cons)
 		k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBadEthSignature)
+		telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeySlashes), 1,
+			[]metrics.Label{telemetry.NewLabel(types.MetricLabelSlashingType, types.AttributeKeyBadEthSignature)})
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(
+		&eventsv1.EventBadSignatureEvidence{
+			EvmChainPrefix: evmChainPrefix,
+			Validator:      val.GetOperator().String(),
+			EthSigner:      ethAddress.GetAddress().Hex(),
+			Checkpoint:     hex.EncodeToString(checkpoint),
+			Slashed:        slashed,
+		},
+	)
 }
 
 // SetPastEthSignatureCheckpoint puts the checkpoint of a valset, batch, or logic call into a set
 // in order to prove later that it existed at one point.
-func (k Keeper) SetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) {
+func (k Keeper) SetPastEthSignatureCheckpoint(ctx sdk.Context, evmChainPrefix string, checkpoint []byte) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetPastEthSignatureCheckpointKey(checkpoint), []byte{0x1})
+	store.Set(types.GetPastEthSignatureCheckpointKey(evmChainPrefix, checkpoint), []byte{0x1})
 }
 
 // GetPastEthSignatureCheckpoint tells you whether a given checkpoint has ever existed
-func (k Keeper) GetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) (found bool) {
+func (k Keeper) GetPastEthSignatureCheckpoint(ctx sdk.Context, evmChainPrefix string, checkpoint []byte) (found bool) {
 	store := ctx.KVStore(k.storeKey)
-	if bytes.Equal(store.Get(types.GetPastEthSignatureCheckpointKey(checkpoint)), []byte{0x1}) {
+	if bytes.Equal(store.Get(types.GetPastEthSignatureCheckpointKey(evmChainPrefix, checkpoint)), []byte{0x1}) {
 		return true
 	} else {
 		return false
 	}
 }
 
-func (k Keeper) IteratePastEthSignatureCheckpoints(ctx sdk.Context, cb func(key []byte, value []byte) (stop bool)) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PastEthSignatureCheckpointKey)
+func (k Keeper) IteratePastEthSignatureCheckpoints(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, value []byte) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendEvmChainPrefix(types.PastEthSignatureCheckpointKey, evmChainPrefix))
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 
@@ -28,7 +28,7 @@ func TestSubmitBadSignatureEvidenceBatchExists(t *testing.T) {
 		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
 		token, e2           = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
-		allVouchers         = sdk.NewCoins(token.GravityCoin())
+		allVouchers         = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
 	)
 	require.NoError(t, e1)
 	require.NoError(t, e2)
@@ -49,19 +49,19 @@ func TestSubmitBadSignatureEvidenceBatchExists(t *testing.T) {
 	for i, v := range []uint64{2, 3, 2, 1} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err)
 	}
 
 	// when
 	ctx = ctx.WithBlockTime(now)
 
-	goodBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	goodBatch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *tokenContract, 2)
 	goodBatchExternal := goodBatch.ToExternal()
 	require.NoError(t, err)
 
@@ -73,7 +73,7 @@ func TestSubmitBadSignatureEvidenceBatchExists(t *testing.T) {
 		Signature: "foo",
 	}
 
-	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, EthChainPrefix, &msg)
 	require.EqualError(t, err, "Checkpoint exists, cannot slash: invalid")
 }
 
@@ -85,7 +85,7 @@ func TestSubmitBadSignatureEvidenceValsetExists(t *testing.T) {
 
 	// ctx := input.Context
 
-	valset := input.GravityKeeper.SetValsetRequest(ctx)
+	valset := input.GravityKeeper.SetValsetRequest(ctx, EthChainPrefix)
 
 	any, err := codectypes.NewAnyWithValue(&valset)
 	require.NoError(t, err)
@@ -95,7 +95,7 @@ func TestSubmitBadSignatureEvidenceValsetExists(t *testing.T) {
 		Signature: "foo",
 	}
 
-	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, EthChainPrefix, &msg)
 	require.EqualError(t, err, "Checkpoint exists, cannot slash: invalid")
 }
 
@@ -118,7 +118,7 @@ func TestSubmitBadSignatureEvidenceLogicCallExists(t *testing.T) {
 		CosmosBlockCreated:   0,
 	}
 
-	input.GravityKeeper.SetOutgoingLogicCall(ctx, logicCall)
+	input.GravityKeeper.SetOutgoingLogicCall(ctx, EthChainPrefix, logicCall)
 
 	any, err := codectypes.NewAnyWithValue(&logicCall)
 	require.NoError(t, err)
@@ -128,7 +128,7 @@ func TestSubmitBadSignatureEvidenceLogicCallExists(t *testing.T) {
 		Signature: "foo",
 	}
 
-	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, EthChainPrefix, &msg)
 	require.EqualError(t, err, "Checkpoint exists, cannot slash: invalid")
 }
 
@@ -142,7 +142,7 @@ func TestSubmitBadSignatureEvidenceSlash(t *testing.T) {
 		BatchTimeout:  420,
 	}
 
-	checkpoint := batch.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx))
+	checkpoint := batch.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx, EthChainPrefix))
 
 	any, err := codectypes.NewAnyWithValue(&batch)
 	require.NoError(t, err)
@@ -163,7 +163,7 @@ func TestSubmitBadSignatureEvidenceSlash(t *testing.T) {
 		Signature: hex.EncodeToString(ethSignature),
 	}
 
-	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, EthChainPrefix, &msg)
 	require.NoError(t, err)
 
 	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+const arbitrumPrefix = "arbitrum"
+
+// nolint: exhaustruct
+func addArbitrum(t *testing.T, ctx sdk.Context, k Keeper) {
+	params := k.GetParams(ctx)
+	params.EvmChains = []types.EvmChainParams{{
+		EvmChainPrefix:           arbitrumPrefix,
+		EvmChainName:             "Arbitrum One",
+		GravityId:                "arbitrum-gravity",
+		BridgeEthereumAddress:    "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8",
+		BridgeChainId:            42161,
+		AverageEthereumBlockTime: 250,
+		BridgeActive:             true,
+	}}
+	require.NoError(t, params.ValidateBasic())
+	k.SetParams(ctx, params)
+	// normally done by the EndBlocker
+	k.InitEvmChain(ctx, arbitrumPrefix)
+}
+
+func TestResolveEvmChainPrefix(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	addArbitrum(t, ctx, k)
+
+	prefix, err := k.ResolveEvmChainPrefix(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, EthChainPrefix, prefix)
+	prefix, err = k.ResolveEvmChainPrefix(ctx, arbitrumPrefix)
+	require.NoError(t, err)
+	require.Equal(t, arbitrumPrefix, prefix)
+	_, err = k.ResolveEvmChainPrefix(ctx, "polygon")
+	require.Error(t, err)
+
+	require.Len(t, k.GetAllEvmChains(ctx), 2)
+	require.Equal(t, "arbitrum-gravity", k.GetGravityID(ctx, arbitrumPrefix))
+	require.Equal(t, uint64(42161), k.GetBridgeChainID(ctx, arbitrumPrefix))
+	require.Equal(t, "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8", k.GetBridgeContractAddress(ctx, arbitrumPrefix).GetAddress().Hex())
+	require.NotEqual(t, k.GetGravityID(ctx, arbitrumPrefix), k.GetGravityID(ctx, EthChainPrefix))
+}
+
+// Bridge state of one EVM chain must never be visible on another
+// nolint: exhaustruct
+func TestEvmChainsAreIsolated(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { ctx.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	addArbitrum(t, ctx, k)
+	handler := AttestationHandler{keeper: &k}
+
+	// valsets are tracked per chain
+	ethNonce := k.GetLatestValsetNonce(ctx, EthChainPrefix)
+	k.SetValsetRequest(ctx, arbitrumPrefix)
+	require.Equal(t, uint64(1), k.GetLatestValsetNonce(ctx, arbitrumPrefix))
+	require.Equal(t, ethNonce, k.GetLatestValsetNonce(ctx, EthChainPrefix))
+	require.NotNil(t, k.GetValset(ctx, arbitrumPrefix, 1))
+
+	// the same token contract on two chains results in two different vouchers
+	token, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	depositor := AccAddrs[0]
+	deposit := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		EthBlockHeight: 10,
+		TokenContract:  token.GetAddress().Hex(),
+		Amount:         sdk.NewInt(1000),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: depositor.String(),
+		EvmChainPrefix: arbitrumPrefix,
+	}
+	require.NoError(t, handler.handleSendToCosmos(ctx, arbitrumPrefix, deposit))
+	arbDenom := types.GravityDenom(arbitrumPrefix, *token)
+	require.Equal(t, "gravity/arbitrum/0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", arbDenom)
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, depositor, arbDenom).Amount)
+	require.True(t, input.BankKeeper.GetBalance(ctx, depositor, types.GravityDenom(EthChainPrefix, *token)).IsZero())
+
+	// vouchers may only be sent back to the chain they came from
+	_, _, err = k.DenomToERC20Lookup(ctx, EthChainPrefix, arbDenom)
+	require.Error(t, err)
+	_, erc20, err := k.DenomToERC20Lookup(ctx, arbitrumPrefix, arbDenom)
+	require.NoError(t, err)
+	require.Equal(t, *token, *erc20)
+
+	receiver, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+	_, err = k.AddToOutgoingPool(ctx, EthChainPrefix, depositor, *receiver, sdk.NewInt64Coin(arbDenom, 100), sdk.NewInt64Coin(arbDenom, 1))
+	require.Error(t, err)
+	_, err = k.AddToOutgoingPool(ctx, arbitrumPrefix, depositor, *receiver, sdk.NewInt64Coin(arbDenom, 100), sdk.NewInt64Coin(arbDenom, 1))
+	require.NoError(t, err)
+	require.Len(t, k.GetUnbatchedTransactions(ctx, arbitrumPrefix), 1)
+	require.Empty(t, k.GetUnbatchedTransactions(ctx, EthChainPrefix))
+
+	// the tx id is shared between chains, batches are not
+	batch, err := k.BuildOutgoingTXBatch(ctx, arbitrumPrefix, *token, 10)
+	require.NoError(t, err)
+	require.NotNil(t, k.GetOutgoingTXBatch(ctx, arbitrumPrefix, *token, batch.BatchNonce))
+	require.Nil(t, k.GetOutgoingTXBatch(ctx, EthChainPrefix, *token, batch.BatchNonce))
+}
@@ -9,20 +9,20 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
-func initBridgeDataFromGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+func initBridgeDataFromGenesis(ctx sdk.Context, k Keeper, evmChainPrefix string, data types.EvmChainData) {
 	// reset valsets in state
 	highest := uint64(0)
 	for _, vs := range data.Valsets {
 		if vs.Nonce > highest {
 			highest = vs.Nonce
 		}
-		k.StoreValset(ctx, vs)
+		k.StoreValset(ctx, evmChainPrefix, vs)
 	}
-	k.SetLatestValsetNonce(ctx, highest)
+	k.SetLatestValsetNonce(ctx, evmChainPrefix, highest)
 
 	// reset valset confirmations in state
 	for _, conf := range data.ValsetConfirms {
-		k.SetValsetConfirm(ctx, conf)
+		k.SetValsetConfirm(ctx, evmChainPrefix, conf)
 	}
 
 	// reset batches in state
@@ -32,24 +32,24 @@ func initBridgeDataFromGenesis(ctx sdk.Context, k Keeper, data types.GenesisStat
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "unable to make batch internal: %v", batch))
 		}
-		k.StoreBatch(ctx, *intBatch)
+		k.StoreBatch(ctx, evmChainPrefix, *intBatch)
 	}
 
 	// reset batch confirmations in state
 	for _, conf := range data.BatchConfirms {
 		conf := conf
-		k.SetBatchConfirm(ctx, &conf)
+		k.SetBatchConfirm(ctx, evmChainPrefix, &conf)
 	}
 
 	// reset logic calls in state
 	for _, call := range data.LogicCalls {
-		k.SetOutgoingLogicCall(ctx, call)
+		k.SetOutgoingLogicCall(ctx, evmChainPrefix, call)
 	}
 
 	// reset logic call confirmations in state
 	for _, conf := range data.LogicCallConfirms {
 		conf := conf
-		k.SetLogicCallConfirm(ctx, &conf)
+		k.SetLogicCallConfirm(ctx, evmChainPrefix, &conf)
 	}
 }
 
@@ -57,16 +57,123 @@ func initBridgeDataFromGenesis(ctx sdk.Context, k Keeper, data types.GenesisStat
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetParams(ctx, *data.Params)
 
-	// restore various nonces, this MUST match GravityNonces in genesis
-	k.SetLatestValsetNonce(ctx, data.GravityNonces.LatestValsetNonce)
-	k.setLastObservedEventNonce(ctx, data.GravityNonces.LastObservedNonce)
-	k.SetLastSlashedValsetNonce(ctx, data.GravityNonces.LastSlashedValsetNonce)
-	k.SetLastSlashedBatchBlock(ctx, data.GravityNonces.LastSlashedBatchBlock)
-	k.SetLastSlashedLogicCallBlock(ctx, data.GravityNonces.LastSlashedLogicCallBlock)
+	// restore the ids shared by every EVM chain
 	k.setID(ctx, data.GravityNonces.LastTxPoolId, []byte(types.KeyLastTXPoolID))
 	k.setID(ctx, data.GravityNonces.LastBatchId, []byte(types.KeyLastOutgoingBatchID))
+	k.setID(ctx, data.GravityNonces.LastMerkleAirdropId, []byte(types.KeyLastMerkleAirdropID))
+
+	// reset delegate keys in state, these are shared by every EVM chain
+	if hasDuplicates(data.DelegateKeys) {
+		panic("Duplicate delegate key found in Genesis!")
+	}
+	for _, keys := range data.DelegateKeys {
+		// delegate keys registered before the eth signature existed are exported without one
+		err := keys.ValidateKeys()
+		if err != nil {
+			panic("Invalid delegate key in Genesis!")
+		}
+		val, err := sdk.ValAddressFromBech32(keys.Validator)
+		if err != nil {
+			panic(err)
+		}
+		ethAddr, err := types.NewEthAddress(keys.EthAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		orch, err := sdk.AccAddressFromBech32(keys.Orchestrator)
+		if err != nil {
+			panic(err)
+		}
+
+		// set the orchestrator address
+		k.SetOrchestratorValidator(ctx, val, orch)
+		// set the ethereum address
+		k.SetEthAddressForValidator(ctx, val, *ethAddr)
+	}
 
-	initBridgeDataFromGenesis(ctx, k, data)
+	// the state of the default EVM chain is stored at the top level of the genesis state
+	initEvmChainFromGenesis(ctx, k, types.EvmChainData{
+		EvmChainPrefix:           types.DefaultEvmChainPrefix,
+		GravityNonces:            data.GravityNonces,
+		Valsets:                  data.Valsets,
+		ValsetConfirms:           data.ValsetConfirms,
+		Batches:                  data.Batches,
+		BatchConfirms:            data.BatchConfirms,
+		LogicCalls:               data.LogicCalls,
+		LogicCallConfirms:        data.LogicCallConfirms,
+		Attestations:             data.Attestations,
+		Erc20ToDenoms:            data.Erc20ToDenoms,
+		UnbatchedTransfers:       data.UnbatchedTransfers,
+		PendingIbcAutoForwards:   data.PendingIbcAutoForwards,
+		Erc20Migrations:          data.Erc20Migrations,
+		AttestedErc20Deployments: data.AttestedErc20Deployments,
+		OutgoingTxCreatedHeights: data.OutgoingTxCreatedHeights,
+	})
+	for _, chain := range data.EvmChains {
+		initEvmChainFromGenesis(ctx, k, chain)
+	}
+
+	// now that we have the denom-erc20 mapping we need to validate
+	// that the valset reward is possible and cosmos originated remove
+	// this if you want a non-cosmos originated reward
+	valsetReward := k.GetParams(ctx).ValsetReward
+	if valsetReward.IsValid() && !valsetReward.IsZero() {
+		_, exists := k.GetCosmosOriginatedERC20(ctx, types.DefaultEvmChainPrefix, valsetReward.Denom)
+		if !exists {
+			panic("Invalid Cosmos originated denom for valset reward")
+		}
+	}
+
+	// restore merkle airdrops and the claims made against them
+	for i, airdrop := range data.MerkleAirdrops {
+		if err := airdrop.ValidateBasic(); err != nil {
+			panic(fmt.Errorf("invalid merkle airdrop in MerkleAirdrops for item %d: %v", i, err))
+		}
+		k.setMerkleAirdrop(ctx, airdrop)
+	}
+	for i, claim := range data.MerkleAirdropClaims {
+		if err := claim.ValidateBasic(); err != nil {
+			panic(fmt.Errorf("invalid merkle airdrop claim in MerkleAirdropClaims for item %d: %v", i, err))
+		}
+		// claims of expired airdrops remain until they are pruned
+		if claim.AirdropId > data.GravityNonces.LastMerkleAirdropId {
+			panic(fmt.Errorf("merkle airdrop claim %d references unknown airdrop %d", i, claim.AirdropId))
+		}
+		k.setMerkleAirdropClaim(ctx, claim)
+	}
+
+	// restore the offence counters of the graduated slashing policy
+	for i, offences := range data.BridgeOffences {
+		if err := offences.ValidateBasic(); err != nil {
+			panic(fmt.Errorf("invalid bridge offences in BridgeOffences for item %d: %v", i, err))
+		}
+		valAddr, _ := sdk.ValAddressFromBech32(offences.Validator)
+		k.setBridgeOffences(ctx, valAddr, offences)
+	}
+
+	// restore the bridge duty records of the validators
+	for i, perf := range data.BridgePerformance {
+		if err := perf.ValidateBasic(); err != nil {
+			panic(fmt.Errorf("invalid bridge performance in BridgePerformance for item %d: %v", i, err))
+		}
+		valAddr, _ := sdk.ValAddressFromBech32(perf.Validator)
+		k.setValidatorBridgePerformance(ctx, valAddr, perf)
+	}
+}
+
+// initEvmChainFromGenesis restores the bridge state of a single EVM chain
+func initEvmChainFromGenesis(ctx sdk.Context, k Keeper, data types.EvmChainData) {
+	evmChainPrefix := data.EvmChainPrefix
+
+	// restore various nonces, this MUST match GravityNonces in genesis
+	k.SetLatestValsetNonce(ctx, evmChainPrefix, data.GravityNonces.LatestValsetNonce)
+	k.setLastObservedEventNonce(ctx, evmChainPrefix, data.GravityNonces.LastObservedNonce)
+	k.SetLastSlashedValsetNonce(ctx, evmChainPrefix, data.GravityNonces.LastSlashedValsetNonce)
+	k.SetLastSlashedBatchBlock(ctx, evmChainPrefix, data.GravityNonces.LastSlashedBatchBlock)
+	k.SetLastSlashedLogicCallBlock(ctx, evmChainPrefix, data.GravityNonces.LastSlashedLogicCallBlock)
+
+	initBridgeDataFromGenesis(ctx, k, evmChainPrefix, data)
 
 	// reset pool transactions in state
 	for _, tx := range data.UnbatchedTransfers {
@@ -74,11 +181,16 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "invalid unbatched tx: %v", tx))
 		}
-		if err := k.addUnbatchedTX(ctx, intTx); err != nil {
+		if err := k.addUnbatchedTX(ctx, evmChainPrefix, intTx); err != nil {
 			panic(err)
 		}
 	}
 
+	// restore the heights the transfers in the pool and in batches were created at
+	for _, created := range data.OutgoingTxCreatedHeights {
+		k.setOutgoingTxCreatedHeight(ctx, evmChainPrefix, created.TxId, created.Height)
+	}
+
 	// reset attestations in state
 	for _, att := range data.Attestations {
 		att := att
@@ -86,13 +198,16 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err != nil {
 			panic("couldn't cast to claim")
 		}
+		if types.ClaimEvmChainPrefix(claim) != evmChainPrefix {
+			panic(fmt.Errorf("attestation for evm chain %s found in the genesis state of %s", types.ClaimEvmChainPrefix(claim), evmChainPrefix))
+		}
 
 		// TODO: block height?
 		hash, err := claim.ClaimHash()
 		if err != nil {
 			panic(fmt.Errorf("error when computing ClaimHash for %v", hash))
 		}
-		k.SetAttestation(ctx, claim.GetEventNonce(), hash, &att)
+		k.SetAttestation(ctx, evmChainPrefix, claim.GetEventNonce(), hash, &att)
 	}
 
 	// reset attestation state of specific validators
@@ -119,40 +234,19 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 			if err != nil {
 				panic(err)
 			}
-			last := k.GetLastEventNonceByValidator(ctx, val)
+			last := k.GetLastEventNonceByValidator(ctx, evmChainPrefix, val)
 			if claim.GetEventNonce() > last {
-				k.SetLastEventNonceByValidator(ctx, val, claim.GetEventNonce())
+				k.SetLastEventNonceByValidator(ctx, evmChainPrefix, val, claim.GetEventNonce())
 			}
 		}
 	}
 
-	// reset delegate keys in state
-	if hasDuplicates(data.DelegateKeys) {
-		panic("Duplicate delegate key found in Genesis!")
-	}
-	for _, keys := range data.DelegateKeys {
-		err := keys.ValidateBasic()
-		if err != nil {
-			panic("Invalid delegate key in Genesis!")
-		}
-		val, err := sdk.ValAddressFromBech32(keys.Validator)
-		if err != nil {
-			panic(err)
-		}
-		ethAddr, err := types.NewEthAddress(keys.EthAddress)
-		if err != nil {
-			panic(err)
-		}
-
-		orch, err := sdk.AccAddressFromBech32(keys.Orchestrator)
-		if err != nil {
-			panic(err)
+	// restore erc20 migrations first, the erc20s migrated away from must only be restored in the erc20-denom direction
+	for i, migration := range data.Erc20Migrations {
+		if err := migration.ValidateBasic(); err != nil {
+			panic(fmt.Errorf("invalid erc20 migration in Erc20Migrations for item %d: %v", i, err))
 		}
-
-		// set the orchestrator address
-		k.SetOrchestratorValidator(ctx, val, orch)
-		// set the ethereum address
-		k.SetEthAddressForValidator(ctx, val, *ethAddr)
+		k.setERC20Migration(ctx, evmChainPrefix, migration)
 	}
 
 	// populate state with cosmos originated denom-erc20 mapping
@@ -161,22 +255,22 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err != nil {
 			panic(fmt.Errorf("invalid erc20 address in Erc20ToDenoms for item %d: %s", i, item.Erc20))
 		}
-		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, *ethAddr)
+		if _, migrated := k.GetERC20Migration(ctx, evmChainPrefix, *ethAddr); migrated {
+			k.setMigratedERC20ToDenom(ctx, evmChainPrefix, item.Denom, *ethAddr)
+		} else {
+			k.setCosmosOriginatedDenomToERC20(ctx, evmChainPrefix, item.Denom, *ethAddr)
+		}
 	}
 
-	// now that we have the denom-erc20 mapping we need to validate
-	// that the valset reward is possible and cosmos originated remove
-	// this if you want a non-cosmos originated reward
-	valsetReward := k.GetParams(ctx).ValsetReward
-	if valsetReward.IsValid() && !valsetReward.IsZero() {
-		_, exists := k.GetCosmosOriginatedERC20(ctx, valsetReward.Denom)
-		if !exists {
-			panic("Invalid Cosmos originated denom for valset reward")
+	for i, deployment := range data.AttestedErc20Deployments {
+		if err := deployment.ValidateBasic(); err != nil {
+			panic(fmt.Errorf("invalid attested erc20 deployment in AttestedErc20Deployments for item %d: %v", i, err))
 		}
+		k.setAttestedERC20Deployment(ctx, evmChainPrefix, deployment)
 	}
 
 	for _, forward := range data.PendingIbcAutoForwards {
-		err := k.addPendingIbcAutoForward(ctx, forward, forward.Token.Denom)
+		err := k.addPendingIbcAutoForward(ctx, evmChainPrefix, forward, forward.Token.Denom)
 		if err != nil {
 			panic(fmt.Errorf("unable to restore pending ibc auto forward (%v) to store: %v", forward, err))
 		}
@@ -199,19 +293,71 @@ func hasDuplicates(d []types.MsgSetOrchestratorAddress) bool {
 // from the current state of the chain
 func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	var (
-		p                  = k.GetParams(ctx)
-		calls              = k.GetOutgoingLogicCalls(ctx)
-		batches            = k.GetOutgoingTxBatches(ctx)
-		valsets            = k.GetValsets(ctx)
-		attmap, attKeys    = k.GetAttestationMapping(ctx)
+		p             = k.GetParams(ctx)
+		delegates     = k.GetDelegateKeys(ctx)
+		airdrops      = k.GetMerkleAirdropsList(ctx)
+		airdropClaims = []types.MerkleAirdropClaim{}
+		evmChains     = []types.EvmChainData{}
+	)
+
+	// export merkle airdrop claims
+	k.IterateMerkleAirdropClaims(ctx, func(key []byte, claim types.MerkleAirdropClaim) bool {
+		airdropClaims = append(airdropClaims, claim)
+		return false
+	})
+
+	for _, chain := range p.EvmChains {
+		evmChains = append(evmChains, exportEvmChainGenesis(ctx, k, chain.EvmChainPrefix))
+	}
+
+	// the state of the default EVM chain is stored at the top level of the genesis state
+	defaultChain := exportEvmChainGenesis(ctx, k, types.DefaultEvmChainPrefix)
+	nonces := defaultChain.GravityNonces
+	nonces.LastTxPoolId = k.getID(ctx, types.KeyLastTXPoolID)
+	nonces.LastBatchId = k.getID(ctx, types.KeyLastOutgoingBatchID)
+	nonces.LastMerkleAirdropId = k.getID(ctx, types.KeyLastMerkleAirdropID)
+
+	return types.GenesisState{
+		Params:                   &p,
+		GravityNonces:            nonces,
+		Valsets:                  defaultChain.Valsets,
+		ValsetConfirms:           defaultChain.ValsetConfirms,
+		Batches:                  defaultChain.Batches,
+		BatchConfirms:            defaultChain.BatchConfirms,
+		LogicCalls:               defaultChain.LogicCalls,
+		LogicCallConfirms:        defaultChain.LogicCallConfirms,
+		Attestations:             defaultChain.Attestations,
+		DelegateKeys:             delegates,
+		Erc20ToDenoms:            defaultChain.Erc20ToDenoms,
+		UnbatchedTransfers:       defaultChain.UnbatchedTransfers,
+		PendingIbcAutoForwards:   defaultChain.PendingIbcAutoForwards,
+		Erc20Migrations:          defaultChain.Erc20Migrations,
+		AttestedErc20Deployments: defaultChain.AttestedErc20Deployments,
+		MerkleAirdrops:           airdrops,
+		MerkleAirdropClaims:      airdropClaims,
+		EvmChains:                evmChains,
+		BridgeOffences:           k.GetAllBridgeOffences(ctx),
+		BridgePerformance:        k.GetAllValidatorBridgePerformance(ctx),
+		OutgoingTxCreatedHeights: defaultChain.OutgoingTxCreatedHeights,
+	}
+}
+
+// exportEvmChainGenesis exports the bridge state of a single EVM chain, the ids shared by every chain are left unset
+func exportEvmChainGenesis(ctx sdk.Context, k Keeper, evmChainPrefix string) types.EvmChainData {
+	var (
+		calls              = k.GetOutgoingLogicCalls(ctx, evmChainPrefix)
+		batches            = k.GetOutgoingTxBatches(ctx, evmChainPrefix)
+		valsets            = k.GetValsets(ctx, evmChainPrefix)
+		attmap, attKeys    = k.GetAttestationMapping(ctx, evmChainPrefix)
 		vsconfs            = []types.MsgValsetConfirm{}
 		batchconfs         = []types.MsgConfirmBatch{}
 		callconfs          = []types.MsgConfirmLogicCall{}
 		attestations       = []types.Attestation{}
-		delegates          = k.GetDelegateKeys(ctx)
 		erc20ToDenoms      = []types.ERC20ToDenom{}
-		unbatchedTransfers = k.GetUnbatchedTransactions(ctx)
-		pendingForwards    = k.PendingIbcAutoForwards(ctx, 0)
+		unbatchedTransfers = k.GetUnbatchedTransactions(ctx, evmChainPrefix)
+		pendingForwards    = k.PendingIbcAutoForwards(ctx, evmChainPrefix, 0)
+		migrations         = []types.ERC20Migration{}
+		deployments        = []types.AttestedERC20Deployment{}
 	)
 	var forwards []types.PendingIbcAutoForward
 	for _, forward := range pendingForwards {
@@ -221,7 +367,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	// export valset confirmations from state
 	for _, vs := range valsets {
 		// TODO: set height = 0?
-		vsconfs = append(vsconfs, k.GetValsetConfirms(ctx, vs.Nonce)...)
+		vsconfs = append(vsconfs, k.GetValsetConfirms(ctx, evmChainPrefix, vs.Nonce)...)
 	}
 
 	// export batch confirmations from state
@@ -229,7 +375,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	for i, batch := range batches {
 		// TODO: set height = 0?
 		batchconfs = append(batchconfs,
-			k.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract)...)
+			k.GetBatchConfirmByNonceAndTokenContract(ctx, evmChainPrefix, batch.BatchNonce, batch.TokenContract)...)
 		extBatches[i] = batch.ToExternal()
 	}
 
@@ -237,7 +383,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	for _, call := range calls {
 		// TODO: set height = 0?
 		callconfs = append(callconfs,
-			k.GetLogicConfirmsByInvalidationIdAndNonce(ctx, call.InvalidationId, call.InvalidationNonce)...)
+			k.GetLogicConfirmsByInvalidationIdAndNonce(ctx, evmChainPrefix, call.InvalidationId, call.InvalidationNonce)...)
 	}
 
 	// export attestations from state
@@ -247,37 +393,50 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	}
 
 	// export erc20 to denom relations
-	k.IterateERC20ToDenom(ctx, func(key []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+	k.IterateERC20ToDenom(ctx, evmChainPrefix, func(key []byte, erc20ToDenom *types.ERC20ToDenom) bool {
 		erc20ToDenoms = append(erc20ToDenoms, *erc20ToDenom)
 		return false
 	})
 
+	// export erc20 migrations and the deployments which may be migrated to
+	k.IterateERC20Migrations(ctx, evmChainPrefix, func(key []byte, migration types.ERC20Migration) bool {
+		migrations = append(migrations, migration)
+		return false
+	})
+	k.IterateAttestedERC20Deployments(ctx, evmChainPrefix, func(key []byte, deployment types.AttestedERC20Deployment) bool {
+		deployments = append(deployments, deployment)
+		return false
+	})
+
 	unbatchedTxs := make([]types.OutgoingTransferTx, len(unbatchedTransfers))
 	for i, v := range unbatchedTransfers {
 		unbatchedTxs[i] = v.ToExternal()
 	}
 
-	return types.GenesisState{
-		Params: &p,
+	return types.EvmChainData{
+		EvmChainPrefix: evmChainPrefix,
 		GravityNonces: types.GravityNonces{
-			LatestValsetNonce:         k.GetLatestValsetNonce(ctx),
-			LastObservedNonce:         k.GetLastObservedEventNonce(ctx),
-			LastSlashedValsetNonce:    k.GetLastSlashedValsetNonce(ctx),
-			LastSlashedBatchBlock:     k.GetLastSlashedBatchBlock(ctx),
-			LastSlashedLogicCallBlock: k.GetLastSlashedLogicCallBlock(ctx),
-			LastTxPoolId:              k.getID(ctx, types.KeyLastTXPoolID),
-			LastBatchId:               k.getID(ctx, types.KeyLastOutgoingBatchID),
+			LatestValsetNonce:         k.GetLatestValsetNonce(ctx, evmChainPrefix),
+			LastObservedNonce:         k.GetLastObservedEventNonce(ctx, evmChainPrefix),
+			LastSlashedValsetNonce:    k.GetLastSlashedValsetNonce(ctx, evmChainPrefix),
+			LastSlashedBatchBlock:     k.GetLastSlashedBatchBlock(ctx, evmChainPrefix),
+			LastSlashedLogicCallBlock: k.GetLastSlashedLogicCallBlock(ctx, evmChainPrefix),
+			LastTxPoolId:              0,
+			LastBatchId:               0,
+			LastMerkleAirdropId:       0,
 		},
-		Valsets:                valsets,
-		ValsetConfirms:         vsconfs,
-		Batches:                extBatches,
-		BatchConfirms:          batchconfs,
-		LogicCalls:             calls,
-		LogicCallConfirms:      callconfs,
-		Attestations:           attestations,
-		DelegateKeys:           delegates,
-		Erc20ToDenoms:          erc20ToDenoms,
-		UnbatchedTransfers:     unbatchedTxs,
-		PendingIbcAutoForwards: forwards,
+		Valsets:                  valsets,
+		ValsetConfirms:           vsconfs,
+		Batches:                  extBatches,
+		BatchConfirms:            batchconfs,
+		LogicCalls:               calls,
+		LogicCallConfirms:        callconfs,
+		Attestations:             attestations,
+		Erc20ToDenoms:            erc20ToDenoms,
+		UnbatchedTransfers:       unbatchedTxs,
+		PendingIbcAutoForwards:   forwards,
+		Erc20Migrations:          migrations,
+		AttestedErc20Deployments: deployments,
+		OutgoingTxCreatedHeights: k.GetAllOutgoingTxCreatedHeights(ctx, evmChainPrefix),
 	}
 }
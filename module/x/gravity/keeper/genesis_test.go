@@ -75,7 +75,7 @@ func TestBatchAndTxImportExport(t *testing.T) {
 	for i, v := range contracts {
 		token, err := types.NewInternalERC20Token(sdk.NewInt(99999999), v.GetAddress().Hex())
 		tokens[i] = token
-		allVouchers := sdk.NewCoins(token.GravityCoin())
+		allVouchers := sdk.NewCoins(token.GravityCoin(EthChainPrefix))
 		vouchers[i] = &allVouchers
 		require.NoError(t, err)
 
@@ -110,7 +110,7 @@ func TestBatchAndTxImportExport(t *testing.T) {
 		require.NoError(t, err)
 
 		// add transaction to the pool
-		id, err := input.GravityKeeper.AddToOutgoingPool(ctx, *sender, *receiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		id, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, *sender, *receiver, amountToken.GravityCoin(EthChainPrefix), feeToken.GravityCoin(EthChainPrefix))
 		require.NoError(t, err)
 		ctx.Logger().Info(fmt.Sprintf("Created transaction %v with amount %v and fee %v of contract %v from %v to %v", i, amount, fee, contract, sender, receiver))
 
@@ -131,7 +131,7 @@ func TestBatchAndTxImportExport(t *testing.T) {
 	// with 100 tx in each batch, 1000 txs per contract, we want 5 batches per contract to batch 500 txs per contract
 	batches := make([]*types.InternalOutgoingTxBatch, 5*len(contracts))
 	for i, v := range contracts {
-		batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, *v, uint(batchSize))
+		batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, *v, uint(batchSize))
 		require.NoError(t, err)
 		batches[i] = batch
 		ctx.Logger().Info(fmt.Sprintf("Created batch %v for contract %v with %v transactions", i, v.GetAddress(), batchSize))
@@ -152,7 +152,7 @@ func TestBatchAndTxImportExport(t *testing.T) {
 	hrpRecords := input.GravityKeeper.bech32IbcKeeper.GetHrpIbcRecords(ctx)
 	require.Equal(t, len(hrpRecords), 1)
 	require.Equal(t, hrpRecords[0], rec)
-	forwards := input.GravityKeeper.PendingIbcAutoForwards(ctx, 0)
+	forwards := input.GravityKeeper.PendingIbcAutoForwards(ctx, EthChainPrefix, 0)
 	require.Equal(t, 0, len(forwards))
 
 	// Create pending forwards which must be preserved
@@ -163,7 +163,7 @@ func TestBatchAndTxImportExport(t *testing.T) {
 		c := contracts[i%len(contracts)]
 		token, err := types.NewInternalERC20Token(sdk.NewInt(99999999), c.GetAddress().Hex())
 		require.NoError(t, err)
-		coins := sdk.NewCoins(token.GravityCoin())
+		coins := sdk.NewCoins(token.GravityCoin(EthChainPrefix))
 		// Mint the coins to be forwarded, since the gravity module should already hold the tokens
 		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
 		fwd := types.PendingIbcAutoForward{
@@ -172,8 +172,8 @@ func TestBatchAndTxImportExport(t *testing.T) {
 			IbcChannel:      sourceChannel,
 			EventNonce:      uint64(i + 1),
 		}
-		input.GravityKeeper.setLastObservedEventNonce(ctx, fwd.EventNonce)
-		err = input.GravityKeeper.addPendingIbcAutoForward(ctx, fwd, stake)
+		input.GravityKeeper.setLastObservedEventNonce(ctx, EthChainPrefix, fwd.EventNonce)
+		err = input.GravityKeeper.addPendingIbcAutoForward(ctx, EthChainPrefix, fwd, stake)
 		require.NoError(t, err)
 		forwards[i] = &fwd
 	}
@@ -183,8 +183,8 @@ func TestBatchAndTxImportExport(t *testing.T) {
 	checkAllTransactionsExist(t, input.GravityKeeper, ctx, txs, forwards)
 
 	// Clear the pending ibc auto forwards so the invariant won't fail
-	input.GravityKeeper.IteratePendingIbcAutoForwards(ctx, func(_ []byte, fwd *types.PendingIbcAutoForward) bool {
-		require.NoError(t, input.GravityKeeper.deletePendingIbcAutoForward(ctx, fwd.EventNonce))
+	input.GravityKeeper.IteratePendingIbcAutoForwards(ctx, EthChainPrefix, func(_ []byte, fwd *types.PendingIbcAutoForward) bool {
+		require.NoError(t, input.GravityKeeper.deletePendingIbcAutoForward(ctx, EthChainPrefix, fwd.EventNonce))
 		require.NoError(t, input.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(*fwd.Token)))
 		return false
 	})
@@ -192,8 +192,8 @@ func TestBatchAndTxImportExport(t *testing.T) {
 
 // Requires that all transactions in txs exist in keeper
 func checkAllTransactionsExist(t *testing.T, keeper Keeper, ctx sdk.Context, txs []*types.InternalOutgoingTransferTx, forwards []*types.PendingIbcAutoForward) {
-	unbatched := keeper.GetUnbatchedTransactions(ctx)
-	batches := keeper.GetOutgoingTxBatches(ctx)
+	unbatched := keeper.GetUnbatchedTransactions(ctx, EthChainPrefix)
+	batches := keeper.GetOutgoingTxBatches(ctx, EthChainPrefix)
 	// Collect all txs into an array
 	var gotTxs []*types.InternalOutgoingTransferTx
 	gotTxs = append(gotTxs, unbatched...)
@@ -217,7 +217,7 @@ func checkAllTransactionsExist(t *testing.T, keeper Keeper, ctx sdk.Context, txs
 		require.Equal(t, exp.Sender.String(), gotTxs[i].Sender.String())
 	}
 
-	gotFwds := keeper.PendingIbcAutoForwards(ctx, 0)
+	gotFwds := keeper.PendingIbcAutoForwards(ctx, EthChainPrefix, 0)
 	require.Equal(t, len(forwards), len(gotFwds))
 	for i, fwd := range gotFwds {
 		// The order should be preserved because of the type of iterator and the construction of `forwards`
@@ -230,15 +230,99 @@ func exportImport(t *testing.T, input *TestInput) {
 	bankGenesis := input.BankKeeper.ExportGenesis(input.Context)                                     // Required for ibc auto forwards
 	bech32ibcGenesis := bech32ibc.ExportGenesis(input.Context, *input.GravityKeeper.bech32IbcKeeper) // Required for ibc auto forwards
 	genesisState := ExportGenesis(input.Context, input.GravityKeeper)
+	// The exported sections must be consistent with each other and with the module balance
+	moduleBalance := input.BankKeeper.GetAllBalances(input.Context, input.AccountKeeper.GetModuleAddress(types.ModuleName))
+	report := types.CheckGenesisConsistency(genesisState, input.EncodingConfig.InterfaceRegistry, moduleBalance)
+	require.Empty(t, report.Problems)
 	newEnv := CreateTestEnv(t)
 	input = &newEnv
-	unbatched := input.GravityKeeper.GetUnbatchedTransactions(input.Context)
+	unbatched := input.GravityKeeper.GetUnbatchedTransactions(input.Context, EthChainPrefix)
 	require.Empty(t, unbatched)
-	batches := input.GravityKeeper.GetOutgoingTxBatches(input.Context)
+	batches := input.GravityKeeper.GetOutgoingTxBatches(input.Context, EthChainPrefix)
 	require.Empty(t, batches)
-	forwards := input.GravityKeeper.PendingIbcAutoForwards(input.Context, 0)
+	forwards := input.GravityKeeper.PendingIbcAutoForwards(input.Context, EthChainPrefix, 0)
 	require.Empty(t, forwards)
 	bech32ibc.InitGenesis(input.Context, *input.GravityKeeper.bech32IbcKeeper, *bech32ibcGenesis)
 	input.BankKeeper.InitGenesis(input.Context, bankGenesis)
 	InitGenesis(input.Context, input.GravityKeeper, genesisState)
 }
+
+// Tests that the bridge duty records of the validators survive a chain restart
+func TestBridgePerformanceImportExport(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context.WithBlockHeight(int64(BridgePerformanceWindow) + 5)
+
+	k.RecordBridgeDuty(ctx, ValAddrs[0], types.BRIDGE_DUTY_VALSET, true)
+	k.RecordBridgeDuty(ctx, ValAddrs[0], types.BRIDGE_DUTY_BATCH, false)
+	k.RecordBridgeDuty(ctx, ValAddrs[1], types.BRIDGE_DUTY_CLAIM, true)
+	expected := []types.ValidatorBridgePerformance{
+		k.GetValidatorBridgePerformance(ctx, ValAddrs[0]),
+		k.GetValidatorBridgePerformance(ctx, ValAddrs[1]),
+	}
+
+	genesisState := ExportGenesis(ctx, k)
+	require.Len(t, genesisState.BridgePerformance, 2)
+	require.NoError(t, genesisState.ValidateBasic())
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context.WithBlockHeight(ctx.BlockHeight())
+	InitGenesis(newCtx, newEnv.GravityKeeper, genesisState)
+	for _, perf := range expected {
+		valAddr, err := sdk.ValAddressFromBech32(perf.Validator)
+		require.NoError(t, err)
+		require.Equal(t, perf, newEnv.GravityKeeper.GetValidatorBridgePerformance(newCtx, valAddr))
+	}
+
+	// records which cannot be valid are rejected
+	genesisState.BridgePerformance[0].Current.Valsets.Performed = 2
+	badEnv := CreateTestEnv(t)
+	require.Panics(t, func() { InitGenesis(badEnv.Context, badEnv.GravityKeeper, genesisState) })
+}
+
+// Tests that the heights the pool transfers were created at survive a chain restart, the bridge status ages the
+// waiting transfers by them
+func TestOutgoingTxCreatedHeightImportExport(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	var (
+		mySender, e1    = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, e2  = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenAddr, e3   = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		token, e4       = types.NewInternalERC20Token(sdk.NewInt(99999), tokenAddr.GetAddress().Hex())
+		allVouchers     = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
+		amount, feeCoin = sdk.NewInt64Coin(allVouchers[0].Denom, 100), sdk.NewInt64Coin(allVouchers[0].Denom, 1)
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	// two transfers added at heights 10 and 12
+	var ids []uint64
+	for _, height := range []int64{10, 12} {
+		id, err := k.AddToOutgoingPool(ctx.WithBlockHeight(height), EthChainPrefix, mySender, *myReceiver, amount, feeCoin)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	genesisState := ExportGenesis(ctx, k)
+	require.Equal(t, []types.OutgoingTxCreatedHeight{{TxId: ids[0], Height: 10}, {TxId: ids[1], Height: 12}},
+		genesisState.OutgoingTxCreatedHeights)
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context.WithBlockHeight(20)
+	InitGenesis(newCtx, newEnv.GravityKeeper, genesisState)
+	for i, height := range []uint64{10, 12} {
+		created, found := newEnv.GravityKeeper.GetOutgoingTxCreatedHeight(newCtx, EthChainPrefix, ids[i])
+		require.True(t, found)
+		require.Equal(t, height, created)
+	}
+	status := newEnv.GravityKeeper.GetBridgeStatus(newCtx, EthChainPrefix)
+	require.Equal(t, uint64(10), status.OldestUnbatchedTxAge)
+}
@@ -1,14 +1,20 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
 // this file contains code related to custom governance proposals
@@ -39,6 +45,30 @@ func RegisterProposalTypes() {
 		// nolint: exhaustruct
 		govtypes.RegisterProposalTypeCodec(&types.AirdropProposal{}, airdrop)
 	}
+	erc20Metadata := "gravity/ERC20Metadata"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(erc20Metadata, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeERC20Metadata)
+		// nolint: exhaustruct
+		govtypes.RegisterProposalTypeCodec(&types.ERC20MetadataProposal{}, erc20Metadata)
+	}
+	erc20Migration := "gravity/ERC20Migration"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(erc20Migration, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeERC20Migration)
+		// nolint: exhaustruct
+		govtypes.RegisterProposalTypeCodec(&types.ERC20MigrationProposal{}, erc20Migration)
+	}
+	merkleAirdrop := "gravity/MerkleAirdrop"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(merkleAirdrop, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeMerkleAirdrop)
+		// nolint: exhaustruct
+		govtypes.RegisterProposalTypeCodec(&types.MerkleAirdropProposal{}, merkleAirdrop)
+	}
+	vestingAirdrop := "gravity/VestingAirdrop"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(vestingAirdrop, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeVestingAirdrop)
+		// nolint: exhaustruct
+		govtypes.RegisterProposalTypeCodec(&types.VestingAirdropProposal{}, vestingAirdrop)
+	}
 }
 
 func NewGravityProposalHandler(k Keeper) govtypes.Handler {
@@ -50,6 +80,14 @@ func NewGravityProposalHandler(k Keeper) govtypes.Handler {
 			return k.HandleAirdropProposal(ctx, c)
 		case *types.IBCMetadataProposal:
 			return k.HandleIBCMetadataProposal(ctx, c)
+		case *types.ERC20MetadataProposal:
+			return k.HandleERC20MetadataProposal(ctx, c)
+		case *types.ERC20MigrationProposal:
+			return k.HandleERC20MigrationProposal(ctx, c)
+		case *types.MerkleAirdropProposal:
+			return k.HandleMerkleAirdropProposal(ctx, c)
+		case *types.VestingAirdropProposal:
+			return k.HandleVestingAirdropProposal(ctx, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized Gravity proposal content type: %T", c)
@@ -57,28 +95,58 @@ func NewGravityProposalHandler(k Keeper) govtypes.Handler {
 	}
 }
 
+// NewParamChangeProposalHandler wraps the params module's proposal handler so that changes to the gravity params
+// are checked as a whole. The params module only runs the per key validators, which cannot see checks spanning
+// several keys such as an EVM chain reusing the default chain's gravity id. Gov runs the handler on a cached
+// context, so returning an error discards the change
+func NewParamChangeProposalHandler(k Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := paramsHandler(ctx, content); err != nil {
+			return err
+		}
+		c, ok := content.(*paramsproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range c.Changes {
+			if change.Subspace == types.DefaultParamspace {
+				params := k.GetParams(ctx)
+				if err := params.ValidateBasic(); err != nil {
+					return sdkerrors.Wrap(err, "invalid gravity params")
+				}
+				return nil
+			}
+		}
+		return nil
+	}
+}
+
 // Unhalt Bridge specific functions
 
 // In the event the bridge is halted and governance has decided to reset oracle
 // history, we roll back oracle history and reset the parameters
 func (k Keeper) HandleUnhaltBridgeProposal(ctx sdk.Context, p *types.UnhaltBridgeProposal) error {
-	ctx.Logger().Info("Gov vote passed: Resetting oracle history", "nonce", p.TargetNonce)
-	pruneAttestationsAfterNonce(ctx, k, p.TargetNonce)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, p.EvmChainPrefix)
+	if err != nil {
+		return err
+	}
+	ctx.Logger().Info("Gov vote passed: Resetting oracle history", "evmChain", evmChainPrefix, "nonce", p.TargetNonce)
+	pruneAttestationsAfterNonce(ctx, k, evmChainPrefix, p.TargetNonce)
 	return nil
 }
 
 // Iterate over all attestations currently being voted on in order of nonce
 // and prune those that are older than nonceCutoff
-func pruneAttestationsAfterNonce(ctx sdk.Context, k Keeper, nonceCutoff uint64) {
+func pruneAttestationsAfterNonce(ctx sdk.Context, k Keeper, evmChainPrefix string, nonceCutoff uint64) {
 	// Decide on the most recent nonce we can actually roll back to
-	lastObserved := k.GetLastObservedEventNonce(ctx)
+	lastObserved := k.GetLastObservedEventNonce(ctx, evmChainPrefix)
 	if nonceCutoff < lastObserved || nonceCutoff == 0 {
 		ctx.Logger().Error("Attempted to reset to a nonce before the last \"observed\" event, which is not allowed", "lastObserved", lastObserved, "nonce", nonceCutoff)
 		return
 	}
 
 	// Get relevant event nonces
-	attmap, keys := k.GetAttestationMapping(ctx)
+	attmap, keys := k.GetAttestationMapping(ctx, evmChainPrefix)
 
 	// Discover all affected validators whose LastEventNonce must be reset to nonceCutoff
 
@@ -112,10 +180,10 @@ func pruneAttestationsAfterNonce(ctx sdk.Context, k Keeper, nonceCutoff uint64)
 		if err != nil {
 			panic(sdkerrors.Wrap(err, "invalid validator address affected by bridge reset"))
 		}
-		valLastNonce := k.GetLastEventNonceByValidator(ctx, val)
+		valLastNonce := k.GetLastEventNonceByValidator(ctx, evmChainPrefix, val)
 		if valLastNonce > nonceCutoff {
 			ctx.Logger().Info("Resetting validator's last event nonce due to bridge unhalt", "validator", vote, "lastEventNonce", valLastNonce, "resetNonce", nonceCutoff)
-			k.SetLastEventNonceByValidator(ctx, val, nonceCutoff)
+			k.SetLastEventNonceByValidator(ctx, evmChainPrefix, val, nonceCutoff)
 		}
 	}
 }
@@ -211,6 +279,235 @@ func (k Keeper) HandleAirdropProposal(ctx sdk.Context, p *types.AirdropProposal)
 	return nil
 }
 
+// In the event governance wants to airdrop to more recipients than fit in a single AirdropProposal, the recipient
+// list is committed to as a Merkle root. The airdrop total is escrowed from the community pool into the gravity
+// module and paid out as users submit MsgClaimAirdrop, what remains at the expiry height returns to the community pool
+func (k Keeper) HandleMerkleAirdropProposal(ctx sdk.Context, p *types.MerkleAirdropProposal) error {
+	ctx.Logger().Info("Gov vote passed: Creating merkle airdrop")
+	startingSupply := k.bankKeeper.GetSupply(ctx, p.Denom)
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		ctx.Logger().Info("Merkle airdrop failed to execute invalid denom!")
+		return sdkerrors.Wrap(types.ErrInvalid, "Invalid airdrop denom")
+	}
+	if len(p.MerkleRoot) != types.MerkleRootLen {
+		ctx.Logger().Info("Merkle airdrop failed to execute invalid merkle root!")
+		return sdkerrors.Wrap(types.ErrInvalid, "Invalid merkle root")
+	}
+	if p.Total == 0 {
+		ctx.Logger().Info("Merkle airdrop failed to execute zero total!")
+		return sdkerrors.Wrap(types.ErrInvalid, "Airdrop total must be non-zero")
+	}
+	if p.ExpiryHeight <= uint64(ctx.BlockHeight()) {
+		ctx.Logger().Info("Merkle airdrop failed to execute expiry height has passed!", "expiry", p.ExpiryHeight)
+		return sdkerrors.Wrap(types.ErrInvalid, "Expiry height has already passed")
+	}
+
+	// check that we have enough tokens in the community pool and escrow them in the gravity module
+	coins := sdk.NewCoins(sdk.NewCoin(p.Denom, sdk.NewIntFromUint64(p.Total)))
+	if err := k.escrowFromCommunityPool(ctx, coins); err != nil {
+		ctx.Logger().Info("Merkle airdrop failed to execute", "error", err)
+		return err
+	}
+
+	endingSupply := k.bankKeeper.GetSupply(ctx, p.Denom)
+	if !startingSupply.Equal(endingSupply) {
+		return sdkerrors.Wrap(types.ErrInvalid, "total chain supply has changed!")
+	}
+
+	airdrop := types.MerkleAirdrop{
+		Id:           k.autoIncrementID(ctx, types.KeyLastMerkleAirdropID),
+		Denom:        p.Denom,
+		MerkleRoot:   p.MerkleRoot,
+		Total:        p.Total,
+		Claimed:      0,
+		ExpiryHeight: p.ExpiryHeight,
+	}
+	k.setMerkleAirdrop(ctx, airdrop)
+
+	return ctx.EventManager().EmitTypedEvents(
+		&types.EventMerkleAirdropCreated{
+			AirdropId:    fmt.Sprint(airdrop.Id),
+			Denom:        airdrop.Denom,
+			Total:        fmt.Sprint(airdrop.Total),
+			ExpiryHeight: fmt.Sprint(airdrop.ExpiryHeight),
+		},
+		&eventsv1.EventMerkleAirdropCreated{
+			AirdropId:    airdrop.Id,
+			Total:        coins[0],
+			MerkleRoot:   hex.EncodeToString(airdrop.MerkleRoot),
+			ExpiryHeight: airdrop.ExpiryHeight,
+		},
+	)
+}
+
+// MaxVestingAirdropPeriods bounds the number of periods stored in each PeriodicVestingAccount created by a
+// VestingAirdropProposal
+const MaxVestingAirdropPeriods = 1000
+
+// In the event governance wants an airdrop to be released over time rather than all at once, the airdropped coins
+// are sent from the community pool into a vesting account for each recipient. As with HandleAirdropProposal any
+// failure returns an error, preventing the changes made so far from taking effect
+func (k Keeper) HandleVestingAirdropProposal(ctx sdk.Context, p *types.VestingAirdropProposal) error {
+	ctx.Logger().Info("Gov vote passed: Performing vesting airdrop")
+	startingSupply := k.bankKeeper.GetSupply(ctx, p.Denom)
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		ctx.Logger().Info("Vesting airdrop failed to execute invalid denom!")
+		return sdkerrors.Wrap(types.ErrInvalid, "Invalid airdrop denom")
+	}
+	if p.StartTime < 0 || p.EndTime <= p.StartTime {
+		ctx.Logger().Info("Vesting airdrop failed to execute invalid schedule!", "start", p.StartTime, "end", p.EndTime)
+		return sdkerrors.Wrap(types.ErrInvalid, "Vesting end time must be after the start time")
+	}
+	if p.EndTime <= ctx.BlockTime().Unix() {
+		ctx.Logger().Info("Vesting airdrop failed to execute end time has passed!", "end", p.EndTime)
+		return sdkerrors.Wrap(types.ErrInvalid, "Vesting end time has already passed")
+	}
+	if p.NumPeriods > MaxVestingAirdropPeriods {
+		ctx.Logger().Info("Vesting airdrop failed to execute too many periods!", "periods", p.NumPeriods)
+		return sdkerrors.Wrapf(types.ErrInvalid, "At most %d vesting periods are allowed", MaxVestingAirdropPeriods)
+	}
+
+	feePool := k.DistKeeper.GetFeePool(ctx)
+	feePoolAmount := feePool.CommunityPool.AmountOf(p.Denom)
+
+	airdropTotal := sdk.NewInt(0)
+	for _, v := range p.Amounts {
+		if v == 0 {
+			ctx.Logger().Info("Vesting airdrop failed to execute zero amount!")
+			return sdkerrors.Wrap(types.ErrInvalid, "Vesting airdrop amounts must be non-zero")
+		}
+		airdropTotal = airdropTotal.Add(sdk.NewIntFromUint64(v))
+	}
+
+	totalRequiredDecCoin := sdk.NewDecCoinFromCoin(sdk.NewCoin(p.Denom, airdropTotal))
+
+	// check that we have enough tokens in the community pool to actually execute
+	// this airdrop with the provided recipients list
+	if totalRequiredDecCoin.Amount.GT(feePoolAmount) {
+		ctx.Logger().Info("Vesting airdrop failed to execute insufficient tokens in the community pool!")
+		return sdkerrors.Wrap(types.ErrInvalid, "Insufficient tokens in community pool")
+	}
+
+	// recipients are packed as 20 bytes, as with AirdropProposal
+	numRecipients := len(p.Recipients) / 20
+	if len(p.Recipients)%20 != 0 || numRecipients != len(p.Amounts) {
+		ctx.Logger().Info("Vesting airdrop failed to execute invalid recipients")
+		return sdkerrors.Wrap(types.ErrInvalid, "Invalid recipients")
+	}
+
+	// the total amount actually locked in vesting accounts in dec coins
+	totalVesting := sdk.NewDec(0)
+	for i := 0; i < numRecipients; i++ {
+		addr := sdk.AccAddress(p.Recipients[i*20 : (i+1)*20])
+		coins := sdk.NewCoins(sdk.NewCoin(p.Denom, sdk.NewIntFromUint64(p.Amounts[i])))
+
+		vestingAcc, err := k.createAirdropVestingAccount(ctx, addr, coins, p.StartTime, p.EndTime, p.NumPeriods)
+		if err != nil {
+			ctx.Logger().Info("invalid recipient in vesting airdrop! not executing", "address", addr, "error", err)
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, coins); err != nil {
+			ctx.Logger().Info("invalid address in vesting airdrop! not executing", "address", addr)
+			return err
+		}
+		totalVesting = totalVesting.Add(sdk.NewDecFromInt(vestingAcc.GetOriginalVesting().AmountOf(p.Denom)))
+	}
+
+	if !totalRequiredDecCoin.Amount.Equal(totalVesting) {
+		ctx.Logger().Info("Vesting airdrop failed to execute Invalid amount vesting", "expected", totalRequiredDecCoin.Amount, "vesting", totalVesting)
+		return sdkerrors.Wrap(types.ErrInvalid, "Invalid amount vesting")
+	}
+
+	newCoins, InvalidModuleBalance := feePool.CommunityPool.SafeSub(sdk.NewDecCoins(totalRequiredDecCoin))
+	// this shouldn't ever happen because we check that we have enough before starting
+	// but lets be conservative.
+	if InvalidModuleBalance {
+		return sdkerrors.Wrap(types.ErrInvalid, "internal error!")
+	}
+	feePool.CommunityPool = newCoins
+	k.DistKeeper.SetFeePool(ctx, feePool)
+
+	endingSupply := k.bankKeeper.GetSupply(ctx, p.Denom)
+	if !startingSupply.Equal(endingSupply) {
+		return sdkerrors.Wrap(types.ErrInvalid, "total chain supply has changed!")
+	}
+
+	return nil
+}
+
+// createAirdropVestingAccount stores a vesting account for addr with originalVesting locked on the given schedule,
+// see VestingAirdropProposal. A new account is created if addr has none, a plain BaseAccount is converted, the coins
+// it already holds remain spendable. The caller is responsible for sending originalVesting to the account
+func (k Keeper) createAirdropVestingAccount(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	originalVesting sdk.Coins,
+	startTime int64,
+	endTime int64,
+	numPeriods uint64,
+) (vestexported.VestingAccount, error) {
+	var baseAcc *authtypes.BaseAccount
+	isNew := false
+	switch acc := k.accountKeeper.GetAccount(ctx, addr).(type) {
+	case nil:
+		baseAcc = authtypes.NewBaseAccountWithAddress(addr)
+		isNew = true
+	case *authtypes.BaseAccount:
+		baseAcc = acc
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "account %s of type %T can not be converted to a vesting account", addr, acc)
+	}
+
+	// both vesting account types are also genesis accounts, which provides Validate
+	var vestingAcc interface {
+		vestexported.VestingAccount
+		authtypes.GenesisAccount
+	}
+	if numPeriods == 0 {
+		vestingAcc = vestingtypes.NewContinuousVestingAccount(baseAcc, originalVesting, startTime, endTime)
+	} else {
+		periods := vestingAirdropPeriods(originalVesting, startTime, endTime, numPeriods)
+		vestingAcc = vestingtypes.NewPeriodicVestingAccount(baseAcc, originalVesting, startTime, periods)
+	}
+	if err := vestingAcc.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(err, "invalid vesting account for %s", addr)
+	}
+
+	if isNew {
+		// assigns the next account number
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccount(ctx, vestingAcc))
+	} else {
+		k.accountKeeper.SetAccount(ctx, vestingAcc)
+	}
+	return vestingAcc, nil
+}
+
+// vestingAirdropPeriods splits amount into numPeriods equal periods between startTime and endTime, any remainder
+// of either the amount or the duration is added to the final period so that the schedule ends exactly at endTime
+func vestingAirdropPeriods(amount sdk.Coins, startTime int64, endTime int64, numPeriods uint64) vestingtypes.Periods {
+	n := int64(numPeriods)
+	length := (endTime - startTime) / n
+	periods := make(vestingtypes.Periods, numPeriods)
+	remaining := amount
+	for i := range periods {
+		periods[i].Length = length
+		if int64(i) == n-1 {
+			periods[i].Length = endTime - startTime - length*(n-1)
+			periods[i].Amount = remaining
+			break
+		}
+		installment := sdk.NewCoins()
+		for _, c := range amount {
+			installment = installment.Add(sdk.NewCoin(c.Denom, c.Amount.QuoRaw(n)))
+		}
+		periods[i].Amount = installment
+		remaining = remaining.Sub(installment)
+	}
+	return periods
+}
+
 // handles a governance proposal for setting the metadata of an IBC token, this takes the normal
 // metadata struct with one key difference, the base unit must be set as the ibc path string in order
 // for setting the denom metadata to work.
@@ -241,7 +538,12 @@ func (k Keeper) HandleIBCMetadataProposal(ctx sdk.Context, p *types.IBCMetadataP
 	// if metadata already exists then changing it is only a good idea if we have not already deployed an ERC20
 	// for this denom if we have we can't change it
 	_, metadataExists := k.bankKeeper.GetDenomMetaData(ctx, p.IbcDenom)
-	_, erc20RepresentationExists := k.GetCosmosOriginatedERC20(ctx, p.IbcDenom)
+	erc20RepresentationExists := false
+	for _, chain := range k.GetAllEvmChains(ctx) {
+		if _, exists := k.GetCosmosOriginatedERC20(ctx, chain.EvmChainPrefix, p.IbcDenom); exists {
+			erc20RepresentationExists = true
+		}
+	}
 	if metadataExists && erc20RepresentationExists {
 		ctx.Logger().Info("invalid trying to set metadata when ERC20 has already been deployed")
 		return sdkerrors.Wrap(types.ErrInvalid, "Metadata can only be changed before ERC20 is created")
@@ -253,3 +555,116 @@ func (k Keeper) HandleIBCMetadataProposal(ctx sdk.Context, p *types.IBCMetadataP
 
 	return nil
 }
+
+// handles a governance proposal for setting the metadata of an Ethereum originated token. Much like the IBC
+// metadata proposal the base unit must be the denom of the token on this chain, in this case the gravity0x...
+// voucher denom. Since the token lives on Ethereum the ERC20 contract is authoritative, so this metadata may be
+// updated by later proposals if it is found to be incorrect.
+func (k Keeper) HandleERC20MetadataProposal(ctx sdk.Context, p *types.ERC20MetadataProposal) error {
+	ctx.Logger().Info("Gov vote passed: Setting ERC20 Metadata", "denom", p.GravityDenom)
+
+	// checks that the provided denom is a gravity voucher, and that the contract it contains is valid
+	evmChainPrefix, tokenContract, err := types.GravityDenomToERC20(p.GravityDenom)
+	if err != nil {
+		ctx.Logger().Info("invalid denom for metadata proposal", "denom", p.GravityDenom)
+		return sdkerrors.Wrap(types.ErrInvalid, "Target denom is not an Ethereum originated token")
+	}
+	if _, err := k.ResolveEvmChainPrefix(ctx, evmChainPrefix); err != nil {
+		ctx.Logger().Info("invalid denom for metadata proposal is from an unknown evm chain", "denom", p.GravityDenom)
+		return err
+	}
+
+	// a Cosmos originated token is represented by an ERC20 deployed by Gravity, that ERC20 must never be
+	// treated as an Ethereum originated token so we refuse to set metadata for its voucher denom
+	if _, cosmosOriginated := k.GetCosmosOriginatedDenom(ctx, evmChainPrefix, *tokenContract); cosmosOriginated {
+		ctx.Logger().Info("invalid token contract for metadata proposal is cosmos originated", "contract", tokenContract.GetAddress().Hex())
+		return sdkerrors.Wrap(types.ErrInvalid, "Target token contract represents a Cosmos originated token")
+	}
+
+	// check that our base unit is the gravity voucher denom. This makes setting/loading denom
+	// metadata work out, as SetDenomMetadata uses the base denom as an index
+	if p.Metadata.Base != p.GravityDenom {
+		ctx.Logger().Info("invalid metadata for metadata proposal must be the same as GravityDenom", "base", p.Metadata.Base)
+		return sdkerrors.Wrap(types.ErrInvalid, "Metadata base must be the same as the Gravity denom!")
+	}
+
+	// outsource validating this to the bank validation function
+	metadataErr := p.Metadata.Validate()
+	if metadataErr != nil {
+		ctx.Logger().Info("invalid metadata for metadata proposal", "validation error", metadataErr)
+		return sdkerrors.Wrap(metadataErr, "Invalid metadata")
+	}
+
+	// write out metadata, this will update existing metadata
+	k.bankKeeper.SetDenomMetaData(ctx, p.Metadata)
+
+	return nil
+}
+
+// handles a governance proposal for migrating a Cosmos originated token to a new ERC20, this is the only way to
+// recover from a faulty ERC20 or from metadata that was wrong when the original ERC20 was deployed. The new ERC20
+// must have been deployed and observed through an ERC20DeployedClaim, if new metadata is provided it replaces the
+// existing metadata and the new ERC20 must match it.
+func (k Keeper) HandleERC20MigrationProposal(ctx sdk.Context, p *types.ERC20MigrationProposal) error {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, p.EvmChainPrefix)
+	if err != nil {
+		return err
+	}
+	ctx.Logger().Info("Gov vote passed: Migrating Cosmos originated token", "evmChain", evmChainPrefix, "denom", p.CosmosDenom, "erc20", p.NewErc20)
+
+	oldErc20, exists := k.GetCosmosOriginatedERC20(ctx, evmChainPrefix, p.CosmosDenom)
+	if !exists {
+		ctx.Logger().Info("invalid denom for migration proposal has no ERC20", "denom", p.CosmosDenom)
+		return sdkerrors.Wrap(types.ErrInvalid, "Target denom does not have an ERC20 representation")
+	}
+	newErc20, err := types.NewEthAddress(p.NewErc20)
+	if err != nil {
+		ctx.Logger().Info("invalid erc20 for migration proposal", "erc20", p.NewErc20)
+		return sdkerrors.Wrap(err, "Invalid new ERC20")
+	}
+	if newErc20.GetAddress() == oldErc20.GetAddress() {
+		return sdkerrors.Wrap(types.ErrInvalid, "New ERC20 is already the ERC20 for this denom")
+	}
+	if existingDenom, registered := k.GetCosmosOriginatedDenom(ctx, evmChainPrefix, *newErc20); registered {
+		ctx.Logger().Info("invalid erc20 for migration proposal is already registered", "erc20", p.NewErc20, "denom", existingDenom)
+		return sdkerrors.Wrap(types.ErrInvalid, "New ERC20 is already registered for a denom")
+	}
+
+	deployment, attested := k.GetAttestedERC20Deployment(ctx, evmChainPrefix, *newErc20, p.CosmosDenom)
+	if !attested {
+		ctx.Logger().Info("invalid erc20 for migration proposal deployment has not been attested", "erc20", p.NewErc20)
+		return sdkerrors.Wrap(types.ErrInvalid, "New ERC20 deployment has not been observed for this denom")
+	}
+
+	metadata, ok := k.bankKeeper.GetDenomMetaData(ctx, p.CosmosDenom)
+	if p.Metadata != nil {
+		if p.Metadata.Base != p.CosmosDenom {
+			ctx.Logger().Info("invalid metadata for migration proposal must be the same as CosmosDenom", "base", p.Metadata.Base)
+			return sdkerrors.Wrap(types.ErrInvalid, "Metadata base must be the same as the Cosmos denom!")
+		}
+		// outsource validating this to the bank validation function
+		if metadataErr := p.Metadata.Validate(); metadataErr != nil {
+			ctx.Logger().Info("invalid metadata for migration proposal", "validation error", metadataErr)
+			return sdkerrors.Wrap(metadataErr, "Invalid metadata")
+		}
+		metadata, ok = *p.Metadata, true
+	}
+	if !ok || metadata.Base == "" {
+		return sdkerrors.Wrap(types.ErrUnknown, fmt.Sprintf("denom not found %s", p.CosmosDenom))
+	}
+	if err := validateERC20AgainstMetadata(deployment.Name, deployment.Symbol, deployment.Decimals, metadata); err != nil {
+		ctx.Logger().Info("invalid erc20 for migration proposal does not match metadata", "error", err)
+		return err
+	}
+
+	// deposits of the old ERC20 are accepted for MigrationWindow Ethereum blocks past the last observed height
+	lastObserved := k.GetLastObservedEthereumBlockHeight(ctx, evmChainPrefix).EthereumBlockHeight
+	if err := k.migrateCosmosOriginatedERC20(ctx, evmChainPrefix, p.CosmosDenom, *oldErc20, *newErc20, lastObserved+p.MigrationWindow); err != nil {
+		return err
+	}
+	if p.Metadata != nil {
+		k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	return nil
+}
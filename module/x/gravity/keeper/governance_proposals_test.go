@@ -2,11 +2,17 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -191,3 +197,483 @@ func TestIBCMetadataProposal(t *testing.T) {
 	require.Error(t, err)
 
 }
+
+// nolint: exhaustruct
+func TestERC20MetadataProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	tokenContract, err := types.NewEthAddress(TokenContractAddrs[0])
+	require.NoError(t, err)
+	gravityDenom := types.GravityDenom(EthChainPrefix, *tokenContract)
+	goodProposal := types.ERC20MetadataProposal{
+		Title:       "test tile",
+		Description: "test description",
+		Metadata: banktypes.Metadata{
+			Description: "Wrapped Ether",
+			Name:        "Wrapped Ether",
+			Base:        gravityDenom,
+			Display:     "weth",
+			Symbol:      "WETH",
+			DenomUnits: []*banktypes.DenomUnit{
+				{
+					Denom:    gravityDenom,
+					Exponent: 0,
+				},
+				{
+					Denom:    "weth",
+					Exponent: 18,
+				},
+			},
+		},
+		GravityDenom: gravityDenom,
+	}
+
+	gk := input.GravityKeeper
+
+	err = gk.HandleERC20MetadataProposal(ctx, &goodProposal)
+	require.NoError(t, err)
+	metadata, exists := gk.bankKeeper.GetDenomMetaData(ctx, gravityDenom)
+	require.True(t, exists)
+	require.Equal(t, metadata, goodProposal.Metadata)
+
+	// metadata for Ethereum originated tokens may be corrected by a later proposal
+	updatedProposal := goodProposal
+	updatedProposal.Metadata.Name = "Wrapped Ether (Gravity Bridge)"
+	err = gk.HandleERC20MetadataProposal(ctx, &updatedProposal)
+	require.NoError(t, err)
+	metadata, exists = gk.bankKeeper.GetDenomMetaData(ctx, gravityDenom)
+	require.True(t, exists)
+	require.Equal(t, metadata, updatedProposal.Metadata)
+
+	// not a gravity voucher denom
+	badDenom := goodProposal
+	badDenom.GravityDenom = "ibc/46B44899322F3CD854D2D46DEEF881958467CDD4B3B10086DA49296BBED94BED/grav"
+	badDenom.Metadata.Base = badDenom.GravityDenom
+	err = gk.HandleERC20MetadataProposal(ctx, &badDenom)
+	require.Error(t, err)
+
+	// base unit does not match the gravity denom
+	badBase := goodProposal
+	badBase.Metadata.Base = "weth"
+	err = gk.HandleERC20MetadataProposal(ctx, &badBase)
+	require.Error(t, err)
+
+	// does not have a zero base unit
+	badMetadata := goodProposal
+	badMetadata.Metadata.DenomUnits = []*banktypes.DenomUnit{
+		{
+			Denom:    gravityDenom,
+			Exponent: 1,
+		},
+		{
+			Denom:    "weth",
+			Exponent: 18,
+		},
+	}
+	err = gk.HandleERC20MetadataProposal(ctx, &badMetadata)
+	require.Error(t, err)
+
+	// the token contract is the ERC20 representation of a Cosmos originated token
+	cosmosOriginated, err := types.NewEthAddress(TokenContractAddrs[1])
+	require.NoError(t, err)
+	gk.setCosmosOriginatedDenomToERC20(ctx, EthChainPrefix, "ugraviton", *cosmosOriginated)
+	cosmosOriginatedDenom := types.GravityDenom(EthChainPrefix, *cosmosOriginated)
+	badCosmosOriginated := goodProposal
+	badCosmosOriginated.GravityDenom = cosmosOriginatedDenom
+	badCosmosOriginated.Metadata.Base = cosmosOriginatedDenom
+	badCosmosOriginated.Metadata.DenomUnits = []*banktypes.DenomUnit{
+		{
+			Denom:    cosmosOriginatedDenom,
+			Exponent: 0,
+		},
+		{
+			Denom:    "weth",
+			Exponent: 18,
+		},
+	}
+	err = gk.HandleERC20MetadataProposal(ctx, &badCosmosOriginated)
+	require.Error(t, err)
+	_, exists = gk.bankKeeper.GetDenomMetaData(ctx, cosmosOriginatedDenom)
+	require.False(t, exists)
+}
+
+// nolint: exhaustruct
+func TestERC20MigrationProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	gk := input.GravityKeeper
+	handler := AttestationHandler{keeper: &gk}
+
+	denom := "ufoo"
+	metadata := banktypes.Metadata{
+		Description: "Foo",
+		Name:        "Foo",
+		Base:        denom,
+		Display:     "foo",
+		Symbol:      "FOO",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "foo", Exponent: 6},
+		},
+	}
+	gk.bankKeeper.SetDenomMetaData(ctx, metadata)
+	oldErc20, err := types.NewEthAddress("0x2a24af0501A534fcA004eE1bD667b783F205A546")
+	require.NoError(t, err)
+	newErc20, err := types.NewEthAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+	require.NoError(t, err)
+	gk.setCosmosOriginatedDenomToERC20(ctx, EthChainPrefix, denom, *oldErc20)
+
+	// queue a transfer of the old erc20 which should be moved over by the migration
+	sender := AccAddrs[0]
+	receiver, err := types.NewEthAddress(EthAddrs[0].String())
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins))
+	txId, err := gk.AddToOutgoingPool(ctx, EthChainPrefix, sender, *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10))
+	require.NoError(t, err)
+
+	proposal := types.ERC20MigrationProposal{
+		Title:           "test tile",
+		Description:     "test description",
+		CosmosDenom:     denom,
+		NewErc20:        newErc20.GetAddress().Hex(),
+		MigrationWindow: 10,
+	}
+
+	// the new erc20 has not been observed yet
+	err = gk.HandleERC20MigrationProposal(ctx, &proposal)
+	require.Error(t, err)
+
+	// observing a deployment for a denom with an erc20 records it instead of failing
+	claim := types.MsgERC20DeployedClaim{
+		EventNonce:    1,
+		CosmosDenom:   denom,
+		TokenContract: newErc20.GetAddress().Hex(),
+		Name:          "Foo",
+		Symbol:        "FOOO",
+		Decimals:      6,
+	}
+	err = handler.handleErc20Deployed(ctx, EthChainPrefix, claim)
+	require.NoError(t, err)
+	_, attested := gk.GetAttestedERC20Deployment(ctx, EthChainPrefix, *newErc20, denom)
+	require.True(t, attested)
+	current, found := gk.GetCosmosOriginatedERC20(ctx, EthChainPrefix, denom)
+	require.True(t, found)
+	require.Equal(t, oldErc20.GetAddress(), current.GetAddress())
+
+	// the deployment does not match the current metadata
+	err = gk.HandleERC20MigrationProposal(ctx, &proposal)
+	require.Error(t, err)
+
+	// new metadata must use the denom as its base
+	badBase := metadata
+	badBase.Base = "foo"
+	proposal.Metadata = &badBase
+	err = gk.HandleERC20MigrationProposal(ctx, &proposal)
+	require.Error(t, err)
+
+	newMetadata := metadata
+	newMetadata.Symbol = "FOOO"
+	proposal.Metadata = &newMetadata
+	gk.SetLastObservedEthereumBlockHeight(ctx, EthChainPrefix, 100)
+	err = gk.HandleERC20MigrationProposal(ctx, &proposal)
+	require.NoError(t, err)
+
+	current, found = gk.GetCosmosOriginatedERC20(ctx, EthChainPrefix, denom)
+	require.True(t, found)
+	require.Equal(t, newErc20.GetAddress(), current.GetAddress())
+	storedMetadata, found := gk.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, "FOOO", storedMetadata.Symbol)
+	_, attested = gk.GetAttestedERC20Deployment(ctx, EthChainPrefix, *newErc20, denom)
+	require.False(t, attested)
+	// the old erc20 still resolves to the denom so that deposits are credited
+	isCosmosOriginated, oldDenom := gk.ERC20ToDenomLookup(ctx, EthChainPrefix, *oldErc20)
+	require.True(t, isCosmosOriginated)
+	require.Equal(t, denom, oldDenom)
+	migration, found := gk.GetERC20Migration(ctx, EthChainPrefix, *oldErc20)
+	require.True(t, found)
+	require.Equal(t, uint64(110), migration.DepositsAcceptedUntil)
+
+	// the queued transfer now uses the new erc20 and the old erc20 is frozen
+	tx, err := gk.GetUnbatchedTxById(ctx, EthChainPrefix, txId)
+	require.NoError(t, err)
+	require.Equal(t, newErc20.GetAddress(), tx.Erc20Token.Contract.GetAddress())
+	require.Equal(t, newErc20.GetAddress(), tx.Erc20Fee.Contract.GetAddress())
+	require.Empty(t, gk.GetUnbatchedTransactionsByContract(ctx, EthChainPrefix, *oldErc20))
+	_, err = gk.BuildOutgoingTXBatch(ctx, EthChainPrefix, *oldErc20, 10)
+	require.Error(t, err)
+	batch, err := gk.BuildOutgoingTXBatch(ctx, EthChainPrefix, *newErc20, 10)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+
+	// deposits of the old erc20 are credited during the migration window, afterwards they go to the community pool
+	depositor := AccAddrs[1]
+	deposit := types.MsgSendToCosmosClaim{
+		EventNonce:     2,
+		EthBlockHeight: 110,
+		TokenContract:  oldErc20.GetAddress().Hex(),
+		Amount:         sdk.NewInt(5),
+		EthereumSender: EthAddrs[1].String(),
+		CosmosReceiver: depositor.String(),
+	}
+	startingBalance := input.BankKeeper.GetBalance(ctx, depositor, denom)
+	require.NoError(t, handler.handleSendToCosmos(ctx, EthChainPrefix, deposit))
+	require.Equal(t, startingBalance.Amount.AddRaw(5), input.BankKeeper.GetBalance(ctx, depositor, denom).Amount)
+
+	deposit.EventNonce = 3
+	deposit.EthBlockHeight = 111
+	require.NoError(t, handler.handleSendToCosmos(ctx, EthChainPrefix, deposit))
+	require.Equal(t, startingBalance.Amount.AddRaw(5), input.BankKeeper.GetBalance(ctx, depositor, denom).Amount)
+	require.Equal(t, sdk.NewDec(5), input.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf(denom))
+}
+
+// nolint: exhaustruct
+func TestMerkleAirdropProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	recipients := []sdk.AccAddress{AccAddrs[0], AccAddrs[1], AccAddrs[2]}
+	amounts := []uint64{1000, 900, 1100}
+	leaves := make([][]byte, len(recipients))
+	for i, r := range recipients {
+		leaves[i] = types.MerkleAirdropLeaf(r, amounts[i])
+	}
+	root, proofs := types.MerkleAirdropTree(leaves)
+
+	feePoolBalance := sdk.NewInt64Coin("grav", 10000)
+	feePool := gk.DistKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(sdk.NewDecCoinFromCoin(feePoolBalance))...)
+	gk.DistKeeper.SetFeePool(ctx, feePool)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(feePoolBalance)))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, disttypes.ModuleName, sdk.NewCoins(feePoolBalance)))
+
+	expiry := uint64(ctx.BlockHeight()) + 100
+	goodAirdrop := types.MerkleAirdropProposal{
+		Title:        "test tile",
+		Description:  "test description",
+		Denom:        "grav",
+		MerkleRoot:   root,
+		Total:        3000,
+		ExpiryHeight: expiry,
+	}
+	airdropTooBig := goodAirdrop
+	airdropTooBig.Total = 100000
+	airdropBadRoot := goodAirdrop
+	airdropBadRoot.MerkleRoot = root[:31]
+	airdropExpired := goodAirdrop
+	airdropExpired.ExpiryHeight = uint64(ctx.BlockHeight())
+
+	require.Error(t, gk.HandleMerkleAirdropProposal(ctx, &airdropTooBig))
+	require.Error(t, gk.HandleMerkleAirdropProposal(ctx, &airdropBadRoot))
+	require.Error(t, gk.HandleMerkleAirdropProposal(ctx, &airdropExpired))
+	input.AssertInvariants()
+
+	require.NoError(t, gk.HandleMerkleAirdropProposal(ctx, &goodAirdrop))
+	assert.Equal(t, sdk.NewDec(7000), gk.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf("grav"))
+	airdrops := gk.GetMerkleAirdropsList(ctx)
+	require.Len(t, airdrops, 1)
+	id := airdrops[0].Id
+	input.AssertInvariants()
+
+	// a claim for the wrong amount or with another recipient's proof must fail
+	require.Error(t, gk.ClaimMerkleAirdrop(ctx, recipients[0], id, 2000, proofs[0]))
+	require.Error(t, gk.ClaimMerkleAirdrop(ctx, recipients[0], id, amounts[0], proofs[1]))
+
+	startingBalance := input.BankKeeper.GetBalance(ctx, recipients[0], "grav")
+	require.NoError(t, gk.ClaimMerkleAirdrop(ctx, recipients[0], id, amounts[0], proofs[0]))
+	require.Equal(t, startingBalance.Amount.AddRaw(1000), input.BankKeeper.GetBalance(ctx, recipients[0], "grav").Amount)
+	// each recipient may only claim once
+	require.Error(t, gk.ClaimMerkleAirdrop(ctx, recipients[0], id, amounts[0], proofs[0]))
+	require.NoError(t, gk.ClaimMerkleAirdrop(ctx, recipients[1], id, amounts[1], proofs[1]))
+	input.AssertInvariants()
+
+	// once expired the unclaimed remainder goes back to the community pool and no more claims are accepted
+	ctx = ctx.WithBlockHeight(int64(expiry))
+	gk.ExpireMerkleAirdrops(ctx)
+	require.Empty(t, gk.GetMerkleAirdropsList(ctx))
+	require.Error(t, gk.ClaimMerkleAirdrop(ctx, recipients[2], id, amounts[2], proofs[2]))
+	assert.Equal(t, sdk.NewDec(8100), gk.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf("grav"))
+}
+
+// The claim records of expired airdrops are pruned over several blocks while those of active airdrops are kept
+func TestPruneExpiredMerkleAirdropClaims(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	// airdrop 2 is active, airdrops 1 and 3 have expired and been removed
+	gk.setMerkleAirdrop(ctx, types.MerkleAirdrop{
+		Id:           2,
+		Denom:        "grav",
+		MerkleRoot:   make([]byte, types.MerkleRootLen),
+		Total:        1,
+		ExpiryHeight: uint64(ctx.BlockHeight()) + 100,
+	})
+	claimCounts := map[uint64]int{1: MerkleAirdropClaimsPrunedPerBlock + 500, 2: 10, 3: 5}
+	for id, count := range claimCounts {
+		for i := 0; i < count; i++ {
+			claimer := sdk.AccAddress(sdk.Uint64ToBigEndian(uint64(i)))
+			gk.setMerkleAirdropClaim(ctx, types.MerkleAirdropClaim{AirdropId: id, Claimer: claimer.String(), Amount: 1})
+		}
+	}
+	remainingClaims := func() map[uint64]int {
+		remaining := make(map[uint64]int)
+		gk.IterateMerkleAirdropClaims(ctx, func(_ []byte, claim types.MerkleAirdropClaim) bool {
+			remaining[claim.AirdropId]++
+			return false
+		})
+		return remaining
+	}
+
+	gk.ExpireMerkleAirdrops(ctx)
+	require.Equal(t, map[uint64]int{1: 500, 2: 10, 3: 5}, remainingClaims())
+	gk.ExpireMerkleAirdrops(ctx)
+	require.Equal(t, map[uint64]int{2: 10}, remainingClaims())
+	gk.ExpireMerkleAirdrops(ctx)
+	require.Equal(t, map[uint64]int{2: 10}, remainingClaims())
+}
+
+// nolint: exhaustruct
+func TestVestingAirdropProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	// one recipient with an existing account and one without any account
+	existing := AccAddrs[0]
+	input.AccountKeeper.SetAccount(ctx, input.AccountKeeper.NewAccountWithAddress(ctx, existing))
+	fresh, err := sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+	require.NoError(t, err)
+	require.Nil(t, input.AccountKeeper.GetAccount(ctx, fresh))
+	recipients := append(existing.Bytes(), fresh.Bytes()...)
+
+	feePoolBalance := sdk.NewInt64Coin("grav", 10000)
+	feePool := gk.DistKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(sdk.NewDecCoinFromCoin(feePoolBalance))...)
+	gk.DistKeeper.SetFeePool(ctx, feePool)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(feePoolBalance)))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, disttypes.ModuleName, sdk.NewCoins(feePoolBalance)))
+
+	now := ctx.BlockTime().Unix()
+	continuous := types.VestingAirdropProposal{
+		Title:       "test tile",
+		Description: "test description",
+		Denom:       "grav",
+		Recipients:  recipients,
+		Amounts:     []uint64{1000, 2000},
+		StartTime:   now,
+		EndTime:     now + 1000,
+	}
+	airdropTooBig := continuous
+	airdropTooBig.Amounts = []uint64{100000, 100000}
+	airdropBadSchedule := continuous
+	airdropBadSchedule.EndTime = continuous.StartTime
+	airdropEnded := continuous
+	airdropEnded.StartTime = now - 1000
+	airdropEnded.EndTime = now
+	airdropBadDest := continuous
+	airdropBadDest.Recipients = []byte{0, 1, 2, 3, 4}
+
+	require.Error(t, gk.HandleVestingAirdropProposal(ctx, &airdropTooBig))
+	require.Error(t, gk.HandleVestingAirdropProposal(ctx, &airdropBadSchedule))
+	require.Error(t, gk.HandleVestingAirdropProposal(ctx, &airdropEnded))
+	require.Error(t, gk.HandleVestingAirdropProposal(ctx, &airdropBadDest))
+	input.AssertInvariants()
+
+	startingSupply := input.BankKeeper.GetSupply(ctx, "grav")
+	require.NoError(t, gk.HandleVestingAirdropProposal(ctx, &continuous))
+	require.Equal(t, startingSupply, input.BankKeeper.GetSupply(ctx, "grav"))
+	assert.Equal(t, sdk.NewDec(7000), gk.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf("grav"))
+	input.AssertInvariants()
+
+	existingAcc, ok := input.AccountKeeper.GetAccount(ctx, existing).(*vestingtypes.ContinuousVestingAccount)
+	require.True(t, ok)
+	freshAcc, ok := input.AccountKeeper.GetAccount(ctx, fresh).(*vestingtypes.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("grav", 2000)), freshAcc.OriginalVesting)
+	// nothing has vested yet, half has vested halfway through the schedule
+	require.True(t, input.BankKeeper.SpendableCoins(ctx, fresh).AmountOf("grav").IsZero())
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(500 * time.Second))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.SpendableCoins(later, fresh).AmountOf("grav"))
+	require.Equal(t, sdk.NewInt(500), existingAcc.GetVestedCoins(later.BlockTime()).AmountOf("grav"))
+
+	// existing vesting accounts can not receive another vesting airdrop
+	require.Error(t, gk.HandleVestingAirdropProposal(ctx, &continuous))
+
+	// periodic vesting releases in installments, with the remainder in the final period
+	periodicRecipient := AccAddrs[1]
+	periodic := continuous
+	periodic.Recipients = periodicRecipient.Bytes()
+	periodic.Amounts = []uint64{1001}
+	periodic.NumPeriods = 4
+	require.NoError(t, gk.HandleVestingAirdropProposal(ctx, &periodic))
+	periodicAcc, ok := input.AccountKeeper.GetAccount(ctx, periodicRecipient).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Len(t, periodicAcc.VestingPeriods, 4)
+	require.Equal(t, now+1000, periodicAcc.EndTime)
+	require.Equal(t, sdk.NewInt(250), periodicAcc.VestingPeriods[0].Amount.AmountOf("grav"))
+	require.Equal(t, sdk.NewInt(251), periodicAcc.VestingPeriods[3].Amount.AmountOf("grav"))
+	require.Equal(t, sdk.NewInt(500), periodicAcc.GetVestedCoins(later.BlockTime()).AmountOf("grav"))
+	input.AssertInvariants()
+
+	// module accounts can not be converted
+	moduleAirdrop := continuous
+	moduleAirdrop.Recipients = authtypes.NewModuleAddress(types.ModuleName).Bytes()
+	moduleAirdrop.Amounts = []uint64{1}
+	require.Error(t, gk.HandleVestingAirdropProposal(ctx, &moduleAirdrop))
+}
+
+// Tests that a param change proposal cannot give an EVM chain the default chain's gravity id, a check the per key
+// validators of the params module cannot make
+func TestParamChangeProposalGravityID(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	k := input.GravityKeeper
+	handler := NewParamChangeProposalHandler(k, params.NewParamChangeProposalHandler(input.ParamsKeeper))
+
+	evmChainsProposal := func(gravityId string) *paramsproposal.ParameterChangeProposal {
+		chains := []types.EvmChainParams{{
+			EvmChainPrefix:           "arbitrum",
+			EvmChainName:             "Arbitrum One",
+			GravityId:                gravityId,
+			BridgeEthereumAddress:    "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8",
+			BridgeChainId:            42161,
+			AverageEthereumBlockTime: 250,
+			BridgeActive:             true,
+		}}
+		value, err := input.LegacyAmino.MarshalJSON(chains)
+		require.NoError(t, err)
+		return paramsproposal.NewParameterChangeProposal("evm chains", "bridge arbitrum", []paramsproposal.ParamChange{
+			paramsproposal.NewParamChange(types.DefaultParamspace, string(types.ParamStoreEvmChains), string(value)),
+		})
+	}
+
+	// reusing the default chain's gravity id would allow signatures to be replayed between the bridges
+	cacheCtx, _ := ctx.CacheContext()
+	require.Error(t, handler(cacheCtx, evmChainsProposal(k.GetParams(ctx).GravityId)))
+
+	require.NoError(t, handler(ctx, evmChainsProposal("arbitrum-gravity")))
+	chain, found := k.GetParams(ctx).GetEvmChain("arbitrum")
+	require.True(t, found)
+	require.Equal(t, "arbitrum-gravity", chain.GravityId)
+	// normally done by the EndBlocker
+	k.InitEvmChain(ctx, "arbitrum")
+
+	// changes to other subspaces are not checked against the gravity params
+	require.NoError(t, handler(ctx, paramsproposal.NewParameterChangeProposal("staking", "more validators", []paramsproposal.ParamChange{
+		paramsproposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "150"),
+	})))
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 	"strings"
 
 	v1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v1"
@@ -9,7 +11,11 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/config"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
@@ -30,7 +36,11 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 func (k Keeper) CurrentValset(
 	c context.Context,
 	req *types.QueryCurrentValsetRequest) (*types.QueryCurrentValsetResponse, error) {
-	vs, err := k.GetCurrentValset(sdk.UnwrapSDKContext(c))
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+	vs, err := k.GetCurrentValset(sdk.UnwrapSDKContext(c), evmChainPrefix)
 	if err != nil {
 		// nolint: exhaustruct
 		return &types.QueryCurrentValsetResponse{}, err
@@ -42,25 +52,37 @@ func (k Keeper) CurrentValset(
 func (k Keeper) ValsetRequest(
 	c context.Context,
 	req *types.QueryValsetRequestRequest) (*types.QueryValsetRequestResponse, error) {
-	return &types.QueryValsetRequestResponse{Valset: k.GetValset(sdk.UnwrapSDKContext(c), req.Nonce)}, nil
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryValsetRequestResponse{Valset: k.GetValset(sdk.UnwrapSDKContext(c), evmChainPrefix, req.Nonce)}, nil
 }
 
 // ValsetConfirm queries the ValsetConfirm of the gravity module
 func (k Keeper) ValsetConfirm(
 	c context.Context,
 	req *types.QueryValsetConfirmRequest) (*types.QueryValsetConfirmResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
-	return &types.QueryValsetConfirmResponse{Confirm: k.GetValsetConfirm(sdk.UnwrapSDKContext(c), req.Nonce, addr)}, nil
+	return &types.QueryValsetConfirmResponse{Confirm: k.GetValsetConfirm(sdk.UnwrapSDKContext(c), evmChainPrefix, req.Nonce, addr)}, nil
 }
 
 // ValsetConfirmsByNonce queries the ValsetConfirmsByNonce of the gravity module
 func (k Keeper) ValsetConfirmsByNonce(
 	c context.Context,
 	req *types.QueryValsetConfirmsByNonceRequest) (*types.QueryValsetConfirmsByNonceResponse, error) {
-	confirms := k.GetValsetConfirms(sdk.UnwrapSDKContext(c), req.Nonce)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+	confirms := k.GetValsetConfirms(sdk.UnwrapSDKContext(c), evmChainPrefix, req.Nonce)
 
 	return &types.QueryValsetConfirmsByNonceResponse{Confirms: confirms}, nil
 }
@@ -71,7 +93,11 @@ const maxValsetRequestsReturned = 5
 func (k Keeper) LastValsetRequests(
 	c context.Context,
 	req *types.QueryLastValsetRequestsRequest) (*types.QueryLastValsetRequestsResponse, error) {
-	valReq := k.GetValsets(sdk.UnwrapSDKContext(c))
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+	valReq := k.GetValsets(sdk.UnwrapSDKContext(c), evmChainPrefix)
 	valReqLen := len(valReq)
 	retLen := 0
 	if valReqLen < maxValsetRequestsReturned {
@@ -86,15 +112,19 @@ func (k Keeper) LastValsetRequests(
 func (k Keeper) LastPendingValsetRequestByAddr(
 	c context.Context,
 	req *types.QueryLastPendingValsetRequestByAddrRequest) (*types.QueryLastPendingValsetRequestByAddrResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
 	}
 
 	var pendingValsetReq []types.Valset
-	k.IterateValsets(sdk.UnwrapSDKContext(c), func(_ []byte, val *types.Valset) bool {
+	k.IterateValsets(sdk.UnwrapSDKContext(c), evmChainPrefix, func(_ []byte, val *types.Valset) bool {
 		// foundConfirm is true if the operatorAddr has signed the valset we are currently looking at
-		foundConfirm := k.GetValsetConfirm(sdk.UnwrapSDKContext(c), val.Nonce, addr) != nil
+		foundConfirm := k.GetValsetConfirm(sdk.UnwrapSDKContext(c), evmChainPrefix, val.Nonce, addr) != nil
 		// if this valset has NOT been signed by operatorAddr, store it in pendingValsetReq
 		// and exit the loop
 		if !foundConfirm {
@@ -115,7 +145,11 @@ func (k Keeper) LastPendingValsetRequestByAddr(
 func (k Keeper) BatchFees(
 	c context.Context,
 	req *types.QueryBatchFeeRequest) (*types.QueryBatchFeeResponse, error) {
-	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(sdk.UnwrapSDKContext(c), OutgoingTxBatchSize)}, nil
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(sdk.UnwrapSDKContext(c), evmChainPrefix, OutgoingTxBatchSize)}, nil
 }
 
 // LastPendingBatchRequestByAddr queries the LastPendingBatchRequestByAddr of
@@ -124,6 +158,10 @@ func (k Keeper) LastPendingBatchRequestByAddr(
 	c context.Context,
 	req *types.QueryLastPendingBatchRequestByAddrRequest,
 ) (*types.QueryLastPendingBatchRequestByAddrResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
@@ -132,8 +170,8 @@ func (k Keeper) LastPendingBatchRequestByAddr(
 	var pendingBatchReq types.InternalOutgoingTxBatches
 
 	found := false
-	k.IterateOutgoingTxBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
-		foundConfirm := k.GetBatchConfirm(sdk.UnwrapSDKContext(c), batch.BatchNonce, batch.TokenContract, addr) != nil
+	k.IterateOutgoingTxBatches(sdk.UnwrapSDKContext(c), evmChainPrefix, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+		foundConfirm := k.GetBatchConfirm(sdk.UnwrapSDKContext(c), evmChainPrefix, batch.BatchNonce, batch.TokenContract, addr) != nil
 		if !foundConfirm {
 			pendingBatchReq = append(pendingBatchReq, batch)
 			found = true
@@ -155,6 +193,10 @@ func (k Keeper) LastPendingBatchRequestByAddr(
 func (k Keeper) LastPendingLogicCallByAddr(
 	c context.Context,
 	req *types.QueryLastPendingLogicCallByAddrRequest) (*types.QueryLastPendingLogicCallByAddrResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := sdk.AccAddressFromBech32(req.Address)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
@@ -162,8 +204,8 @@ func (k Keeper) LastPendingLogicCallByAddr(
 
 	var pendingLogicReq []types.OutgoingLogicCall
 	found := false
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, logic types.OutgoingLogicCall) bool {
-		foundConfirm := k.GetLogicCallConfirm(sdk.UnwrapSDKContext(c),
+	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), evmChainPrefix, func(_ []byte, logic types.OutgoingLogicCall) bool {
+		foundConfirm := k.GetLogicCallConfirm(sdk.UnwrapSDKContext(c), evmChainPrefix,
 			logic.InvalidationId, logic.InvalidationNonce, addr) != nil
 		if !foundConfirm {
 			pendingLogicReq = append(pendingLogicReq, logic)
@@ -180,14 +222,81 @@ func (k Keeper) LastPendingLogicCallByAddr(
 	}
 }
 
+// PendingWorkByOrchestrator returns every valset, batch and logic call the given orchestrator has not signed yet,
+// so a restarted orchestrator can catch up in a single query
+func (k Keeper) PendingWorkByOrchestrator(
+	c context.Context,
+	req *types.QueryPendingWorkByOrchestratorRequest) (*types.QueryPendingWorkByOrchestratorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
+	}
+
+	return &types.QueryPendingWorkByOrchestratorResponse{Work: k.GetPendingWorkByOrchestrator(ctx, evmChainPrefix, addr)}, nil
+}
+
+// FeeStatistics summarizes the fees paid by the recently executed batches of a token
+func (k Keeper) FeeStatistics(
+	c context.Context,
+	req *types.QueryFeeStatisticsRequest) (*types.QueryFeeStatisticsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+	tokenContract, err := types.NewEthAddress(req.TokenContract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid token contract")
+	}
+
+	res := k.GetFeeStatistics(ctx, evmChainPrefix, *tokenContract)
+	return &res, nil
+}
+
+// EstimatedBatchGas estimates the EVM gas of executing a stored batch
+func (k Keeper) EstimatedBatchGas(
+	c context.Context,
+	req *types.QueryEstimatedBatchGasRequest) (*types.QueryEstimatedBatchGasResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+	tokenContract, err := types.NewEthAddress(req.TokenContract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid token contract")
+	}
+	batch := k.GetOutgoingTXBatch(ctx, evmChainPrefix, *tokenContract, req.Nonce)
+	if batch == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "cannot find tx batch")
+	}
+
+	gas, gasParams := k.EstimateBatchGas(ctx, evmChainPrefix, *batch)
+	return &types.QueryEstimatedBatchGasResponse{
+		EstimatedGas:   gas,
+		TxCount:        uint64(len(batch.Transactions)),
+		GasPerTransfer: gasParams.GasPerTransfer,
+		MaxBatchGas:    gasParams.MaxBatchGas,
+	}, nil
+}
+
 const MaxResults = 100 // todo: impl pagination
 
 // OutgoingTxBatches queries the OutgoingTxBatches of the gravity module
 func (k Keeper) OutgoingTxBatches(
 	c context.Context,
 	req *types.QueryOutgoingTxBatchesRequest) (*types.QueryOutgoingTxBatchesResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	var batches []types.OutgoingTxBatch
-	k.IterateOutgoingTxBatches(sdk.UnwrapSDKContext(c), func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+	k.IterateOutgoingTxBatches(sdk.UnwrapSDKContext(c), evmChainPrefix, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
 		batches = append(batches, batch.ToExternal())
 		return len(batches) == MaxResults
 	})
@@ -198,8 +307,12 @@ func (k Keeper) OutgoingTxBatches(
 func (k Keeper) OutgoingLogicCalls(
 	c context.Context,
 	req *types.QueryOutgoingLogicCallsRequest) (*types.QueryOutgoingLogicCallsResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	var calls []types.OutgoingLogicCall
-	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, call types.OutgoingLogicCall) bool {
+	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), evmChainPrefix, func(_ []byte, call types.OutgoingLogicCall) bool {
 		calls = append(calls, call)
 		return len(calls) == MaxResults
 	})
@@ -211,12 +324,16 @@ func (k Keeper) BatchRequestByNonce(
 	c context.Context,
 	req *types.QueryBatchRequestByNonceRequest,
 ) (*types.QueryBatchRequestByNonceResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	addr, err := types.NewEthAddress(req.ContractAddress)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
 	}
 
-	foundBatch := k.GetOutgoingTXBatch(sdk.UnwrapSDKContext(c), *addr, req.Nonce)
+	foundBatch := k.GetOutgoingTXBatch(sdk.UnwrapSDKContext(c), evmChainPrefix, *addr, req.Nonce)
 	if foundBatch == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "cannot find tx batch")
 	}
@@ -228,12 +345,16 @@ func (k Keeper) BatchRequestByNonce(
 func (k Keeper) BatchConfirms(
 	c context.Context,
 	req *types.QueryBatchConfirmsRequest) (*types.QueryBatchConfirmsResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	var confirms []types.MsgConfirmBatch
 	contract, err := types.NewEthAddress(req.ContractAddress)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid contract address in request")
 	}
-	k.IterateBatchConfirmByNonceAndTokenContract(sdk.UnwrapSDKContext(c),
+	k.IterateBatchConfirmByNonceAndTokenContract(sdk.UnwrapSDKContext(c), evmChainPrefix,
 		req.Nonce, *contract, func(_ []byte, c types.MsgConfirmBatch) bool {
 			confirms = append(confirms, c)
 			return false
@@ -245,7 +366,11 @@ func (k Keeper) BatchConfirms(
 func (k Keeper) LogicConfirms(
 	c context.Context,
 	req *types.QueryLogicConfirmsRequest) (*types.QueryLogicConfirmsResponse, error) {
-	confirms := k.GetLogicConfirmsByInvalidationIdAndNonce(sdk.UnwrapSDKContext(c), req.InvalidationId, req.InvalidationNonce)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+	confirms := k.GetLogicConfirmsByInvalidationIdAndNonce(sdk.UnwrapSDKContext(c), evmChainPrefix, req.InvalidationId, req.InvalidationNonce)
 
 	return &types.QueryLogicConfirmsResponse{Confirms: confirms}, nil
 }
@@ -255,6 +380,10 @@ func (k Keeper) LogicConfirms(
 func (k Keeper) LastEventNonceByAddr(
 	c context.Context,
 	req *types.QueryLastEventNonceByAddrRequest) (*types.QueryLastEventNonceByAddrResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 	var ret types.QueryLastEventNonceByAddrResponse
 	addr, err := sdk.AccAddressFromBech32(req.Address)
@@ -268,7 +397,7 @@ func (k Keeper) LastEventNonceByAddr(
 	if err := sdk.VerifyAddressFormat(validator.GetOperator()); err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid validator address")
 	}
-	lastEventNonce := k.GetLastEventNonceByValidator(ctx, validator.GetOperator())
+	lastEventNonce := k.GetLastEventNonceByValidator(ctx, evmChainPrefix, validator.GetOperator())
 	ret.EventNonce = lastEventNonce
 	return &ret, nil
 }
@@ -277,8 +406,12 @@ func (k Keeper) LastEventNonceByAddr(
 func (k Keeper) DenomToERC20(
 	c context.Context,
 	req *types.QueryDenomToERC20Request) (*types.QueryDenomToERC20Response, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(c)
-	cosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, req.Denom)
+	cosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, req.Denom)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "invalid denom (%v) queried", req.Denom)
 	}
@@ -293,12 +426,16 @@ func (k Keeper) DenomToERC20(
 func (k Keeper) ERC20ToDenom(
 	c context.Context,
 	req *types.QueryERC20ToDenomRequest) (*types.QueryERC20ToDenomResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 	ethAddr, err := types.NewEthAddress(req.Erc20)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "invalid Erc20 in request: %s", req.Erc20)
 	}
-	cosmosOriginated, name := k.ERC20ToDenomLookup(ctx, *ethAddr)
+	cosmosOriginated, name := k.ERC20ToDenomLookup(ctx, evmChainPrefix, *ethAddr)
 	var ret types.QueryERC20ToDenomResponse
 	ret.Denom = name
 	ret.CosmosOriginated = cosmosOriginated
@@ -311,18 +448,20 @@ func (k Keeper) GetLastObservedEthBlock(
 	c context.Context,
 	req *types.QueryLastObservedEthBlockRequest,
 ) (*types.QueryLastObservedEthBlockResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 
 	// Use the old locator pre-Mercury, when the keys changed to hashed strings
-	var locator func(ctx sdk.Context) types.LastObservedEthereumBlockHeight
+	var ethHeight types.LastObservedEthereumBlockHeight
 	if req.UseV1Key {
-		locator = k.GetOldLastObservedEthereumBlockHeight
+		ethHeight = k.GetOldLastObservedEthereumBlockHeight(ctx)
 	} else {
-		locator = k.GetLastObservedEthereumBlockHeight
+		ethHeight = k.GetLastObservedEthereumBlockHeight(ctx, evmChainPrefix)
 	}
 
-	ethHeight := locator(ctx)
-
 	return &types.QueryLastObservedEthBlockResponse{Block: ethHeight.EthereumBlockHeight}, nil
 }
 
@@ -349,16 +488,19 @@ func (k Keeper) GetLastObservedEthNonce(
 	c context.Context,
 	req *types.QueryLastObservedEthNonceRequest,
 ) (*types.QueryLastObservedEthNonceResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 
 	// Use the old locator pre-Mercury, when the keys changed to hashed strings
-	var locator func(ctx sdk.Context) uint64
+	var nonce uint64
 	if req.UseV1Key {
-		locator = k.GetOldLastObservedEventNonce
+		nonce = k.GetOldLastObservedEventNonce(ctx)
 	} else {
-		locator = k.GetLastObservedEventNonce
+		nonce = k.GetLastObservedEventNonce(ctx, evmChainPrefix)
 	}
-	nonce := locator(ctx)
 
 	return &types.QueryLastObservedEthNonceResponse{Nonce: nonce}, nil
 }
@@ -378,6 +520,10 @@ func (k Keeper) GetAttestations(
 	c context.Context,
 	req *types.QueryAttestationsRequest,
 ) (*types.QueryAttestationsResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 
 	// Use the old iterator pre-Mercury, when the keys changed to hashed strings
@@ -385,7 +531,9 @@ func (k Keeper) GetAttestations(
 	if req.UseV1Key {
 		iterator = k.IterateOldAttestations
 	} else {
-		iterator = k.IterateAttestations
+		iterator = func(ctx sdk.Context, reverse bool, cb func([]byte, types.Attestation) bool) {
+			k.IterateAttestations(ctx, evmChainPrefix, reverse, func(key []byte, att types.Attestation) bool { return cb(key, att) })
+		}
 	}
 
 	limit := req.Limit
@@ -550,9 +698,13 @@ func (k Keeper) GetDelegateKeyByEth(
 func (k Keeper) GetPendingSendToEth(
 	c context.Context,
 	req *types.QueryPendingSendToEth) (*types.QueryPendingSendToEthResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(c)
-	batches := k.GetOutgoingTxBatches(ctx)
-	unbatchedTxs := k.GetUnbatchedTransactions(ctx)
+	batches := k.GetOutgoingTxBatches(ctx, evmChainPrefix)
+	unbatchedTxs := k.GetUnbatchedTransactions(ctx, evmChainPrefix)
 	senderAddress := req.GetSenderAddress()
 	res := types.QueryPendingSendToEthResponse{
 		TransfersInBatches: []types.OutgoingTransferTx{},
@@ -578,7 +730,204 @@ func (k Keeper) GetPendingIbcAutoForwards(
 	c context.Context,
 	req *types.QueryPendingIbcAutoForwards,
 ) (*types.QueryPendingIbcAutoForwardsResponse, error) {
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(sdk.UnwrapSDKContext(c), req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(c)
-	pendingForwards := k.PendingIbcAutoForwards(ctx, req.Limit)
+	pendingForwards := k.PendingIbcAutoForwards(ctx, evmChainPrefix, req.Limit)
 	return &types.QueryPendingIbcAutoForwardsResponse{PendingIbcAutoForwards: pendingForwards}, nil
 }
+
+// GetEvmChains returns the params of every bridged EVM chain, starting with the default chain
+func (k Keeper) GetEvmChains(
+	c context.Context,
+	req *types.QueryEvmChainsRequest,
+) (*types.QueryEvmChainsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEvmChainsResponse{EvmChains: k.GetAllEvmChains(ctx)}, nil
+}
+
+// GetMerkleAirdrops returns every Merkle airdrop which has not yet expired
+func (k Keeper) GetMerkleAirdrops(
+	c context.Context,
+	req *types.QueryMerkleAirdropsRequest,
+) (*types.QueryMerkleAirdropsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMerkleAirdropsResponse{MerkleAirdrops: k.GetMerkleAirdropsList(ctx)}, nil
+}
+
+// ValidatorBridgePerformance returns the bridge duty record of a validator and the unsigned items it is at risk of
+// being slashed for
+func (k Keeper) ValidatorBridgePerformance(
+	c context.Context,
+	req *types.QueryValidatorBridgePerformanceRequest,
+) (*types.QueryValidatorBridgePerformanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid validator address")
+	}
+	validator, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, req.ValidatorAddress)
+	}
+	report := k.validatorBridgePerformanceReport(ctx, validator, k.getPendingBridgeItems(ctx))
+	return &types.QueryValidatorBridgePerformanceResponse{Report: report}, nil
+}
+
+// AllValidatorsBridgePerformance returns the bridge duty records of the bonded validators by power, followed by
+// those of any other validator with a record
+func (k Keeper) AllValidatorsBridgePerformance(
+	c context.Context,
+	req *types.QueryAllValidatorsBridgePerformanceRequest,
+) (*types.QueryAllValidatorsBridgePerformanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	pending := k.getPendingBridgeItems(ctx)
+
+	reports := []types.ValidatorBridgePerformanceReport{}
+	reported := make(map[string]bool)
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		reports = append(reports, k.validatorBridgePerformanceReport(ctx, validator, pending))
+		reported[validator.GetOperator().String()] = true
+	}
+	k.IterateValidatorBridgePerformance(ctx, func(valAddr sdk.ValAddress, _ types.ValidatorBridgePerformance) bool {
+		if reported[valAddr.String()] {
+			return false
+		}
+		// validators which have been removed from staking have nothing left to report
+		if validator, found := k.StakingKeeper.GetValidator(ctx, valAddr); found {
+			reports = append(reports, k.validatorBridgePerformanceReport(ctx, validator, pending))
+		}
+		return false
+	})
+	return &types.QueryAllValidatorsBridgePerformanceResponse{Reports: reports}, nil
+}
+
+// SimulateSignatureCheck simulates the signature check Gravity.sol performs when the requested valset update or
+// batch is submitted, so relayers can tell whether the submission would revert before paying for it
+func (k Keeper) SimulateSignatureCheck(
+	c context.Context,
+	req *types.QuerySimulateSignatureCheckRequest,
+) (*types.QuerySimulateSignatureCheckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	isBatch := req.BatchNonce != 0 || req.TokenContract != ""
+	switch {
+	case req.ValsetNonce != 0 && !isBatch:
+		return k.SimulateValsetSignatureCheck(ctx, evmChainPrefix, req.ValsetNonce)
+	case req.ValsetNonce == 0 && isBatch:
+		tokenContract, err := types.NewEthAddress(req.TokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid token contract")
+		}
+		return k.SimulateBatchSignatureCheck(ctx, evmChainPrefix, *tokenContract, req.BatchNonce)
+	default:
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "either a valset nonce or a batch nonce and token contract must be given")
+	}
+}
+
+// EthereumCalldata returns the Gravity.sol calldata submitting the requested valset update, batch or logic call
+func (k Keeper) EthereumCalldata(
+	c context.Context,
+	req *types.QueryEthereumCalldataRequest,
+) (*types.QueryEthereumCalldataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	isValset := req.ValsetNonce != 0
+	isBatch := req.BatchNonce != 0 || req.TokenContract != ""
+	isLogicCall := len(req.InvalidationId) != 0 || req.InvalidationNonce != 0
+	switch {
+	case isValset && !isBatch && !isLogicCall:
+		return k.GetValsetUpdateCalldata(ctx, evmChainPrefix, req.ValsetNonce)
+	case isBatch && !isValset && !isLogicCall:
+		tokenContract, err := types.NewEthAddress(req.TokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid token contract")
+		}
+		return k.GetBatchCalldata(ctx, evmChainPrefix, *tokenContract, req.BatchNonce)
+	case isLogicCall && !isValset && !isBatch:
+		return k.GetLogicCallCalldata(ctx, evmChainPrefix, req.InvalidationId, req.InvalidationNonce)
+	default:
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "exactly one of a valset, batch or logic call must be given")
+	}
+}
+
+// EIP712TypedData returns the EIP-712 typed data a wallet must sign for the given unsigned tx, using the chain's
+// current account number and sequence for the fee payer
+func (k Keeper) EIP712TypedData(
+	c context.Context,
+	req *types.QueryEIP712TypedDataRequest,
+) (*types.QueryEIP712TypedDataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var tx txtypes.Tx
+	if err := k.cdc.Unmarshal(req.TxBytes, &tx); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	if tx.Body == nil || tx.AuthInfo == nil || tx.AuthInfo.Fee == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "incomplete tx")
+	}
+	msgs := tx.GetMsgs()
+	for _, msg := range msgs {
+		if _, ok := msg.(legacytx.LegacyMsg); !ok {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "%s does not support amino signing", sdk.MsgTypeURL(msg))
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	if len(tx.GetSigners()) != 1 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "EIP-712 signed txs must have exactly one signer")
+	}
+	feePayer := tx.FeePayer()
+	account := k.accountKeeper.GetAccount(ctx, feePayer)
+	if account == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", feePayer)
+	}
+
+	evmChainID, err := strconv.ParseUint(config.GravityEvmChainID, 10, 64)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid evm chain id")
+	}
+	signBytes := legacytx.StdSignBytes(
+		ctx.ChainID(),
+		account.GetAccountNumber(),
+		account.GetSequence(),
+		tx.Body.TimeoutHeight,
+		legacytx.StdFee{Amount: tx.GetFee(), Gas: tx.GetGas()}, // nolint: exhaustruct
+		msgs,
+		tx.Body.Memo,
+	)
+	typedData, err := types.EIP712TypedData(evmChainID, signBytes, msgs, feePayer)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(typedData)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to marshal typed data")
+	}
+	return &types.QueryEIP712TypedDataResponse{TypedData: string(bz)}, nil
+}
+
+// BridgeStatus summarizes the health of the bridge to an EVM chain in a single query
+func (k Keeper) BridgeStatus(
+	c context.Context,
+	req *types.QueryBridgeStatusRequest) (*types.QueryBridgeStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, req.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	res := k.GetBridgeStatus(ctx, evmChainPrefix)
+	return &res, nil
+}
@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
@@ -10,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/require"
 )
 
@@ -156,6 +159,54 @@ func createAttestations(t *testing.T, k keeper.Keeper, ctx sdk.Context, length i
 		hash, err := msg.ClaimHash()
 		require.NoError(t, err)
 
-		k.SetAttestation(ctx, nonce, hash, att)
+		k.SetAttestation(ctx, keeper.EthChainPrefix, nonce, hash, att)
 	}
 }
+
+// nolint: exhaustruct
+func TestQueryEIP712TypedData(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	encCfg := app.MakeEncodingConfig()
+	ctx := input.Context
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, input.GravityKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	sender := keeper.AccAddrs[0]
+	if input.AccountKeeper.GetAccount(ctx, sender) == nil {
+		input.AccountKeeper.SetAccount(ctx, input.AccountKeeper.NewAccountWithAddress(ctx, sender))
+	}
+	account := input.AccountKeeper.GetAccount(ctx, sender)
+	dest, err := types.NewEthAddress(keeper.EthAddrs[0].String())
+	require.NoError(t, err)
+	msg := types.NewMsgSendToEth(sender, *dest, sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("stake", 1))
+
+	encodeTx := func(msgs ...sdk.Msg) []byte {
+		builder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetGasLimit(200000)
+		builder.SetMemo("memo")
+		bz, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	res, err := queryClient.EIP712TypedData(gocontext.Background(), &types.QueryEIP712TypedDataRequest{TxBytes: encodeTx(msg)})
+	require.NoError(t, err)
+	var typedData apitypes.TypedData
+	require.NoError(t, json.Unmarshal([]byte(res.TypedData), &typedData))
+	require.Equal(t, "Tx", typedData.PrimaryType)
+	for name, fields := range types.EIP712MsgTypes[sdk.MsgTypeURL(msg)] {
+		require.Equal(t, fields, typedData.Types[name])
+	}
+	require.Equal(t, fmt.Sprint(account.GetAccountNumber()), typedData.Message["account_number"])
+	require.Equal(t, ctx.ChainID(), typedData.Message["chain_id"])
+	require.Equal(t, "memo", typedData.Message["memo"])
+
+	// txs which can not be EIP-712 signed are rejected
+	_, err = queryClient.EIP712TypedData(gocontext.Background(), &types.QueryEIP712TypedDataRequest{TxBytes: encodeTx(msg, types.NewMsgCancelSendToEth(sender, 1))})
+	require.Error(t, err)
+	_, err = queryClient.EIP712TypedData(gocontext.Background(), &types.QueryEIP712TypedDataRequest{TxBytes: []byte{1, 2, 3}})
+	require.Error(t, err)
+}
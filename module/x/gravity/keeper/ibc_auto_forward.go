@@ -14,9 +14,11 @@ import (
 	"fmt"
 	"time"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	bech32ibctypes "github.com/althea-net/bech32-ibc/x/bech32ibc/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -27,12 +29,12 @@ import (
 // ValidatePendingIbcAutoForward performs basic validation, asserts the nonce is not ahead of what gravity is aware of,
 // requires ForeignReceiver's bech32 prefix to be registered and match with IbcChannel, and gravity module must have the
 // funds to meet this forward amount
-func (k Keeper) ValidatePendingIbcAutoForward(ctx sdk.Context, forward types.PendingIbcAutoForward) error {
+func (k Keeper) ValidatePendingIbcAutoForward(ctx sdk.Context, evmChainPrefix string, forward types.PendingIbcAutoForward) error {
 	if err := forward.ValidateBasic(); err != nil {
 		return err
 	}
 
-	latestEventNonce := k.GetLastObservedEventNonce(ctx)
+	latestEventNonce := k.GetLastObservedEventNonce(ctx, evmChainPrefix)
 	if forward.EventNonce > latestEventNonce {
 		return sdkerrors.Wrap(types.ErrInvalid, "EventNonce must be <= latest observed event nonce")
 	}
@@ -62,9 +64,9 @@ func (k Keeper) ValidatePendingIbcAutoForward(ctx sdk.Context, forward types.Pen
 }
 
 // GetNextPendingIbcAutoForward returns the first pending IBC Auto-Forward in the queue
-func (k Keeper) GetNextPendingIbcAutoForward(ctx sdk.Context) *types.PendingIbcAutoForward {
+func (k Keeper) GetNextPendingIbcAutoForward(ctx sdk.Context, evmChainPrefix string) *types.PendingIbcAutoForward {
 	store := ctx.KVStore(k.storeKey)
-	prefix := types.PendingIbcAutoForwards
+	prefix := types.AppendEvmChainPrefix(types.PendingIbcAutoForwards, evmChainPrefix)
 	iter := store.Iterator(prefixRange(prefix))
 	defer iter.Close()
 	if iter.Valid() {
@@ -82,10 +84,10 @@ func (k Keeper) GetNextPendingIbcAutoForward(ctx sdk.Context) *types.PendingIbcA
 }
 
 // PendingIbcAutoForwards returns an ordered slice of the queued IBC Auto-Forward sends to IBC-enabled chains
-func (k Keeper) PendingIbcAutoForwards(ctx sdk.Context, limit uint64) []*types.PendingIbcAutoForward {
+func (k Keeper) PendingIbcAutoForwards(ctx sdk.Context, evmChainPrefix string, limit uint64) []*types.PendingIbcAutoForward {
 	forwards := make([]*types.PendingIbcAutoForward, 0)
 
-	k.IteratePendingIbcAutoForwards(ctx, func(key []byte, forward *types.PendingIbcAutoForward) (stop bool) {
+	k.IteratePendingIbcAutoForwards(ctx, evmChainPrefix, func(key []byte, forward *types.PendingIbcAutoForward) (stop bool) {
 		forwards = append(forwards, forward)
 		if limit != 0 && uint64(len(forwards)) >= limit {
 			return true
@@ -98,9 +100,9 @@ func (k Keeper) PendingIbcAutoForwards(ctx sdk.Context, limit uint64) []*types.P
 
 // IteratePendingIbcAutoForwards executes the given callback on each PendingIbcAutoForward in the store
 // cb should return true to stop iteration, false to continue
-func (k Keeper) IteratePendingIbcAutoForwards(ctx sdk.Context, cb func(key []byte, forward *types.PendingIbcAutoForward) (stop bool)) {
+func (k Keeper) IteratePendingIbcAutoForwards(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, forward *types.PendingIbcAutoForward) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	prefixStore := prefix.NewStore(store, types.PendingIbcAutoForwards)
+	prefixStore := prefix.NewStore(store, types.AppendEvmChainPrefix(types.PendingIbcAutoForwards, evmChainPrefix))
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -115,12 +117,12 @@ func (k Keeper) IteratePendingIbcAutoForwards(ctx sdk.Context, cb func(key []byt
 
 // addPendingIbcAutoForward enqueues a single new pending IBC Auto-Forward send to an IBC-enabled chain
 // IbcAutoForwards are added to
-func (k Keeper) addPendingIbcAutoForward(ctx sdk.Context, forward types.PendingIbcAutoForward, token string) error {
-	if err := k.ValidatePendingIbcAutoForward(ctx, forward); err != nil {
+func (k Keeper) addPendingIbcAutoForward(ctx sdk.Context, evmChainPrefix string, forward types.PendingIbcAutoForward, token string) error {
+	if err := k.ValidatePendingIbcAutoForward(ctx, evmChainPrefix, forward); err != nil {
 		return err
 	}
 	store := ctx.KVStore(k.storeKey)
-	key := types.GetPendingIbcAutoForwardKey(forward.EventNonce)
+	key := types.GetPendingIbcAutoForwardKey(evmChainPrefix, forward.EventNonce)
 
 	if store.Has(key) {
 		return sdkerrors.Wrapf(types.ErrDuplicate,
@@ -128,6 +130,8 @@ func (k Keeper) addPendingIbcAutoForward(ctx sdk.Context, forward types.PendingI
 		)
 	}
 	store.Set(key, k.cdc.MustMarshal(&forward))
+	telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyIbcForwardsQueued), 1,
+		types.EvmChainMetricLabels(evmChainPrefix, telemetry.NewLabel(types.MetricLabelChannel, forward.IbcChannel)))
 
 	k.logger(ctx).Info("SendToCosmos Pending IBC Auto-Forward", "ibcReceiver", forward.ForeignReceiver,
 		"token", token, "denom", forward.Token.Denom, "amount", forward.Token.Amount.String(),
@@ -135,20 +139,29 @@ func (k Keeper) addPendingIbcAutoForward(ctx sdk.Context, forward types.PendingI
 		"cosmosBlockTime", ctx.BlockTime(), "cosmosBlockHeight", ctx.BlockHeight(),
 	)
 
-	return ctx.EventManager().EmitTypedEvent(&types.EventSendToCosmosPendingIbcAutoForward{
-		Nonce:    fmt.Sprint(forward.EventNonce),
-		Receiver: forward.ForeignReceiver,
-		Token:    token,
-		Amount:   forward.Token.Amount.String(),
-		Channel:  forward.IbcChannel,
-	})
+	return ctx.EventManager().EmitTypedEvents(
+		&types.EventSendToCosmosPendingIbcAutoForward{
+			Nonce:    fmt.Sprint(forward.EventNonce),
+			Receiver: forward.ForeignReceiver,
+			Token:    token,
+			Amount:   forward.Token.Amount.String(),
+			Channel:  forward.IbcChannel,
+		},
+		&eventsv1.EventIbcAutoForwardQueued{
+			EvmChainPrefix:  evmChainPrefix,
+			EventNonce:      forward.EventNonce,
+			ForeignReceiver: forward.ForeignReceiver,
+			Amount:          *forward.Token,
+			Channel:         forward.IbcChannel,
+		},
+	)
 }
 
 // deletePendingIbcAutoForward removes a single pending IBC Auto-Forward send to an IBC-enabled chain from the store
 // WARNING: this should only be called while clearing the queue in ClearNextPendingIbcAutoForward
-func (k Keeper) deletePendingIbcAutoForward(ctx sdk.Context, eventNonce uint64) error {
+func (k Keeper) deletePendingIbcAutoForward(ctx sdk.Context, evmChainPrefix string, eventNonce uint64) error {
 	store := ctx.KVStore(k.storeKey)
-	key := types.GetPendingIbcAutoForwardKey(eventNonce)
+	key := types.GetPendingIbcAutoForwardKey(evmChainPrefix, eventNonce)
 	if !store.Has(key) {
 		return sdkerrors.Wrapf(types.ErrInvalid, "No PendingIbcAutoForward with nonce %v in the store", eventNonce)
 	}
@@ -159,9 +172,9 @@ func (k Keeper) deletePendingIbcAutoForward(ctx sdk.Context, eventNonce uint64)
 // ProcessPendingIbcAutoForwards processes and dequeues many pending IBC Auto-Forwards, either sending the funds to their
 // respective destination chains or on error sending the funds to the local gravity-prefixed account
 // See ProcessNextPendingIbcAutoForward for more details
-func (k Keeper) ProcessPendingIbcAutoForwards(ctx sdk.Context, forwardsToClear uint64) error {
+func (k Keeper) ProcessPendingIbcAutoForwards(ctx sdk.Context, evmChainPrefix string, forwardsToClear uint64) error {
 	for i := uint64(0); i < forwardsToClear; i++ {
-		stop, err := k.ProcessNextPendingIbcAutoForward(ctx)
+		stop, err := k.ProcessNextPendingIbcAutoForward(ctx, evmChainPrefix)
 		if err != nil {
 			return sdkerrors.Wrapf(err, "unable to process Pending IBC Auto-Forward number %v", i)
 		}
@@ -180,8 +193,8 @@ func (k Keeper) ProcessPendingIbcAutoForwards(ctx sdk.Context, forwardsToClear u
 // the gravity chain directly.
 // Return: stop - true if there are no items to process
 // Return: err  - nil if successful, non-nil if there was an issue sending funds to the user
-func (k Keeper) ProcessNextPendingIbcAutoForward(ctx sdk.Context) (stop bool, err error) {
-	forward := k.GetNextPendingIbcAutoForward(ctx)
+func (k Keeper) ProcessNextPendingIbcAutoForward(ctx sdk.Context, evmChainPrefix string) (stop bool, err error) {
+	forward := k.GetNextPendingIbcAutoForward(ctx, evmChainPrefix)
 	if forward == nil {
 		return true, nil // No forwards to process, exit early
 	}
@@ -190,7 +203,7 @@ func (k Keeper) ProcessNextPendingIbcAutoForward(ctx sdk.Context) (stop bool, er
 		panic(fmt.Sprintf("Invalid forward found in Pending IBC Auto-Forward queue: %s", err.Error()))
 	}
 	// Point of no return: the funds will be sent somewhere, either the IBC address, local address or the community pool
-	err = k.deletePendingIbcAutoForward(ctx, forward.EventNonce)
+	err = k.deletePendingIbcAutoForward(ctx, evmChainPrefix, forward.EventNonce)
 	if err != nil {
 		// Fail this tx
 		panic(fmt.Sprintf("Discovered nonexistent Pending IBC Auto-Forward in the queue %s", forward.String()))
@@ -205,10 +218,12 @@ func (k Keeper) ProcessNextPendingIbcAutoForward(ctx sdk.Context) (stop bool, er
 		panic(fmt.Sprintf("Invalid ForeignReceiver found in Pending IBC Auto-Forward queue: %s [[%+v]]", err.Error(), forward))
 	}
 
+	labels := types.EvmChainMetricLabels(evmChainPrefix, telemetry.NewLabel(types.MetricLabelChannel, forward.IbcChannel))
 	coins := sdk.NewCoins(*forward.Token)
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, fallback, coins)
 	if err != nil {
 		// Couldn't send to fallback account, need to try community pool
+		telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyIbcForwardsFailed), 1, labels)
 		return false, k.SendToCommunityPool(ctx, coins)
 	}
 
@@ -223,8 +238,10 @@ func (k Keeper) ProcessNextPendingIbcAutoForward(ctx sdk.Context) (stop bool, er
 
 	// Log + emit event
 	if recoverableErr == nil {
-		k.logEmitIbcForwardSuccessEvent(ctx, *forward, msgTransfer)
+		telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyIbcForwardsExecuted), 1, labels)
+		k.logEmitIbcForwardSuccessEvent(ctx, evmChainPrefix, *forward, msgTransfer)
 	} else {
+		telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyIbcForwardsFailed), 1, labels)
 		// Funds have already been sent to the fallback user, emit a failure log
 		/*
 			k.ibcTransferKeeper.Transfer() failure cases (and resolution)
@@ -241,7 +258,7 @@ func (k Keeper) ProcessNextPendingIbcAutoForward(ctx sdk.Context) (stop bool, er
 			9. Could not send packet to the channel e.g. connection issues, misconfigured packet, timeouts, sequences
 			    (local receiver)
 		*/
-		k.logEmitIbcForwardFailureEvent(ctx, *forward, recoverableErr)
+		k.logEmitIbcForwardFailureEvent(ctx, evmChainPrefix, *forward, recoverableErr)
 	}
 	return false, nil // Error case has been handled, funds are in receiver's control locally or on IBC chain
 }
@@ -274,7 +291,7 @@ func thirtyDaysInFuture(ctx sdk.Context) time.Time {
 // logEmitIbcForwardSuccessEvent logs for successful IBC Auto-Forwarding and emits a
 // EventSendToCosmosExecutedIbcAutoForward type event
 func (k Keeper) logEmitIbcForwardSuccessEvent(
-	ctx sdk.Context,
+	ctx sdk.Context, evmChainPrefix string,
 	forward types.PendingIbcAutoForward,
 	msgTransfer ibctransfertypes.MsgTransfer,
 ) {
@@ -284,22 +301,32 @@ func (k Keeper) logEmitIbcForwardSuccessEvent(
 		"claimNonce", forward.EventNonce, "cosmosBlockHeight", ctx.BlockHeight(),
 	)
 
-	err := ctx.EventManager().EmitTypedEvent(&types.EventSendToCosmosExecutedIbcAutoForward{
-		Nonce:         fmt.Sprint(forward.EventNonce),
-		Receiver:      forward.ForeignReceiver,
-		Token:         forward.Token.Denom,
-		Amount:        forward.Token.Amount.String(),
-		Channel:       forward.IbcChannel,
-		TimeoutHeight: msgTransfer.TimeoutHeight.String(),
-		TimeoutTime:   fmt.Sprint(msgTransfer.TimeoutTimestamp),
-	})
+	err := ctx.EventManager().EmitTypedEvents(
+		&types.EventSendToCosmosExecutedIbcAutoForward{
+			Nonce:         fmt.Sprint(forward.EventNonce),
+			Receiver:      forward.ForeignReceiver,
+			Token:         forward.Token.Denom,
+			Amount:        forward.Token.Amount.String(),
+			Channel:       forward.IbcChannel,
+			TimeoutHeight: msgTransfer.TimeoutHeight.String(),
+			TimeoutTime:   fmt.Sprint(msgTransfer.TimeoutTimestamp),
+		},
+		&eventsv1.EventIbcAutoForwardExecuted{
+			EvmChainPrefix:   evmChainPrefix,
+			EventNonce:       forward.EventNonce,
+			ForeignReceiver:  forward.ForeignReceiver,
+			Amount:           *forward.Token,
+			Channel:          forward.IbcChannel,
+			TimeoutTimestamp: msgTransfer.TimeoutTimestamp,
+		},
+	)
 	if err != nil {
 		panic(err)
 	}
 }
 
 // logEmitIbcForwardFailureEvent logs failed IBC Auto-Forwarding and emits a EventSendToCosmosLocal type event
-func (k Keeper) logEmitIbcForwardFailureEvent(ctx sdk.Context, forward types.PendingIbcAutoForward, err error) {
+func (k Keeper) logEmitIbcForwardFailureEvent(ctx sdk.Context, evmChainPrefix string, forward types.PendingIbcAutoForward, err error) {
 	var localReceiver sdk.AccAddress
 	localReceiver, er := types.IBCAddressFromBech32(forward.ForeignReceiver) // checked valid bech32 receiver earlier
 	if er != nil {
@@ -311,12 +338,22 @@ func (k Keeper) logEmitIbcForwardFailureEvent(ctx sdk.Context, forward types.Pen
 		"claimNonce", forward.EventNonce, "cosmosBlockHeight", ctx.BlockHeight(), "err", err,
 	)
 
-	er = ctx.EventManager().EmitTypedEvent(&types.EventSendToCosmosLocal{
-		Nonce:    fmt.Sprint(forward.EventNonce),
-		Receiver: forward.ForeignReceiver,
-		Token:    forward.Token.Denom,
-		Amount:   forward.Token.Amount.String(),
-	})
+	er = ctx.EventManager().EmitTypedEvents(
+		&types.EventSendToCosmosLocal{
+			Nonce:    fmt.Sprint(forward.EventNonce),
+			Receiver: forward.ForeignReceiver,
+			Token:    forward.Token.Denom,
+			Amount:   forward.Token.Amount.String(),
+		},
+		&eventsv1.EventIbcAutoForwardFailed{
+			EvmChainPrefix:  evmChainPrefix,
+			EventNonce:      forward.EventNonce,
+			ForeignReceiver: forward.ForeignReceiver,
+			LocalReceiver:   localReceiver.String(),
+			Amount:          *forward.Token,
+			Channel:         forward.IbcChannel,
+		},
+	)
 	if er != nil {
 		panic(err)
 	}
@@ -49,14 +49,17 @@ func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 			newInt := sdk.NewInt(0)
 			expectedBals[v.Denom] = &newInt
 		}
-		expectedBals = sumUnconfirmedBatchModuleBalances(ctx, k, expectedBals)
-		expectedBals = sumUnbatchedTxModuleBalances(ctx, k, expectedBals)
-		expectedBals = sumPendingIbcAutoForwards(ctx, k, expectedBals)
+		for _, chain := range k.GetAllEvmChains(ctx) {
+			expectedBals = sumUnconfirmedBatchModuleBalances(ctx, k, chain.EvmChainPrefix, expectedBals)
+			expectedBals = sumUnbatchedTxModuleBalances(ctx, k, chain.EvmChainPrefix, expectedBals)
+			expectedBals = sumPendingIbcAutoForwards(ctx, k, chain.EvmChainPrefix, expectedBals)
+		}
+		expectedBals = sumMerkleAirdrops(ctx, k, expectedBals)
 
 		// Compare actual vs expected balances
 		for _, actual := range actualBals {
 			denom := actual.GetDenom()
-			cosmosOriginated, _, err := k.DenomToERC20Lookup(ctx, denom)
+			cosmosOriginated, err := isCosmosOriginatedDenom(ctx, k, denom)
 			if err != nil {
 				// Here we do not return because a user could halt the chain by gifting gravity a cosmos asset with no erc20 repr
 				ctx.Logger().Error("Unexpected gravity module balance of cosmos-originated asset with no erc20 representation", "asset", denom)
@@ -80,9 +83,23 @@ func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 
 /////// MODULE BALANCE HELPERS
 
+// isCosmosOriginatedDenom returns true if denom has an ERC20 representation on any EVM chain, false if it is a
+// gravity voucher and an error if it is neither
+func isCosmosOriginatedDenom(ctx sdk.Context, k Keeper, denom string) (bool, error) {
+	if _, _, err := types.GravityDenomToERC20(denom); err == nil {
+		return false, nil
+	}
+	for _, chain := range k.GetAllEvmChains(ctx) {
+		if _, found := k.GetCosmosOriginatedERC20(ctx, chain.EvmChainPrefix, denom); found {
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("denom %s is not a gravity voucher and has no erc20 representation", denom)
+}
+
 // sumUnconfirmedBatchModuleBalances calculate the value the module should have stored due to unconfirmed batches
-func sumUnconfirmedBatchModuleBalances(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
-	k.IterateOutgoingTxBatches(ctx, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+func sumUnconfirmedBatchModuleBalances(ctx sdk.Context, k Keeper, evmChainPrefix string, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
+	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
 		batchTotal := sdk.NewInt(0)
 		// Collect the send amount + fee amount for each tx
 		for _, tx := range batch.Transactions {
@@ -90,7 +107,7 @@ func sumUnconfirmedBatchModuleBalances(ctx sdk.Context, k Keeper, expectedBals m
 			batchTotal = newTotal
 		}
 		contract := batch.TokenContract
-		_, denom := k.ERC20ToDenomLookup(ctx, contract)
+		_, denom := k.ERC20ToDenomLookup(ctx, evmChainPrefix, contract)
 		// Add the batch total to the contract counter
 		_, ok := expectedBals[denom]
 		if !ok {
@@ -107,11 +124,11 @@ func sumUnconfirmedBatchModuleBalances(ctx sdk.Context, k Keeper, expectedBals m
 }
 
 // sumUnbatchedTxModuleBalances calculates the value the module should have stored due to unbatched txs
-func sumUnbatchedTxModuleBalances(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
+func sumUnbatchedTxModuleBalances(ctx sdk.Context, k Keeper, evmChainPrefix string, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
 	// It is also given the balance of all unbatched txs in the pool
-	k.IterateUnbatchedTransactions(ctx, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+	k.IterateUnbatchedTransactions(ctx, evmChainPrefix, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 		contract := tx.Erc20Token.Contract
-		_, denom := k.ERC20ToDenomLookup(ctx, contract)
+		_, denom := k.ERC20ToDenomLookup(ctx, evmChainPrefix, contract)
 
 		// Collect the send amount + fee amount for each tx
 		txTotal := tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount)
@@ -128,8 +145,8 @@ func sumUnbatchedTxModuleBalances(ctx sdk.Context, k Keeper, expectedBals map[st
 	return expectedBals
 }
 
-func sumPendingIbcAutoForwards(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
-	for _, forward := range k.PendingIbcAutoForwards(ctx, uint64(0)) {
+func sumPendingIbcAutoForwards(ctx sdk.Context, k Keeper, evmChainPrefix string, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
+	for _, forward := range k.PendingIbcAutoForwards(ctx, evmChainPrefix, uint64(0)) {
 		if _, ok := expectedBals[forward.Token.Denom]; !ok {
 			zero := sdk.ZeroInt()
 			expectedBals[forward.Token.Denom] = &zero
@@ -141,6 +158,21 @@ func sumPendingIbcAutoForwards(ctx sdk.Context, k Keeper, expectedBals map[strin
 	return expectedBals
 }
 
+// sumMerkleAirdrops calculates the value the module should have escrowed for unclaimed merkle airdrops
+func sumMerkleAirdrops(ctx sdk.Context, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
+	k.IterateMerkleAirdrops(ctx, func(_ []byte, airdrop types.MerkleAirdrop) bool {
+		if _, ok := expectedBals[airdrop.Denom]; !ok {
+			zero := sdk.ZeroInt()
+			expectedBals[airdrop.Denom] = &zero
+		}
+		*expectedBals[airdrop.Denom] = expectedBals[airdrop.Denom].Add(sdk.NewIntFromUint64(airdrop.Total - airdrop.Claimed))
+
+		return false // continue iterating
+	})
+
+	return expectedBals
+}
+
 // StoreValidityInvariant checks that the currently stored objects are not corrupted and all pass ValidateBasic checks
 // Note that the returned bool should be true if there is an error, e.g. an unexpected batch was processed
 func StoreValidityInvariant(k Keeper) sdk.Invariant {
@@ -149,22 +181,24 @@ func StoreValidityInvariant(k Keeper) sdk.Invariant {
 		if err != nil {
 			return err.Error(), true
 		}
-		// Assert that batch metadata is consistent and expected
-		err = CheckBatches(ctx, k)
-		if err != nil {
-			return err.Error(), true
-		}
+		for _, chain := range k.GetAllEvmChains(ctx) {
+			// Assert that batch metadata is consistent and expected
+			err = CheckBatches(ctx, k, chain.EvmChainPrefix)
+			if err != nil {
+				return err.Error(), true
+			}
 
-		// Assert that valsets have been updated in the expected manner
-		err = CheckValsets(ctx, k)
-		if err != nil {
-			return err.Error(), true
-		}
+			// Assert that valsets have been updated in the expected manner
+			err = CheckValsets(ctx, k, chain.EvmChainPrefix)
+			if err != nil {
+				return err.Error(), true
+			}
 
-		// Assert that pending ibc auto-forwards are only outgoing
-		err = CheckPendingIbcAutoForwards(ctx, k)
-		if err != nil {
-			return err.Error(), true
+			// Assert that pending ibc auto-forwards are only outgoing
+			err = CheckPendingIbcAutoForwards(ctx, k, chain.EvmChainPrefix)
+			if err != nil {
+				return err.Error(), true
+			}
 		}
 
 		// SUCCESS: If execution made it here, everything passes the sanity checks
@@ -204,9 +238,78 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 	if err != nil {
 		return err
 	}
+	// SequenceKeyPrefix HAS BEEN REMOVED
+
+	// KeyLastTXPoolID (type checked when fetching)
+	_ = k.getID(ctx, types.KeyLastTXPoolID)
+	// KeyLastOutgoingBatchID (type checked when fetching)
+	_ = k.getID(ctx, types.KeyLastOutgoingBatchID)
+	// KeyOrchestratorAddress
+	k.IterateValidatorsByOrchestratorAddress(ctx, func(key []byte, addr sdk.ValAddress) (stop bool) {
+		bech := addr.String()
+		if len(bech) == 0 || !strings.HasPrefix(bech, sdk.GetConfig().GetBech32ValidatorAddrPrefix()) {
+			err = fmt.Errorf("Invalid validator %v under key %v in IterateValidatorsByOrchestratorAddress", addr, key)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	// MerkleAirdropKey
+	k.IterateMerkleAirdrops(ctx, func(key []byte, airdrop types.MerkleAirdrop) (stop bool) {
+		if err = airdrop.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid MerkleAirdrop %v under key %v: %v", airdrop, key, err)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	// MerkleAirdropClaimKey
+	k.IterateMerkleAirdropClaims(ctx, func(key []byte, claim types.MerkleAirdropClaim) (stop bool) {
+		if err = claim.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid MerkleAirdropClaim %v under key %v: %v", claim, key, err)
+			return true
+		}
+		// claims of expired airdrops remain until they are pruned
+		if claim.AirdropId > k.getID(ctx, types.KeyLastMerkleAirdropID) {
+			err = fmt.Errorf("Discovered MerkleAirdropClaim %v under key %v for unknown airdrop", claim, key)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	// LastUnBondingBlockHeight (type is checked when fetching)
+	_ = k.GetLastUnBondingBlockHeight(ctx)
+
+	// Every EVM chain has its own copy of the remaining keys
+	for _, chain := range k.GetAllEvmChains(ctx) {
+		if err := validateEvmChainStore(ctx, k, chain.EvmChainPrefix); err != nil {
+			return err
+		}
+	}
+
+	// Finally the params, which are not placed in the store
+	params := k.GetParams(ctx)
+	err = params.ValidateBasic()
+	if err != nil {
+		return fmt.Errorf("Discovered invalid params %v: %v", params, err)
+	}
+
+	return nil
+}
+
+// validateEvmChainStore checks that all values stored for the given EVM chain can be decoded and pass a ValidateBasic check
+// nolint: gocyclo
+func validateEvmChainStore(ctx sdk.Context, k Keeper, evmChainPrefix string) error {
+	var err error = nil
 	// ValsetRequestKey
 	var actualLatestValsetNonce uint64 = 0
-	k.IterateValsets(ctx, func(key []byte, valset *types.Valset) (stop bool) {
+	k.IterateValsets(ctx, evmChainPrefix, func(key []byte, valset *types.Valset) (stop bool) {
 		err = valset.ValidateBasic()
 		if err != nil {
 			err = fmt.Errorf("Invalid valset %v in IterateValsets: %v", valset, err)
@@ -222,7 +325,7 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 	// ValsetConfirmKey
-	k.IterateValsetConfirms(ctx, func(key []byte, confirms []types.MsgValsetConfirm, nonce uint64) (stop bool) {
+	k.IterateValsetConfirms(ctx, evmChainPrefix, func(key []byte, confirms []types.MsgValsetConfirm, nonce uint64) (stop bool) {
 		for _, confirm := range confirms {
 			err = confirm.ValidateBasic()
 			if err != nil {
@@ -241,10 +344,10 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 	// OracleClaimKey HAS BEEN REMOVED
 
 	// LastObservedEventNonceKey (type checked when fetching)
-	lastObservedEventNonce := k.GetLastObservedEventNonce(ctx)
+	lastObservedEventNonce := k.GetLastObservedEventNonce(ctx, evmChainPrefix)
 	lastObservedEthereumClaimHeight := uint64(0) // used later to validate ethereum claim height
 	// OracleAttestationKey
-	k.IterateAttestations(ctx, false, func(key []byte, att types.Attestation) (stop bool) {
+	k.IterateAttestations(ctx, evmChainPrefix, false, func(key []byte, att types.Attestation) (stop bool) {
 		er := att.ValidateBasic(k.cdc)
 		if er != nil {
 			err = fmt.Errorf("Invalid attestation %v in IterateAttestations: %v", att, er)
@@ -271,7 +374,7 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 	// OutgoingTXPoolKey
-	k.IterateUnbatchedTransactions(ctx, func(key []byte, tx *types.InternalOutgoingTransferTx) (stop bool) {
+	k.IterateUnbatchedTransactions(ctx, evmChainPrefix, func(key []byte, tx *types.InternalOutgoingTransferTx) (stop bool) {
 		err = tx.ValidateBasic()
 		if err != nil {
 			err = fmt.Errorf("Invalid unbatched transaction %v under key %v in IterateUnbatchedTransactions: %v", tx, key, err)
@@ -283,7 +386,7 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 	// OutgoingTXBatchKey
-	k.IterateOutgoingTxBatches(ctx, func(key []byte, batch types.InternalOutgoingTxBatch) (stop bool) {
+	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(key []byte, batch types.InternalOutgoingTxBatch) (stop bool) {
 		err = batch.ValidateBasic()
 		if err != nil {
 			err = fmt.Errorf("Invalid outgoing batch %v under key %v in IterateOutgoingTxBatches: %v", batch, key, err)
@@ -295,7 +398,7 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 	// BatchConfirmKey
-	k.IterateBatchConfirms(ctx, func(key []byte, confirm types.MsgConfirmBatch) (stop bool) {
+	k.IterateBatchConfirms(ctx, evmChainPrefix, func(key []byte, confirm types.MsgConfirmBatch) (stop bool) {
 		err = confirm.ValidateBasic()
 		if err != nil {
 			err = fmt.Errorf("Invalid batch confirm %v under key %v in IterateBatchConfirms: %v", confirm, key, err)
@@ -307,32 +410,14 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 	// LastEventNonceByValidatorKey (type checked when fetching)
-	k.IterateValidatorLastEventNonces(ctx, func(key []byte, nonce uint64) (stop bool) {
-		return false
-	})
-	if err != nil {
-		return err
-	}
-	// SequenceKeyPrefix HAS BEEN REMOVED
-
-	// KeyLastTXPoolID (type checked when fetching)
-	_ = k.getID(ctx, types.KeyLastTXPoolID)
-	// KeyLastOutgoingBatchID (type checked when fetching)
-	_ = k.getID(ctx, types.KeyLastOutgoingBatchID)
-	// KeyOrchestratorAddress
-	k.IterateValidatorsByOrchestratorAddress(ctx, func(key []byte, addr sdk.ValAddress) (stop bool) {
-		bech := addr.String()
-		if len(bech) == 0 || !strings.HasPrefix(bech, sdk.GetConfig().GetBech32ValidatorAddrPrefix()) {
-			err = fmt.Errorf("Invalid validator %v under key %v in IterateValidatorsByOrchestratorAddress", addr, key)
-			return true
-		}
+	k.IterateValidatorLastEventNonces(ctx, evmChainPrefix, func(key []byte, nonce uint64) (stop bool) {
 		return false
 	})
 	if err != nil {
 		return err
 	}
 	// KeyOutgoingLogicCall
-	k.IterateOutgoingLogicCalls(ctx, func(key []byte, logicCall types.OutgoingLogicCall) (stop bool) {
+	k.IterateOutgoingLogicCalls(ctx, evmChainPrefix, func(key []byte, logicCall types.OutgoingLogicCall) (stop bool) {
 		err = logicCall.ValidateBasic()
 		if err != nil {
 			err = fmt.Errorf("Invalid logic call %v under key %v in IterateOutgoingLogicCalls: %v", logicCall, key, err)
@@ -344,7 +429,7 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 	// KeyOutgoingLogicConfirm
-	k.IterateLogicConfirms(ctx, func(key []byte, confirm *types.MsgConfirmLogicCall) (stop bool) {
+	k.IterateLogicConfirms(ctx, evmChainPrefix, func(key []byte, confirm *types.MsgConfirmLogicCall) (stop bool) {
 		err = confirm.ValidateBasic()
 		if err != nil {
 			err = fmt.Errorf("Invalid logic call confirm %v under key %v in IterateLogicConfirms: %v", confirm, key, err)
@@ -356,7 +441,7 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 	// LastObservedEthereumBlockHeightKey
-	lastEthHeight := k.GetLastObservedEthereumBlockHeight(ctx)
+	lastEthHeight := k.GetLastObservedEthereumBlockHeight(ctx, evmChainPrefix)
 	if lastEthHeight.EthereumBlockHeight < lastObservedEthereumClaimHeight {
 		err = fmt.Errorf(
 			"Stored last observed ethereum block height is less than the actual last observed height (%d < %d)",
@@ -368,7 +453,7 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 	// DenomToERC20Key
-	k.IterateCosmosOriginatedERC20s(ctx, func(key []byte, erc20 *types.EthAddress) (stop bool) {
+	k.IterateCosmosOriginatedERC20s(ctx, evmChainPrefix, func(key []byte, erc20 *types.EthAddress) (stop bool) {
 		if err = erc20.ValidateBasic(); err != nil {
 			err = fmt.Errorf("Discovered invalid cosmos originated erc20 %v under key %v: %v", erc20, key, err)
 			return true
@@ -379,7 +464,7 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 	// ERC20ToDenomKey
-	k.IterateERC20ToDenom(ctx, func(key []byte, erc20ToDenom *types.ERC20ToDenom) (stop bool) {
+	k.IterateERC20ToDenom(ctx, evmChainPrefix, func(key []byte, erc20ToDenom *types.ERC20ToDenom) (stop bool) {
 		if err = erc20ToDenom.ValidateBasic(); err != nil {
 			err = fmt.Errorf("Discovered invalid ERC20ToDenom %v under key %v: %v", erc20ToDenom, key, err)
 			return true
@@ -389,11 +474,53 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 	if err != nil {
 		return err
 	}
+	// ERC20MigrationKey
+	k.IterateERC20Migrations(ctx, evmChainPrefix, func(key []byte, migration types.ERC20Migration) (stop bool) {
+		if err = migration.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid ERC20Migration %v under key %v: %v", migration, key, err)
+			return true
+		}
+		if err = checkERC20Migration(ctx, k, evmChainPrefix, migration); err != nil {
+			err = fmt.Errorf("Discovered inconsistent ERC20Migration %v under key %v: %v", migration, key, err)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	// A denom may only resolve to a different ERC20 than the ERC20ToDenom index if the ERC20 has been migrated away from
+	k.IterateERC20ToDenom(ctx, evmChainPrefix, func(key []byte, erc20ToDenom *types.ERC20ToDenom) (stop bool) {
+		erc20, _ := types.NewEthAddress(erc20ToDenom.Erc20) // Checked above
+		current, found := k.GetCosmosOriginatedERC20(ctx, evmChainPrefix, erc20ToDenom.Denom)
+		if !found || current.GetAddress() == erc20.GetAddress() {
+			return false
+		}
+		if _, migrated := k.GetERC20Migration(ctx, evmChainPrefix, *erc20); !migrated {
+			err = fmt.Errorf("Discovered ERC20ToDenom %v under key %v which is not the ERC20 for its denom and has not been migrated", erc20ToDenom, key)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	// AttestedERC20DeploymentKey
+	k.IterateAttestedERC20Deployments(ctx, evmChainPrefix, func(key []byte, deployment types.AttestedERC20Deployment) (stop bool) {
+		if err = deployment.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid AttestedERC20Deployment %v under key %v: %v", deployment, key, err)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
 	// LastSlashedValsetNonce (type is checked when fetching)
-	_ = k.GetLastSlashedValsetNonce(ctx)
+	_ = k.GetLastSlashedValsetNonce(ctx, evmChainPrefix)
 
 	// LatestValsetNonce
-	latestValsetNonce := k.GetLatestValsetNonce(ctx)
+	latestValsetNonce := k.GetLatestValsetNonce(ctx, evmChainPrefix)
 	if latestValsetNonce != actualLatestValsetNonce {
 		return fmt.Errorf(
 			"GetLatestValsetNonce <> max(IterateValsets' nonce) mismatch (%d != %d)",
@@ -402,16 +529,13 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		)
 	}
 	// LastSlashedBatchBlock (type is checked when fetching)
-	_ = k.GetLastSlashedBatchBlock(ctx)
+	_ = k.GetLastSlashedBatchBlock(ctx, evmChainPrefix)
 
 	// LastSlashedLogicCallBlock (type is checked when fetching)
-	_ = k.GetLastSlashedLogicCallBlock(ctx)
-
-	// LastUnBondingBlockHeight (type is checked when fetching)
-	_ = k.GetLastUnBondingBlockHeight(ctx)
+	_ = k.GetLastSlashedLogicCallBlock(ctx, evmChainPrefix)
 
 	// LastObservedValsetKey
-	valset := k.GetLastObservedValset(ctx)
+	valset := k.GetLastObservedValset(ctx, evmChainPrefix)
 
 	if valset != nil {
 		err = valset.ValidateBasic()
@@ -420,13 +544,13 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return fmt.Errorf("Discovered invalid last observed valset %v: %v", valset, err)
 	}
 	// PastEthSignatureCheckpointKey
-	k.IteratePastEthSignatureCheckpoints(ctx, func(key []byte, value []byte) (stop bool) {
+	k.IteratePastEthSignatureCheckpoints(ctx, evmChainPrefix, func(key []byte, value []byte) (stop bool) {
 		// Check is performed in the iterator function
 		return false
 	})
 
 	// PendingIbcAutoForwards
-	k.IteratePendingIbcAutoForwards(ctx, func(key []byte, forward *types.PendingIbcAutoForward) (stop bool) {
+	k.IteratePendingIbcAutoForwards(ctx, evmChainPrefix, func(key []byte, forward *types.PendingIbcAutoForward) (stop bool) {
 		if err = forward.ValidateBasic(); err != nil {
 			err = fmt.Errorf("Discovered invalid PendingIbcAutoForward %v under key %v: %v", forward, key, err)
 			return true
@@ -437,21 +561,33 @@ func ValidateStore(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 
-	// Finally the params, which are not placed in the store
-	params := k.GetParams(ctx)
-	err = params.ValidateBasic()
-	if err != nil {
-		return fmt.Errorf("Discovered invalid params %v: %v", params, err)
-	}
+	return nil
+}
 
+// checkERC20Migration checks that a migrated away ERC20 still resolves to its denom, so that deposits and refunds of
+// the old ERC20 are handled correctly, while the denom no longer resolves to the old ERC20 and it is not used by any
+// unbatched transaction
+func checkERC20Migration(ctx sdk.Context, k Keeper, evmChainPrefix string, migration types.ERC20Migration) error {
+	oldErc20, _ := types.NewEthAddress(migration.OldErc20) // Checked by ValidateBasic
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, evmChainPrefix, *oldErc20)
+	if !isCosmosOriginated || denom != migration.CosmosDenom {
+		return fmt.Errorf("old erc20 resolves to %s instead of %s", denom, migration.CosmosDenom)
+	}
+	current, found := k.GetCosmosOriginatedERC20(ctx, evmChainPrefix, migration.CosmosDenom)
+	if !found || current.GetAddress() == oldErc20.GetAddress() {
+		return fmt.Errorf("denom %s still resolves to the old erc20", migration.CosmosDenom)
+	}
+	if len(k.GetUnbatchedTransactionsByContract(ctx, evmChainPrefix, *oldErc20)) != 0 {
+		return fmt.Errorf("unbatched transactions still use the old erc20")
+	}
 	return nil
 }
 
 // CheckBatches checks that all batch related data in the store is appropriate
 // Returns an error string and a boolean indicating an error if true, for use in an invariant
-func CheckBatches(ctx sdk.Context, k Keeper) error {
+func CheckBatches(ctx sdk.Context, k Keeper, evmChainPrefix string) error {
 	// Get the in-progress batches by the batch nonces
-	inProgressBatches := k.GetOutgoingTxBatchesByNonce(ctx)
+	inProgressBatches := k.GetOutgoingTxBatchesByNonce(ctx, evmChainPrefix)
 	if len(inProgressBatches) == 0 {
 		return nil // nothing to check against
 	}
@@ -479,7 +615,7 @@ func CheckBatches(ctx sdk.Context, k Keeper) error {
 	}
 
 	var err error = nil
-	k.IterateClaims(ctx, true, types.CLAIM_TYPE_BATCH_SEND_TO_ETH, func(key []byte, att types.Attestation, claim types.EthereumClaim) (stop bool) {
+	k.IterateClaims(ctx, evmChainPrefix, true, types.CLAIM_TYPE_BATCH_SEND_TO_ETH, func(key []byte, att types.Attestation, claim types.EthereumClaim) (stop bool) {
 		batchClaim := claim.(*types.MsgBatchSendToEthClaim)
 		// Executed (aka observed) batches should have strictly lesser batch nonces than the in progress batches for the same token contract
 		// note that batches for different tokens have the same nonce stream but don't invalidate each other (nonces should probably be separate per token type)
@@ -498,15 +634,15 @@ func CheckBatches(ctx sdk.Context, k Keeper) error {
 
 // CheckValsets checks that all valset related data in the store is appropriate
 // Returns an error string and a boolean indicating an error if true, for use in an invariant
-func CheckValsets(ctx sdk.Context, k Keeper) error {
-	valsets := k.GetValsets(ctx) // Highest to lowest nonce
+func CheckValsets(ctx sdk.Context, k Keeper, evmChainPrefix string) error {
+	valsets := k.GetValsets(ctx, evmChainPrefix) // Highest to lowest nonce
 	if len(valsets) == 0 {
-		if k.GetLatestValsetNonce(ctx) != 0 {
+		if k.GetLatestValsetNonce(ctx, evmChainPrefix) != 0 {
 			return fmt.Errorf("No valsets in store but the latest valset nonce is nonzero!")
 		}
 		return nil
 	}
-	latest := k.GetLatestValset(ctx) // Should have the highest nonce
+	latest := k.GetLatestValset(ctx, evmChainPrefix) // Should have the highest nonce
 	equal, err := latest.Equal(valsets[0])
 	if err != nil {
 		return fmt.Errorf("Unable to compare latest valsets: %s", err.Error())
@@ -515,7 +651,7 @@ func CheckValsets(ctx sdk.Context, k Keeper) error {
 		return fmt.Errorf("Latest stored valset (%v) is unexpectedly different from GetLatestValset() (%v)", valsets[0], latest)
 	}
 	// Should have power diff of less than 0.05 between the current valset and the last stored one
-	current, err := k.GetCurrentValset(ctx)
+	current, err := k.GetCurrentValset(ctx, evmChainPrefix)
 	if err != nil {
 		return fmt.Errorf("Unable to retrieve current valsets: %s", err.Error())
 	}
@@ -531,17 +667,17 @@ func CheckValsets(ctx sdk.Context, k Keeper) error {
 	// The previously stored valsets may have been created for multiple reasons, so we make no more power diff checks
 
 	// Check the stored valsets against the observed valset update attestations
-	k.IterateClaims(ctx, true, types.CLAIM_TYPE_VALSET_UPDATED, func(key []byte, att types.Attestation, claim types.EthereumClaim) (stop bool) {
+	k.IterateClaims(ctx, evmChainPrefix, true, types.CLAIM_TYPE_VALSET_UPDATED, func(key []byte, att types.Attestation, claim types.EthereumClaim) (stop bool) {
 		if !att.Observed {
 			// This claim is in-progress, malicious, or erroneous - continue to the next one
 			return false
 		}
 		claimVs := claim.(*types.MsgValsetUpdatedClaim) // Claim's valset
 		claimNonce := claimVs.ValsetNonce
-		storedVs := k.GetValset(ctx, claimNonce)
+		storedVs := k.GetValset(ctx, evmChainPrefix, claimNonce)
 		if storedVs == nil {
 			// We have found the earliest stored valset, all valsets before it should have been pruned
-			shouldNotExist := k.GetValset(ctx, claimNonce-1)
+			shouldNotExist := k.GetValset(ctx, evmChainPrefix, claimNonce-1)
 			if shouldNotExist != nil {
 				err = fmt.Errorf("Discovered a valset missing from the store: nonce %d is present but nonce %d is not", shouldNotExist.Nonce, claimNonce)
 				return true
@@ -573,9 +709,9 @@ func CheckValsets(ctx sdk.Context, k Keeper) error {
 }
 
 // CheckPendingIbcAutoForwards checks each forward is appropriate and also that the transfer module holds enough of each token
-func CheckPendingIbcAutoForwards(ctx sdk.Context, k Keeper) error {
+func CheckPendingIbcAutoForwards(ctx sdk.Context, k Keeper, evmChainPrefix string) error {
 	nativeHrp := sdk.GetConfig().GetBech32AccountAddrPrefix()
-	pendingForwards := k.PendingIbcAutoForwards(ctx, 0)
+	pendingForwards := k.PendingIbcAutoForwards(ctx, evmChainPrefix, 0)
 	for _, fwd := range pendingForwards {
 		// Check the foreign address
 		hrp, _, err := bech32.DecodeAndConvert(fwd.ForeignReceiver)
@@ -29,7 +29,7 @@ func TestModuleBalanceUnbatchedTxs(t *testing.T) {
 	// mint some voucher first
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
-	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 	// set senders balance
@@ -45,12 +45,12 @@ func TestModuleBalanceUnbatchedTxs(t *testing.T) {
 	for i, v := range []uint64{2, 3, 2, 1} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NotZero(t, r)
 		require.NoError(t, err)
 		// Should create:
@@ -62,7 +62,7 @@ func TestModuleBalanceUnbatchedTxs(t *testing.T) {
 	checkInvariant(t, ctx, input.GravityKeeper, true)
 
 	// Remove one of the transactions
-	err = input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 1, mySender)
+	err = input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, 1, mySender)
 	require.NoError(t, err)
 	checkInvariant(t, ctx, input.GravityKeeper, true)
 
@@ -70,7 +70,7 @@ func TestModuleBalanceUnbatchedTxs(t *testing.T) {
 	oneVoucher, err := types.NewInternalERC20Token(sdk.NewInt(1), myTokenContractAddr)
 	require.NoError(t, err)
 
-	checkImbalancedModule(t, ctx, input.GravityKeeper, input.BankKeeper, mySender, sdk.NewCoins(oneVoucher.GravityCoin()))
+	checkImbalancedModule(t, ctx, input.GravityKeeper, input.BankKeeper, mySender, sdk.NewCoins(oneVoucher.GravityCoin(EthChainPrefix)))
 }
 
 // Tests that the gravity module's balance is accounted for with batches of txs, including unbatched txs and tx cancellation
@@ -102,12 +102,12 @@ func TestModuleBalanceBatchedTxs(t *testing.T) {
 	voucher2, err := types.NewInternalERC20Token(sdk.NewInt(1), myTokenContractAddr2.GetAddress().Hex())
 	require.NoError(t, err)
 	voucherCoins := []sdk.Coins{
-		sdk.NewCoins(voucher1.GravityCoin()),
-		sdk.NewCoins(voucher2.GravityCoin()),
+		sdk.NewCoins(voucher1.GravityCoin(EthChainPrefix)),
+		sdk.NewCoins(voucher2.GravityCoin(EthChainPrefix)),
 	}
 	allVouchers := []sdk.Coins{
-		sdk.NewCoins(tokens[0].GravityCoin()),
-		sdk.NewCoins(tokens[1].GravityCoin()),
+		sdk.NewCoins(tokens[0].GravityCoin(EthChainPrefix)),
+		sdk.NewCoins(tokens[1].GravityCoin(EthChainPrefix)),
 	}
 
 	// mint some voucher first
@@ -117,7 +117,7 @@ func TestModuleBalanceBatchedTxs(t *testing.T) {
 		input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, v))
 	}
-	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 1234567)
+	input.GravityKeeper.SetLastObservedEthereumBlockHeight(ctx, EthChainPrefix, 1234567)
 
 	////////////////// EXECUTE //////////////////
 	// Check the invariant without any transactions
@@ -128,12 +128,12 @@ func TestModuleBalanceBatchedTxs(t *testing.T) {
 		for i, v := range []uint64{2, 3, 2, 1, 2, 4, 5, 1} {
 			amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), tok.Contract.GetAddress().Hex())
 			require.NoError(t, err)
-			amount := amountToken.GravityCoin()
+			amount := amountToken.GravityCoin(EthChainPrefix)
 			feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), tok.Contract.GetAddress().Hex())
 			require.NoError(t, err)
-			fee := feeToken.GravityCoin()
+			fee := feeToken.GravityCoin(EthChainPrefix)
 
-			r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+			r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amount, fee)
 			require.NoError(t, err)
 			ctx.Logger().Info(fmt.Sprintf("Created transaction %v with amount %v and fee %v", r, amount, fee))
 			// Should create:
@@ -152,10 +152,10 @@ func TestModuleBalanceBatchedTxs(t *testing.T) {
 		// when
 		ctx = ctx.WithBlockTime(now)
 		// tx batch size is 3, so that some of them stay behind
-		batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, tok.Contract, 3)
+		batch, err := input.GravityKeeper.BuildOutgoingTXBatch(ctx, EthChainPrefix, tok.Contract, 3)
 		require.NoError(t, err)
 		// then check the batch persists
-		gotBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce)
+		gotBatch := input.GravityKeeper.GetOutgoingTXBatch(ctx, EthChainPrefix, batch.TokenContract, batch.BatchNonce)
 		require.NotNil(t, gotBatch)
 		batches[i] = gotBatch
 		// The module should be balanced with the new unobserved batch + leftover unbatched txs
@@ -163,8 +163,8 @@ func TestModuleBalanceBatchedTxs(t *testing.T) {
 		checkImbalancedModule(t, ctx, input.GravityKeeper, input.BankKeeper, mySender, voucherCoins[i])
 	}
 	// Remove a tx from the pool for each contract (both of these have fee = 1 and won't be batched
-	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 4, mySender))
-	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, 8, mySender))
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, 4, mySender))
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, 8, mySender))
 
 	// Here we execute the most recently created batch to test the module's balance is correct after deletion of the first batch
 	// All of the batch's transactions need to end up back in the unbatched tx pool and should be counted there for us
@@ -182,7 +182,7 @@ func TestModuleBalanceBatchedTxs(t *testing.T) {
 		TokenContract:  "",
 		Orchestrator:   "",
 	}
-	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, batches[1].TokenContract, msg)
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, EthChainPrefix, batches[1].TokenContract, msg)
 	// The module should be balanced with the batch now being observed + one leftover unbatched tx still in the pool
 	checkInvariant(t, ctx, input.GravityKeeper, true)
 	checkImbalancedModule(t, ctx, input.GravityKeeper, input.BankKeeper, mySender, voucherCoins[0])
@@ -168,10 +168,65 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
-// GetBridgeContractAddress returns the bridge contract address on ETH
-func (k Keeper) GetBridgeContractAddress(ctx sdk.Context) *types.EthAddress {
+// GetAllEvmChains returns the params of every bridged EVM chain, starting with the default chain
+func (k Keeper) GetAllEvmChains(ctx sdk.Context) []types.EvmChainParams {
+	return k.GetParams(ctx).AllEvmChains()
+}
+
+// GetEvmChainParams returns the params of the EVM chain identified by evmChainPrefix, panicking if the chain is
+// not bridged. Prefixes from users must be checked with ResolveEvmChainPrefix first
+func (k Keeper) GetEvmChainParams(ctx sdk.Context, evmChainPrefix string) types.EvmChainParams {
+	if evmChainPrefix == types.DefaultEvmChainPrefix {
+		return k.GetParams(ctx).DefaultEvmChainParams()
+	}
+	var chains []types.EvmChainParams
+	k.paramSpace.Get(ctx, types.ParamStoreEvmChains, &chains)
+	for _, chain := range chains {
+		if chain.EvmChainPrefix == evmChainPrefix {
+			return chain
+		}
+	}
+	panic(fmt.Sprintf("evm chain %s is not bridged", evmChainPrefix))
+}
+
+// InitEvmChain sets the nonces of an EVM chain which was added to the EvmChains param after genesis,
+// chains which are already initialized are left alone
+func (k Keeper) InitEvmChain(ctx sdk.Context, evmChainPrefix string) {
+	if k.CheckLatestValsetNonce(ctx, evmChainPrefix) {
+		return
+	}
+	ctx.Logger().Info("Initializing evm chain", "evm-chain-prefix", evmChainPrefix)
+	k.SetLatestValsetNonce(ctx, evmChainPrefix, 0)
+	k.SetLastSlashedValsetNonce(ctx, evmChainPrefix, 0)
+	k.SetLastSlashedBatchBlock(ctx, evmChainPrefix, 0)
+	k.SetLastSlashedLogicCallBlock(ctx, evmChainPrefix, 0)
+}
+
+// ResolveEvmChainPrefix turns the evm chain prefix of a Msg or query into the prefix of a bridged EVM chain,
+// an empty prefix selects the default chain
+func (k Keeper) ResolveEvmChainPrefix(ctx sdk.Context, evmChainPrefix string) (string, error) {
+	evmChainPrefix = types.EvmChainPrefixOrDefault(evmChainPrefix)
+	if evmChainPrefix == types.DefaultEvmChainPrefix {
+		return evmChainPrefix, nil
+	}
+	var chains []types.EvmChainParams
+	k.paramSpace.Get(ctx, types.ParamStoreEvmChains, &chains)
+	for _, chain := range chains {
+		if chain.EvmChainPrefix == evmChainPrefix {
+			return evmChainPrefix, nil
+		}
+	}
+	return "", sdkerrors.Wrapf(types.ErrInvalid, "evm chain %s is not bridged", evmChainPrefix)
+}
+
+// GetBridgeContractAddress returns the bridge contract address on the given EVM chain
+func (k Keeper) GetBridgeContractAddress(ctx sdk.Context, evmChainPrefix string) *types.EthAddress {
 	var a string
-	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeEthereumAddress, &a)
+	if evmChainPrefix == types.DefaultEvmChainPrefix {
+		k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeEthereumAddress, &a)
+	} else {
+		a = k.GetEvmChainParams(ctx, evmChainPrefix).BridgeEthereumAddress
+	}
 	addr, err := types.NewEthAddress(a)
 	if err != nil {
 		panic(sdkerrors.Wrapf(err, "found invalid bridge contract address in store: %v", a))
@@ -179,8 +234,11 @@ func (k Keeper) GetBridgeContractAddress(ctx sdk.Context) *types.EthAddress {
 	return addr
 }
 
-// GetBridgeChainID returns the chain id of the ETH chain we are running against
-func (k Keeper) GetBridgeChainID(ctx sdk.Context) uint64 {
+// GetBridgeChainID returns the chain id of the given EVM chain
+func (k Keeper) GetBridgeChainID(ctx sdk.Context, evmChainPrefix string) uint64 {
+	if evmChainPrefix != types.DefaultEvmChainPrefix {
+		return k.GetEvmChainParams(ctx, evmChainPrefix).BridgeChainId
+	}
 	var a uint64
 	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractChainID, &a)
 	return a
@@ -196,7 +254,13 @@ func (k Keeper) GetBridgeChainID(ctx sdk.Context) uint64 {
 // is deployed the GravityID CAN NOT BE CHANGED. Meaning that it can't just be the
 // same as the chain id since the chain id may be changed many times with each
 // successive chain in charge of the same bridge
-func (k Keeper) GetGravityID(ctx sdk.Context) string {
+//
+// Every bridged EVM chain has its own GravityID, so signatures for one EVM chain
+// can not be played back on another
+func (k Keeper) GetGravityID(ctx sdk.Context, evmChainPrefix string) string {
+	if evmChainPrefix != types.DefaultEvmChainPrefix {
+		return k.GetEvmChainParams(ctx, evmChainPrefix).GravityId
+	}
 	var a string
 	k.paramSpace.Get(ctx, types.ParamsStoreKeyGravityID, &a)
 	return a
@@ -379,26 +443,26 @@ func (k Keeper) IterateValidatorsByOrchestratorAddress(ctx sdk.Context, cb func(
 
 // SetLastSlashedLogicCallBlock returns true if the last slashed logic call block
 // has been set in the store
-func (k Keeper) HasLastSlashedLogicCallBlock(ctx sdk.Context) bool {
+func (k Keeper) HasLastSlashedLogicCallBlock(ctx sdk.Context, evmChainPrefix string) bool {
 	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.LastSlashedLogicCallBlock)
+	return store.Has(types.AppendEvmChainPrefix(types.LastSlashedLogicCallBlock, evmChainPrefix))
 }
 
 // SetLastSlashedLogicCallBlock sets the latest slashed logic call block height
-func (k Keeper) SetLastSlashedLogicCallBlock(ctx sdk.Context, blockHeight uint64) {
+func (k Keeper) SetLastSlashedLogicCallBlock(ctx sdk.Context, evmChainPrefix string, blockHeight uint64) {
 
-	if k.HasLastSlashedLogicCallBlock(ctx) && k.GetLastSlashedLogicCallBlock(ctx) > blockHeight {
+	if k.HasLastSlashedLogicCallBlock(ctx, evmChainPrefix) && k.GetLastSlashedLogicCallBlock(ctx, evmChainPrefix) > blockHeight {
 		panic("Attempted to decrement LastSlashedBatchBlock")
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.LastSlashedLogicCallBlock, types.UInt64Bytes(blockHeight))
+	store.Set(types.AppendEvmChainPrefix(types.LastSlashedLogicCallBlock, evmChainPrefix), types.UInt64Bytes(blockHeight))
 }
 
 // GetLastSlashedLogicCallBlock returns the latest slashed logic call block
-func (k Keeper) GetLastSlashedLogicCallBlock(ctx sdk.Context) uint64 {
+func (k Keeper) GetLastSlashedLogicCallBlock(ctx sdk.Context, evmChainPrefix string) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.LastSlashedLogicCallBlock)
+	bytes := store.Get(types.AppendEvmChainPrefix(types.LastSlashedLogicCallBlock, evmChainPrefix))
 
 	if len(bytes) == 0 {
 		panic("Last slashed logic call block not initialized in genesis")
@@ -407,9 +471,9 @@ func (k Keeper) GetLastSlashedLogicCallBlock(ctx sdk.Context) uint64 {
 }
 
 // GetUnSlashedLogicCalls returns all the unslashed logic calls in state
-func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, maxHeight uint64) (out []types.OutgoingLogicCall) {
-	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx)
-	calls := k.GetOutgoingLogicCalls(ctx)
+func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, evmChainPrefix string, maxHeight uint64) (out []types.OutgoingLogicCall) {
+	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx, evmChainPrefix)
+	calls := k.GetOutgoingLogicCalls(ctx, evmChainPrefix)
 	for _, call := range calls {
 		if call.CosmosBlockCreated > lastSlashedLogicCallBlock {
 			out = append(out, call)
@@ -469,12 +533,12 @@ func (k Keeper) DeserializeValidatorIterator(vals []byte) stakingtypes.ValAddres
 }
 
 // Checks if the provided Ethereum address is on the Governance blacklist
-func (k Keeper) IsOnBlacklist(ctx sdk.Context, addr types.EthAddress) bool {
-	params := k.GetParams(ctx)
+func (k Keeper) IsOnBlacklist(ctx sdk.Context, evmChainPrefix string, addr types.EthAddress) bool {
+	blacklist := k.GetEvmChainParams(ctx, evmChainPrefix).EthereumBlacklist
 	// Checks the address if it's inside the blacklisted address list and marks
 	// if it's inside the list.
-	for index := 0; index < len(params.EthereumBlacklist); index++ {
-		baddr, err := types.NewEthAddress(params.EthereumBlacklist[index])
+	for index := 0; index < len(blacklist); index++ {
+		baddr, err := types.NewEthAddress(blacklist[index])
 		if err != nil {
 			// this should not be possible we validate on genesis load
 			panic("unvalidated black list address!")
@@ -492,6 +556,6 @@ func (k Keeper) IsOnBlacklist(ctx sdk.Context, addr types.EthAddress) bool {
 // blacklist. (2) is not yet implemented
 // Blocking some addresses is technically motivated, if any ERC20 transfers in a batch fail the entire batch
 // becomes impossible to execute.
-func (k Keeper) InvalidSendToEthAddress(ctx sdk.Context, addr types.EthAddress, _erc20Addr types.EthAddress) bool {
-	return k.IsOnBlacklist(ctx, addr) || addr == types.ZeroAddress()
+func (k Keeper) InvalidSendToEthAddress(ctx sdk.Context, evmChainPrefix string, addr types.EthAddress, _erc20Addr types.EthAddress) bool {
+	return k.IsOnBlacklist(ctx, evmChainPrefix, addr) || addr == types.ZeroAddress()
 }
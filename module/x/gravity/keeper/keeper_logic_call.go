@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
@@ -15,8 +16,12 @@ import (
 /////////////////////
 
 // GetOutgoingLogicCall gets an outgoing logic call
-func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) *types.OutgoingLogicCall {
+func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, evmChainPrefix string, invalidationID []byte, invalidationNonce uint64) *types.OutgoingLogicCall {
 	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOutgoingLogicCallKey(evmChainPrefix, invalidationID, invalidationNonce))
+	if bz == nil {
+		return nil
+	}
 	call := types.OutgoingLogicCall{
 		Transfers:            []types.ERC20Token{},
 		Fees:                 []types.ERC20Token{},
@@ -27,19 +32,19 @@ func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, inv
 		InvalidationNonce:    invalidationNonce,
 		CosmosBlockCreated:   0,
 	}
-	k.cdc.MustUnmarshal(store.Get(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce)), &call)
+	k.cdc.MustUnmarshal(bz, &call)
 	return &call
 }
 
 // SetOutogingLogicCall sets an outgoing logic call, panics if one already exists at this
 // index, since we collect signatures over logic calls no mutation can be valid
-func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call types.OutgoingLogicCall) {
+func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, evmChainPrefix string, call types.OutgoingLogicCall) {
 	store := ctx.KVStore(k.storeKey)
 
 	// Store checkpoint to prove that this logic call actually happened
-	checkpoint := call.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
-	key := types.GetOutgoingLogicCallKey(call.InvalidationId, call.InvalidationNonce)
+	checkpoint := call.GetCheckpoint(k.GetGravityID(ctx, evmChainPrefix))
+	k.SetPastEthSignatureCheckpoint(ctx, evmChainPrefix, checkpoint)
+	key := types.GetOutgoingLogicCallKey(evmChainPrefix, call.InvalidationId, call.InvalidationNonce)
 	if store.Has(key) {
 		panic("Can not overwrite logic call")
 	}
@@ -48,13 +53,13 @@ func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call types.OutgoingLogicCa
 }
 
 // DeleteOutgoingLogicCall deletes outgoing logic calls
-func (k Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
-	ctx.KVStore(k.storeKey).Delete(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
+func (k Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, evmChainPrefix string, invalidationID []byte, invalidationNonce uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetOutgoingLogicCallKey(evmChainPrefix, invalidationID, invalidationNonce))
 }
 
 // IterateOutgoingLogicCalls iterates over outgoing logic calls
-func (k Keeper) IterateOutgoingLogicCalls(ctx sdk.Context, cb func([]byte, types.OutgoingLogicCall) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyOutgoingLogicCall)
+func (k Keeper) IterateOutgoingLogicCalls(ctx sdk.Context, evmChainPrefix string, cb func([]byte, types.OutgoingLogicCall) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendEvmChainPrefix(types.KeyOutgoingLogicCall, evmChainPrefix))
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -68,8 +73,8 @@ func (k Keeper) IterateOutgoingLogicCalls(ctx sdk.Context, cb func([]byte, types
 }
 
 // GetOutgoingLogicCalls returns the outgoing logic calls
-func (k Keeper) GetOutgoingLogicCalls(ctx sdk.Context) (out []types.OutgoingLogicCall) {
-	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call types.OutgoingLogicCall) bool {
+func (k Keeper) GetOutgoingLogicCalls(ctx sdk.Context, evmChainPrefix string) (out []types.OutgoingLogicCall) {
+	k.IterateOutgoingLogicCalls(ctx, evmChainPrefix, func(_ []byte, call types.OutgoingLogicCall) bool {
 		out = append(out, call)
 		return false
 	})
@@ -77,20 +82,25 @@ func (k Keeper) GetOutgoingLogicCalls(ctx sdk.Context) (out []types.OutgoingLogi
 }
 
 // CancelOutgoingLogicCalls releases all TX in the batch and deletes the batch
-func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64) error {
-	call := k.GetOutgoingLogicCall(ctx, invalidationId, invalidationNonce)
+func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, evmChainPrefix string, invalidationId []byte, invalidationNonce uint64) error {
+	call := k.GetOutgoingLogicCall(ctx, evmChainPrefix, invalidationId, invalidationNonce)
 	if call == nil {
 		return types.ErrUnknown
 	}
 	// Delete batch since it is finished
-	k.DeleteOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
+	k.DeleteOutgoingLogicCall(ctx, evmChainPrefix, call.InvalidationId, call.InvalidationNonce)
 
 	// a consuming application will have to watch for this event and act on it
-	return ctx.EventManager().EmitTypedEvent(
+	return ctx.EventManager().EmitTypedEvents(
 		&types.EventOutgoingLogicCallCanceled{
 			LogicCallInvalidationId:    fmt.Sprint(call.InvalidationId),
 			LogicCallInvalidationNonce: fmt.Sprint(call.InvalidationNonce),
 		},
+		&eventsv1.EventLogicCallCanceled{
+			EvmChainPrefix:    evmChainPrefix,
+			InvalidationId:    hex.EncodeToString(call.InvalidationId),
+			InvalidationNonce: call.InvalidationNonce,
+		},
 	)
 }
 
@@ -99,7 +109,7 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 /////////////////////////////
 
 // SetLogicCallConfirm sets a logic confirm in the store
-func (k Keeper) SetLogicCallConfirm(ctx sdk.Context, msg *types.MsgConfirmLogicCall) {
+func (k Keeper) SetLogicCallConfirm(ctx sdk.Context, evmChainPrefix string, msg *types.MsgConfirmLogicCall) {
 	bytes, err := hex.DecodeString(msg.InvalidationId)
 	if err != nil {
 		panic(err)
@@ -111,17 +121,17 @@ func (k Keeper) SetLogicCallConfirm(ctx sdk.Context, msg *types.MsgConfirmLogicC
 	}
 
 	ctx.KVStore(k.storeKey).
-		Set(types.GetLogicConfirmKey(bytes, msg.InvalidationNonce, acc), k.cdc.MustMarshal(msg))
+		Set(types.GetLogicConfirmKey(evmChainPrefix, bytes, msg.InvalidationNonce, acc), k.cdc.MustMarshal(msg))
 }
 
 // GetLogicCallConfirm gets a logic confirm from the store
-func (k Keeper) GetLogicCallConfirm(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64, val sdk.AccAddress) *types.MsgConfirmLogicCall {
+func (k Keeper) GetLogicCallConfirm(ctx sdk.Context, evmChainPrefix string, invalidationId []byte, invalidationNonce uint64, val sdk.AccAddress) *types.MsgConfirmLogicCall {
 	if err := sdk.VerifyAddressFormat(val); err != nil {
 		ctx.Logger().Error("invalid val address")
 		return nil
 	}
 	store := ctx.KVStore(k.storeKey)
-	data := store.Get(types.GetLogicConfirmKey(invalidationId, invalidationNonce, val))
+	data := store.Get(types.GetLogicConfirmKey(evmChainPrefix, invalidationId, invalidationNonce, val))
 	if data == nil {
 		return nil
 	}
@@ -138,31 +148,31 @@ func (k Keeper) GetLogicCallConfirm(ctx sdk.Context, invalidationId []byte, inva
 
 // DeleteLogicCallConfirm deletes a logic confirm from the store
 func (k Keeper) DeleteLogicCallConfirm(
-	ctx sdk.Context,
+	ctx sdk.Context, evmChainPrefix string,
 	invalidationID []byte,
 	invalidationNonce uint64,
 	val sdk.AccAddress) {
-	ctx.KVStore(k.storeKey).Delete(types.GetLogicConfirmKey(invalidationID, invalidationNonce, val))
+	ctx.KVStore(k.storeKey).Delete(types.GetLogicConfirmKey(evmChainPrefix, invalidationID, invalidationNonce, val))
 }
 
 // IterateLogicConfirmsByInvalidationIDAndNonce iterates over all logic confirms stored by invalidation id and nonce,
 // applying the given callback on each discovered confirm.
 // cb should return true to stop iteration, false to continue
 func (k Keeper) IterateLogicConfirmsByInvalidationIDAndNonce(
-	ctx sdk.Context,
+	ctx sdk.Context, evmChainPrefix string,
 	invalidationID []byte,
 	invalidationNonce uint64,
 	cb func(key []byte, confirm *types.MsgConfirmLogicCall) (stop bool),
 ) {
-	prefix := types.GetLogicConfirmNonceInvalidationIdPrefix(invalidationID, invalidationNonce)
+	prefix := types.GetLogicConfirmNonceInvalidationIdPrefix(evmChainPrefix, invalidationID, invalidationNonce)
 	k.iterateLogicConfirmsByPrefix(ctx, prefix, cb)
 }
 
 // IterateLogicConfirmsByInvalidationIDAndNonce iterates over all logic confirms in the store applying the given
 // callback on each discovered confirm.
 // cb should return true to stop iteration, false to continue
-func (k Keeper) IterateLogicConfirms(ctx sdk.Context, cb func(key []byte, confirm *types.MsgConfirmLogicCall) (stop bool)) {
-	prefix := types.KeyOutgoingLogicConfirm
+func (k Keeper) IterateLogicConfirms(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, confirm *types.MsgConfirmLogicCall) (stop bool)) {
+	prefix := types.AppendEvmChainPrefix(types.KeyOutgoingLogicConfirm, evmChainPrefix)
 	k.iterateLogicConfirmsByPrefix(ctx, prefix, cb)
 }
 
@@ -186,8 +196,8 @@ func (k Keeper) iterateLogicConfirmsByPrefix(ctx sdk.Context, prefix []byte, cb
 }
 
 // GetLogicConfirmsByInvalidationIdAndNonce returns the logic call confirms
-func (k Keeper) GetLogicConfirmsByInvalidationIdAndNonce(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64) (out []types.MsgConfirmLogicCall) {
-	k.IterateLogicConfirmsByInvalidationIDAndNonce(ctx, invalidationId, invalidationNonce, func(_ []byte, msg *types.MsgConfirmLogicCall) bool {
+func (k Keeper) GetLogicConfirmsByInvalidationIdAndNonce(ctx sdk.Context, evmChainPrefix string, invalidationId []byte, invalidationNonce uint64) (out []types.MsgConfirmLogicCall) {
+	k.IterateLogicConfirmsByInvalidationIDAndNonce(ctx, evmChainPrefix, invalidationId, invalidationNonce, func(_ []byte, msg *types.MsgConfirmLogicCall) bool {
 		out = append(out, *msg)
 		return false
 	})
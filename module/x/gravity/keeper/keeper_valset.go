@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
@@ -24,27 +25,33 @@ import (
 // is signed by consensus. If you want to peek at the present state of the set
 // and perhaps take action based on that use k.GetCurrentValset
 // i.e. {"nonce": 1, "members": [{"eth_addr": "foo", "power": 11223}]}
-func (k Keeper) SetValsetRequest(ctx sdk.Context) types.Valset {
-	valset, err := k.GetCurrentValset(ctx)
+func (k Keeper) SetValsetRequest(ctx sdk.Context, evmChainPrefix string) types.Valset {
+	valset, err := k.GetCurrentValset(ctx, evmChainPrefix)
 	if err != nil {
 		panic(err)
 	}
-	k.StoreValset(ctx, valset)
-	k.SetLatestValsetNonce(ctx, valset.Nonce)
+	k.StoreValset(ctx, evmChainPrefix, valset)
+	k.SetLatestValsetNonce(ctx, evmChainPrefix, valset.Nonce)
 
 	// Store the checkpoint as a legit past valset, this is only for evidence
 	// based slashing. We are storing the checkpoint that will be signed with
 	// the validators Ethereum keys so that we know not to slash them if someone
 	// attempts to submit the signature of this validator set as evidence of bad behavior
-	checkpoint := valset.GetCheckpoint(k.GetGravityID(ctx))
-	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	checkpoint := valset.GetCheckpoint(k.GetGravityID(ctx, evmChainPrefix))
+	k.SetPastEthSignatureCheckpoint(ctx, evmChainPrefix, checkpoint)
 
-	if err := ctx.EventManager().EmitTypedEvent(
+	if err := ctx.EventManager().EmitTypedEvents(
 		&types.EventMultisigUpdateRequest{
-			BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress().Hex(),
-			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx))),
+			BridgeContract: k.GetBridgeContractAddress(ctx, evmChainPrefix).GetAddress().Hex(),
+			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
 			MultisigId:     fmt.Sprint(valset.Nonce),
 			Nonce:          fmt.Sprint(valset.Nonce),
+			EvmChainPrefix: evmChainPrefix,
+		},
+		&eventsv1.EventValsetCreated{
+			EvmChainPrefix: evmChainPrefix,
+			ValsetNonce:    valset.Nonce,
+			Height:         valset.Height,
 		},
 	); err != nil {
 		panic(err)
@@ -58,8 +65,8 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context) types.Valset {
 // therefore this function will panic if you attempt to overwrite an existing key. Any changes to
 // historical valsets can not possibly be correct, as it would invalidate the signatures. The only
 // valid operation on the same index is store followed by delete when it is time to prune state
-func (k Keeper) StoreValset(ctx sdk.Context, valset types.Valset) {
-	key := types.GetValsetKey(valset.Nonce)
+func (k Keeper) StoreValset(ctx sdk.Context, evmChainPrefix string, valset types.Valset) {
+	key := types.GetValsetKey(evmChainPrefix, valset.Nonce)
 	store := ctx.KVStore(k.storeKey)
 
 	if store.Has(key) {
@@ -70,52 +77,52 @@ func (k Keeper) StoreValset(ctx sdk.Context, valset types.Valset) {
 }
 
 // HasValsetRequest returns true if a valset defined by a nonce exists
-func (k Keeper) HasValsetRequest(ctx sdk.Context, nonce uint64) bool {
+func (k Keeper) HasValsetRequest(ctx sdk.Context, evmChainPrefix string, nonce uint64) bool {
 	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.GetValsetKey(nonce))
+	return store.Has(types.GetValsetKey(evmChainPrefix, nonce))
 }
 
 // DeleteValset deletes the valset at a given nonce from state
-func (k Keeper) DeleteValset(ctx sdk.Context, nonce uint64) {
-	ctx.KVStore(k.storeKey).Delete(types.GetValsetKey(nonce))
+func (k Keeper) DeleteValset(ctx sdk.Context, evmChainPrefix string, nonce uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetValsetKey(evmChainPrefix, nonce))
 }
 
 // CheckLatestValsetNonce returns true if the latest valset nonce
 // is declared in the store and false if it has not been initialized
-func (k Keeper) CheckLatestValsetNonce(ctx sdk.Context) bool {
+func (k Keeper) CheckLatestValsetNonce(ctx sdk.Context, evmChainPrefix string) bool {
 	store := ctx.KVStore(k.storeKey)
-	has := store.Has(types.LatestValsetNonce)
+	has := store.Has(types.AppendEvmChainPrefix(types.LatestValsetNonce, evmChainPrefix))
 	return has
 }
 
 // GetLatestValsetNonce returns the latest valset nonce
-func (k Keeper) GetLatestValsetNonce(ctx sdk.Context) uint64 {
-	if !k.CheckLatestValsetNonce(ctx) {
+func (k Keeper) GetLatestValsetNonce(ctx sdk.Context, evmChainPrefix string) uint64 {
+	if !k.CheckLatestValsetNonce(ctx, evmChainPrefix) {
 		panic("Valset nonce not initialized from genesis")
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.LatestValsetNonce)
+	bytes := store.Get(types.AppendEvmChainPrefix(types.LatestValsetNonce, evmChainPrefix))
 	return types.UInt64FromBytesUnsafe(bytes)
 }
 
 // SetLatestValsetNonce sets the latest valset nonce, since it's
 // expected that this value will only increase it panics on an attempt
 // to decrement
-func (k Keeper) SetLatestValsetNonce(ctx sdk.Context, nonce uint64) {
+func (k Keeper) SetLatestValsetNonce(ctx sdk.Context, evmChainPrefix string, nonce uint64) {
 	// this is purely an increasing counter and should never decrease
-	if k.CheckLatestValsetNonce(ctx) && k.GetLatestValsetNonce(ctx) > nonce {
+	if k.CheckLatestValsetNonce(ctx, evmChainPrefix) && k.GetLatestValsetNonce(ctx, evmChainPrefix) > nonce {
 		panic("Decrementing valset nonce!")
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.LatestValsetNonce, types.UInt64Bytes(nonce))
+	store.Set(types.AppendEvmChainPrefix(types.LatestValsetNonce, evmChainPrefix), types.UInt64Bytes(nonce))
 }
 
 // GetValset returns a valset by nonce
-func (k Keeper) GetValset(ctx sdk.Context, nonce uint64) *types.Valset {
+func (k Keeper) GetValset(ctx sdk.Context, evmChainPrefix string, nonce uint64) *types.Valset {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetValsetKey(nonce))
+	bz := store.Get(types.GetValsetKey(evmChainPrefix, nonce))
 	if bz == nil {
 		return nil
 	}
@@ -125,8 +132,8 @@ func (k Keeper) GetValset(ctx sdk.Context, nonce uint64) *types.Valset {
 }
 
 // IterateValsets returns all valsetRequests in reverse order, aka most recent first
-func (k Keeper) IterateValsets(ctx sdk.Context, cb func(key []byte, val *types.Valset) (stop bool)) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
+func (k Keeper) IterateValsets(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, val *types.Valset) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendEvmChainPrefix(types.ValsetRequestKey, evmChainPrefix))
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -140,8 +147,8 @@ func (k Keeper) IterateValsets(ctx sdk.Context, cb func(key []byte, val *types.V
 }
 
 // GetValsets returns all the validator sets in state
-func (k Keeper) GetValsets(ctx sdk.Context) (out []types.Valset) {
-	k.IterateValsets(ctx, func(_ []byte, val *types.Valset) bool {
+func (k Keeper) GetValsets(ctx sdk.Context, evmChainPrefix string) (out []types.Valset) {
+	k.IterateValsets(ctx, evmChainPrefix, func(_ []byte, val *types.Valset) bool {
 		out = append(out, *val)
 		return false
 	})
@@ -153,22 +160,22 @@ func (k Keeper) GetValsets(ctx sdk.Context) (out []types.Valset) {
 // from the CurrentValset because this one has been saved and is therefore *the* valset
 // for this nonce. GetCurrentValset shows you what could be, if you chose to save it, this function
 // shows you what is the latest valset that was saved.
-func (k Keeper) GetLatestValset(ctx sdk.Context) (out *types.Valset) {
-	latestValsetNonce := k.GetLatestValsetNonce(ctx)
-	out = k.GetValset(ctx, latestValsetNonce)
+func (k Keeper) GetLatestValset(ctx sdk.Context, evmChainPrefix string) (out *types.Valset) {
+	latestValsetNonce := k.GetLatestValsetNonce(ctx, evmChainPrefix)
+	out = k.GetValset(ctx, evmChainPrefix, latestValsetNonce)
 	return
 }
 
 // setLastSlashedValsetNonce sets the latest slashed valset nonce
-func (k Keeper) SetLastSlashedValsetNonce(ctx sdk.Context, nonce uint64) {
+func (k Keeper) SetLastSlashedValsetNonce(ctx sdk.Context, evmChainPrefix string, nonce uint64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.LastSlashedValsetNonce, types.UInt64Bytes(nonce))
+	store.Set(types.AppendEvmChainPrefix(types.LastSlashedValsetNonce, evmChainPrefix), types.UInt64Bytes(nonce))
 }
 
 // GetLastSlashedValsetNonce returns the latest slashed valset nonce
-func (k Keeper) GetLastSlashedValsetNonce(ctx sdk.Context) uint64 {
+func (k Keeper) GetLastSlashedValsetNonce(ctx sdk.Context, evmChainPrefix string) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.LastSlashedValsetNonce)
+	bytes := store.Get(types.AppendEvmChainPrefix(types.LastSlashedValsetNonce, evmChainPrefix))
 
 	if len(bytes) == 0 {
 		return 0
@@ -196,10 +203,10 @@ func (k Keeper) GetLastUnBondingBlockHeight(ctx sdk.Context) uint64 {
 }
 
 // GetUnSlashedValsets returns all the "ready-to-slash" unslashed validator sets in state (valsets at least signedValsetsWindow blocks old)
-func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, signedValsetsWindow uint64) (out []*types.Valset) {
-	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx)
+func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, evmChainPrefix string, signedValsetsWindow uint64) (out []*types.Valset) {
+	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx, evmChainPrefix)
 	blockHeight := uint64(ctx.BlockHeight())
-	k.IterateValsetBySlashedValsetNonce(ctx, lastSlashedValsetNonce, func(_ []byte, valset *types.Valset) bool {
+	k.IterateValsetBySlashedValsetNonce(ctx, evmChainPrefix, lastSlashedValsetNonce, func(_ []byte, valset *types.Valset) bool {
 		// Implicitly the unslashed valsets appear after the last slashed valset,
 		// however not all valsets are ready-to-slash since validators have a window
 		if valset.Nonce > lastSlashedValsetNonce && !(blockHeight < valset.Height+signedValsetsWindow) {
@@ -211,10 +218,10 @@ func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, signedValsetsWindow uint64)
 }
 
 // IterateValsetBySlashedValsetNonce iterates through all valset by last slashed valset nonce in ASC order
-func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, lastSlashedValsetNonce uint64, cb func([]byte, *types.Valset) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetRequestKey)
+func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, evmChainPrefix string, lastSlashedValsetNonce uint64, cb func([]byte, *types.Valset) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendEvmChainPrefix(types.ValsetRequestKey, evmChainPrefix))
 	// Consider all valsets, including the most recent one
-	cutoffNonce := k.GetLatestValsetNonce(ctx) + 1
+	cutoffNonce := k.GetLatestValsetNonce(ctx, evmChainPrefix) + 1
 	iter := prefixStore.Iterator(types.UInt64Bytes(lastSlashedValsetNonce), types.UInt64Bytes(cutoffNonce))
 	defer iter.Close()
 
@@ -253,7 +260,9 @@ func (k Keeper) IterateValsetBySlashedValsetNonce(ctx sdk.Context, lastSlashedVa
 // The function is intended to return what the valset would look like if you made one now
 // you should call this function, evaluate if you want to save this new valset, and discard
 // it or save
-func (k Keeper) GetCurrentValset(ctx sdk.Context) (types.Valset, error) {
+//
+// Every EVM chain shares the same validators and Ethereum keys, only the valset nonce and reward differ
+func (k Keeper) GetCurrentValset(ctx sdk.Context, evmChainPrefix string) (types.Valset, error) {
 	validators := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	if len(validators) == 0 {
 		// nolint: exhaustruct
@@ -291,6 +300,12 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) (types.Valset, error) {
 
 	// get the reward from the params store
 	reward := k.GetParams(ctx).ValsetReward
+	if evmChainPrefix != types.DefaultEvmChainPrefix && reward.IsValid() && !reward.IsZero() {
+		// the reward is only checked against the default chain, other chains pay no reward until the token is bridged
+		if _, _, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, reward.Denom); err != nil {
+			reward = sdk.Coin{}
+		}
+	}
 	var rewardToken *types.EthAddress
 	var rewardAmount sdk.Int
 	if !reward.IsValid() || reward.IsZero() {
@@ -302,11 +317,11 @@ func (k Keeper) GetCurrentValset(ctx sdk.Context) (types.Valset, error) {
 		rewardAmount = sdk.NewIntFromUint64(0)
 
 	} else {
-		rewardToken, rewardAmount = k.RewardToERC20Lookup(ctx, reward)
+		rewardToken, rewardAmount = k.RewardToERC20Lookup(ctx, evmChainPrefix, reward)
 	}
 
 	// increment the nonce, since this potential future valset should be after the current valset
-	valsetNonce := k.GetLatestValsetNonce(ctx) + 1
+	valsetNonce := k.GetLatestValsetNonce(ctx, evmChainPrefix) + 1
 
 	valset, err := types.NewValset(valsetNonce, uint64(ctx.BlockHeight()), bridgeValidators, rewardAmount, *rewardToken)
 	if err != nil {
@@ -338,13 +353,13 @@ func normalizeValidatorPower(rawPower uint64, totalValidatorPower sdk.Int) uint6
 /////////////////////////////
 
 // GetValsetConfirm returns a valset confirmation by a nonce and validator address
-func (k Keeper) GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.AccAddress) *types.MsgValsetConfirm {
+func (k Keeper) GetValsetConfirm(ctx sdk.Context, evmChainPrefix string, nonce uint64, validator sdk.AccAddress) *types.MsgValsetConfirm {
 	store := ctx.KVStore(k.storeKey)
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
 		ctx.Logger().Error("invalid validator address")
 		return nil
 	}
-	entity := store.Get(types.GetValsetConfirmKey(nonce, validator))
+	entity := store.Get(types.GetValsetConfirmKey(evmChainPrefix, nonce, validator))
 	if entity == nil {
 		return nil
 	}
@@ -359,21 +374,21 @@ func (k Keeper) GetValsetConfirm(ctx sdk.Context, nonce uint64, validator sdk.Ac
 }
 
 // SetValsetConfirm sets a valset confirmation
-func (k Keeper) SetValsetConfirm(ctx sdk.Context, valsetConf types.MsgValsetConfirm) []byte {
+func (k Keeper) SetValsetConfirm(ctx sdk.Context, evmChainPrefix string, valsetConf types.MsgValsetConfirm) []byte {
 	store := ctx.KVStore(k.storeKey)
 	addr, err := sdk.AccAddressFromBech32(valsetConf.Orchestrator)
 	if err != nil {
 		panic(err)
 	}
-	key := types.GetValsetConfirmKey(valsetConf.Nonce, addr)
+	key := types.GetValsetConfirmKey(evmChainPrefix, valsetConf.Nonce, addr)
 	store.Set(key, k.cdc.MustMarshal(&valsetConf))
 	return key
 }
 
 // GetValsetConfirms returns all validator set confirmations by nonce
-func (k Keeper) GetValsetConfirms(ctx sdk.Context, nonce uint64) (confirms []types.MsgValsetConfirm) {
+func (k Keeper) GetValsetConfirms(ctx sdk.Context, evmChainPrefix string, nonce uint64) (confirms []types.MsgValsetConfirm) {
 	store := ctx.KVStore(k.storeKey)
-	prefix := types.GetValsetConfirmNoncePrefix(nonce)
+	prefix := types.GetValsetConfirmNoncePrefix(evmChainPrefix, nonce)
 	iterator := store.Iterator(prefixRange([]byte(prefix)))
 
 	defer iterator.Close()
@@ -393,8 +408,8 @@ func (k Keeper) GetValsetConfirms(ctx sdk.Context, nonce uint64) (confirms []typ
 }
 
 // IterateValsetConfirms returns all valset confirms in reverse order by nonce, aka most recent valset nonce first
-func (k Keeper) IterateValsetConfirms(ctx sdk.Context, cb func(key []byte, confirms []types.MsgValsetConfirm, nonce uint64) (stop bool)) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValsetConfirmKey)
+func (k Keeper) IterateValsetConfirms(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, confirms []types.MsgValsetConfirm, nonce uint64) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendEvmChainPrefix(types.ValsetConfirmKey, evmChainPrefix))
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 
@@ -438,12 +453,12 @@ func (k Keeper) IterateValsetConfirms(ctx sdk.Context, cb func(key []byte, confi
 }
 
 // DeleteValsetConfirms deletes the valset confirmations for the valset at a given nonce from state
-func (k Keeper) DeleteValsetConfirms(ctx sdk.Context, nonce uint64) {
+func (k Keeper) DeleteValsetConfirms(ctx sdk.Context, evmChainPrefix string, nonce uint64) {
 	store := ctx.KVStore(k.storeKey)
-	for _, confirm := range k.GetValsetConfirms(ctx, nonce) {
+	for _, confirm := range k.GetValsetConfirms(ctx, evmChainPrefix, nonce) {
 		orchestrator, err := sdk.AccAddressFromBech32(confirm.Orchestrator)
 		if err == nil {
-			confirmKey := types.GetValsetConfirmKey(nonce, orchestrator)
+			confirmKey := types.GetValsetConfirmKey(evmChainPrefix, nonce, orchestrator)
 			if store.Has(confirmKey) {
 				store.Delete(confirmKey)
 			}
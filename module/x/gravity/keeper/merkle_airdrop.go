@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+/*	Merkle Airdrops
+
+	A MerkleAirdropProposal escrows the airdrop total from the community pool into the gravity module account and
+	stores only the Merkle root of the recipient list. Recipients then claim their share with a MsgClaimAirdrop
+	carrying a Merkle proof, each address may claim once per airdrop. Once the Cosmos block height reaches the
+	airdrop's ExpiryHeight the unclaimed remainder is returned to the community pool and the airdrop is removed.
+	An airdrop may have been claimed by a great many addresses, so its claim records are pruned over the following
+	blocks, at most MerkleAirdropClaimsPrunedPerBlock of them per block.
+*/
+
+// MerkleAirdropClaimsPrunedPerBlock is the maximum number of claim records of expired airdrops deleted per block
+const MerkleAirdropClaimsPrunedPerBlock = 1000
+
+// GetMerkleAirdrop returns the airdrop with the given id, if any
+func (k Keeper) GetMerkleAirdrop(ctx sdk.Context, id uint64) (*types.MerkleAirdrop, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMerkleAirdropKey(id))
+	if bz == nil {
+		return nil, false
+	}
+	var airdrop types.MerkleAirdrop
+	k.cdc.MustUnmarshal(bz, &airdrop)
+	return &airdrop, true
+}
+
+// setMerkleAirdrop stores the airdrop, indexed by id
+func (k Keeper) setMerkleAirdrop(ctx sdk.Context, airdrop types.MerkleAirdrop) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMerkleAirdropKey(airdrop.Id), k.cdc.MustMarshal(&airdrop))
+}
+
+// deleteMerkleAirdrop removes the airdrop, the claims made against it are left to pruneExpiredMerkleAirdropClaims
+func (k Keeper) deleteMerkleAirdrop(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMerkleAirdropKey(id))
+}
+
+// pruneExpiredMerkleAirdropClaims deletes up to MerkleAirdropClaimsPrunedPerBlock claim records of airdrops which
+// have been removed, the claims of active airdrops are skipped over one airdrop at a time
+func (k Keeper) pruneExpiredMerkleAirdropClaims(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	start, end := types.MerkleAirdropClaimKey, sdk.PrefixEndBytes(types.MerkleAirdropClaimKey)
+	var keys [][]byte
+	for len(keys) < MerkleAirdropClaimsPrunedPerBlock {
+		iter := store.Iterator(start, end)
+		if !iter.Valid() {
+			iter.Close()
+			break
+		}
+		id := types.UInt64FromBytesUnsafe(iter.Key()[len(types.MerkleAirdropClaimKey) : len(types.MerkleAirdropClaimKey)+8])
+		claimPrefix := types.GetMerkleAirdropClaimPrefix(id)
+		if _, active := k.GetMerkleAirdrop(ctx, id); !active {
+			for ; iter.Valid() && bytes.HasPrefix(iter.Key(), claimPrefix) && len(keys) < MerkleAirdropClaimsPrunedPerBlock; iter.Next() {
+				keys = append(keys, iter.Key())
+			}
+		}
+		iter.Close()
+		start = sdk.PrefixEndBytes(claimPrefix)
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateMerkleAirdrops iterates through every MerkleAirdrop in ascending id order, passing it to the given callback.
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateMerkleAirdrops(ctx sdk.Context, cb func(key []byte, airdrop types.MerkleAirdrop) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MerkleAirdropKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var airdrop types.MerkleAirdrop
+		k.cdc.MustUnmarshal(iter.Value(), &airdrop)
+		// cb returns true to stop early
+		if cb(iter.Key(), airdrop) {
+			break
+		}
+	}
+}
+
+// GetMerkleAirdropsList returns every active MerkleAirdrop in ascending id order
+func (k Keeper) GetMerkleAirdropsList(ctx sdk.Context) []types.MerkleAirdrop {
+	airdrops := []types.MerkleAirdrop{}
+	k.IterateMerkleAirdrops(ctx, func(_ []byte, airdrop types.MerkleAirdrop) bool {
+		airdrops = append(airdrops, airdrop)
+		return false
+	})
+	return airdrops
+}
+
+// GetMerkleAirdropClaim returns the claim made by claimer against the given airdrop, if any
+func (k Keeper) GetMerkleAirdropClaim(ctx sdk.Context, airdropId uint64, claimer sdk.AccAddress) (*types.MerkleAirdropClaim, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMerkleAirdropClaimKey(airdropId, claimer))
+	if bz == nil {
+		return nil, false
+	}
+	var claim types.MerkleAirdropClaim
+	k.cdc.MustUnmarshal(bz, &claim)
+	return &claim, true
+}
+
+// setMerkleAirdropClaim records a claim against an airdrop
+func (k Keeper) setMerkleAirdropClaim(ctx sdk.Context, claim types.MerkleAirdropClaim) {
+	claimer, err := sdk.AccAddressFromBech32(claim.Claimer)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid claimer in merkle airdrop claim %v", claim))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMerkleAirdropClaimKey(claim.AirdropId, claimer), k.cdc.MustMarshal(&claim))
+}
+
+// IterateMerkleAirdropClaims iterates through every MerkleAirdropClaim, passing it to the given callback.
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateMerkleAirdropClaims(ctx sdk.Context, cb func(key []byte, claim types.MerkleAirdropClaim) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MerkleAirdropClaimKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var claim types.MerkleAirdropClaim
+		k.cdc.MustUnmarshal(iter.Value(), &claim)
+		// cb returns true to stop early
+		if cb(iter.Key(), claim) {
+			break
+		}
+	}
+}
+
+// escrowFromCommunityPool moves coins from the community pool into the gravity module account
+func (k Keeper) escrowFromCommunityPool(ctx sdk.Context, coins sdk.Coins) error {
+	feePool := k.DistKeeper.GetFeePool(ctx)
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(coins...))
+	if negative {
+		return sdkerrors.Wrap(types.ErrInvalid, "Insufficient tokens in community pool")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, disttypes.ModuleName, types.ModuleName, coins); err != nil {
+		return sdkerrors.Wrap(err, "transfer from community pool failed")
+	}
+	feePool.CommunityPool = newPool
+	k.DistKeeper.SetFeePool(ctx, feePool)
+	return nil
+}
+
+// ClaimMerkleAirdrop verifies the proof for claimer and amount against the airdrop's root and pays out the claim
+func (k Keeper) ClaimMerkleAirdrop(ctx sdk.Context, claimer sdk.AccAddress, airdropId uint64, amount uint64, proof [][]byte) error {
+	airdrop, found := k.GetMerkleAirdrop(ctx, airdropId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknown, "merkle airdrop %d", airdropId)
+	}
+	if uint64(ctx.BlockHeight()) >= airdrop.ExpiryHeight {
+		return sdkerrors.Wrapf(types.ErrInvalid, "merkle airdrop %d has expired", airdropId)
+	}
+	if _, claimed := k.GetMerkleAirdropClaim(ctx, airdropId, claimer); claimed {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "merkle airdrop %d already claimed by %s", airdropId, claimer)
+	}
+	if !types.VerifyMerkleAirdropProof(airdrop.MerkleRoot, types.MerkleAirdropLeaf(claimer, amount), proof) {
+		return sdkerrors.Wrap(types.ErrInvalid, "invalid merkle proof")
+	}
+	// a root committing to more than the total can only be the result of a bad proposal, refuse to overdraw
+	if amount > airdrop.Total-airdrop.Claimed {
+		return sdkerrors.Wrapf(types.ErrInvalid, "merkle airdrop %d has insufficient funds remaining", airdropId)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(airdrop.Denom, sdk.NewIntFromUint64(amount)))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimer, coins); err != nil {
+		return sdkerrors.Wrap(err, "unable to pay out merkle airdrop claim")
+	}
+
+	airdrop.Claimed += amount
+	k.setMerkleAirdrop(ctx, *airdrop)
+	k.setMerkleAirdropClaim(ctx, types.MerkleAirdropClaim{
+		AirdropId: airdropId,
+		Claimer:   claimer.String(),
+		Amount:    amount,
+	})
+
+	return ctx.EventManager().EmitTypedEvents(
+		&types.EventMerkleAirdropClaimed{
+			AirdropId: fmt.Sprint(airdropId),
+			Claimer:   claimer.String(),
+			Amount:    fmt.Sprint(amount),
+		},
+		&eventsv1.EventMerkleAirdropClaimed{
+			AirdropId: airdropId,
+			Claimer:   claimer.String(),
+			Amount:    sdk.NewCoin(airdrop.Denom, sdk.NewIntFromUint64(amount)),
+		},
+	)
+}
+
+// ExpireMerkleAirdrops returns the unclaimed funds of every airdrop which has reached its expiry height to the
+// community pool and removes the airdrop, then prunes some of the claim records of removed airdrops
+func (k Keeper) ExpireMerkleAirdrops(ctx sdk.Context) {
+	defer k.pruneExpiredMerkleAirdropClaims(ctx)
+
+	var expired []types.MerkleAirdrop
+	k.IterateMerkleAirdrops(ctx, func(_ []byte, airdrop types.MerkleAirdrop) bool {
+		if uint64(ctx.BlockHeight()) >= airdrop.ExpiryHeight {
+			expired = append(expired, airdrop)
+		}
+		return false
+	})
+
+	for _, airdrop := range expired {
+		remaining := airdrop.Total - airdrop.Claimed
+		if remaining > 0 {
+			coins := sdk.NewCoins(sdk.NewCoin(airdrop.Denom, sdk.NewIntFromUint64(remaining)))
+			if err := k.SendToCommunityPool(ctx, coins); err != nil {
+				panic(sdkerrors.Wrapf(err, "unable to return expired merkle airdrop %d to the community pool", airdrop.Id))
+			}
+		}
+		k.deleteMerkleAirdrop(ctx, airdrop.Id)
+		k.logger(ctx).Info("Merkle airdrop expired", "id", airdrop.Id, "returned", remaining)
+
+		if err := ctx.EventManager().EmitTypedEvents(
+			&types.EventMerkleAirdropExpired{
+				AirdropId: fmt.Sprint(airdrop.Id),
+				Returned:  fmt.Sprint(remaining),
+			},
+			&eventsv1.EventMerkleAirdropExpired{
+				AirdropId: airdrop.Id,
+				Returned:  sdk.NewCoin(airdrop.Denom, sdk.NewIntFromUint64(remaining)),
+			},
+		); err != nil {
+			panic(sdkerrors.Wrap(err, "unable to emit merkle airdrop expiry event"))
+		}
+	}
+}
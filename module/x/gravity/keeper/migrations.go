@@ -3,6 +3,7 @@ package keeper
 import (
 	v4 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v4"
 	v5 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v5"
+	v6 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v6"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -33,3 +34,14 @@ func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	ctx.Logger().Info("Gravity migration finished!")
 	return nil
 }
+
+// Migrate5to6 migrates from consensus version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	ctx.Logger().Info("Begin Gravity v5 -> v6 migration")
+	if err := v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+	v6.MigrateParams(ctx, m.keeper.paramSpace)
+	ctx.Logger().Info("Gravity migration finished!")
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	auctiontypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/auction/types"
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
@@ -52,6 +53,11 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
 	}
 
+	// ensure that the validator controls the ethereum key, a mistyped address would otherwise be bound forever
+	if err := msg.VerifyEthSignature(ctx.ChainID()); err != nil {
+		return nil, err
+	}
+
 	_, foundExistingOrchestratorKey := k.GetOrchestratorValidator(ctx, orch)
 	_, foundExistingEthAddress := k.GetEthAddressByValidator(ctx, val)
 
@@ -75,23 +81,32 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 	k.SetOrchestratorValidator(ctx, val, orch)
 	k.SetEthAddressForValidator(ctx, val, *ethAddr)
 
-	return &types.MsgSetOrchestratorAddressResponse{}, ctx.EventManager().EmitTypedEvent(
+	return &types.MsgSetOrchestratorAddressResponse{}, ctx.EventManager().EmitTypedEvents(
 		&types.EventSetOperatorAddress{
 			Message: msg.Type(),
 			Address: orch.String(),
 		},
+		&eventsv1.EventOrchestratorAddressSet{
+			Validator:    val.String(),
+			Orchestrator: orch.String(),
+			EthAddress:   ethAddr.GetAddress().Hex(),
+		},
 	)
 }
 
 // ValsetConfirm handles MsgValsetConfirm
 func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm) (*types.MsgValsetConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	valset := k.GetValset(ctx, msg.Nonce) // A valset request was previously created
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, msg.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
+	valset := k.GetValset(ctx, evmChainPrefix, msg.Nonce) // A valset request was previously created
 	if valset == nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find valset")
 	}
 
-	gravityID := k.GetGravityID(ctx)
+	gravityID := k.GetGravityID(ctx, evmChainPrefix)
 	checkpoint := valset.GetCheckpoint(gravityID)
 	orchaddr, err := sdk.AccAddressFromBech32(msg.Orchestrator)
 	if err != nil {
@@ -103,22 +118,32 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 	}
 
 	// persist signature
-	if k.GetValsetConfirm(ctx, msg.Nonce, orchaddr) != nil {
+	if k.GetValsetConfirm(ctx, evmChainPrefix, msg.Nonce, orchaddr) != nil {
 		return nil, sdkerrors.Wrap(types.ErrDuplicate, "signature duplicate")
 	}
-	key := k.SetValsetConfirm(ctx, *msg)
+	key := k.SetValsetConfirm(ctx, evmChainPrefix, *msg)
 
-	return &types.MsgValsetConfirmResponse{}, ctx.EventManager().EmitTypedEvent(
+	return &types.MsgValsetConfirmResponse{}, ctx.EventManager().EmitTypedEvents(
 		&types.EventValsetConfirmKey{
 			Message: msg.Type(),
 			Key:     string(key),
 		},
+		&eventsv1.EventValsetConfirmed{
+			EvmChainPrefix: evmChainPrefix,
+			ValsetNonce:    msg.Nonce,
+			Orchestrator:   orchaddr.String(),
+			EthSigner:      msg.EthAddress,
+		},
 	)
 }
 
 // SendToEth handles MsgSendToEth
 func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types.MsgSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, msg.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid sender")
@@ -129,12 +154,12 @@ func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types
 		return nil, sdkerrors.Wrap(err, "invalid eth dest")
 	}
 
-	_, erc20, err := k.DenomToERC20Lookup(ctx, msg.Amount.Denom)
+	_, erc20, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, msg.Amount.Denom)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid denom")
 	}
 
-	if k.InvalidSendToEthAddress(ctx, *dest, *erc20) {
+	if k.InvalidSendToEthAddress(ctx, evmChainPrefix, *dest, *erc20) {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "destination address is invalid or blacklisted")
 	}
 
@@ -143,7 +168,7 @@ func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types
 		return nil, sdkerrors.Wrapf(err, "Could not deduct chainFee %v from account %v", msg.ChainFee.String(), msg.Sender)
 	}
 
-	txID, err := k.AddToOutgoingPool(ctx, sender, *dest, msg.Amount, msg.BridgeFee)
+	txID, err := k.AddToOutgoingPool(ctx, evmChainPrefix, sender, *dest, msg.Amount, msg.BridgeFee)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not add to outgoing pool")
 	}
@@ -193,10 +218,10 @@ func (k msgServer) checkAndDeductSendToEthFees(ctx sdk.Context, sender sdk.AccAd
 	if !(chainFee == sdk.Coin{}) && chainFee.Amount.IsPositive() {
 		senderAcc := k.accountKeeper.GetAccount(ctx, sender)
 
-		var stakerFee sdk.Int
+		poolFee, stakerFee := sdk.ZeroInt(), sdk.ZeroInt()
 		if chainFeeAuctionable {
 			// Determine the pool's share by first multiplying the total with the [0,1] fraction param, ignoring any dust
-			poolFee := params.ChainFeeAuctionPoolFraction.Mul(sdk.NewDecFromInt(chainFee.Amount)).TruncateInt()
+			poolFee = params.ChainFeeAuctionPoolFraction.Mul(sdk.NewDecFromInt(chainFee.Amount)).TruncateInt()
 			// Then the stakers will receive the remainder
 			stakerFee = chainFee.Amount.Sub(poolFee)
 
@@ -219,12 +244,19 @@ func (k msgServer) checkAndDeductSendToEthFees(ctx sdk.Context, sender sdk.AccAd
 		}
 
 		// Report the fee collection to the event log
-		return ctx.EventManager().EmitTypedEvent(
+		return ctx.EventManager().EmitTypedEvents(
 			&types.EventSendToEthFeeCollected{
 				Sender:     sender.String(),
 				SendAmount: sendAmount.String(),
 				FeeAmount:  chainFee.String(),
 			},
+			&eventsv1.EventChainFeeCollected{
+				Sender:         sender.String(),
+				SendAmount:     sendAmount,
+				ChainFee:       chainFee,
+				AuctionPoolFee: sdk.NewCoin(chainFee.Denom, poolFee),
+				StakerFee:      sdk.NewCoin(chainFee.Denom, stakerFee),
+			},
 		)
 	}
 
@@ -234,15 +266,19 @@ func (k msgServer) checkAndDeductSendToEthFees(ctx sdk.Context, sender sdk.AccAd
 // RequestBatch handles MsgRequestBatch
 func (k msgServer) RequestBatch(c context.Context, msg *types.MsgRequestBatch) (*types.MsgRequestBatchResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, msg.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check if the denom is a gravity coin, if not, check if there is a deployed ERC20 representing it.
 	// If not, error out
-	_, tokenContract, err := k.DenomToERC20Lookup(ctx, msg.Denom)
+	_, tokenContract, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, msg.Denom)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not look up erc 20 denominator")
 	}
 
-	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, OutgoingTxBatchSize)
+	batch, err := k.BuildOutgoingTXBatch(ctx, evmChainPrefix, *tokenContract, OutgoingTxBatchSize)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not build outgoing tx batch")
 	}
@@ -266,14 +302,18 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "eth address invalid")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, msg.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 
 	// fetch the outgoing batch given the nonce
-	batch := k.GetOutgoingTXBatch(ctx, *contract, msg.Nonce)
+	batch := k.GetOutgoingTXBatch(ctx, evmChainPrefix, *contract, msg.Nonce)
 	if batch == nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find batch")
 	}
 
-	gravityID := k.GetGravityID(ctx)
+	gravityID := k.GetGravityID(ctx, evmChainPrefix)
 	checkpoint := batch.GetCheckpoint(gravityID)
 	orchaddr, err := sdk.AccAddressFromBech32(msg.Orchestrator)
 	if err != nil {
@@ -286,34 +326,45 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 	}
 
 	// check if we already have this confirm
-	if k.GetBatchConfirm(ctx, msg.Nonce, *contract, orchaddr) != nil {
+	if k.GetBatchConfirm(ctx, evmChainPrefix, msg.Nonce, *contract, orchaddr) != nil {
 		return nil, sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
 	}
-	key := k.SetBatchConfirm(ctx, msg)
+	key := k.SetBatchConfirm(ctx, evmChainPrefix, msg)
 
-	return &types.MsgConfirmBatchResponse{}, ctx.EventManager().EmitTypedEvent(
+	return &types.MsgConfirmBatchResponse{}, ctx.EventManager().EmitTypedEvents(
 		&types.EventBatchConfirmKey{
 			Message:         msg.Type(),
 			BatchConfirmKey: string(key),
 		},
+		&eventsv1.EventBatchConfirmed{
+			EvmChainPrefix: evmChainPrefix,
+			BatchNonce:     msg.Nonce,
+			TokenContract:  contract.GetAddress().Hex(),
+			Orchestrator:   orchaddr.String(),
+			EthSigner:      msg.EthSigner,
+		},
 	)
 }
 
 // ConfirmLogicCall handles MsgConfirmLogicCall
 func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogicCall) (*types.MsgConfirmLogicCallResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, msg.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	invalidationIdBytes, err := hex.DecodeString(msg.InvalidationId)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "invalidation id encoding")
 	}
 
 	// fetch the outgoing logic given the nonce
-	logic := k.GetOutgoingLogicCall(ctx, invalidationIdBytes, msg.InvalidationNonce)
+	logic := k.GetOutgoingLogicCall(ctx, evmChainPrefix, invalidationIdBytes, msg.InvalidationNonce)
 	if logic == nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "couldn't find logic")
 	}
 
-	gravityID := k.GetGravityID(ctx)
+	gravityID := k.GetGravityID(ctx, evmChainPrefix)
 	checkpoint := logic.GetCheckpoint(gravityID)
 	orchaddr, err := sdk.AccAddressFromBech32(msg.Orchestrator)
 	if err != nil {
@@ -325,13 +376,21 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 	}
 
 	// check if we already have this confirm
-	if k.GetLogicCallConfirm(ctx, invalidationIdBytes, msg.InvalidationNonce, orchaddr) != nil {
+	if k.GetLogicCallConfirm(ctx, evmChainPrefix, invalidationIdBytes, msg.InvalidationNonce, orchaddr) != nil {
 		return nil, sdkerrors.Wrap(types.ErrDuplicate, "duplicate signature")
 	}
 
-	k.SetLogicCallConfirm(ctx, msg)
+	k.SetLogicCallConfirm(ctx, evmChainPrefix, msg)
 
-	return nil, nil
+	return nil, ctx.EventManager().EmitTypedEvent(
+		&eventsv1.EventLogicCallConfirmed{
+			EvmChainPrefix:    evmChainPrefix,
+			InvalidationId:    hex.EncodeToString(invalidationIdBytes),
+			InvalidationNonce: msg.InvalidationNonce,
+			Orchestrator:      orchaddr.String(),
+			EthSigner:         msg.EthSigner,
+		},
+	)
 }
 
 // checkOrchestratorValidatorInSet checks that the orchestrator refers to a validator that is
@@ -358,8 +417,14 @@ func (k msgServer) checkOrchestratorValidatorInSet(ctx sdk.Context, orchestrator
 // claimHandlerCommon is an internal function that provides common code for processing claims once they are
 // translated from the message to the Ethereum claim interface
 func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, msg types.EthereumClaim) error {
+	// Claims may only be made for chains which are bridged
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, msg.GetEvmChainPrefix())
+	if err != nil {
+		return err
+	}
+
 	// Add the claim to the store
-	_, err := k.Attest(ctx, msg, msgAny)
+	_, err = k.Attest(ctx, msg, msgAny)
 	if err != nil {
 		return sdkerrors.Wrap(err, "create attestation")
 	}
@@ -369,11 +434,19 @@ func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, m
 	}
 
 	// Emit the handle message event
-	return ctx.EventManager().EmitTypedEvent(
+	return ctx.EventManager().EmitTypedEvents(
 		&types.EventClaim{
 			Message:       string(msg.GetType()),
 			ClaimHash:     string(hash),
-			AttestationId: string(types.GetAttestationKey(msg.GetEventNonce(), hash)),
+			AttestationId: string(types.GetAttestationKey(evmChainPrefix, msg.GetEventNonce(), hash)),
+		},
+		&eventsv1.EventClaimSubmitted{
+			EvmChainPrefix: evmChainPrefix,
+			ClaimType:      msg.GetType(),
+			EventNonce:     msg.GetEventNonce(),
+			EthBlockHeight: msg.GetEthBlockHeight(),
+			Orchestrator:   msg.GetClaimer().String(),
+			ClaimHash:      hex.EncodeToString(hash),
 		},
 	)
 }
@@ -451,14 +524,37 @@ func (k msgServer) SendToCosmosClaim(c context.Context, msg *types.MsgSendToCosm
 // in the same block. This endpoint triggers the creation of those ibc-transfer events which relayers watch for.
 func (k msgServer) ExecuteIbcAutoForwards(c context.Context, msg *types.MsgExecuteIbcAutoForwards) (*types.MsgExecuteIbcAutoForwardsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, msg.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := k.ProcessPendingIbcAutoForwards(ctx, msg.GetForwardsToClear()); err != nil {
+	if err := k.ProcessPendingIbcAutoForwards(ctx, evmChainPrefix, msg.GetForwardsToClear()); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgExecuteIbcAutoForwardsResponse{}, nil
 }
 
+// ClaimAirdrop pays out the sender's share of a Merkle airdrop created by a MerkleAirdropProposal
+func (k msgServer) ClaimAirdrop(c context.Context, msg *types.MsgClaimAirdrop) (*types.MsgClaimAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	claimer, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid claimer")
+	}
+	proof, err := msg.DecodeProof()
+	if err != nil {
+		return nil, err
+	}
+	if err := k.ClaimMerkleAirdrop(ctx, claimer, msg.AirdropId, msg.Amount, proof); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAirdropResponse{}, nil
+}
+
 // WithdrawClaim handles MsgBatchSendToEthClaim
 // TODO it is possible to submit an old msgWithdrawClaim (old defined as covering an event nonce that has already been
 // executed aka 'observed' and had it's slashing window expire) that will never be cleaned up in the endblocker. This
@@ -498,7 +594,7 @@ func additionalPatchChecks(ctx sdk.Context, k msgServer, msg *types.MsgBatchSend
 	}
 
 	// Replicate the following but without using a gas meter:
-	b := k.GetOutgoingTXBatch(ctx, *contractAddress, msg.BatchNonce)
+	b := k.GetOutgoingTXBatch(ctx, types.ClaimEvmChainPrefix(msg), *contractAddress, msg.BatchNonce)
 	if b == nil {
 		// Batch deleted, just add the vote to the stored attestation
 		return
@@ -571,11 +667,15 @@ func (k msgServer) ValsetUpdateClaim(c context.Context, msg *types.MsgValsetUpda
 
 func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendToEth) (*types.MsgCancelSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, msg.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
-	err = k.RemoveFromOutgoingPoolAndRefund(ctx, msg.TransactionId, sender)
+	err = k.RemoveFromOutgoingPoolAndRefund(ctx, evmChainPrefix, msg.TransactionId, sender)
 	if err != nil {
 		return nil, err
 	}
@@ -585,8 +685,12 @@ func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendTo
 
 func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	evmChainPrefix, err := k.ResolveEvmChainPrefix(ctx, msg.EvmChainPrefix)
+	if err != nil {
+		return nil, err
+	}
 
-	err := k.CheckBadSignatureEvidence(ctx, msg)
+	err = k.CheckBadSignatureEvidence(ctx, evmChainPrefix, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,7 @@ func TestConfirmHandlerCommon(t *testing.T) {
 		CosmosBlockCreated: 0,
 	}
 
-	checkpoint := batch.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx))
+	checkpoint := batch.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx, EthChainPrefix))
 
 	ethSignature, err := types.NewEthereumSignature(checkpoint, privKey)
 	require.NoError(t, err)
@@ -69,7 +69,7 @@ func confirmHandlerCommonWithAddress(t *testing.T, address string, testVar testI
 		CosmosBlockCreated: 0,
 	}
 
-	checkpoint := batch.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx))
+	checkpoint := batch.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx, EthChainPrefix))
 
 	ethSignature, err := types.NewEthereumSignature(checkpoint, privKey)
 	require.NoError(t, err)
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GetPendingWorkByOrchestrator returns the stored valsets, batches and logic calls which orchestrator has not signed,
+// ordered by the height they were created at. Valsets come before batches and batches before logic calls created
+// at the same height. Like the LastPending*ByAddr queries at most MaxResults unsigned items of each kind are
+// collected, and the MaxResults oldest of them are returned
+func (k Keeper) GetPendingWorkByOrchestrator(ctx sdk.Context, evmChainPrefix string, orchestrator sdk.AccAddress) []types.PendingWork {
+	params := k.GetParams(ctx)
+	gravityID := k.GetGravityID(ctx, evmChainPrefix)
+	work := []types.PendingWork{}
+	// collected is the number of items collected of the kind being iterated
+	collected := 0
+
+	k.IterateValsets(ctx, evmChainPrefix, func(_ []byte, valset *types.Valset) bool {
+		if k.GetValsetConfirm(ctx, evmChainPrefix, valset.Nonce, orchestrator) == nil {
+			// nolint: exhaustruct
+			work = append(work, types.PendingWork{
+				Duty:            types.BRIDGE_DUTY_VALSET,
+				CreatedHeight:   valset.Height,
+				SlashableHeight: valsetSlashableHeight(params, *valset),
+				Checkpoint:      valset.GetCheckpoint(gravityID),
+				Valset:          valset,
+			})
+			collected++
+		}
+		return collected == MaxResults
+	})
+
+	collected = 0
+
+	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+		if k.GetBatchConfirm(ctx, evmChainPrefix, batch.BatchNonce, batch.TokenContract, orchestrator) == nil {
+			external := batch.ToExternal()
+			// nolint: exhaustruct
+			work = append(work, types.PendingWork{
+				Duty:            types.BRIDGE_DUTY_BATCH,
+				CreatedHeight:   batch.CosmosBlockCreated,
+				SlashableHeight: batchSlashableHeight(params, batch.CosmosBlockCreated),
+				Checkpoint:      batch.GetCheckpoint(gravityID),
+				Batch:           &external,
+			})
+			collected++
+		}
+		return collected == MaxResults
+	})
+
+	collected = 0
+
+	k.IterateOutgoingLogicCalls(ctx, evmChainPrefix, func(_ []byte, call types.OutgoingLogicCall) bool {
+		if k.GetLogicCallConfirm(ctx, evmChainPrefix, call.InvalidationId, call.InvalidationNonce, orchestrator) == nil {
+			call := call
+			// nolint: exhaustruct
+			work = append(work, types.PendingWork{
+				Duty:            types.BRIDGE_DUTY_LOGIC_CALL,
+				CreatedHeight:   call.CosmosBlockCreated,
+				SlashableHeight: logicCallSlashableHeight(params, call.CosmosBlockCreated),
+				Checkpoint:      call.GetCheckpoint(gravityID),
+				LogicCall:       &call,
+			})
+			collected++
+		}
+		return collected == MaxResults
+	})
+
+	// the work was collected by type, the stable sort keeps valsets, batches and logic calls of a height in order
+	sort.SliceStable(work, func(i, j int) bool {
+		return work[i].CreatedHeight < work[j].CreatedHeight
+	})
+	if len(work) > MaxResults {
+		work = work[:MaxResults]
+	}
+	return work
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// nolint: exhaustruct
+func TestPendingWorkByOrchestrator(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { ctx.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	params := k.GetParams(ctx)
+	gravityID := k.GetGravityID(ctx, EthChainPrefix)
+	tokenContract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+
+	// a valset and logic call created at height 5 and a batch created earlier, at height 3
+	ctx = ctx.WithBlockHeight(5)
+	vs, err := k.GetCurrentValset(ctx, EthChainPrefix)
+	require.NoError(t, err)
+	vs.Height = 5
+	vs.Nonce = k.GetLatestValsetNonce(ctx, EthChainPrefix) + 1
+	k.StoreValset(ctx, EthChainPrefix, vs)
+	k.SetLatestValsetNonce(ctx, EthChainPrefix, vs.Nonce)
+
+	externalBatch := types.OutgoingTxBatch{
+		BatchNonce:         1,
+		BatchTimeout:       1000,
+		Transactions:       []types.OutgoingTransferTx{},
+		TokenContract:      tokenContract,
+		CosmosBlockCreated: 3,
+	}
+	batch, err := externalBatch.ToInternal()
+	require.NoError(t, err)
+	k.StoreBatch(ctx, EthChainPrefix, *batch)
+
+	call := types.OutgoingLogicCall{
+		Transfers:            []types.ERC20Token{},
+		Fees:                 []types.ERC20Token{},
+		LogicContractAddress: "0x17c1736CcF692F653c433d7aa2aB45148C016F68",
+		Payload:              []byte{},
+		Timeout:              420,
+		InvalidationId:       []byte{0x01},
+		InvalidationNonce:    1,
+		CosmosBlockCreated:   5,
+	}
+	k.SetOutgoingLogicCall(ctx, EthChainPrefix, call)
+
+	// the second orchestrator has signed the valset
+	ethAddr, err := types.NewEthAddress(EthAddrs[1].String())
+	require.NoError(t, err)
+	k.SetValsetConfirm(ctx, EthChainPrefix, *types.NewMsgValsetConfirm(vs.Nonce, *ethAddr, OrchAddrs[1], "dummysig"))
+
+	res, err := k.PendingWorkByOrchestrator(sdk.WrapSDKContext(ctx), &types.QueryPendingWorkByOrchestratorRequest{Address: OrchAddrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Work, 3)
+	require.Equal(t, types.BRIDGE_DUTY_BATCH, res.Work[0].Duty)
+	require.Equal(t, uint64(3), res.Work[0].CreatedHeight)
+	require.Equal(t, 3+params.SignedBatchesWindow+1, res.Work[0].SlashableHeight)
+	require.Equal(t, batch.GetCheckpoint(gravityID), res.Work[0].Checkpoint)
+	require.Equal(t, externalBatch, *res.Work[0].Batch)
+	require.Equal(t, types.BRIDGE_DUTY_VALSET, res.Work[1].Duty)
+	require.Equal(t, vs.Height+params.SignedValsetsWindow, res.Work[1].SlashableHeight)
+	require.Equal(t, vs.GetCheckpoint(gravityID), res.Work[1].Checkpoint)
+	require.Equal(t, vs.Nonce, res.Work[1].Valset.Nonce)
+	require.Equal(t, types.BRIDGE_DUTY_LOGIC_CALL, res.Work[2].Duty)
+	require.Equal(t, call.GetCheckpoint(gravityID), res.Work[2].Checkpoint)
+	require.Equal(t, call.InvalidationNonce, res.Work[2].LogicCall.InvalidationNonce)
+
+	res, err = k.PendingWorkByOrchestrator(sdk.WrapSDKContext(ctx), &types.QueryPendingWorkByOrchestratorRequest{Address: OrchAddrs[1].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Work, 2)
+	require.Equal(t, types.BRIDGE_DUTY_BATCH, res.Work[0].Duty)
+	require.Equal(t, types.BRIDGE_DUTY_LOGIC_CALL, res.Work[1].Duty)
+
+	// the response is capped at MaxResults, the oldest work is kept
+	for i := 0; i < MaxResults; i++ {
+		call.InvalidationNonce = uint64(2 + i)
+		call.CosmosBlockCreated = uint64(10 + i)
+		k.SetOutgoingLogicCall(ctx, EthChainPrefix, call)
+	}
+	res, err = k.PendingWorkByOrchestrator(sdk.WrapSDKContext(ctx), &types.QueryPendingWorkByOrchestratorRequest{Address: OrchAddrs[1].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Work, MaxResults)
+	require.Equal(t, types.BRIDGE_DUTY_BATCH, res.Work[0].Duty)
+	require.Equal(t, uint64(1), res.Work[1].LogicCall.InvalidationNonce)
+	require.Equal(t, uint64(MaxResults-1), res.Work[MaxResults-1].LogicCall.InvalidationNonce)
+
+	_, err = k.PendingWorkByOrchestrator(sdk.WrapSDKContext(ctx), &types.QueryPendingWorkByOrchestratorRequest{Address: "gravity1"})
+	require.Error(t, err)
+}
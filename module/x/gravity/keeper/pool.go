@@ -5,9 +5,11 @@ import (
 	"sort"
 	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
@@ -19,7 +21,7 @@ import (
 // - persists an OutgoingTx
 // - adds the TX to the `available` TX pool
 func (k Keeper) AddToOutgoingPool(
-	ctx sdk.Context,
+	ctx sdk.Context, evmChainPrefix string,
 	sender sdk.AccAddress,
 	counterpartReceiver types.EthAddress,
 	amount sdk.Coin,
@@ -35,7 +37,7 @@ func (k Keeper) AddToOutgoingPool(
 	// If the coin is a gravity voucher, burn the coins. If not, check if there is a deployed ERC20 contract representing it.
 	// If there is, lock the coins.
 
-	_, tokenContract, err := k.DenomToERC20Lookup(ctx, totalAmount.Denom)
+	_, tokenContract, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, totalAmount.Denom)
 	if err != nil {
 		return 0, err
 	}
@@ -73,21 +75,33 @@ func (k Keeper) AddToOutgoingPool(
 	}
 
 	// add a second index with the fee
-	err = k.addUnbatchedTX(ctx, outgoing)
+	err = k.addUnbatchedTX(ctx, evmChainPrefix, outgoing)
 	if err != nil {
 		panic(err)
 	}
+	k.setOutgoingTxCreatedHeight(ctx, evmChainPrefix, nextID, uint64(ctx.BlockHeight()))
+	telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyPoolTxsAdded), 1,
+		types.EvmChainMetricLabels(evmChainPrefix, types.TokenMetricLabel(erc20Token.Contract)))
 
 	// todo: add second index for sender so that we can easily query: give pending Tx by sender
 	// todo: what about a second index for receiver?
 
-	return nextID, ctx.EventManager().EmitTypedEvent(
+	return nextID, ctx.EventManager().EmitTypedEvents(
 		&types.EventWithdrawalReceived{
-			BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress().Hex(),
-			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx))),
+			BridgeContract: k.GetBridgeContractAddress(ctx, evmChainPrefix).GetAddress().Hex(),
+			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
 			OutgoingTxId:   strconv.Itoa(int(nextID)),
 			Nonce:          fmt.Sprint(nextID),
 		},
+		&eventsv1.EventSendToEthQueued{
+			EvmChainPrefix: evmChainPrefix,
+			TxId:           nextID,
+			Sender:         sender.String(),
+			Receiver:       counterpartReceiver.GetAddress().Hex(),
+			TokenContract:  tokenContract.GetAddress().Hex(),
+			Amount:         amount,
+			BridgeFee:      fee,
+		},
 	)
 }
 
@@ -95,12 +109,12 @@ func (k Keeper) AddToOutgoingPool(
 // - checks that the provided tx actually exists
 // - deletes the unbatched tx from the pool
 // - issues the tokens back to the sender
-func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, sender sdk.AccAddress) error {
+func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, evmChainPrefix string, txId uint64, sender sdk.AccAddress) error {
 	if ctx.IsZero() || txId < 1 || sdk.VerifyAddressFormat(sender) != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, "arguments")
 	}
 	// check that we actually have a tx with that id and what it's details are
-	tx, err := k.GetUnbatchedTxById(ctx, txId)
+	tx, err := k.GetUnbatchedTxById(ctx, evmChainPrefix, txId)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "unknown transaction with id %d from sender %s", txId, sender.String())
 	}
@@ -118,18 +132,22 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 	}
 
 	// delete this tx from the pool
-	err = k.removeUnbatchedTX(ctx, *tx.Erc20Fee, txId)
+	err = k.removeUnbatchedTX(ctx, evmChainPrefix, *tx.Erc20Fee, txId)
 	if err != nil {
 		return sdkerrors.Wrapf(types.ErrInvalid, "txId %d not in unbatched index! Must be in a batch!", txId)
 	}
 	// Make sure the tx was removed
-	oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
+	oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, evmChainPrefix, *tx.Erc20Fee, tx.Id)
 	if oldTx != nil || oldTxErr == nil {
 		return sdkerrors.Wrapf(types.ErrInvalid, "tx with id %d was not fully removed from the pool, a duplicate must exist", txId)
 	}
+	k.deleteOutgoingTxCreatedHeight(ctx, evmChainPrefix, txId)
+	telemetry.IncrCounterWithLabels(types.MetricKeys(types.MetricKeyPoolTxsRefunded), 1,
+		types.EvmChainMetricLabels(evmChainPrefix, types.TokenMetricLabel(tx.Erc20Token.Contract)))
+	k.resetEmptyPoolMetrics(ctx, evmChainPrefix, tx.Erc20Token.Contract)
 
 	// Calculate refund
-	_, denom := k.ERC20ToDenomLookup(ctx, tx.Erc20Token.Contract)
+	_, denom := k.ERC20ToDenomLookup(ctx, evmChainPrefix, tx.Erc20Token.Contract)
 	totalToRefund := sdk.NewCoin(denom, tx.Erc20Token.Amount)
 	totalToRefund.Amount = totalToRefund.Amount.Add(tx.Erc20Fee.Amount)
 	totalToRefundCoins := sdk.NewCoins(totalToRefund)
@@ -139,21 +157,28 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 		return sdkerrors.Wrap(err, "transfer vouchers")
 	}
 
-	return ctx.EventManager().EmitTypedEvent(
+	return ctx.EventManager().EmitTypedEvents(
 		&types.EventWithdrawCanceled{
 			Sender:         sender.String(),
 			TxId:           fmt.Sprint(txId),
-			BridgeContract: k.GetBridgeContractAddress(ctx).GetAddress().Hex(),
-			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx))),
+			BridgeContract: k.GetBridgeContractAddress(ctx, evmChainPrefix).GetAddress().Hex(),
+			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
+		},
+		&eventsv1.EventSendToEthCanceled{
+			EvmChainPrefix: evmChainPrefix,
+			TxId:           txId,
+			Sender:         sender.String(),
+			TokenContract:  tx.Erc20Token.Contract.GetAddress().Hex(),
+			Refund:         totalToRefund,
 		},
 	)
 }
 
 // addUnbatchedTx creates a new transaction in the pool
 // WARNING: Do not make this function public
-func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.InternalOutgoingTransferTx) error {
+func (k Keeper) addUnbatchedTX(ctx sdk.Context, evmChainPrefix string, val *types.InternalOutgoingTransferTx) error {
 	store := ctx.KVStore(k.storeKey)
-	idxKey := types.GetOutgoingTxPoolKey(*val.Erc20Fee, val.Id)
+	idxKey := types.GetOutgoingTxPoolKey(evmChainPrefix, *val.Erc20Fee, val.Id)
 	if store.Has(idxKey) {
 		return sdkerrors.Wrap(types.ErrDuplicate, "transaction already in pool")
 	}
@@ -171,9 +196,9 @@ func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.InternalOutgoingTrans
 
 // removeUnbatchedTXIndex removes the tx from the pool
 // WARNING: Do not make this function public
-func (k Keeper) removeUnbatchedTX(ctx sdk.Context, fee types.InternalERC20Token, txID uint64) error {
+func (k Keeper) removeUnbatchedTX(ctx sdk.Context, evmChainPrefix string, fee types.InternalERC20Token, txID uint64) error {
 	store := ctx.KVStore(k.storeKey)
-	idxKey := types.GetOutgoingTxPoolKey(fee, txID)
+	idxKey := types.GetOutgoingTxPoolKey(evmChainPrefix, fee, txID)
 	if !store.Has(idxKey) {
 		return sdkerrors.Wrap(types.ErrUnknown, "pool transaction")
 	}
@@ -186,9 +211,9 @@ func (k Keeper) removeUnbatchedTX(ctx sdk.Context, fee types.InternalERC20Token,
 ///////////////////////////////////////////////////////////////////////////////////////
 
 // GetUnbatchedTxByFeeAndId grabs a tx from the pool given its fee and txID
-func (k Keeper) GetUnbatchedTxByFeeAndId(ctx sdk.Context, fee types.InternalERC20Token, txID uint64) (*types.InternalOutgoingTransferTx, error) {
+func (k Keeper) GetUnbatchedTxByFeeAndId(ctx sdk.Context, evmChainPrefix string, fee types.InternalERC20Token, txID uint64) (*types.InternalOutgoingTransferTx, error) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetOutgoingTxPoolKey(fee, txID))
+	bz := store.Get(types.GetOutgoingTxPoolKey(evmChainPrefix, fee, txID))
 	if bz == nil {
 		return nil, sdkerrors.Wrap(types.ErrUnknown, "pool transaction")
 	}
@@ -206,9 +231,9 @@ func (k Keeper) GetUnbatchedTxByFeeAndId(ctx sdk.Context, fee types.InternalERC2
 
 // GetUnbatchedTxById grabs a tx from the pool given only the txID
 // note that due to the way unbatched txs are indexed, the GetUnbatchedTxByFeeAndId method is much faster
-func (k Keeper) GetUnbatchedTxById(ctx sdk.Context, txID uint64) (*types.InternalOutgoingTransferTx, error) {
+func (k Keeper) GetUnbatchedTxById(ctx sdk.Context, evmChainPrefix string, txID uint64) (*types.InternalOutgoingTransferTx, error) {
 	var r *types.InternalOutgoingTransferTx = nil
-	k.IterateUnbatchedTransactions(ctx, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+	k.IterateUnbatchedTransactions(ctx, evmChainPrefix, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 		if tx.Id == txID {
 			r = tx
 			return true
@@ -225,18 +250,18 @@ func (k Keeper) GetUnbatchedTxById(ctx sdk.Context, txID uint64) (*types.Interna
 
 // GetUnbatchedTransactionsByContract grabs all unbatched transactions from the tx pool for the given contract
 // unbatched transactions are sorted by fee amount in DESC order
-func (k Keeper) GetUnbatchedTransactionsByContract(ctx sdk.Context, contractAddress types.EthAddress) []*types.InternalOutgoingTransferTx {
-	return k.collectUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(contractAddress))
+func (k Keeper) GetUnbatchedTransactionsByContract(ctx sdk.Context, evmChainPrefix string, contractAddress types.EthAddress) []*types.InternalOutgoingTransferTx {
+	return k.collectUnbatchedTransactions(ctx, evmChainPrefix, types.GetOutgoingTxPoolContractPrefix(evmChainPrefix, contractAddress))
 }
 
 // GetUnbatchedTransactions grabs all transactions from the tx pool, useful for queries or genesis save/load
-func (k Keeper) GetUnbatchedTransactions(ctx sdk.Context) []*types.InternalOutgoingTransferTx {
-	return k.collectUnbatchedTransactions(ctx, types.OutgoingTXPoolKey)
+func (k Keeper) GetUnbatchedTransactions(ctx sdk.Context, evmChainPrefix string) []*types.InternalOutgoingTransferTx {
+	return k.collectUnbatchedTransactions(ctx, evmChainPrefix, types.AppendEvmChainPrefix(types.OutgoingTXPoolKey, evmChainPrefix))
 }
 
 // Aggregates all unbatched transactions in the store with a given prefix
-func (k Keeper) collectUnbatchedTransactions(ctx sdk.Context, prefixKey []byte) (out []*types.InternalOutgoingTransferTx) {
-	k.filterAndIterateUnbatchedTransactions(ctx, prefixKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+func (k Keeper) collectUnbatchedTransactions(ctx sdk.Context, evmChainPrefix string, prefixKey []byte) (out []*types.InternalOutgoingTransferTx) {
+	k.filterAndIterateUnbatchedTransactions(ctx, evmChainPrefix, prefixKey, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 		out = append(out, tx)
 		return false
 	})
@@ -246,20 +271,20 @@ func (k Keeper) collectUnbatchedTransactions(ctx sdk.Context, prefixKey []byte)
 // IterateUnbatchedTransactionsByContract iterates through unbatched transactions from the tx pool for the given contract,
 // executing the given callback on each discovered Tx. Return true in cb to stop iteration, false to continue.
 // unbatched transactions are sorted by fee amount in DESC order
-func (k Keeper) IterateUnbatchedTransactionsByContract(ctx sdk.Context, contractAddress types.EthAddress, cb func(key []byte, tx *types.InternalOutgoingTransferTx) bool) {
-	k.filterAndIterateUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(contractAddress), cb)
+func (k Keeper) IterateUnbatchedTransactionsByContract(ctx sdk.Context, evmChainPrefix string, contractAddress types.EthAddress, cb func(key []byte, tx *types.InternalOutgoingTransferTx) bool) {
+	k.filterAndIterateUnbatchedTransactions(ctx, evmChainPrefix, types.GetOutgoingTxPoolContractPrefix(evmChainPrefix, contractAddress), cb)
 }
 
 // IterateUnbatchedTransactions iterates through all unbatched transactions in DESC order, executing the given callback
 // on each discovered Tx. Return true in cb to stop iteration, false to continue.
 // For finer grained control, use filterAndIterateUnbatchedTransactions or one of the above methods
-func (k Keeper) IterateUnbatchedTransactions(ctx sdk.Context, cb func(key []byte, tx *types.InternalOutgoingTransferTx) (stop bool)) {
-	k.filterAndIterateUnbatchedTransactions(ctx, types.OutgoingTXPoolKey, cb)
+func (k Keeper) IterateUnbatchedTransactions(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, tx *types.InternalOutgoingTransferTx) (stop bool)) {
+	k.filterAndIterateUnbatchedTransactions(ctx, evmChainPrefix, types.AppendEvmChainPrefix(types.OutgoingTXPoolKey, evmChainPrefix), cb)
 }
 
 // filterAndIterateUnbatchedTransactions iterates through all unbatched transactions whose keys begin with prefixKey in DESC order
 // prefixKey should be either OutgoingTXPoolKey or some more granular key, passing the wrong key will cause a panic
-func (k Keeper) filterAndIterateUnbatchedTransactions(ctx sdk.Context, prefixKey []byte, cb func(key []byte, tx *types.InternalOutgoingTransferTx) bool) {
+func (k Keeper) filterAndIterateUnbatchedTransactions(ctx sdk.Context, evmChainPrefix string, prefixKey []byte, cb func(key []byte, tx *types.InternalOutgoingTransferTx) bool) {
 	prefixStore := ctx.KVStore(k.storeKey)
 	iter := prefixStore.ReverseIterator(prefixRange(prefixKey))
 	defer iter.Close()
@@ -284,13 +309,13 @@ func (k Keeper) filterAndIterateUnbatchedTransactions(ctx sdk.Context, prefixKey
 // GetBatchFeeByTokenType picks transactions in the pool for a potential batch and gives the total fees the batch would
 // grant if created right now. This info is both presented to relayers for the purpose of determining when to request
 // batches and also used by the batch creation process to decide not to create a new batch (fees must be increasing)
-func (k Keeper) GetBatchFeeByTokenType(ctx sdk.Context, tokenContractAddr types.EthAddress, maxElements uint) *types.BatchFees {
+func (k Keeper) GetBatchFeeByTokenType(ctx sdk.Context, evmChainPrefix string, tokenContractAddr types.EthAddress, maxElements uint) *types.BatchFees {
 	batchFee := types.BatchFees{Token: tokenContractAddr.GetAddress().Hex(), TotalFees: sdk.NewInt(0), TxCount: 0}
 
 	// Since transactions are stored with keys [ prefix | contract | fee_amount] and since this iterator returns results
 	// in DESC order, we can safely pick the first N and have a batch with maximal fees for relaying
-	k.IterateUnbatchedTransactionsByContract(ctx, tokenContractAddr, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
-		if !k.IsOnBlacklist(ctx, *tx.DestAddress) {
+	k.IterateUnbatchedTransactionsByContract(ctx, evmChainPrefix, tokenContractAddr, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+		if !k.IsOnBlacklist(ctx, evmChainPrefix, *tx.DestAddress) {
 			fee := tx.Erc20Fee
 			if fee.Contract.GetAddress() != tokenContractAddr.GetAddress() {
 				panic(fmt.Errorf("unexpected fee contract %s under key %v when getting batch fees for contract %s", fee.Contract.GetAddress().Hex(), key, tokenContractAddr.GetAddress().Hex()))
@@ -309,8 +334,8 @@ func (k Keeper) GetBatchFeeByTokenType(ctx sdk.Context, tokenContractAddr types.
 
 // GetAllBatchFees creates a fee entry for every batch type currently in the store
 // this can be used by relayers to determine what batch types are desireable to request
-func (k Keeper) GetAllBatchFees(ctx sdk.Context, maxElements uint) (batchFees []types.BatchFees) {
-	batchFeesMap := k.createBatchFees(ctx, maxElements)
+func (k Keeper) GetAllBatchFees(ctx sdk.Context, evmChainPrefix string, maxElements uint) (batchFees []types.BatchFees) {
+	batchFeesMap := k.createBatchFees(ctx, evmChainPrefix, maxElements)
 	// create array of batchFees
 	for _, batchFee := range batchFeesMap {
 		batchFees = append(batchFees, batchFee)
@@ -328,19 +353,23 @@ func (k Keeper) GetAllBatchFees(ctx sdk.Context, maxElements uint) (batchFees []
 // createBatchFees iterates over the unbatched transaction pool and creates batch token fee map
 // Implicitly creates batches with the highest potential fee because the transaction keys enforce an order which goes
 // fee contract address -> fee amount -> transaction nonce
-func (k Keeper) createBatchFees(ctx sdk.Context, maxElements uint) map[string]types.BatchFees {
+func (k Keeper) createBatchFees(ctx sdk.Context, evmChainPrefix string, maxElements uint) map[string]types.BatchFees {
 	batchFeesMap := make(map[string]types.BatchFees)
+	// the most transfers a batch of each token may hold, given its gas budget
+	params := k.GetParams(ctx)
+	batchSizes := make(map[string]uint64)
 
-	k.IterateUnbatchedTransactions(ctx, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+	k.IterateUnbatchedTransactions(ctx, evmChainPrefix, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 		feeAddrStr := tx.Erc20Fee.Contract.GetAddress()
 
 		if fees, ok := batchFeesMap[feeAddrStr.Hex()]; ok {
-			if fees.TxCount < uint64(maxElements) {
+			if fees.TxCount < batchSizes[feeAddrStr.Hex()] {
 				fees.TotalFees = batchFeesMap[feeAddrStr.Hex()].TotalFees.Add(tx.Erc20Fee.Amount)
 				fees.TxCount++
 				batchFeesMap[feeAddrStr.Hex()] = fees
 			}
 		} else {
+			batchSizes[feeAddrStr.Hex()] = uint64(params.TokenGasParamsFor(evmChainPrefix, tx.Erc20Fee.Contract).MaxBatchTransfers(maxElements))
 			batchFeesMap[feeAddrStr.Hex()] = types.BatchFees{
 				Token:     feeAddrStr.Hex(),
 				TotalFees: tx.Erc20Fee.Amount,
@@ -354,6 +383,83 @@ func (k Keeper) createBatchFees(ctx sdk.Context, maxElements uint) map[string]ty
 	return batchFeesMap
 }
 
+// PoolMetricsInterval is the number of blocks between two reports of the pool gauges by the EndBlocker, a report
+// walks the whole pool of the chain
+const PoolMetricsInterval int64 = 50
+
+// SetPoolMetrics reports the number of transfers and the amount waiting in the pool of every token of the chain,
+// tokens whose pool was emptied are reset by resetEmptyPoolMetrics when their last transfer leaves the pool
+func (k Keeper) SetPoolMetrics(ctx sdk.Context, evmChainPrefix string) {
+	txs := make(map[types.EthAddress]int)
+	values := make(map[types.EthAddress]sdk.Int)
+	k.IterateUnbatchedTransactions(ctx, evmChainPrefix, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		contract := tx.Erc20Token.Contract
+		if _, ok := values[contract]; !ok {
+			values[contract] = sdk.ZeroInt()
+		}
+		txs[contract]++
+		values[contract] = values[contract].Add(tx.Erc20Token.Amount)
+		return false
+	})
+	for contract, count := range txs {
+		labels := types.EvmChainMetricLabels(evmChainPrefix, types.TokenMetricLabel(contract))
+		telemetry.SetGaugeWithLabels(types.MetricKeys(types.MetricKeyPoolTxs), float32(count), labels)
+		telemetry.SetGaugeWithLabels(types.MetricKeys(types.MetricKeyPoolValue), types.MetricValue(values[contract]), labels)
+	}
+}
+
+// resetEmptyPoolMetrics sets the pool gauges of the token to zero once its pool is empty, SetPoolMetrics only
+// reports the tokens which have transfers in the pool
+func (k Keeper) resetEmptyPoolMetrics(ctx sdk.Context, evmChainPrefix string, contract types.EthAddress) {
+	empty := true
+	k.IterateUnbatchedTransactionsByContract(ctx, evmChainPrefix, contract, func(_ []byte, _ *types.InternalOutgoingTransferTx) bool {
+		empty = false
+		return true
+	})
+	if empty {
+		labels := types.EvmChainMetricLabels(evmChainPrefix, types.TokenMetricLabel(contract))
+		telemetry.SetGaugeWithLabels(types.MetricKeys(types.MetricKeyPoolTxs), 0, labels)
+		telemetry.SetGaugeWithLabels(types.MetricKeys(types.MetricKeyPoolValue), 0, labels)
+	}
+}
+
+// GetOutgoingTxCreatedHeight returns the height the transfer with the given id was added to the pool at, transfers
+// added before the v6 upgrade are recorded at the upgrade height
+func (k Keeper) GetOutgoingTxCreatedHeight(ctx sdk.Context, evmChainPrefix string, id uint64) (height uint64, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOutgoingTxCreatedHeightKey(evmChainPrefix, id))
+	if bz == nil {
+		return 0, false
+	}
+	return types.UInt64FromBytesUnsafe(bz), true
+}
+
+// setOutgoingTxCreatedHeight records the height the transfer with the given id was added to the pool at
+func (k Keeper) setOutgoingTxCreatedHeight(ctx sdk.Context, evmChainPrefix string, id uint64, height uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetOutgoingTxCreatedHeightKey(evmChainPrefix, id), types.UInt64Bytes(height))
+}
+
+// GetAllOutgoingTxCreatedHeights returns the recorded creation heights of the transfers of the chain, ordered by id
+func (k Keeper) GetAllOutgoingTxCreatedHeights(ctx sdk.Context, evmChainPrefix string) []types.OutgoingTxCreatedHeight {
+	prefix := types.AppendEvmChainPrefix(types.OutgoingTxCreatedHeightKey, evmChainPrefix)
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+
+	out := []types.OutgoingTxCreatedHeight{}
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, types.OutgoingTxCreatedHeight{
+			TxId:   types.UInt64FromBytesUnsafe(iter.Key()[len(prefix):]),
+			Height: types.UInt64FromBytesUnsafe(iter.Value()),
+		})
+	}
+	return out
+}
+
+// deleteOutgoingTxCreatedHeight forgets the creation height of a transfer which has left the bridge, either refunded
+// or executed on the EVM chain
+func (k Keeper) deleteOutgoingTxCreatedHeight(ctx sdk.Context, evmChainPrefix string, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetOutgoingTxCreatedHeightKey(evmChainPrefix, id))
+}
+
 // a specialized function used for iterating store counters, handling
 // returning, initializing and incrementing all at once. This is particularly
 // used for the transaction pool and batch pool where each batch or transaction is
@@ -3,12 +3,17 @@ package keeper
 import (
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/armon/go-metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
+	eventsv1 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/events/v1"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
@@ -31,7 +36,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 	// mint some voucher first
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
-	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -44,12 +49,12 @@ func TestAddToOutgoingPool(t *testing.T) {
 	for i, v := range []uint64{2, 3, 2, 1} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err)
 		t.Logf("___ response: %#v", r)
 		// Should create:
@@ -60,7 +65,7 @@ func TestAddToOutgoingPool(t *testing.T) {
 
 	}
 	// then
-	got := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *tokenContract)
+	got := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, EthChainPrefix, *tokenContract)
 
 	receiverAddr, err := types.NewEthAddress(myReceiver)
 	require.NoError(t, err)
@@ -127,20 +132,20 @@ func TestAddToOutgoingPoolEdgeCases(t *testing.T) {
 	require.NoError(t, err)
 	amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(100)), myTokenContractAddr)
 	require.NoError(t, err)
-	amount := amountToken.GravityCoin()
+	amount := amountToken.GravityCoin(EthChainPrefix)
 	feeToken, err := types.NewInternalERC20Token(sdk.NewInt(2), myTokenContractAddr)
 	require.NoError(t, err)
-	fee := feeToken.GravityCoin()
+	fee := feeToken.GravityCoin(EthChainPrefix)
 
 	//////// Nonexistant Token ////////
-	r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+	r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 	require.Error(t, err)
 	require.Zero(t, r)
 
 	// mint some voucher first
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
-	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -152,16 +157,16 @@ func TestAddToOutgoingPoolEdgeCases(t *testing.T) {
 	//////// Insufficient Balance from Amount ////////
 	badAmountToken, err := types.NewInternalERC20Token(sdk.NewInt(999999), myTokenContractAddr)
 	require.NoError(t, err)
-	badAmount := badAmountToken.GravityCoin()
-	r, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, badAmount, fee)
+	badAmount := badAmountToken.GravityCoin(EthChainPrefix)
+	r, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, badAmount, fee)
 	require.Error(t, err)
 	require.Zero(t, r)
 
 	//////// Insufficient Balance from Fee ////////
 	badFeeToken, err := types.NewInternalERC20Token(sdk.NewInt(999999), myTokenContractAddr)
 	require.NoError(t, err)
-	badFee := badFeeToken.GravityCoin()
-	r, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, badFee)
+	badFee := badFeeToken.GravityCoin(EthChainPrefix)
+	r, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, badFee)
 	require.Error(t, err)
 	require.Zero(t, r)
 
@@ -169,8 +174,8 @@ func TestAddToOutgoingPoolEdgeCases(t *testing.T) {
 	// Amount is 100, fee is the current balance - 99
 	badFeeToken, err = types.NewInternalERC20Token(sdk.NewInt(99999-99), myTokenContractAddr)
 	require.NoError(t, err)
-	badFee = badFeeToken.GravityCoin()
-	r, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, badFee)
+	badFee = badFeeToken.GravityCoin(EthChainPrefix)
+	r, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, badFee)
 	require.Error(t, err)
 	require.Zero(t, r)
 
@@ -179,7 +184,7 @@ func TestAddToOutgoingPoolEdgeCases(t *testing.T) {
 	mtSend := new(sdk.AccAddress)
 	var mtRecieve = types.ZeroAddress() // This address should not actually cause an issue
 	mtCoin := new(sdk.Coin)
-	r, err = input.GravityKeeper.AddToOutgoingPool(*mtCtx, *mtSend, mtRecieve, *mtCoin, *mtCoin)
+	r, err = input.GravityKeeper.AddToOutgoingPool(*mtCtx, EthChainPrefix, *mtSend, mtRecieve, *mtCoin, *mtCoin)
 	require.Error(t, err)
 	require.Zero(t, r)
 
@@ -187,8 +192,8 @@ func TestAddToOutgoingPoolEdgeCases(t *testing.T) {
 	badFeeContractAddr := "0x429881672b9AE42b8eBA0e26cd9c73711b891ca6"
 	badFeeToken, err = types.NewInternalERC20Token(sdk.NewInt(100), badFeeContractAddr)
 	require.NoError(t, err)
-	badFee = badFeeToken.GravityCoin()
-	r, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, badFee)
+	badFee = badFeeToken.GravityCoin(EthChainPrefix)
+	r, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, badFee)
 	require.Error(t, err)
 	require.Zero(t, r)
 }
@@ -211,7 +216,7 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 	// mint some voucher first
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
-	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -224,12 +229,12 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 	for i, v := range []uint64{2, 3, 2, 1} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		r, err2 := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		r, err2 := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err2)
 		t.Logf("___ response: %#v", r)
 	}
@@ -241,7 +246,7 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 	// mint some voucher first
 	allVouchersToken, err = types.NewInternalERC20Token(sdk.NewIntFromUint64(uint64(18446744073709551615)), myToken2ContractAddr)
 	require.NoError(t, err)
-	allVouchers = sdk.Coins{allVouchersToken.GravityCoin()}
+	allVouchers = sdk.Coins{allVouchersToken.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -256,17 +261,17 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 	for i := 0; i < 110; i++ {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myToken2ContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(5), myToken2ContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err)
 		t.Logf("___ response: %#v", r)
 	}
 
-	batchFees := input.GravityKeeper.GetAllBatchFees(ctx, OutgoingTxBatchSize)
+	batchFees := input.GravityKeeper.GetAllBatchFees(ctx, EthChainPrefix, OutgoingTxBatchSize)
 	/*
 		tokenFeeMap should be
 		map[0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5:8 0x7D1AfA7B718fb893dB30A3aBc0Cfc608AaCfeBB0:500]
@@ -306,13 +311,13 @@ func TestGetBatchFeeByTokenType(t *testing.T) {
 	// mint some vouchers first
 	allVouchersToken1, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr1)
 	require.NoError(t, err)
-	allVouchers1 := sdk.Coins{allVouchersToken1.GravityCoin()}
+	allVouchers1 := sdk.Coins{allVouchersToken1.GravityCoin(EthChainPrefix)}
 	allVouchersToken2, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr2)
 	require.NoError(t, err)
-	allVouchers2 := sdk.Coins{allVouchersToken2.GravityCoin()}
+	allVouchers2 := sdk.Coins{allVouchersToken2.GravityCoin(EthChainPrefix)}
 	allVouchersToken3, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr3)
 	require.NoError(t, err)
-	allVouchers3 := sdk.Coins{allVouchersToken3.GravityCoin()}
+	allVouchers3 := sdk.Coins{allVouchersToken3.GravityCoin(EthChainPrefix)}
 
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers1)
 	require.NoError(t, err)
@@ -339,57 +344,57 @@ func TestGetBatchFeeByTokenType(t *testing.T) {
 	for i := 0; i < 110; i++ {
 		amountToken1, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr1)
 		require.NoError(t, err)
-		amount1 := amountToken1.GravityCoin()
+		amount1 := amountToken1.GravityCoin(EthChainPrefix)
 		feeAmt1 := int64(i + 1) // fees can't be 0
 		feeToken1, err := types.NewInternalERC20Token(sdk.NewInt(feeAmt1), myTokenContractAddr1)
 		require.NoError(t, err)
-		fee1 := feeToken1.GravityCoin()
+		fee1 := feeToken1.GravityCoin(EthChainPrefix)
 		amountToken2, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr2)
 		require.NoError(t, err)
-		amount2 := amountToken2.GravityCoin()
+		amount2 := amountToken2.GravityCoin(EthChainPrefix)
 		feeAmt2 := int64(2*i + 1) // fees can't be 0
 		feeToken2, err := types.NewInternalERC20Token(sdk.NewInt(feeAmt2), myTokenContractAddr2)
 		require.NoError(t, err)
-		fee2 := feeToken2.GravityCoin()
+		fee2 := feeToken2.GravityCoin(EthChainPrefix)
 		amountToken3, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr3)
 		require.NoError(t, err)
-		amount3 := amountToken3.GravityCoin()
+		amount3 := amountToken3.GravityCoin(EthChainPrefix)
 		feeAmt3 := int64(3*i + 1) // fees can't be 0
 		feeToken3, err := types.NewInternalERC20Token(sdk.NewInt(feeAmt3), myTokenContractAddr3)
 		require.NoError(t, err)
-		fee3 := feeToken3.GravityCoin()
+		fee3 := feeToken3.GravityCoin(EthChainPrefix)
 
 		if i >= 10 {
 			totalFee1 += feeAmt1
 		}
-		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender1, *receiver, amount1, fee1)
+		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender1, *receiver, amount1, fee1)
 		require.NoError(t, err)
 		t.Logf("___ response: %d", r)
 
 		if i >= 10 {
 			totalFee2 += feeAmt2
 		}
-		r, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender2, *receiver, amount2, fee2)
+		r, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender2, *receiver, amount2, fee2)
 		require.NoError(t, err)
 		t.Logf("___ response: %d", r)
 
 		if i >= 10 {
 			totalFee3 += feeAmt3
 		}
-		r, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender3, *receiver, amount3, fee3)
+		r, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender3, *receiver, amount3, fee3)
 		require.NoError(t, err)
 		t.Logf("___ response: %d", r)
 	}
 
-	batchFee1 := input.GravityKeeper.GetBatchFeeByTokenType(ctx, *tokenContract1, 100)
+	batchFee1 := input.GravityKeeper.GetBatchFeeByTokenType(ctx, EthChainPrefix, *tokenContract1, 100)
 	require.Equal(t, batchFee1.Token, myTokenContractAddr1)
 	require.Equal(t, batchFee1.TotalFees.Uint64(), uint64(totalFee1), fmt.Errorf("expected total fees %d but got %d", batchFee1.TotalFees.Uint64(), uint64(totalFee1)))
 	require.Equal(t, batchFee1.TxCount, uint64(100), fmt.Errorf("expected tx count %d but got %d", batchFee1.TxCount, uint64(100)))
-	batchFee2 := input.GravityKeeper.GetBatchFeeByTokenType(ctx, *tokenContract2, 100)
+	batchFee2 := input.GravityKeeper.GetBatchFeeByTokenType(ctx, EthChainPrefix, *tokenContract2, 100)
 	require.Equal(t, batchFee2.Token, myTokenContractAddr2)
 	require.Equal(t, batchFee2.TotalFees.Uint64(), uint64(totalFee2), fmt.Errorf("expected total fees %d but got %d", batchFee2.TotalFees.Uint64(), uint64(totalFee2)))
 	require.Equal(t, batchFee2.TxCount, uint64(100), fmt.Errorf("expected tx count %d but got %d", batchFee2.TxCount, uint64(100)))
-	batchFee3 := input.GravityKeeper.GetBatchFeeByTokenType(ctx, *tokenContract3, 100)
+	batchFee3 := input.GravityKeeper.GetBatchFeeByTokenType(ctx, EthChainPrefix, *tokenContract3, 100)
 	require.Equal(t, batchFee3.Token, myTokenContractAddr3)
 	require.Equal(t, batchFee3.TotalFees.Uint64(), uint64(totalFee3), fmt.Errorf("expected total fees %d but got %d", batchFee3.TotalFees.Uint64(), uint64(totalFee3)))
 	require.Equal(t, batchFee3.TxCount, uint64(100), fmt.Errorf("expected tx count %d but got %d", batchFee3.TxCount, uint64(100)))
@@ -415,7 +420,7 @@ func TestRemoveFromOutgoingPoolAndRefund(t *testing.T) {
 
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(originalBal), myTokenContractAddr)
 	require.NoError(t, err)
-	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -425,7 +430,7 @@ func TestRemoveFromOutgoingPoolAndRefund(t *testing.T) {
 	require.NoError(t, err)
 
 	// Create unbatched transactions
-	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx))
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix))
 	feesAndAmounts := uint64(0)
 	ids := make([]uint64, 4)
 	fees := []uint64{2, 3, 2, 1}
@@ -433,13 +438,13 @@ func TestRemoveFromOutgoingPoolAndRefund(t *testing.T) {
 	for i, v := range fees {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(amounts[i]), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
 		feesAndAmounts += v + amounts[i]
-		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err)
 		t.Logf("___ response: %#v", r)
 		ids[i] = r
@@ -459,7 +464,7 @@ func TestRemoveFromOutgoingPoolAndRefund(t *testing.T) {
 	checkRemovedTx(t, input, ctx, ids[3], fees[3], amounts[3], &feesAndAmounts, originalBal, mySender, myTokenContractAddr, myTokenDenom)
 	checkRemovedTx(t, input, ctx, ids[1], fees[1], amounts[1], &feesAndAmounts, originalBal, mySender, myTokenContractAddr, myTokenDenom)
 	checkRemovedTx(t, input, ctx, ids[0], fees[0], amounts[0], &feesAndAmounts, originalBal, mySender, myTokenContractAddr, myTokenDenom)
-	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx))
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix))
 }
 
 func TestRemoveFromOutgoingPoolAndRefundCosmosOriginated(t *testing.T) {
@@ -484,9 +489,9 @@ func TestRemoveFromOutgoingPoolAndRefundCosmosOriginated(t *testing.T) {
 	require.NoError(t, err)
 
 	// add it to the ERC20 registry
-	input.GravityKeeper.setCosmosOriginatedDenomToERC20(ctx, myTokenDenom, *tokenAddr)
+	input.GravityKeeper.setCosmosOriginatedDenomToERC20(ctx, EthChainPrefix, myTokenDenom, *tokenAddr)
 
-	isCosmosOriginated, addr, err := input.GravityKeeper.DenomToERC20Lookup(ctx, myTokenDenom)
+	isCosmosOriginated, addr, err := input.GravityKeeper.DenomToERC20Lookup(ctx, EthChainPrefix, myTokenDenom)
 	require.True(t, isCosmosOriginated)
 	require.NoError(t, err)
 	require.Equal(t, tokenAddr.GetAddress().Hex(), myTokenContractAddr)
@@ -502,7 +507,7 @@ func TestRemoveFromOutgoingPoolAndRefundCosmosOriginated(t *testing.T) {
 	require.NoError(t, err)
 
 	// Create unbatched transactions
-	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx))
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix))
 	feesAndAmounts := uint64(0)
 	ids := make([]uint64, 4)
 	fees := []uint64{2, 3, 2, 1}
@@ -512,7 +517,7 @@ func TestRemoveFromOutgoingPoolAndRefundCosmosOriginated(t *testing.T) {
 		fee := sdk.NewCoin(myTokenDenom, sdk.NewIntFromUint64(v))
 
 		feesAndAmounts += v + amounts[i]
-		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err)
 		t.Logf("___ response: %#v", r)
 		ids[i] = r
@@ -532,7 +537,7 @@ func TestRemoveFromOutgoingPoolAndRefundCosmosOriginated(t *testing.T) {
 	checkRemovedTx(t, input, ctx, ids[3], fees[3], amounts[3], &feesAndAmounts, originalBal, mySender, myTokenContractAddr, myTokenDenom)
 	checkRemovedTx(t, input, ctx, ids[1], fees[1], amounts[1], &feesAndAmounts, originalBal, mySender, myTokenContractAddr, myTokenDenom)
 	checkRemovedTx(t, input, ctx, ids[0], fees[0], amounts[0], &feesAndAmounts, originalBal, mySender, myTokenContractAddr, myTokenDenom)
-	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx))
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix))
 }
 
 // Helper method to:
@@ -542,13 +547,13 @@ func TestRemoveFromOutgoingPoolAndRefundCosmosOriginated(t *testing.T) {
 // 4. Require that the unbatched transaction pool does not contain the refunded transaction via iterating its elements
 func checkRemovedTx(t *testing.T, input TestInput, ctx sdk.Context, id uint64, fee uint64, amount uint64,
 	feesAndAmounts *uint64, originalBal uint64, mySender sdk.AccAddress, myTokenContractAddr string, myTokenDenom string) {
-	err := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, id, mySender)
+	err := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, id, mySender)
 	require.NoError(t, err)
 	*feesAndAmounts -= fee + amount // user should have regained the locked amounts from tx
 	currentBal := input.BankKeeper.GetBalance(ctx, mySender, myTokenDenom).Amount.Uint64()
 	require.Equal(t, currentBal, originalBal-*feesAndAmounts)
 	expectedKey := myTokenContractAddr + fmt.Sprint(fee) + fmt.Sprint(id)
-	input.GravityKeeper.IterateUnbatchedTransactions(ctx, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+	input.GravityKeeper.IterateUnbatchedTransactions(ctx, EthChainPrefix, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
 		require.NotEqual(t, []byte(expectedKey), key)
 		found := id == tx.Id &&
 			fee == tx.Erc20Fee.Amount.Uint64() &&
@@ -584,11 +589,11 @@ func TestRefundInconsistentTx(t *testing.T) {
 	require.NoError(t, err)
 
 	// This way should fail
-	r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *myReceiver, amountToken.GravityCoin(), badFeeToken.GravityCoin())
+	r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amountToken.GravityCoin(EthChainPrefix), badFeeToken.GravityCoin(EthChainPrefix))
 	require.Zero(t, r)
 	require.Error(t, err)
 	// But this unsafe override won't fail
-	err = input.GravityKeeper.addUnbatchedTX(ctx, &types.InternalOutgoingTransferTx{
+	err = input.GravityKeeper.addUnbatchedTX(ctx, EthChainPrefix, &types.InternalOutgoingTransferTx{
 		Id:          uint64(5),
 		Sender:      mySender,
 		DestAddress: myReceiver,
@@ -597,7 +602,7 @@ func TestRefundInconsistentTx(t *testing.T) {
 	})
 	origBalances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.NoError(t, err, "someone added validation to addUnbatchedTx")
-	err = input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, uint64(5), mySender)
+	err = input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, uint64(5), mySender)
 	require.Error(t, err)
 	newBalances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.Equal(t, origBalances, newBalances)
@@ -615,7 +620,7 @@ func TestRefundNonexistentTx(t *testing.T) {
 
 	//////// Refund a tx which never existed ////////
 	origBalances := input.BankKeeper.GetAllBalances(ctx, mySender)
-	err := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, uint64(1), mySender)
+	err := input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, uint64(1), mySender)
 	require.Error(t, err)
 	newBalances := input.BankKeeper.GetAllBalances(ctx, mySender)
 	require.Equal(t, origBalances, newBalances)
@@ -641,7 +646,7 @@ func TestRefundTwice(t *testing.T) {
 	originalBal := uint64(99999)
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(originalBal), myTokenContractAddr)
 	require.NoError(t, err)
-	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -656,17 +661,17 @@ func TestRefundTwice(t *testing.T) {
 	require.NoError(t, err)
 	origBalances := input.BankKeeper.GetAllBalances(ctx, mySender)
 
-	txId, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+	txId, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amountToken.GravityCoin(EthChainPrefix), feeToken.GravityCoin(EthChainPrefix))
 	require.NoError(t, err)
 	afterAddBalances := input.BankKeeper.GetAllBalances(ctx, mySender)
 
 	// First refund goes through
-	err = input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, txId, mySender)
+	err = input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, txId, mySender)
 	require.NoError(t, err)
 	afterRefundBalances := input.BankKeeper.GetAllBalances(ctx, mySender)
 
 	// Second fails
-	err = input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, txId, mySender)
+	err = input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, txId, mySender)
 	require.Error(t, err)
 	afterSecondRefundBalances := input.BankKeeper.GetAllBalances(ctx, mySender)
 
@@ -700,12 +705,12 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 	// mint some vouchers first
 	allVouchersToken1, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr1)
 	require.NoError(t, err)
-	allVouchers1 := sdk.Coins{allVouchersToken1.GravityCoin()}
+	allVouchers1 := sdk.Coins{allVouchersToken1.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers1)
 	require.NoError(t, err)
 	allVouchersToken2, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr2)
 	require.NoError(t, err)
-	allVouchers2 := sdk.Coins{allVouchersToken2.GravityCoin()}
+	allVouchers2 := sdk.Coins{allVouchersToken2.GravityCoin(EthChainPrefix)}
 	require.NoError(t, err)
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers2)
 	require.NoError(t, err)
@@ -726,12 +731,12 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 	for i, v := range fees {
 		amountToken1, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(amounts[i]), myTokenContractAddr1)
 		require.NoError(t, err)
-		amount1 := amountToken1.GravityCoin()
+		amount1 := amountToken1.GravityCoin(EthChainPrefix)
 		feeToken1, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr1)
 		require.NoError(t, err)
-		fee1 := feeToken1.GravityCoin()
+		fee1 := feeToken1.GravityCoin(EthChainPrefix)
 
-		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender1, *receiver, amount1, fee1)
+		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender1, *receiver, amount1, fee1)
 		require.NoError(t, err)
 		ids1[i] = r
 		idToTxMap[r] = &types.OutgoingTransferTx{
@@ -743,12 +748,12 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 		}
 		amountToken2, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(amounts[i]), myTokenContractAddr2)
 		require.NoError(t, err)
-		amount2 := amountToken2.GravityCoin()
+		amount2 := amountToken2.GravityCoin(EthChainPrefix)
 		feeToken2, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr2)
 		require.NoError(t, err)
-		fee2 := feeToken2.GravityCoin()
+		fee2 := feeToken2.GravityCoin(EthChainPrefix)
 
-		r, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender2, *receiver, amount2, fee2)
+		r, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender2, *receiver, amount2, fee2)
 		require.NoError(t, err)
 		ids2[i] = r
 		idToTxMap[r] = &types.OutgoingTransferTx{
@@ -766,7 +771,7 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 	token1Amount, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(amounts[0]), myTokenContractAddr1)
 	require.NoError(t, err)
 	token1Id := ids1[0]
-	tx1, err1 := input.GravityKeeper.GetUnbatchedTxByFeeAndId(ctx, *token1Fee, token1Id)
+	tx1, err1 := input.GravityKeeper.GetUnbatchedTxByFeeAndId(ctx, EthChainPrefix, *token1Fee, token1Id)
 	require.NoError(t, err1)
 	expTx1, err1 := types.NewInternalOutgoingTransferTx(token1Id, mySender1.String(), myReceiver, token1Amount.ToExternal(), token1Fee.ToExternal())
 	require.NoError(t, err1)
@@ -778,23 +783,23 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 	require.NoError(t, err)
 
 	token2Id := ids2[3]
-	tx2, err2 := input.GravityKeeper.GetUnbatchedTxByFeeAndId(ctx, *token2Fee, token2Id)
+	tx2, err2 := input.GravityKeeper.GetUnbatchedTxByFeeAndId(ctx, EthChainPrefix, *token2Fee, token2Id)
 	require.NoError(t, err2)
 	expTx2, err2 := types.NewInternalOutgoingTransferTx(token2Id, mySender2.String(), myReceiver, token2Amount.ToExternal(), token2Fee.ToExternal())
 	require.NoError(t, err2)
 	require.Equal(t, *expTx2, *tx2)
 
 	// GetUnbatchedTxById
-	tx1, err1 = input.GravityKeeper.GetUnbatchedTxById(ctx, token1Id)
+	tx1, err1 = input.GravityKeeper.GetUnbatchedTxById(ctx, EthChainPrefix, token1Id)
 	require.NoError(t, err1)
 	require.Equal(t, *expTx1, *tx1)
 
-	tx2, err2 = input.GravityKeeper.GetUnbatchedTxById(ctx, token2Id)
+	tx2, err2 = input.GravityKeeper.GetUnbatchedTxById(ctx, EthChainPrefix, token2Id)
 	require.NoError(t, err2)
 	require.Equal(t, *expTx2, *tx2)
 
 	// GetUnbatchedTransactionsByContract
-	token1Txs := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *tokenContract1)
+	token1Txs := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, EthChainPrefix, *tokenContract1)
 	for _, v := range token1Txs {
 		expTx := idToTxMap[v.Id]
 		require.NotNil(t, expTx)
@@ -803,7 +808,7 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 		require.Equal(t, expTx.DestAddress, v.DestAddress.GetAddress().Hex())
 		require.Equal(t, expTx.Sender, v.Sender.String())
 	}
-	token2Txs := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, *tokenContract2)
+	token2Txs := input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, EthChainPrefix, *tokenContract2)
 	for _, v := range token2Txs {
 		expTx := idToTxMap[v.Id]
 		require.NotNil(t, expTx)
@@ -813,7 +818,7 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 		require.Equal(t, expTx.Sender, v.Sender.String())
 	}
 	// GetUnbatchedTransactions
-	allTxs := input.GravityKeeper.GetUnbatchedTransactions(ctx)
+	allTxs := input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix)
 	for _, v := range allTxs {
 		expTx := idToTxMap[v.Id]
 		require.NotNil(t, expTx)
@@ -849,13 +854,13 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 	// mint some vouchers first
 	token1, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr1)
 	require.NoError(t, err)
-	allVouchers1 := sdk.Coins{token1.GravityCoin()}
+	allVouchers1 := sdk.Coins{token1.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers1)
 	require.NoError(t, err)
 
 	token2, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr2)
 	require.NoError(t, err)
-	allVouchers2 := sdk.Coins{token2.GravityCoin()}
+	allVouchers2 := sdk.Coins{token2.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers2)
 	require.NoError(t, err)
 
@@ -877,7 +882,7 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 		require.NoError(t, err)
 		fee1, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr1)
 		require.NoError(t, err)
-		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender1, *receiver, amount1.GravityCoin(), fee1.GravityCoin())
+		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender1, *receiver, amount1.GravityCoin(EthChainPrefix), fee1.GravityCoin(EthChainPrefix))
 		require.NoError(t, err)
 		ids1[i] = r
 		idToTxMap[r] = &types.OutgoingTransferTx{
@@ -891,7 +896,7 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 		require.NoError(t, err)
 		fee2, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr2)
 		require.NoError(t, err)
-		r, err = input.GravityKeeper.AddToOutgoingPool(ctx, mySender2, *receiver, amount2.GravityCoin(), fee2.GravityCoin())
+		r, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender2, *receiver, amount2.GravityCoin(EthChainPrefix), fee2.GravityCoin(EthChainPrefix))
 		require.NoError(t, err)
 
 		ids2[i] = r
@@ -905,7 +910,7 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 	}
 	// IterateUnbatchedTransactionsByContract
 	foundMap := make(map[uint64]bool)
-	input.GravityKeeper.IterateUnbatchedTransactionsByContract(ctx, *tokenContract1, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+	input.GravityKeeper.IterateUnbatchedTransactionsByContract(ctx, EthChainPrefix, *tokenContract1, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
 		require.NotNil(t, tx)
 		fTx := idToTxMap[tx.Id]
 		require.NotNil(t, fTx)
@@ -916,7 +921,7 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 		foundMap[fTx.Id] = true
 		return false
 	})
-	input.GravityKeeper.IterateUnbatchedTransactionsByContract(ctx, *tokenContract2, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+	input.GravityKeeper.IterateUnbatchedTransactionsByContract(ctx, EthChainPrefix, *tokenContract2, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
 		require.NotNil(t, tx)
 		fTx := idToTxMap[tx.Id]
 		require.NotNil(t, fTx)
@@ -933,7 +938,7 @@ func TestIterateUnbatchedTransactions(t *testing.T) {
 	}
 	// filterAndIterateUnbatchedTransactions
 	anotherFoundMap := make(map[uint64]bool)
-	input.GravityKeeper.IterateUnbatchedTransactions(ctx, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
+	input.GravityKeeper.IterateUnbatchedTransactions(ctx, EthChainPrefix, func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
 		require.NotNil(t, tx)
 		fTx := idToTxMap[tx.Id]
 		require.NotNil(t, fTx)
@@ -966,7 +971,7 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 	// mint some voucher first
 	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
-	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin(EthChainPrefix)}
 
 	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
 	require.NoError(t, err)
@@ -982,12 +987,12 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 	for i, v := range []uint64{2, 3, 2, 1} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
+		fee := feeToken.GravityCoin(EthChainPrefix)
 
-		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, mySender, *receiver, amount, fee)
+		r, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err)
 
 		unbatchedTxMap[r] = types.OutgoingTransferTx{
@@ -1015,3 +1020,131 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 		require.True(t, v)
 	}
 }
+
+// Tests that the pool reports its size and value per token, along with the transfers added and refunded
+func TestPoolMetrics(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	conf := metrics.DefaultConfig("")
+	conf.EnableHostname = false
+	conf.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(conf, sink)
+	require.NoError(t, err)
+	defer func() {
+		_, err := metrics.NewGlobal(conf, &metrics.BlackholeSink{})
+		require.NoError(t, err)
+	}()
+
+	var (
+		mySender, e1   = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, e2 = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenAddr, e3  = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		token, e4      = types.NewInternalERC20Token(sdk.NewInt(99999), tokenAddr.GetAddress().Hex())
+		allVouchers    = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	denom := allVouchers[0].Denom
+	for _, amount := range []int64{100, 200, 300} {
+		_, err := k.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, sdk.NewInt64Coin(denom, amount), sdk.NewInt64Coin(denom, 1))
+		require.NoError(t, err)
+	}
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, 2, mySender))
+	k.SetPoolMetrics(ctx, EthChainPrefix)
+
+	labels := fmt.Sprintf(";%s=%s;%s=%s", types.MetricLabelEvmChain, EthChainPrefix, types.MetricLabelTokenContract, tokenAddr.GetAddress().Hex())
+	data := sink.Data()
+	require.NotEmpty(t, data)
+	current := data[len(data)-1]
+	require.Equal(t, float32(2), current.Gauges[types.ModuleName+"."+types.MetricKeyPoolTxs+labels].Value)
+	require.Equal(t, float32(400), current.Gauges[types.ModuleName+"."+types.MetricKeyPoolValue+labels].Value)
+	require.Equal(t, float64(3), current.Counters[types.ModuleName+"."+types.MetricKeyPoolTxsAdded+labels].Sum)
+	require.Equal(t, float64(1), current.Counters[types.ModuleName+"."+types.MetricKeyPoolTxsRefunded+labels].Sum)
+
+	// emptying the pool resets the gauges of the token without waiting for the next report
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, 1, mySender))
+	require.Equal(t, float32(2), sink.Data()[len(sink.Data())-1].Gauges[types.ModuleName+"."+types.MetricKeyPoolTxs+labels].Value)
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, 3, mySender))
+	data = sink.Data()
+	current = data[len(data)-1]
+	require.Equal(t, float32(0), current.Gauges[types.ModuleName+"."+types.MetricKeyPoolTxs+labels].Value)
+	require.Equal(t, float32(0), current.Gauges[types.ModuleName+"."+types.MetricKeyPoolValue+labels].Value)
+}
+
+// Tests that queuing and cancelling a transfer emits the versioned events with typed fields next to the legacy ones
+// nolint: exhaustruct
+func TestPoolEvents(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	var (
+		mySender, e1   = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, e2 = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenAddr, e3  = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		token, e4      = types.NewInternalERC20Token(sdk.NewInt(99999), tokenAddr.GetAddress().Hex())
+		allVouchers    = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
+		amount, fee    = sdk.NewInt64Coin(allVouchers[0].Denom, 100), sdk.NewInt64Coin(allVouchers[0].Denom, 2)
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	txId, err := k.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *myReceiver, amount, fee)
+	require.NoError(t, err)
+	events := gravityEvents(ctx)
+	require.Len(t, events, 2)
+	require.Equal(t, "gravity.v1.EventWithdrawalReceived", events[0].Type)
+	queued, err := sdk.ParseTypedEvent(events[1])
+	require.NoError(t, err)
+	require.Equal(t, &eventsv1.EventSendToEthQueued{
+		EvmChainPrefix: EthChainPrefix,
+		TxId:           txId,
+		Sender:         mySender.String(),
+		Receiver:       myReceiver.GetAddress().Hex(),
+		TokenContract:  tokenAddr.GetAddress().Hex(),
+		Amount:         amount,
+		BridgeFee:      fee,
+	}, queued)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, txId, mySender))
+	events = gravityEvents(ctx)
+	require.Len(t, events, 2)
+	require.Equal(t, "gravity.v1.EventWithdrawCanceled", events[0].Type)
+	canceled, err := sdk.ParseTypedEvent(events[1])
+	require.NoError(t, err)
+	require.Equal(t, &eventsv1.EventSendToEthCanceled{
+		EvmChainPrefix: EthChainPrefix,
+		TxId:           txId,
+		Sender:         mySender.String(),
+		TokenContract:  tokenAddr.GetAddress().Hex(),
+		Refund:         amount.Add(fee),
+	}, canceled)
+}
+
+// gravityEvents returns the legacy and versioned gravity events emitted so far, in order
+func gravityEvents(ctx sdk.Context) (events []abci.Event) {
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if strings.HasPrefix(event.Type, "gravity.") {
+			events = append(events, event)
+		}
+	}
+	return events
+}
@@ -33,11 +33,12 @@ func TestQueryValsetConfirm(t *testing.T) {
 	sdkCtx := input.Context
 	ctx := sdk.WrapSDKContext(input.Context)
 	k := input.GravityKeeper
-	input.GravityKeeper.SetValsetConfirm(sdkCtx, types.MsgValsetConfirm{
-		Nonce:        nonce,
-		Orchestrator: myValidatorCosmosAddr.String(),
-		EthAddress:   myValidatorEthereumAddr.GetAddress().Hex(),
-		Signature:    "abcdef123456789",
+	input.GravityKeeper.SetValsetConfirm(sdkCtx, EthChainPrefix, types.MsgValsetConfirm{
+		Nonce:          nonce,
+		Orchestrator:   myValidatorCosmosAddr.String(),
+		EthAddress:     myValidatorEthereumAddr.GetAddress().Hex(),
+		Signature:      "abcdef123456789",
+		EvmChainPrefix: EthChainPrefix,
 	})
 
 	specs := map[string]struct {
@@ -125,7 +126,8 @@ func TestAllValsetConfirmsBynonce(t *testing.T) {
 		msg.Nonce = uint64(1)
 		msg.Orchestrator = addr.String()
 		msg.Signature = fmt.Sprintf("d34db33f%d", i)
-		input.GravityKeeper.SetValsetConfirm(sdkCtx, msg)
+		msg.EvmChainPrefix = EthChainPrefix
+		input.GravityKeeper.SetValsetConfirm(sdkCtx, EthChainPrefix, msg)
 	}
 
 	specs := map[string]struct {
@@ -307,7 +309,7 @@ func TestLastValsetRequests(t *testing.T) {
 	// Run the staking endblocker to ensure valset is correct in state
 	staking.EndBlocker(input.Context, input.StakingKeeper)
 
-	input.GravityKeeper.SetValsetRequest(input.Context)
+	input.GravityKeeper.SetValsetRequest(input.Context, EthChainPrefix)
 
 	k := input.GravityKeeper
 	for msg, spec := range specs {
@@ -475,7 +477,7 @@ func TestPendingValsetRequests(t *testing.T) {
 	// Run the staking endblocker to ensure valset is correct in state
 	staking.EndBlocker(input.Context, input.StakingKeeper)
 
-	input.GravityKeeper.SetValsetRequest(input.Context)
+	input.GravityKeeper.SetValsetRequest(input.Context, EthChainPrefix)
 
 	var valAddr sdk.AccAddress = bytes.Repeat([]byte{byte(1)}, 20)
 	for msg, spec := range specs {
@@ -570,7 +572,7 @@ func createTestBatch(t *testing.T, input TestInput, maxTxElements uint) {
 	// mint some voucher first
 	token, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
 	require.NoError(t, err)
-	allVouchers := sdk.Coins{token.GravityCoin()}
+	allVouchers := sdk.Coins{token.GravityCoin(EthChainPrefix)}
 	err = input.BankKeeper.MintCoins(input.Context, types.ModuleName, allVouchers)
 	require.NoError(t, err)
 
@@ -583,11 +585,11 @@ func createTestBatch(t *testing.T, input TestInput, maxTxElements uint) {
 	for i, v := range []uint64{2, 3, 2, 1} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
-		_, err = input.GravityKeeper.AddToOutgoingPool(input.Context, mySender, *receiver, amount, fee)
+		fee := feeToken.GravityCoin(EthChainPrefix)
+		_, err = input.GravityKeeper.AddToOutgoingPool(input.Context, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err)
 		// Should create:
 		// 1: amount 100, fee 2
@@ -599,7 +601,7 @@ func createTestBatch(t *testing.T, input TestInput, maxTxElements uint) {
 	input.Context = input.Context.WithBlockTime(now)
 
 	// tx batch size is 2, so that some of them stay behind
-	_, err = input.GravityKeeper.BuildOutgoingTXBatch(input.Context, *tokenContract, maxTxElements)
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(input.Context, EthChainPrefix, *tokenContract, maxTxElements)
 	require.NoError(t, err)
 	// Should have 2 and 3 from above
 	// 1 and 4 should be unbatched
@@ -620,7 +622,7 @@ func TestQueryAllBatchConfirms(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	input.GravityKeeper.SetBatchConfirm(sdkCtx, &types.MsgConfirmBatch{
+	input.GravityKeeper.SetBatchConfirm(sdkCtx, EthChainPrefix, &types.MsgConfirmBatch{
 		Nonce:         1,
 		TokenContract: tokenContract,
 		EthSigner:     "0xf35e2cc8e6523d683ed44870f5b7cc785051a77d",
@@ -692,9 +694,9 @@ func TestQueryLogicCalls(t *testing.T) {
 		InvalidationId:       invalidationId,
 		InvalidationNonce:    uint64(invalidationNonce),
 	}
-	k.SetOutgoingLogicCall(sdkCtx, call)
+	k.SetOutgoingLogicCall(sdkCtx, EthChainPrefix, call)
 
-	res := k.GetOutgoingLogicCall(sdkCtx, invalidationId, invalidationNonce)
+	res := k.GetOutgoingLogicCall(sdkCtx, EthChainPrefix, invalidationId, invalidationNonce)
 
 	require.Equal(t, call, *res)
 
@@ -753,7 +755,7 @@ func TestQueryLogicCallConfirms(t *testing.T) {
 		InvalidationId:       invalidationId,
 		InvalidationNonce:    uint64(invalidationNonce),
 	}
-	k.SetOutgoingLogicCall(sdkCtx, call)
+	k.SetOutgoingLogicCall(sdkCtx, EthChainPrefix, call)
 
 	var valAddr sdk.AccAddress = bytes.Repeat([]byte{byte(1)}, 20)
 
@@ -766,9 +768,9 @@ func TestQueryLogicCallConfirms(t *testing.T) {
 		Signature:         "d34db33f",
 	}
 
-	k.SetLogicCallConfirm(sdkCtx, &confirm)
+	k.SetLogicCallConfirm(sdkCtx, EthChainPrefix, &confirm)
 
-	res := k.GetLogicConfirmsByInvalidationIdAndNonce(sdkCtx, invalidationId, 1)
+	res := k.GetLogicConfirmsByInvalidationIdAndNonce(sdkCtx, EthChainPrefix, invalidationId, 1)
 	assert.Equal(t, len(res), 1)
 }
 
@@ -973,7 +975,7 @@ func TestQueryCurrentValset(t *testing.T) {
 
 	sdkCtx := input.Context
 
-	currentValset, err := input.GravityKeeper.GetCurrentValset(sdkCtx)
+	currentValset, err := input.GravityKeeper.GetCurrentValset(sdkCtx, EthChainPrefix)
 	require.NoError(t, err)
 
 	assert.Equal(t, expectedValset, currentValset)
@@ -996,7 +998,7 @@ func TestQueryERC20ToDenom(t *testing.T) {
 	sdkCtx := input.Context
 	ctx := sdk.WrapSDKContext(input.Context)
 	k := input.GravityKeeper
-	input.GravityKeeper.setCosmosOriginatedDenomToERC20(sdkCtx, denom, *erc20)
+	input.GravityKeeper.setCosmosOriginatedDenomToERC20(sdkCtx, EthChainPrefix, denom, *erc20)
 
 	queriedDenom, err := k.ERC20ToDenom(ctx, &types.QueryERC20ToDenomRequest{Erc20: erc20.GetAddress().Hex()})
 	require.NoError(t, err)
@@ -1021,7 +1023,7 @@ func TestQueryDenomToERC20(t *testing.T) {
 	sdkCtx := input.Context
 	ctx := sdk.WrapSDKContext(input.Context)
 	k := input.GravityKeeper
-	input.GravityKeeper.setCosmosOriginatedDenomToERC20(sdkCtx, denom, *erc20)
+	input.GravityKeeper.setCosmosOriginatedDenomToERC20(sdkCtx, EthChainPrefix, denom, *erc20)
 
 	queriedERC20, err := k.DenomToERC20(ctx, &types.QueryDenomToERC20Request{Denom: denom})
 	require.NoError(t, err)
@@ -1043,7 +1045,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
 		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
 		token, err2         = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
-		allVouchers         = sdk.NewCoins(token.GravityCoin())
+		allVouchers         = sdk.NewCoins(token.GravityCoin(EthChainPrefix))
 	)
 	require.NoError(t, err1)
 	require.NoError(t, err2)
@@ -1065,11 +1067,11 @@ func TestQueryPendingSendToEth(t *testing.T) {
 	for i, v := range []uint64{2, 3, 2, 1} {
 		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
 		require.NoError(t, err)
-		amount := amountToken.GravityCoin()
+		amount := amountToken.GravityCoin(EthChainPrefix)
 		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
 		require.NoError(t, err)
-		fee := feeToken.GravityCoin()
-		_, err = input.GravityKeeper.AddToOutgoingPool(sdkCtx, mySender, *receiver, amount, fee)
+		fee := feeToken.GravityCoin(EthChainPrefix)
+		_, err = input.GravityKeeper.AddToOutgoingPool(sdkCtx, EthChainPrefix, mySender, *receiver, amount, fee)
 		require.NoError(t, err)
 		// Should create:
 		// 1: amount 100, fee 2
@@ -1083,7 +1085,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 
 	// tx batch size is 2, so that some of them stay behind
 	// Should contain 2 and 3 from above
-	_, err = input.GravityKeeper.BuildOutgoingTXBatch(sdkCtx, *tokenContract, 2)
+	_, err = input.GravityKeeper.BuildOutgoingTXBatch(sdkCtx, EthChainPrefix, *tokenContract, 2)
 	require.NoError(t, err)
 
 	// Should receive 1 and 4 unbatched, 2 and 3 batched in response
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GravityPowerThreshold is constant_powerThreshold in Gravity.sol, the valset powers are normalized to a total of
+// 2^32 so this is two thirds of the total power. Signatures must represent strictly more than this
+const GravityPowerThreshold uint64 = 2863311530
+
+// SimulateValsetSignatureCheck performs the signature check Gravity.sol runs in updateValset for the valset with
+// the given nonce
+func (k Keeper) SimulateValsetSignatureCheck(ctx sdk.Context, evmChainPrefix string, nonce uint64) (*types.QuerySimulateSignatureCheckResponse, error) {
+	valset := k.GetValset(ctx, evmChainPrefix, nonce)
+	if valset == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "valset %d not found", nonce)
+	}
+	signatures := make(map[types.EthAddress]string)
+	for _, confirm := range k.GetValsetConfirms(ctx, evmChainPrefix, nonce) {
+		addSignature(signatures, confirm.EthAddress, confirm.Signature)
+	}
+	return k.simulateSignatureCheck(ctx, evmChainPrefix, valset.GetCheckpoint(k.GetGravityID(ctx, evmChainPrefix)), signatures)
+}
+
+// SimulateBatchSignatureCheck performs the signature check Gravity.sol runs in submitBatch for the batch with the
+// given nonce and token contract
+func (k Keeper) SimulateBatchSignatureCheck(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress, nonce uint64) (*types.QuerySimulateSignatureCheckResponse, error) {
+	batch := k.GetOutgoingTXBatch(ctx, evmChainPrefix, tokenContract, nonce)
+	if batch == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "batch %d for token %s not found", nonce, tokenContract.GetAddress().Hex())
+	}
+	signatures := make(map[types.EthAddress]string)
+	for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, evmChainPrefix, nonce, tokenContract) {
+		addSignature(signatures, confirm.EthSigner, confirm.Signature)
+	}
+	return k.simulateSignatureCheck(ctx, evmChainPrefix, batch.GetCheckpoint(k.GetGravityID(ctx, evmChainPrefix)), signatures)
+}
+
+// SimulateLogicCallSignatureCheck performs the signature check Gravity.sol runs in submitLogicCall for the logic
+// call with the given invalidation id and nonce
+func (k Keeper) SimulateLogicCallSignatureCheck(ctx sdk.Context, evmChainPrefix string, invalidationID []byte, invalidationNonce uint64) (*types.QuerySimulateSignatureCheckResponse, error) {
+	call := k.GetOutgoingLogicCall(ctx, evmChainPrefix, invalidationID, invalidationNonce)
+	if call == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "logic call %x/%d not found", invalidationID, invalidationNonce)
+	}
+	signatures := make(map[types.EthAddress]string)
+	for _, confirm := range k.GetLogicConfirmsByInvalidationIdAndNonce(ctx, evmChainPrefix, invalidationID, invalidationNonce) {
+		addSignature(signatures, confirm.EthSigner, confirm.Signature)
+	}
+	return k.simulateSignatureCheck(ctx, evmChainPrefix, call.GetCheckpoint(k.GetGravityID(ctx, evmChainPrefix)), signatures)
+}
+
+// addSignature adds the signature of a confirm to signatures, indexed by the signer
+func addSignature(signatures map[types.EthAddress]string, ethAddress string, signature string) {
+	signer, err := types.NewEthAddress(ethAddress)
+	if err != nil {
+		// confirms are validated before they are stored, this should never happen
+		panic(sdkerrors.Wrap(err, "invalid confirm eth address"))
+	}
+	signatures[*signer] = signature
+}
+
+// simulateSignatureCheck mirrors checkValidatorSignatures in Gravity.sol, which walks the members of the valset
+// currently in the contract in order, verifying every signature provided and adding up the power of the signers
+// until the power threshold is exceeded. The last observed valset stands in for the valset in the contract
+func (k Keeper) simulateSignatureCheck(ctx sdk.Context, evmChainPrefix string, checkpoint []byte, signatures map[types.EthAddress]string) (*types.QuerySimulateSignatureCheckResponse, error) {
+	valset := k.GetLastObservedValset(ctx, evmChainPrefix)
+	if valset == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "no valset has been observed on the evm chain yet")
+	}
+
+	res := types.QuerySimulateSignatureCheckResponse{
+		ValsetNonce:     valset.Nonce,
+		Signatures:      make([]types.SignatureCheckEntry, len(valset.Members)),
+		CumulativePower: 0,
+		PowerThreshold:  GravityPowerThreshold,
+		Passes:          false,
+	}
+	for i, member := range valset.Members {
+		res.Signatures[i] = types.SignatureCheckEntry{
+			EthereumAddress: member.EthereumAddress,
+			Power:           member.Power,
+			Signature:       "",
+		}
+		memberAddress, err := types.NewEthAddress(member.EthereumAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid last observed valset member")
+		}
+		signature, found := signatures[*memberAddress]
+		if !found {
+			continue
+		}
+		// the contract reverts on any invalid signature, so those are left out like missing ones
+		sigBytes, err := hex.DecodeString(signature)
+		if err != nil || types.ValidateEthereumSignature(checkpoint, sigBytes, *memberAddress) != nil {
+			continue
+		}
+		res.Signatures[i].Signature = signature
+
+		// the contract stops verifying once enough power has signed
+		if !res.Passes {
+			res.CumulativePower += member.Power
+			res.Passes = res.CumulativePower > GravityPowerThreshold
+		}
+	}
+	return &res, nil
+}
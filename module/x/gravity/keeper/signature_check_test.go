@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// nolint: exhaustruct
+func TestSimulateSignatureCheck(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { ctx.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// no valset has been observed yet
+	_, err := k.SimulateSignatureCheck(wctx, &types.QuerySimulateSignatureCheckRequest{ValsetNonce: 1})
+	require.Error(t, err)
+
+	// the observed valset is made of four signers with a quarter of the power each
+	privKeys := make([]*ecdsa.PrivateKey, 4)
+	members := make(types.InternalBridgeValidators, 4)
+	for i := range privKeys {
+		privKeys[i], err = crypto.GenerateKey()
+		require.NoError(t, err)
+		ethAddr, err := types.NewEthAddress(crypto.PubkeyToAddress(privKeys[i].PublicKey).String())
+		require.NoError(t, err)
+		members[i] = &types.InternalBridgeValidator{Power: 1073741823, EthereumAddress: *ethAddr}
+	}
+	observed, err := types.NewValset(1, 1, members, sdk.ZeroInt(), types.ZeroAddress())
+	require.NoError(t, err)
+	k.SetLastObservedValset(ctx, EthChainPrefix, *observed)
+	// NewValset sorts the members the way the contract expects them
+	ordered := make([]*ecdsa.PrivateKey, 4)
+	for i, member := range observed.Members {
+		for _, privKey := range privKeys {
+			if crypto.PubkeyToAddress(privKey.PublicKey).String() == member.EthereumAddress {
+				ordered[i] = privKey
+			}
+		}
+	}
+
+	valset, err := types.NewValset(2, 2, members, sdk.ZeroInt(), types.ZeroAddress())
+	require.NoError(t, err)
+	k.StoreValset(ctx, EthChainPrefix, *valset)
+	k.SetLatestValsetNonce(ctx, EthChainPrefix, valset.Nonce)
+	checkpoint := valset.GetCheckpoint(k.GetGravityID(ctx, EthChainPrefix))
+
+	confirm := func(i int, signature string) {
+		ethAddr, err := types.NewEthAddress(observed.Members[i].EthereumAddress)
+		require.NoError(t, err)
+		k.SetValsetConfirm(ctx, EthChainPrefix, *types.NewMsgValsetConfirm(valset.Nonce, *ethAddr, OrchAddrs[i], signature))
+	}
+	sign := func(i int) string {
+		sig, err := types.NewEthereumSignature(checkpoint, ordered[i])
+		require.NoError(t, err)
+		return hex.EncodeToString(sig)
+	}
+
+	// half the power with a bad signature from a third signer does not pass
+	confirm(0, sign(0))
+	confirm(2, sign(2))
+	confirm(3, hex.EncodeToString(make([]byte, 65)))
+	res, err := k.SimulateSignatureCheck(wctx, &types.QuerySimulateSignatureCheckRequest{ValsetNonce: valset.Nonce})
+	require.NoError(t, err)
+	require.False(t, res.Passes)
+	require.Equal(t, observed.Nonce, res.ValsetNonce)
+	require.Equal(t, uint64(2*1073741823), res.CumulativePower)
+	// constant_powerThreshold in Gravity.sol
+	require.Equal(t, uint64(2863311530), res.PowerThreshold)
+	require.Len(t, res.Signatures, 4)
+	for i, entry := range res.Signatures {
+		require.Equal(t, observed.Members[i].EthereumAddress, entry.EthereumAddress)
+	}
+	require.Equal(t, sign(0), res.Signatures[0].Signature)
+	require.Empty(t, res.Signatures[1].Signature)
+	require.Equal(t, sign(2), res.Signatures[2].Signature)
+	require.Empty(t, res.Signatures[3].Signature)
+
+	// a third valid signature exceeds the threshold
+	confirm(1, sign(1))
+	res, err = k.SimulateSignatureCheck(wctx, &types.QuerySimulateSignatureCheckRequest{ValsetNonce: valset.Nonce})
+	require.NoError(t, err)
+	require.True(t, res.Passes)
+	require.Equal(t, uint64(3*1073741823), res.CumulativePower)
+
+	// batches are checked against the same valset
+	tokenContract := "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	batch := types.OutgoingTxBatch{
+		BatchNonce:         1,
+		BatchTimeout:       1000,
+		Transactions:       []types.OutgoingTransferTx{},
+		TokenContract:      tokenContract,
+		CosmosBlockCreated: 0,
+	}
+	internalBatch, err := batch.ToInternal()
+	require.NoError(t, err)
+	k.StoreBatch(ctx, EthChainPrefix, *internalBatch)
+	checkpoint = batch.GetCheckpoint(k.GetGravityID(ctx, EthChainPrefix))
+	for i := 0; i < 2; i++ {
+		k.SetBatchConfirm(ctx, EthChainPrefix, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: tokenContract,
+			EthSigner:     observed.Members[i].EthereumAddress,
+			Orchestrator:  OrchAddrs[i].String(),
+			Signature:     sign(i),
+		})
+	}
+	res, err = k.SimulateSignatureCheck(wctx, &types.QuerySimulateSignatureCheckRequest{BatchNonce: batch.BatchNonce, TokenContract: tokenContract})
+	require.NoError(t, err)
+	require.False(t, res.Passes)
+	require.Equal(t, uint64(2*1073741823), res.CumulativePower)
+
+	// the calldata carries the same signatures
+	calldata, err := k.EthereumCalldata(wctx, &types.QueryEthereumCalldataRequest{ValsetNonce: valset.Nonce})
+	require.NoError(t, err)
+	require.Equal(t, "updateValset", calldata.Function)
+	require.True(t, calldata.Passes)
+	require.Equal(t, observed.Nonce, calldata.ValsetNonce)
+	calldata, err = k.EthereumCalldata(wctx, &types.QueryEthereumCalldataRequest{BatchNonce: batch.BatchNonce, TokenContract: tokenContract})
+	require.NoError(t, err)
+	require.Equal(t, "submitBatch", calldata.Function)
+	require.False(t, calldata.Passes)
+	// the calldata holds the r and s values of the signatures next to each other
+	require.Contains(t, calldata.Calldata, sign(0)[:128])
+	_, err = k.EthereumCalldata(wctx, &types.QueryEthereumCalldataRequest{InvalidationId: []byte{1}, InvalidationNonce: 1})
+	require.Error(t, err)
+	_, err = k.EthereumCalldata(wctx, &types.QueryEthereumCalldataRequest{ValsetNonce: valset.Nonce, InvalidationNonce: 1})
+	require.Error(t, err)
+
+	// unknown items and ambiguous requests are rejected
+	_, err = k.SimulateSignatureCheck(wctx, &types.QuerySimulateSignatureCheckRequest{ValsetNonce: 10})
+	require.Error(t, err)
+	_, err = k.SimulateSignatureCheck(wctx, &types.QuerySimulateSignatureCheckRequest{BatchNonce: 10, TokenContract: tokenContract})
+	require.Error(t, err)
+	_, err = k.SimulateSignatureCheck(wctx, &types.QuerySimulateSignatureCheckRequest{ValsetNonce: valset.Nonce, BatchNonce: batch.BatchNonce, TokenContract: tokenContract})
+	require.Error(t, err)
+	_, err = k.SimulateSignatureCheck(wctx, &types.QuerySimulateSignatureCheckRequest{})
+	require.Error(t, err)
+}
+
+// Tests that, like Gravity.sol, signed power equal to the threshold fails and signed power just above it passes
+// nolint: exhaustruct
+func TestSimulateSignatureCheckThreshold(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { ctx.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	k := input.GravityKeeper
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// the first member holds exactly the threshold, the second a single unit of power and the third the remainder
+	powers := []uint64{2863311530, 1, 4294967295 - 2863311530 - 1}
+	privKeys := make(map[string]*ecdsa.PrivateKey, len(powers))
+	members := make(types.InternalBridgeValidators, len(powers))
+	for i, power := range powers {
+		privKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		ethAddr, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+		require.NoError(t, err)
+		privKeys[ethAddr.GetAddress().String()] = privKey
+		members[i] = &types.InternalBridgeValidator{Power: power, EthereumAddress: *ethAddr}
+	}
+	observed, err := types.NewValset(1, 1, members, sdk.ZeroInt(), types.ZeroAddress())
+	require.NoError(t, err)
+	k.SetLastObservedValset(ctx, EthChainPrefix, *observed)
+
+	valset, err := types.NewValset(2, 2, members, sdk.ZeroInt(), types.ZeroAddress())
+	require.NoError(t, err)
+	k.StoreValset(ctx, EthChainPrefix, *valset)
+	k.SetLatestValsetNonce(ctx, EthChainPrefix, valset.Nonce)
+	checkpoint := valset.GetCheckpoint(k.GetGravityID(ctx, EthChainPrefix))
+
+	confirm := func(power uint64) {
+		for i, member := range observed.Members {
+			if member.Power != power {
+				continue
+			}
+			sig, err := types.NewEthereumSignature(checkpoint, privKeys[member.EthereumAddress])
+			require.NoError(t, err)
+			ethAddr, err := types.NewEthAddress(member.EthereumAddress)
+			require.NoError(t, err)
+			k.SetValsetConfirm(ctx, EthChainPrefix, *types.NewMsgValsetConfirm(valset.Nonce, *ethAddr, OrchAddrs[i], hex.EncodeToString(sig)))
+		}
+	}
+
+	// power equal to the threshold does not pass
+	confirm(2863311530)
+	res, err := k.SimulateSignatureCheck(wctx, &types.QuerySimulateSignatureCheckRequest{ValsetNonce: valset.Nonce})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2863311530), res.CumulativePower)
+	require.False(t, res.Passes)
+
+	// one more unit of power passes
+	confirm(1)
+	res, err = k.SimulateSignatureCheck(wctx, &types.QuerySimulateSignatureCheckRequest{ValsetNonce: valset.Nonce})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2863311531), res.CumulativePower)
+	require.True(t, res.Passes)
+}
@@ -77,6 +77,9 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
+// EthChainPrefix is the prefix of the default EVM chain, which most tests run against
+const EthChainPrefix = types.DefaultEvmChainPrefix
+
 var (
 	// ModuleBasics is a mock module basic manager for testing
 	ModuleBasics = module.NewBasicManager(
@@ -245,6 +248,14 @@ var (
 		EthereumBlacklist:            []string{},
 		MinChainFeeBasisPoints:       0,
 		ChainFeeAuctionPoolFraction:  sdk.NewDecWithPrec(50, 2), // 50%
+		OffenceDecayWindow:           1000,
+		OffenceSlashEscalation:       sdk.NewDec(2),
+		OffenceTombstoneThreshold:    5,
+		ValsetPowerDiffThreshold:     sdk.NewDecWithPrec(5, 2), // 5%
+		ValsetMaxAge:                 0,
+		ValsetMinInterval:            0,
+		TokenGasParams:               []types.TokenGasParams{},
+		OffenceJailOnlyCount:         1,
 	}
 )
 
@@ -257,6 +268,7 @@ type TestInput struct {
 	DistKeeper        distrkeeper.Keeper
 	BankKeeper        bankkeeper.BaseKeeper
 	GovKeeper         govkeeper.Keeper
+	ParamsKeeper      paramskeeper.Keeper
 	IbcKeeper         ibckeeper.Keeper
 	IbcTransferKeeper ibctransferkeeper.Keeper
 	MintKeeper        mintkeeper.Keeper
@@ -382,7 +394,7 @@ func SetupTestChain(t *testing.T, weights []uint64, setDelegateAddresses bool) (
 			staking.EndBlocker(input.Context, input.StakingKeeper)
 
 			// set a request every time.
-			input.GravityKeeper.SetValsetRequest(input.Context)
+			input.GravityKeeper.SetValsetRequest(input.Context, EthChainPrefix)
 		}
 
 	}
@@ -650,13 +662,14 @@ func CreateTestEnv(t *testing.T) TestInput {
 	)
 
 	// set gravityIDs for batches and tx items, simulating genesis setup
-	k.SetLatestValsetNonce(ctx, 0)
-	k.setLastObservedEventNonce(ctx, 0)
-	k.SetLastSlashedValsetNonce(ctx, 0)
-	k.SetLastSlashedBatchBlock(ctx, 0)
-	k.SetLastSlashedLogicCallBlock(ctx, 0)
+	k.SetLatestValsetNonce(ctx, EthChainPrefix, 0)
+	k.setLastObservedEventNonce(ctx, EthChainPrefix, 0)
+	k.SetLastSlashedValsetNonce(ctx, EthChainPrefix, 0)
+	k.SetLastSlashedBatchBlock(ctx, EthChainPrefix, 0)
+	k.SetLastSlashedLogicCallBlock(ctx, EthChainPrefix, 0)
 	k.setID(ctx, 0, types.KeyLastTXPoolID)
 	k.setID(ctx, 0, types.KeyLastOutgoingBatchID)
+	k.setID(ctx, 0, types.KeyLastMerkleAirdropID)
 
 	k.SetParams(ctx, TestingGravityParams)
 
@@ -668,6 +681,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		DistKeeper:        distKeeper,
 		BankKeeper:        bankKeeper,
 		GovKeeper:         govKeeper,
+		ParamsKeeper:      paramsKeeper,
 		IbcKeeper:         ibcKeeper,
 		IbcTransferKeeper: ibcTransferKeeper,
 		MintKeeper:        mintKeeper,
@@ -749,7 +763,7 @@ func MakeTestEncodingConfig() gravityparams.EncodingConfig {
 
 // MintVouchersFromAir creates new gravity vouchers given erc20tokens
 func MintVouchersFromAir(t *testing.T, ctx sdk.Context, k Keeper, dest sdk.AccAddress, amount types.InternalERC20Token) sdk.Coin {
-	coin := amount.GravityCoin()
+	coin := amount.GravityCoin(EthChainPrefix)
 	vouchers := sdk.Coins{coin}
 	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers)
 	require.NoError(t, err)
@@ -0,0 +1,523 @@
+package keeper
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// The reverts of the fake Gravity contract, named after the custom errors in Gravity.sol
+var (
+	ErrFakeInvalidSignature   = errors.New("InvalidSignature")
+	ErrFakeInvalidValsetNonce = errors.New("InvalidValsetNonce")
+	ErrFakeInvalidBatchNonce  = errors.New("InvalidBatchNonce")
+	ErrFakeBatchTimedOut      = errors.New("BatchTimedOut")
+	ErrFakeInsufficientPower  = errors.New("InsufficientPower")
+	ErrFakeInsufficientFunds  = errors.New("ERC20: transfer amount exceeds balance")
+)
+
+// FakeGravityContract simulates the state of a Gravity.sol deployment, so that bridge round trips can be tested
+// in-process. Every call which changes the contract state is mined in a block of its own, and every event the
+// contract emits is recorded as the claim an orchestrator would make for it
+type FakeGravityContract struct {
+	GravityID   string
+	Address     types.EthAddress
+	BlockHeight uint64
+	// EventNonce is the nonce of the last event emitted, Events[i] has the event nonce i+1
+	EventNonce uint64
+	Events     []types.EthereumClaim
+	// Valset is the validator set the contract currently trusts, ValsetCheckpoint is its checkpoint
+	Valset           types.Valset
+	ValsetCheckpoint []byte
+	LastBatchNonces  map[types.EthAddress]uint64
+	// Balances holds the ERC20 balances by token contract and holder
+	Balances map[types.EthAddress]map[types.EthAddress]sdk.Int
+}
+
+// NewFakeGravityContract deploys a fake Gravity contract trusting the given valset, like the Gravity.sol constructor
+// it emits a ValsetUpdatedEvent for the initial valset
+func NewFakeGravityContract(gravityID string, address types.EthAddress, valset types.Valset) *FakeGravityContract {
+	c := &FakeGravityContract{
+		GravityID:        gravityID,
+		Address:          address,
+		BlockHeight:      1,
+		EventNonce:       0,
+		Events:           []types.EthereumClaim{},
+		Valset:           valset,
+		ValsetCheckpoint: valset.GetCheckpoint(gravityID),
+		LastBatchNonces:  make(map[types.EthAddress]uint64),
+		Balances:         make(map[types.EthAddress]map[types.EthAddress]sdk.Int),
+	}
+	c.emitValsetUpdated()
+	return c
+}
+
+// AdvanceBlocks mines n empty blocks
+func (c *FakeGravityContract) AdvanceBlocks(n uint64) {
+	c.BlockHeight += n
+}
+
+// BalanceOf returns the holder's balance of the token
+func (c *FakeGravityContract) BalanceOf(token types.EthAddress, holder types.EthAddress) sdk.Int {
+	if balance, ok := c.Balances[token][holder]; ok {
+		return balance
+	}
+	return sdk.ZeroInt()
+}
+
+// Mint creates ERC20 tokens for the holder out of thin air
+func (c *FakeGravityContract) Mint(token types.EthAddress, holder types.EthAddress, amount sdk.Int) {
+	c.setBalance(token, holder, c.BalanceOf(token, holder).Add(amount))
+}
+
+// EventsAfter returns the events emitted after the given event nonce
+func (c *FakeGravityContract) EventsAfter(nonce uint64) []types.EthereumClaim {
+	if nonce >= c.EventNonce {
+		return nil
+	}
+	return c.Events[nonce:]
+}
+
+// SendToCosmos locks the sender's tokens in the contract and emits a SendToCosmosEvent
+func (c *FakeGravityContract) SendToCosmos(token types.EthAddress, sender types.EthAddress, destination string, amount sdk.Int) error {
+	c.BlockHeight++
+	if err := c.transfer(token, sender, c.Address, amount); err != nil {
+		return err
+	}
+
+	c.EventNonce++
+	c.emit(&types.MsgSendToCosmosClaim{
+		EventNonce:     c.EventNonce,
+		EthBlockHeight: c.BlockHeight,
+		TokenContract:  token.GetAddress().Hex(),
+		Amount:         amount,
+		EthereumSender: sender.GetAddress().Hex(),
+		CosmosReceiver: destination,
+		Orchestrator:   "",
+		EvmChainPrefix: "",
+	})
+	return nil
+}
+
+// UpdateValset replaces the trusted valset with newValset if it is signed by enough of the current valset, and
+// emits a ValsetUpdatedEvent
+func (c *FakeGravityContract) UpdateValset(newValset types.Valset, signatures map[types.EthAddress][]byte) error {
+	c.BlockHeight++
+	if newValset.Nonce <= c.Valset.Nonce {
+		return fmt.Errorf("%w: new nonce %d, current nonce %d", ErrFakeInvalidValsetNonce, newValset.Nonce, c.Valset.Nonce)
+	}
+	newPower := uint64(0)
+	for _, member := range newValset.Members {
+		newPower += member.Power
+	}
+	if newPower <= GravityPowerThreshold {
+		return fmt.Errorf("%w: new valset power %d, threshold %d", ErrFakeInsufficientPower, newPower, GravityPowerThreshold)
+	}
+	checkpoint := newValset.GetCheckpoint(c.GravityID)
+	if err := c.checkValidatorSignatures(checkpoint, signatures); err != nil {
+		return err
+	}
+
+	c.Valset = newValset
+	c.ValsetCheckpoint = checkpoint
+	c.emitValsetUpdated()
+	return nil
+}
+
+// SubmitBatch executes a batch signed by enough of the current valset, paying out the transactions and sending
+// the fees to the relayer, and emits a TransactionBatchExecutedEvent
+func (c *FakeGravityContract) SubmitBatch(batch types.InternalOutgoingTxBatch, signatures map[types.EthAddress][]byte, relayer types.EthAddress) error {
+	c.BlockHeight++
+	token := batch.TokenContract
+	if batch.BatchNonce <= c.LastBatchNonces[token] {
+		return fmt.Errorf("%w: new nonce %d, current nonce %d", ErrFakeInvalidBatchNonce, batch.BatchNonce, c.LastBatchNonces[token])
+	}
+	if c.BlockHeight >= batch.BatchTimeout {
+		return fmt.Errorf("%w: block %d, timeout %d", ErrFakeBatchTimedOut, c.BlockHeight, batch.BatchTimeout)
+	}
+	if err := c.checkValidatorSignatures(batch.GetCheckpoint(c.GravityID), signatures); err != nil {
+		return err
+	}
+
+	// the contract only has to check the total, the transfers cannot fail after that
+	total := sdk.ZeroInt()
+	fees := sdk.ZeroInt()
+	for _, tx := range batch.Transactions {
+		total = total.Add(tx.Erc20Token.Amount)
+		fees = fees.Add(tx.Erc20Fee.Amount)
+	}
+	if c.BalanceOf(token, c.Address).LT(total.Add(fees)) {
+		return fmt.Errorf("%w: batch %d pays out %v", ErrFakeInsufficientFunds, batch.BatchNonce, total.Add(fees))
+	}
+	c.LastBatchNonces[token] = batch.BatchNonce
+	for _, tx := range batch.Transactions {
+		c.mustTransfer(token, c.Address, *tx.DestAddress, tx.Erc20Token.Amount)
+	}
+	c.mustTransfer(token, c.Address, relayer, fees)
+
+	c.EventNonce++
+	c.emit(&types.MsgBatchSendToEthClaim{
+		EventNonce:     c.EventNonce,
+		EthBlockHeight: c.BlockHeight,
+		BatchNonce:     batch.BatchNonce,
+		TokenContract:  token.GetAddress().Hex(),
+		Orchestrator:   "",
+		EvmChainPrefix: "",
+	})
+	return nil
+}
+
+// checkValidatorSignatures mirrors checkValidatorSignatures in Gravity.sol, every signature provided by a member
+// of the current valset is verified until the power of the signers exceeds the threshold
+func (c *FakeGravityContract) checkValidatorSignatures(checkpoint []byte, signatures map[types.EthAddress][]byte) error {
+	power := uint64(0)
+	for _, member := range c.Valset.Members {
+		memberAddress, err := types.NewEthAddress(member.EthereumAddress)
+		if err != nil {
+			return fmt.Errorf("%w: invalid valset member %s: %v", ErrFakeInvalidSignature, member.EthereumAddress, err)
+		}
+		signature, found := signatures[*memberAddress]
+		if !found {
+			continue
+		}
+		if err := types.ValidateEthereumSignature(checkpoint, signature, *memberAddress); err != nil {
+			return fmt.Errorf("%w: %s", ErrFakeInvalidSignature, member.EthereumAddress)
+		}
+		power += member.Power
+		if power > GravityPowerThreshold {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: signed power %d, threshold %d", ErrFakeInsufficientPower, power, GravityPowerThreshold)
+}
+
+func (c *FakeGravityContract) emitValsetUpdated() {
+	c.EventNonce++
+	c.emit(&types.MsgValsetUpdatedClaim{
+		EventNonce:     c.EventNonce,
+		ValsetNonce:    c.Valset.Nonce,
+		EthBlockHeight: c.BlockHeight,
+		Members:        c.Valset.Members,
+		RewardAmount:   c.Valset.RewardAmount,
+		RewardToken:    c.Valset.RewardToken,
+		Orchestrator:   "",
+		EvmChainPrefix: "",
+	})
+}
+
+func (c *FakeGravityContract) emit(event types.EthereumClaim) {
+	c.Events = append(c.Events, event)
+}
+
+func (c *FakeGravityContract) transfer(token types.EthAddress, from types.EthAddress, to types.EthAddress, amount sdk.Int) error {
+	balance := c.BalanceOf(token, from)
+	if balance.LT(amount) {
+		return fmt.Errorf("%w: balance %v, amount %v", ErrFakeInsufficientFunds, balance, amount)
+	}
+	c.setBalance(token, from, balance.Sub(amount))
+	c.setBalance(token, to, c.BalanceOf(token, to).Add(amount))
+	return nil
+}
+
+func (c *FakeGravityContract) mustTransfer(token types.EthAddress, from types.EthAddress, to types.EthAddress, amount sdk.Int) {
+	if err := c.transfer(token, from, to, amount); err != nil {
+		panic(err)
+	}
+}
+
+func (c *FakeGravityContract) setBalance(token types.EthAddress, holder types.EthAddress, amount sdk.Int) {
+	if _, ok := c.Balances[token]; !ok {
+		c.Balances[token] = make(map[types.EthAddress]sdk.Int)
+	}
+	c.Balances[token][holder] = amount
+}
+
+// OrchestratorBehavior determines what a FakeOrchestrator does with the events it observes
+type OrchestratorBehavior int
+
+const (
+	// HonestOrchestrator signs every valset and batch and claims every event as it happened
+	HonestOrchestrator OrchestratorBehavior = iota
+	// OfflineOrchestrator does nothing at all
+	OfflineOrchestrator
+	// LyingOrchestrator signs like an honest orchestrator but claims altered events, redirecting deposits to
+	// itself and reporting every other event a block late
+	LyingOrchestrator
+)
+
+// FakeOrchestrator is a validator's orchestrator, with the Ethereum key it signs confirms with
+type FakeOrchestrator struct {
+	Validator  sdk.ValAddress
+	Address    sdk.AccAddress
+	EthKey     *ecdsa.PrivateKey
+	EthAddress types.EthAddress
+	Behavior   OrchestratorBehavior
+}
+
+// Act runs one round of the orchestrator: signing the pending valsets and batches, then claiming the events
+// emitted by the contract since the validator's last claim
+func (o *FakeOrchestrator) Act(ctx sdk.Context, k Keeper, contract *FakeGravityContract) error {
+	if o.Behavior == OfflineOrchestrator {
+		return nil
+	}
+	validator, found := k.StakingKeeper.GetValidator(ctx, o.Validator)
+	if !found || validator.IsUnbonded() {
+		return nil
+	}
+	if err := o.SignValsets(ctx, k); err != nil {
+		return err
+	}
+	if err := o.SignBatches(ctx, k); err != nil {
+		return err
+	}
+	// only the active set may claim events, unbonding validators are still expected to sign
+	if !validator.IsBonded() {
+		return nil
+	}
+	return o.ClaimEvents(ctx, k, contract)
+}
+
+// SignValsets confirms every stored valset the orchestrator has not confirmed yet
+func (o *FakeOrchestrator) SignValsets(ctx sdk.Context, k Keeper) error {
+	msgServer := NewMsgServerImpl(k)
+	gravityID := k.GetGravityID(ctx, EthChainPrefix)
+	for _, valset := range k.GetValsets(ctx, EthChainPrefix) {
+		if k.GetValsetConfirm(ctx, EthChainPrefix, valset.Nonce, o.Address) != nil {
+			continue
+		}
+		signature, err := types.NewEthereumSignature(valset.GetCheckpoint(gravityID), o.EthKey)
+		if err != nil {
+			return err
+		}
+		msg := types.NewMsgValsetConfirm(valset.Nonce, o.EthAddress, o.Address, hex.EncodeToString(signature))
+		msg.EvmChainPrefix = EthChainPrefix
+		if _, err := msgServer.ValsetConfirm(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SignBatches confirms every outgoing batch the orchestrator has not confirmed yet
+func (o *FakeOrchestrator) SignBatches(ctx sdk.Context, k Keeper) error {
+	msgServer := NewMsgServerImpl(k)
+	gravityID := k.GetGravityID(ctx, EthChainPrefix)
+	for _, batch := range k.GetOutgoingTxBatches(ctx, EthChainPrefix) {
+		if k.GetBatchConfirm(ctx, EthChainPrefix, batch.BatchNonce, batch.TokenContract, o.Address) != nil {
+			continue
+		}
+		signature, err := types.NewEthereumSignature(batch.GetCheckpoint(gravityID), o.EthKey)
+		if err != nil {
+			return err
+		}
+		msg := &types.MsgConfirmBatch{
+			Nonce:          batch.BatchNonce,
+			TokenContract:  batch.TokenContract.GetAddress().Hex(),
+			EthSigner:      o.EthAddress.GetAddress().Hex(),
+			Orchestrator:   o.Address.String(),
+			Signature:      hex.EncodeToString(signature),
+			EvmChainPrefix: EthChainPrefix,
+		}
+		if _, err := msgServer.ConfirmBatch(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClaimEvents submits a claim for every event emitted by the contract after the validator's last claimed event
+func (o *FakeOrchestrator) ClaimEvents(ctx sdk.Context, k Keeper, contract *FakeGravityContract) error {
+	msgServer := NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+	lastNonce := k.GetLastEventNonceByValidator(ctx, EthChainPrefix, o.Validator)
+	for _, event := range contract.EventsAfter(lastNonce) {
+		var err error
+		switch event := event.(type) {
+		case *types.MsgSendToCosmosClaim:
+			claim := *event
+			claim.Orchestrator, claim.EvmChainPrefix = o.Address.String(), EthChainPrefix
+			if o.Behavior == LyingOrchestrator {
+				claim.CosmosReceiver = o.Address.String()
+			}
+			_, err = msgServer.SendToCosmosClaim(goCtx, &claim)
+		case *types.MsgBatchSendToEthClaim:
+			claim := *event
+			claim.Orchestrator, claim.EvmChainPrefix = o.Address.String(), EthChainPrefix
+			if o.Behavior == LyingOrchestrator {
+				claim.EthBlockHeight++
+			}
+			_, err = msgServer.BatchSendToEthClaim(goCtx, &claim)
+		case *types.MsgValsetUpdatedClaim:
+			claim := *event
+			claim.Orchestrator, claim.EvmChainPrefix = o.Address.String(), EthChainPrefix
+			if o.Behavior == LyingOrchestrator {
+				claim.EthBlockHeight++
+			}
+			_, err = msgServer.ValsetUpdateClaim(goCtx, &claim)
+		default:
+			err = fmt.Errorf("unsupported fake contract event %T", event)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FakeEthereumEnv is a gravity chain bridged to a FakeGravityContract, operated by a FakeOrchestrator per validator.
+// The keeper package cannot run the module EndBlocker, so it is provided by the caller
+type FakeEthereumEnv struct {
+	t             *testing.T
+	Input         TestInput
+	Contract      *FakeGravityContract
+	Orchestrators []*FakeOrchestrator
+	endBlocker    func(ctx sdk.Context, k Keeper)
+}
+
+// SetupFakeEthereumChain creates a chain with a validator of each of the given consensus powers, then deploys the
+// fake contract with the current valset. The validator, orchestrator and Ethereum keys are derived from the
+// validator index, so runs are deterministic
+func SetupFakeEthereumChain(t *testing.T, powers []int64, endBlocker func(ctx sdk.Context, k Keeper)) *FakeEthereumEnv {
+	t.Helper()
+	input := CreateTestEnv(t)
+	stakingParams := TestingStakeParams
+	stakingParams.MaxValidators = 100
+	input.StakingKeeper.SetParams(input.Context, stakingParams)
+
+	env := &FakeEthereumEnv{
+		t:             t,
+		Input:         input,
+		Contract:      nil,
+		Orchestrators: []*FakeOrchestrator{},
+		endBlocker:    endBlocker,
+	}
+	for _, power := range powers {
+		env.AddValidator(power)
+	}
+	staking.EndBlocker(env.Input.Context, env.Input.StakingKeeper)
+
+	k := env.Input.GravityKeeper
+	valset, err := k.GetCurrentValset(env.Input.Context, EthChainPrefix)
+	require.NoError(t, err)
+	// the contract is deployed with the valset of nonce zero, which is never stored on chain
+	valset.Nonce = 0
+	bridgeAddress := k.GetBridgeContractAddress(env.Input.Context, EthChainPrefix)
+	env.Contract = NewFakeGravityContract(k.GetGravityID(env.Input.Context, EthChainPrefix), *bridgeAddress, valset)
+
+	return env
+}
+
+// AddValidator creates a validator with the given consensus power, registers its delegate keys and returns its
+// orchestrator. The validator joins the active set at the end of the block
+func (e *FakeEthereumEnv) AddValidator(power int64) *FakeOrchestrator {
+	e.t.Helper()
+	ctx := e.Input.Context
+	i := len(e.Orchestrators)
+
+	consPrivKey := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("consensus %d", i)))
+	valPrivKey := secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("validator %d", i)))
+	orchPrivKey := secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("orchestrator %d", i)))
+	ethKey, err := ethcrypto.ToECDSA(ethcrypto.Keccak256([]byte(fmt.Sprintf("ethereum %d", i))))
+	require.NoError(e.t, err)
+	ethAddr, err := types.NewEthAddress(ethcrypto.PubkeyToAddress(ethKey.PublicKey).Hex())
+	require.NoError(e.t, err)
+
+	accAddr := sdk.AccAddress(valPrivKey.PubKey().Address())
+	valAddr := sdk.ValAddress(accAddr)
+	acc := e.Input.AccountKeeper.NewAccount(ctx, authtypes.NewBaseAccount(accAddr, valPrivKey.PubKey(), 0, 0))
+	e.Input.AccountKeeper.SetAccount(ctx, acc)
+
+	tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	coins := sdk.NewCoins(sdk.NewCoin(TestingStakeParams.BondDenom, tokens))
+	require.NoError(e.t, e.Input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(e.t, e.Input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, accAddr, coins))
+	_, err = staking.NewHandler(e.Input.StakingKeeper)(ctx, NewTestMsgCreateValidator(valAddr, consPrivKey.PubKey(), tokens))
+	require.NoError(e.t, err)
+
+	orchestrator := &FakeOrchestrator{
+		Validator:  valAddr,
+		Address:    sdk.AccAddress(orchPrivKey.PubKey().Address()),
+		EthKey:     ethKey,
+		EthAddress: *ethAddr,
+		Behavior:   HonestOrchestrator,
+	}
+	e.Input.GravityKeeper.SetEthAddressForValidator(ctx, valAddr, *ethAddr)
+	e.Input.GravityKeeper.SetOrchestratorValidator(ctx, valAddr, orchestrator.Address)
+	e.Orchestrators = append(e.Orchestrators, orchestrator)
+
+	return orchestrator
+}
+
+// Context returns the context of the current block
+func (e *FakeEthereumEnv) Context() sdk.Context {
+	return e.Input.Context
+}
+
+// NextBlock ends the current block, running the staking and gravity end blockers, and starts the next one
+func (e *FakeEthereumEnv) NextBlock() {
+	ctx := e.Input.Context
+	staking.EndBlocker(ctx, e.Input.StakingKeeper)
+	e.endBlocker(ctx, e.Input.GravityKeeper)
+	e.Input.Context = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+}
+
+// Step lets every orchestrator act in the current block, then moves on to the next block
+func (e *FakeEthereumEnv) Step() {
+	e.t.Helper()
+	for _, orchestrator := range e.Orchestrators {
+		require.NoError(e.t, orchestrator.Act(e.Input.Context, e.Input.GravityKeeper, e.Contract))
+	}
+	e.NextBlock()
+}
+
+// RelayValset submits the stored valset with the given nonce to the contract, with every confirm collected for it
+func (e *FakeEthereumEnv) RelayValset(nonce uint64) error {
+	ctx := e.Input.Context
+	k := e.Input.GravityKeeper
+	valset := k.GetValset(ctx, EthChainPrefix, nonce)
+	if valset == nil {
+		return fmt.Errorf("no valset with nonce %d", nonce)
+	}
+	signatures := make(map[types.EthAddress][]byte)
+	for _, confirm := range k.GetValsetConfirms(ctx, EthChainPrefix, nonce) {
+		e.addSignature(signatures, confirm.EthAddress, confirm.Signature)
+	}
+	return e.Contract.UpdateValset(*valset, signatures)
+}
+
+// RelayBatch submits the stored batch to the contract, with every confirm collected for it, paying the fees to
+// the relayer
+func (e *FakeEthereumEnv) RelayBatch(token types.EthAddress, nonce uint64, relayer types.EthAddress) error {
+	ctx := e.Input.Context
+	k := e.Input.GravityKeeper
+	batch := k.GetOutgoingTXBatch(ctx, EthChainPrefix, token, nonce)
+	if batch == nil {
+		return fmt.Errorf("no batch of %s with nonce %d", token.GetAddress().Hex(), nonce)
+	}
+	signatures := make(map[types.EthAddress][]byte)
+	for _, confirm := range k.GetBatchConfirmByNonceAndTokenContract(ctx, EthChainPrefix, nonce, token) {
+		e.addSignature(signatures, confirm.EthSigner, confirm.Signature)
+	}
+	return e.Contract.SubmitBatch(*batch, signatures, relayer)
+}
+
+func (e *FakeEthereumEnv) addSignature(signatures map[types.EthAddress][]byte, ethAddress string, signature string) {
+	signer, err := types.NewEthAddress(ethAddress)
+	require.NoError(e.t, err)
+	sigBytes, err := hex.DecodeString(signature)
+	require.NoError(e.t, err)
+	signatures[*signer] = sigBytes
+}
@@ -70,8 +70,11 @@ func TestMigrateCosmosOriginatedDenomToERC20(t *testing.T) {
 	err := v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
 
-	addr, found := input.GravityKeeper.GetCosmosOriginatedERC20(input.Context, denom)
-	assert.True(t, found)
+	// The current keeper reads an EVM chain scoped layout, so read the v2 layout directly
+	bz := input.Context.KVStore(input.GravityStoreKey).Get(v2.GetDenomToERC20Key(denom))
+	assert.NotNil(t, bz)
+	addr, err := types.NewEthAddressFromBytes(bz)
+	require.NoError(t, err)
 	// Triple check that the migration worked
 	assert.Equal(t, tokenContract, strings.ToLower(addr.GetAddress().Hex()))
 	assert.Equal(t, gethcommon.HexToAddress(tokenContract), addr.GetAddress())
@@ -90,19 +93,9 @@ func TestMigrateCosmosOriginatedERC20ToDenom(t *testing.T) {
 	tokenAddr, err := types.NewEthAddress(tokenContract)
 	assert.NoError(t, err)
 
-	storedDenom, found := input.GravityKeeper.GetCosmosOriginatedDenom(input.Context, *tokenAddr)
-	assert.True(t, found)
-	assert.Equal(t, denom, storedDenom)
-
-	var erc20ToDenoms = []types.ERC20ToDenom{}
-	input.GravityKeeper.IterateERC20ToDenom(input.Context, func(key []byte, erc20ToDenom *types.ERC20ToDenom) bool {
-		erc20ToDenoms = append(erc20ToDenoms, *erc20ToDenom)
-		return false
-	})
-	fromStoreTokenAddr, err := types.NewEthAddress(erc20ToDenoms[0].Erc20)
-	assert.NoError(t, err)
-	assert.Equal(t, denom, erc20ToDenoms[0].Denom)
-	assert.Equal(t, fromStoreTokenAddr, tokenAddr)
+	// The current keeper reads an EVM chain scoped layout, so read the v2 layout directly
+	storedDenom := input.Context.KVStore(input.GravityStoreKey).Get(v2.GetERC20ToDenomKey(*tokenAddr))
+	assert.Equal(t, denom, string(storedDenom))
 }
 
 func TestMigrateEthAddressByValidator(t *testing.T) {
@@ -183,7 +176,7 @@ func TestMigrateBatchConfirms(t *testing.T) {
 	addr, err := types.NewEthAddress(ethAddr)
 	assert.NoError(t, err)
 
-	newKey := types.GetBatchConfirmKey(*addr, 123, orch)
+	newKey := v2.GetBatchConfirmKey(*addr, 123, orch)
 	entity := input.Context.KVStore(input.GravityStoreKey).Get(newKey)
 	assert.Equal(t, entity, confirmBytes)
 }
@@ -214,7 +207,7 @@ func TestMigrateOutgoingTxs(t *testing.T) {
 	err = v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
 
-	key := types.GetOutgoingTxPoolKey(*internalTx, outtx.Id)
+	key := v2.GetOutgoingTxPoolKey(*internalTx, outtx.Id)
 	res := input.Context.KVStore(input.GravityStoreKey).Get([]byte(key))
 	assert.Equal(t, inputBytes, res)
 }
@@ -243,7 +236,7 @@ func TestMigrateOutgoingTxBatches(t *testing.T) {
 	err = v2.MigrateStore(input.Context, input.GravityStoreKey, input.Marshaler)
 	assert.NoError(t, err)
 
-	key := types.GetOutgoingTxBatchKey(*addr, batch.BatchNonce)
+	key := v2.GetOutgoingTxBatchKey(*addr, batch.BatchNonce)
 	res := input.Context.KVStore(input.GravityStoreKey).Get([]byte(key))
 	assert.Equal(t, inputBytes, res)
 }
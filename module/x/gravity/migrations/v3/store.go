@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v2"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
@@ -84,7 +85,7 @@ func getAttestationConverter(logger log.Logger) func([]byte, codec.BinaryCodec,
 			return nil, err
 		}
 
-		newKey, err := types.GetAttestationKey(claim.GetEventNonce(), hash), nil
+		newKey, err := v2.GetAttestationKey(claim.GetEventNonce(), hash), nil
 		// The new key must be returned without a prefix, since it will be set on a PrefixStore
 		newKeyNoPrefix := newKey[len(implicitPrefix):]
 
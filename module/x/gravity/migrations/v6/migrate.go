@@ -0,0 +1,121 @@
+package v6
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The migration includes:
+//
+// - Move all the EVM chain scoped state under the default EVM chain's prefix
+// - Initialize the KeyLastMerkleAirdropID counter, which v5 chains lack
+// - Record the upgrade height as the creation height of every transfer in the pool or in a batch, v5 did not record
+// when transfers were added to the pool
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Gravity v6 Migration: Moving bridge state under the default EVM chain", "evm-chain-prefix", types.DefaultEvmChainPrefix)
+	store := ctx.KVStore(storeKey)
+
+	for _, keyPrefix := range types.EvmChainScopedKeys {
+		migrateKeysToEvmChain(store, keyPrefix, types.DefaultEvmChainPrefix)
+	}
+
+	if !store.Has(types.KeyLastMerkleAirdropID) {
+		store.Set(types.KeyLastMerkleAirdropID, sdk.Uint64ToBigEndian(0))
+	}
+
+	if err := backfillOutgoingTxCreatedHeights(ctx, store, cdc, types.DefaultEvmChainPrefix); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("Gravity v6 Migration: Store migration finished")
+	return nil
+}
+
+// MigrateParams performs in-place param migrations from v5 to v6. The migration includes:
+//
+// - Set EvmChains to an empty list, only the default chain described by the existing params is bridged
+// - Set the graduated slashing params OffenceDecayWindow, OffenceSlashEscalation, OffenceTombstoneThreshold and
+// OffenceJailOnlyCount to their default values, which keep slashing the full SlashFraction and jailing on every
+// missed signature and never tombstone, governance opts in to graduated slashing
+// - Set the valset trigger params ValsetPowerDiffThreshold, ValsetMaxAge and ValsetMinInterval to their default values
+// - Set TokenGasParams to an empty list, every token is estimated with the default gas per transfer and not budgeted
+func MigrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) {
+	ctx.Logger().Info("Gravity v6 Migration: Migrating to new Params")
+	defaultParams := types.DefaultParams()
+	paramSpace.Set(ctx, types.ParamStoreEvmChains, []types.EvmChainParams{})
+	paramSpace.Set(ctx, types.ParamStoreOffenceDecayWindow, defaultParams.OffenceDecayWindow)
+	paramSpace.Set(ctx, types.ParamStoreOffenceSlashEscalation, defaultParams.OffenceSlashEscalation)
+	paramSpace.Set(ctx, types.ParamStoreOffenceTombstoneThreshold, defaultParams.OffenceTombstoneThreshold)
+	paramSpace.Set(ctx, types.ParamStoreValsetPowerDiffThreshold, defaultParams.ValsetPowerDiffThreshold)
+	paramSpace.Set(ctx, types.ParamStoreValsetMaxAge, defaultParams.ValsetMaxAge)
+	paramSpace.Set(ctx, types.ParamStoreValsetMinInterval, defaultParams.ValsetMinInterval)
+	paramSpace.Set(ctx, types.ParamStoreTokenGasParams, []types.TokenGasParams{})
+	paramSpace.Set(ctx, types.ParamStoreOffenceJailOnlyCount, defaultParams.OffenceJailOnlyCount)
+	ctx.Logger().Info("Gravity v6 Migration: Params migration finished")
+}
+
+// backfillOutgoingTxCreatedHeights sets the current height as the creation height of every unbatched or batched
+// transfer of the chain which has none
+func backfillOutgoingTxCreatedHeights(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, evmChainPrefix string) error {
+	var ids []uint64
+
+	// the pool keys end with the transfer id
+	poolPrefix := types.AppendEvmChainPrefix(types.OutgoingTXPoolKey, evmChainPrefix)
+	iter := sdk.KVStorePrefixIterator(store, poolPrefix)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		ids = append(ids, types.UInt64FromBytesUnsafe(key[len(key)-8:]))
+	}
+	iter.Close()
+
+	iter = sdk.KVStorePrefixIterator(store, types.AppendEvmChainPrefix(types.OutgoingTXBatchKey, evmChainPrefix))
+	for ; iter.Valid(); iter.Next() {
+		var batch types.OutgoingTxBatch
+		if err := cdc.Unmarshal(iter.Value(), &batch); err != nil {
+			iter.Close()
+			return sdkerrors.Wrapf(err, "invalid batch under key %x", iter.Key())
+		}
+		for _, tx := range batch.Transactions {
+			ids = append(ids, tx.Id)
+		}
+	}
+	iter.Close()
+
+	height := types.UInt64Bytes(uint64(ctx.BlockHeight()))
+	for _, id := range ids {
+		key := types.GetOutgoingTxCreatedHeightKey(evmChainPrefix, id)
+		if !store.Has(key) {
+			store.Set(key, height)
+		}
+	}
+	return nil
+}
+
+// migrateKeysToEvmChain moves every value stored under keyPrefix to the same key with evmChainPrefix inserted
+// after keyPrefix. The keys are collected before writing since the new keys also begin with keyPrefix, keys which
+// are already under the new prefix are left alone
+func migrateKeysToEvmChain(store sdk.KVStore, keyPrefix []byte, evmChainPrefix string) {
+	newPrefix := types.AppendEvmChainPrefix(keyPrefix, evmChainPrefix)
+
+	var oldKeys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, keyPrefix)
+	for ; iter.Valid(); iter.Next() {
+		if !bytes.HasPrefix(iter.Key(), newPrefix) {
+			oldKeys = append(oldKeys, iter.Key())
+		}
+	}
+	iter.Close()
+
+	for _, oldKey := range oldKeys {
+		newKey := types.AppendBytes(newPrefix, oldKey[len(keyPrefix):])
+		store.Set(newKey, store.Get(oldKey))
+		store.Delete(oldKey)
+	}
+}
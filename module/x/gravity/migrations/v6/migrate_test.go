@@ -0,0 +1,82 @@
+package v6_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v2"
+	v6 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v6"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// nolint: exhaustruct
+func TestMigrateStore(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	store := ctx.KVStore(input.GravityStoreKey)
+
+	// write some bridge state in the v5 layout, which lacks the evm chain prefix
+	valset := types.Valset{Nonce: 3, Height: 1, Members: types.BridgeValidators{{Power: 100, EthereumAddress: keeper.EthAddrs[0].String()}}}
+	store.Set(v2.GetValsetKey(valset.Nonce), input.Marshaler.MustMarshal(&valset))
+	store.Set(types.LatestValsetNonce, types.UInt64Bytes(valset.Nonce))
+	token, err := types.NewEthAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	require.NoError(t, err)
+	store.Set(v2.GetDenomToERC20Key("graviton"), token.GetAddress().Bytes())
+	store.Set(v2.GetERC20ToDenomKey(*token), []byte("graviton"))
+	// global state must not move
+	store.Set(types.KeyLastTXPoolID, types.UInt64Bytes(7))
+	// v5 chains have no merkle airdrop counter
+	store.Delete(types.KeyLastMerkleAirdropID)
+	// v5 chains do not record when transfers were added to the pool
+	fee, err := types.NewInternalERC20Token(sdk.NewInt(10), token.GetAddress().Hex())
+	require.NoError(t, err)
+	store.Set(types.AppendBytes(types.OutgoingTXPoolKey, fee.Contract.GetAddress().Bytes(), fee.Amount.BigInt().FillBytes(make([]byte, 32)), types.UInt64Bytes(4)), []byte{1})
+	batch := types.OutgoingTxBatch{BatchNonce: 2, TokenContract: token.GetAddress().Hex(), Transactions: []types.OutgoingTransferTx{{Id: 5}, {Id: 6}}}
+	store.Set(types.AppendBytes(types.OutgoingTXBatchKey, token.GetAddress().Bytes(), types.UInt64Bytes(batch.BatchNonce)), input.Marshaler.MustMarshal(&batch))
+
+	require.NoError(t, v6.MigrateStore(ctx, input.GravityStoreKey, input.Marshaler))
+
+	k := input.GravityKeeper
+	require.Equal(t, valset.Nonce, k.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))
+	stored := k.GetValset(ctx, keeper.EthChainPrefix, valset.Nonce)
+	require.NotNil(t, stored)
+	require.Equal(t, valset.Members, stored.Members)
+	erc20, found := k.GetCosmosOriginatedERC20(ctx, keeper.EthChainPrefix, "graviton")
+	require.True(t, found)
+	require.Equal(t, token, erc20)
+	denom, found := k.GetCosmosOriginatedDenom(ctx, keeper.EthChainPrefix, *token)
+	require.True(t, found)
+	require.Equal(t, "graviton", denom)
+	for _, id := range []uint64{4, 5, 6} {
+		created, found := k.GetOutgoingTxCreatedHeight(ctx, keeper.EthChainPrefix, id)
+		require.True(t, found)
+		require.Equal(t, uint64(ctx.BlockHeight()), created)
+	}
+
+	// the old keys are gone and the global keys are untouched
+	require.False(t, store.Has(v2.GetValsetKey(valset.Nonce)))
+	require.False(t, store.Has(types.LatestValsetNonce))
+	require.False(t, store.Has(v2.GetDenomToERC20Key("graviton")))
+	require.Equal(t, types.UInt64Bytes(7), store.Get(types.KeyLastTXPoolID))
+	require.Equal(t, sdk.Uint64ToBigEndian(0), store.Get(types.KeyLastMerkleAirdropID))
+}
+
+// nolint: exhaustruct
+func TestMigrate5to6(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+
+	require.NoError(t, keeper.NewMigrator(input.GravityKeeper).Migrate5to6(ctx))
+
+	params := input.GravityKeeper.GetParams(ctx)
+	require.Empty(t, params.EvmChains)
+	require.Equal(t, types.DefaultParams().OffenceSlashEscalation, params.OffenceSlashEscalation)
+	require.Equal(t, types.DefaultParams().OffenceTombstoneThreshold, params.OffenceTombstoneThreshold)
+	require.Equal(t, types.DefaultParams().OffenceJailOnlyCount, params.OffenceJailOnlyCount)
+	require.Equal(t, types.DefaultParams().ValsetPowerDiffThreshold, params.ValsetPowerDiffThreshold)
+	require.Len(t, input.GravityKeeper.GetAllEvmChains(ctx), 1)
+}
@@ -0,0 +1,124 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding gravity type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	decodeProto := func(kvA, kvB kv.Pair, a, b codec.ProtoMarshaler) string {
+		cdc.MustUnmarshal(kvA.Value, a)
+		cdc.MustUnmarshal(kvB.Value, b)
+		return fmt.Sprintf("%v\n%v", a, b)
+	}
+
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case hasPrefix(kvA.Key, types.EthAddressByValidatorKey, types.DenomToERC20Key):
+			return fmt.Sprintf("%v\n%v", gethcommon.BytesToAddress(kvA.Value).Hex(), gethcommon.BytesToAddress(kvB.Value).Hex())
+
+		case hasPrefix(kvA.Key, types.ValidatorByEthAddressKey, types.KeyOrchestratorAddress):
+			return fmt.Sprintf("%v\n%v", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
+
+		case hasPrefix(kvA.Key, types.ValsetRequestKey, types.LastObservedValsetKey):
+			return decodeProto(kvA, kvB, &types.Valset{}, &types.Valset{})
+
+		case hasPrefix(kvA.Key, types.ValsetConfirmKey):
+			return decodeProto(kvA, kvB, &types.MsgValsetConfirm{}, &types.MsgValsetConfirm{})
+
+		case hasPrefix(kvA.Key, types.OracleAttestationKey):
+			return decodeProto(kvA, kvB, &types.Attestation{}, &types.Attestation{})
+
+		case hasPrefix(kvA.Key, types.OutgoingTXPoolKey):
+			return decodeProto(kvA, kvB, &types.OutgoingTransferTx{}, &types.OutgoingTransferTx{})
+
+		case hasPrefix(kvA.Key, types.OutgoingTXBatchKey):
+			return decodeProto(kvA, kvB, &types.OutgoingTxBatch{}, &types.OutgoingTxBatch{})
+
+		case hasPrefix(kvA.Key, types.BatchConfirmKey):
+			return decodeProto(kvA, kvB, &types.MsgConfirmBatch{}, &types.MsgConfirmBatch{})
+
+		case hasPrefix(kvA.Key, types.KeyOutgoingLogicCall):
+			return decodeProto(kvA, kvB, &types.OutgoingLogicCall{}, &types.OutgoingLogicCall{})
+
+		case hasPrefix(kvA.Key, types.KeyOutgoingLogicConfirm):
+			return decodeProto(kvA, kvB, &types.MsgConfirmLogicCall{}, &types.MsgConfirmLogicCall{})
+
+		case hasPrefix(kvA.Key, types.LastObservedEthereumBlockHeightKey):
+			return decodeProto(kvA, kvB, &types.LastObservedEthereumBlockHeight{}, &types.LastObservedEthereumBlockHeight{})
+
+		case hasPrefix(kvA.Key, types.ERC20ToDenomKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case hasPrefix(kvA.Key, types.PastEthSignatureCheckpointKey):
+			return fmt.Sprintf("%x\n%x", kvA.Value, kvB.Value)
+
+		case hasPrefix(kvA.Key, types.PendingIbcAutoForwards):
+			return decodeProto(kvA, kvB, &types.PendingIbcAutoForward{}, &types.PendingIbcAutoForward{})
+
+		case hasPrefix(kvA.Key, types.ERC20MigrationKey):
+			return decodeProto(kvA, kvB, &types.ERC20Migration{}, &types.ERC20Migration{})
+
+		case hasPrefix(kvA.Key, types.AttestedERC20DeploymentKey):
+			return decodeProto(kvA, kvB, &types.AttestedERC20Deployment{}, &types.AttestedERC20Deployment{})
+
+		case hasPrefix(kvA.Key, types.MerkleAirdropKey):
+			return decodeProto(kvA, kvB, &types.MerkleAirdrop{}, &types.MerkleAirdrop{})
+
+		case hasPrefix(kvA.Key, types.MerkleAirdropClaimKey):
+			return decodeProto(kvA, kvB, &types.MerkleAirdropClaim{}, &types.MerkleAirdropClaim{})
+
+		case hasPrefix(kvA.Key, types.ValidatorBridgePerformanceKey):
+			return decodeProto(kvA, kvB, &types.ValidatorBridgePerformance{}, &types.ValidatorBridgePerformance{})
+
+		case hasPrefix(kvA.Key, types.BridgeOffencesKey):
+			return decodeProto(kvA, kvB, &types.BridgeOffences{}, &types.BridgeOffences{})
+
+		case hasPrefix(kvA.Key, types.BatchFeeRecordKey):
+			return decodeProto(kvA, kvB, &types.BatchFeeRecord{}, &types.BatchFeeRecord{})
+
+		case hasPrefix(kvA.Key,
+			types.LastEventNonceByValidatorKey,
+			types.LastObservedEventNonceKey,
+			types.KeyLastTXPoolID,
+			types.KeyLastOutgoingBatchID,
+			types.KeyLastMerkleAirdropID,
+			types.LastSlashedValsetNonce,
+			types.LatestValsetNonce,
+			types.LastSlashedBatchBlock,
+			types.LastSlashedLogicCallBlock,
+			types.LastUnBondingBlockHeight,
+			types.BatchFeeRecordCountKey,
+			types.OutgoingTxCreatedHeightKey,
+		):
+			return fmt.Sprintf("%d\n%d", types.UInt64FromBytesUnsafe(kvA.Value), types.UInt64FromBytesUnsafe(kvB.Value))
+
+		case hasPrefix(kvA.Key, types.LEGACYOracleClaimKey, types.LEGACYSequenceKeyPrefix):
+			// legacy keys are only written by migration tests, their values are shown as they are
+			return fmt.Sprintf("%s\n%s", hex.EncodeToString(kvA.Value), hex.EncodeToString(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid gravity key prefix %X", kvA.Key))
+		}
+	}
+}
+
+// hasPrefix returns true if key starts with any of the given store prefixes
+func hasPrefix(key []byte, prefixes ...[]byte) bool {
+	for _, prefix := range prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
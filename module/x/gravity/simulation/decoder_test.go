@@ -0,0 +1,73 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/simulation"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// nolint: exhaustruct
+func TestDecodeStore(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	dec := simulation.NewDecodeStore(cdc)
+
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	ethAddr, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	fee, err := types.NewInternalERC20Token(sdk.NewInt(10), ethAddr.GetAddress().Hex())
+	require.NoError(t, err)
+
+	valset := types.Valset{Nonce: 3, Height: 10, RewardToken: "0x0000000000000000000000000000000000000000"}
+	claim := &types.MsgSendToCosmosClaim{EventNonce: 5, EthBlockHeight: 20, TokenContract: ethAddr.GetAddress().Hex(), Amount: sdk.NewInt(100)}
+	anyClaim, err := codectypes.NewAnyWithValue(claim)
+	require.NoError(t, err)
+	attestation := types.Attestation{Observed: true, Height: 4, Claim: anyClaim}
+	transfer := types.OutgoingTransferTx{Id: 7, Sender: sdk.AccAddress(valAddr).String(), Erc20Token: fee.ToExternal(), Erc20Fee: fee.ToExternal()}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetValsetKey(types.DefaultEvmChainPrefix, 3), Value: cdc.MustMarshal(&valset)},
+			{Key: types.GetAttestationKey(types.DefaultEvmChainPrefix, 5, []byte("hash")), Value: cdc.MustMarshal(&attestation)},
+			{Key: types.GetOutgoingTxPoolKey(types.DefaultEvmChainPrefix, *fee, 7), Value: cdc.MustMarshal(&transfer)},
+			{Key: types.GetLastEventNonceByValidatorKey(types.DefaultEvmChainPrefix, valAddr), Value: types.UInt64Bytes(5)},
+			{Key: types.GetEthAddressByValidatorKey(valAddr), Value: ethAddr.GetAddress().Bytes()},
+			{Key: types.GetERC20ToDenomKey(types.DefaultEvmChainPrefix, *ethAddr), Value: []byte("ugraviton")},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Valset", fmt.Sprintf("%v\n%v", &valset, &valset)},
+		{"Attestation", fmt.Sprintf("%v\n%v", &attestation, &attestation)},
+		{"OutgoingTransferTx", fmt.Sprintf("%v\n%v", &transfer, &transfer)},
+		{"LastEventNonceByValidator", "5\n5"},
+		{"EthAddressByValidator", fmt.Sprintf("%s\n%s", ethAddr.GetAddress().Hex(), ethAddr.GetAddress().Hex())},
+		{"ERC20ToDenom", "ugraviton\nugraviton"},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
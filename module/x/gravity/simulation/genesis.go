@@ -0,0 +1,177 @@
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Simulation parameter constants
+const (
+	GravityID              = "gravity_id"
+	BridgeChainID          = "bridge_chain_id"
+	SignedValsetsWindow    = "signed_valsets_window"
+	SignedBatchesWindow    = "signed_batches_window"
+	SignedLogicCallsWindow = "signed_logic_calls_window"
+	TargetBatchTimeout     = "target_batch_timeout"
+	MinChainFeeBasisPoints = "min_chain_fee_basis_points"
+	CosmosOriginatedERC20  = "cosmos_originated_erc20"
+)
+
+// SimulatedERC20s are the Ethereum originated tokens which the simulated Ethereum chain sends to Cosmos, their
+// vouchers make up the randomized pending pool of the genesis state
+var SimulatedERC20s = []string{
+	"0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+	"0xd7b3A2c9F7b8a5C9e1bc5f3A6E2C1D4b8A9f0E12",
+	"0x7580bFE88Dd3d07947908FAE12d95872a260F2D8",
+}
+
+// GenGravityID randomized GravityId
+func GenGravityID(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, 10)
+}
+
+// GenBridgeChainID randomized BridgeChainId
+func GenBridgeChainID(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 1000))
+}
+
+// GenSignedWindow randomized SignedValsetsWindow, SignedBatchesWindow and SignedLogicCallsWindow, the windows are long
+// enough that the simulated orchestrators usually get to confirm before they are slashed
+func GenSignedWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 100, 10000))
+}
+
+// GenTargetBatchTimeout randomized TargetBatchTimeout in milliseconds
+func GenTargetBatchTimeout(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 60000, 43200000))
+}
+
+// GenMinChainFeeBasisPoints randomized MinChainFeeBasisPoints
+func GenMinChainFeeBasisPoints(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// GenCosmosOriginatedERC20 randomized ERC20 representation of the bond denom, empty if the bond denom is not bridged
+func GenCosmosOriginatedERC20(r *rand.Rand) string {
+	if r.Intn(4) == 0 {
+		return ""
+	}
+	return RandomEthAddress(r).GetAddress().Hex()
+}
+
+// GenUnbatchedTransfers randomized pending pool of transfers of the simulated ERC20s out of the given accounts
+func GenUnbatchedTransfers(r *rand.Rand, accs []simtypes.Account) []types.OutgoingTransferTx {
+	transfers := make([]types.OutgoingTransferTx, r.Intn(20))
+	for i := range transfers {
+		sender, _ := simtypes.RandomAcc(r, accs)
+		token := SimulatedERC20s[r.Intn(len(SimulatedERC20s))]
+		transfers[i] = types.OutgoingTransferTx{
+			Id:          uint64(i + 1),
+			Sender:      sender.Address.String(),
+			DestAddress: RandomEthAddress(r).GetAddress().Hex(),
+			Erc20Token:  types.ERC20Token{Contract: token, Amount: sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000000)))},
+			Erc20Fee:    types.ERC20Token{Contract: token, Amount: sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1000)))},
+		}
+	}
+	return transfers
+}
+
+// RandomizedGenState generates a random GenesisState for gravity, the first NumBonded accounts, which the staking
+// module makes validators, orchestrate their own validator with the Ethereum key returned by EthereumKey
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GravityID, &params.GravityId, simState.Rand,
+		func(r *rand.Rand) { params.GravityId = GenGravityID(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BridgeChainID, &params.BridgeChainId, simState.Rand,
+		func(r *rand.Rand) { params.BridgeChainId = GenBridgeChainID(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedValsetsWindow, &params.SignedValsetsWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedValsetsWindow = GenSignedWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedBatchesWindow, &params.SignedBatchesWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedBatchesWindow = GenSignedWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedLogicCallsWindow, &params.SignedLogicCallsWindow, simState.Rand,
+		func(r *rand.Rand) { params.SignedLogicCallsWindow = GenSignedWindow(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetBatchTimeout, &params.TargetBatchTimeout, simState.Rand,
+		func(r *rand.Rand) { params.TargetBatchTimeout = GenTargetBatchTimeout(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinChainFeeBasisPoints, &params.MinChainFeeBasisPoints, simState.Rand,
+		func(r *rand.Rand) { params.MinChainFeeBasisPoints = GenMinChainFeeBasisPoints(r) },
+	)
+	params.BridgeEthereumAddress = RandomEthAddress(simState.Rand).GetAddress().Hex()
+
+	var cosmosOriginatedERC20 string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CosmosOriginatedERC20, &cosmosOriginatedERC20, simState.Rand,
+		func(r *rand.Rand) { cosmosOriginatedERC20 = GenCosmosOriginatedERC20(r) },
+	)
+	unbatched := GenUnbatchedTransfers(simState.Rand, simState.Accounts)
+
+	delegateKeys := make([]types.MsgSetOrchestratorAddress, 0, simState.NumBonded)
+	for _, acc := range simState.Accounts[:simState.NumBonded] {
+		delegateKeys = append(delegateKeys, types.MsgSetOrchestratorAddress{
+			Validator:    sdk.ValAddress(acc.Address).String(),
+			Orchestrator: acc.Address.String(),
+			EthAddress:   crypto.PubkeyToAddress(EthereumKey(acc).PublicKey).Hex(),
+		})
+	}
+	erc20ToDenoms := []types.ERC20ToDenom{}
+	if cosmosOriginatedERC20 != "" {
+		erc20ToDenoms = append(erc20ToDenoms, types.ERC20ToDenom{Erc20: cosmosOriginatedERC20, Denom: sdk.DefaultBondDenom})
+	}
+
+	gravityGenesis := types.DefaultGenesisState()
+	gravityGenesis.Params = params
+	gravityGenesis.GravityNonces.LastTxPoolId = uint64(len(unbatched))
+	gravityGenesis.DelegateKeys = delegateKeys
+	gravityGenesis.Erc20ToDenoms = erc20ToDenoms
+	gravityGenesis.UnbatchedTransfers = unbatched
+
+	bz, err := json.MarshalIndent(&gravityGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated gravity parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gravityGenesis)
+}
+
+// EthereumKey returns the Ethereum key of a simulated orchestrator, it is derived from the account's Cosmos key so
+// operations can sign Ethereum checkpoints for any account without keeping additional state
+func EthereumKey(acc simtypes.Account) *ecdsa.PrivateKey {
+	key, err := crypto.ToECDSA(acc.PrivKey.Bytes())
+	if err != nil {
+		panic(fmt.Sprintf("simulated account %s has no valid Ethereum key: %v", acc.Address, err))
+	}
+	return key
+}
+
+// RandomEthAddress returns a random Ethereum address
+func RandomEthAddress(r *rand.Rand) types.EthAddress {
+	bz := make([]byte, 20)
+	r.Read(bz) // nolint: errcheck
+	addr, err := types.NewEthAddressFromBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return *addr
+}
@@ -0,0 +1,59 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/simulation"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// TestRandomizedGenState tests the normal scenario of applying RandomizedGenState, the generated genesis must be
+// valid and every bonded account must orchestrate its own validator with its simulated Ethereum key
+// nolint: exhaustruct
+func TestRandomizedGenState(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 10),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var gravityGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &gravityGenesis)
+
+	require.NoError(t, gravityGenesis.ValidateBasic())
+	require.Len(t, gravityGenesis.DelegateKeys, 3)
+	for i, key := range gravityGenesis.DelegateKeys {
+		acc := simState.Accounts[i]
+		require.Equal(t, sdk.ValAddress(acc.Address).String(), key.Validator)
+		require.Equal(t, acc.Address.String(), key.Orchestrator)
+		require.Equal(t, crypto.PubkeyToAddress(simulation.EthereumKey(acc).PublicKey).Hex(), key.EthAddress)
+	}
+	require.Equal(t, uint64(len(gravityGenesis.UnbatchedTransfers)), gravityGenesis.GravityNonces.LastTxPoolId)
+	for _, tx := range gravityGenesis.UnbatchedTransfers {
+		require.Contains(t, simulation.SimulatedERC20s, tx.Erc20Token.Contract)
+		require.Equal(t, tx.Erc20Token.Contract, tx.Erc20Fee.Contract)
+	}
+}
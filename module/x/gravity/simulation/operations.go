@@ -0,0 +1,399 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Simulation operation weights constants
+// nolint: gosec
+const (
+	OpWeightMsgSendToEth       = "op_weight_msg_send_to_eth"
+	OpWeightMsgCancelSendToEth = "op_weight_msg_cancel_send_to_eth"
+	OpWeightMsgRequestBatch    = "op_weight_msg_request_batch"
+	OpWeightMsgValsetConfirm   = "op_weight_msg_valset_confirm"
+	OpWeightMsgConfirmBatch    = "op_weight_msg_confirm_batch"
+	OpWeightMsgEthereumClaim   = "op_weight_msg_ethereum_claim"
+
+	DefaultWeightMsgSendToEth       = 100
+	DefaultWeightMsgCancelSendToEth = 20
+	DefaultWeightMsgRequestBatch    = 50
+	DefaultWeightMsgValsetConfirm   = 100
+	DefaultWeightMsgConfirmBatch    = 100
+	DefaultWeightMsgEthereumClaim   = 100
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgSendToEth       int
+		weightMsgCancelSendToEth int
+		weightMsgRequestBatch    int
+		weightMsgValsetConfirm   int
+		weightMsgConfirmBatch    int
+		weightMsgEthereumClaim   int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendToEth, &weightMsgSendToEth, nil,
+		func(_ *rand.Rand) { weightMsgSendToEth = DefaultWeightMsgSendToEth },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelSendToEth, &weightMsgCancelSendToEth, nil,
+		func(_ *rand.Rand) { weightMsgCancelSendToEth = DefaultWeightMsgCancelSendToEth },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRequestBatch, &weightMsgRequestBatch, nil,
+		func(_ *rand.Rand) { weightMsgRequestBatch = DefaultWeightMsgRequestBatch },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgValsetConfirm, &weightMsgValsetConfirm, nil,
+		func(_ *rand.Rand) { weightMsgValsetConfirm = DefaultWeightMsgValsetConfirm },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgConfirmBatch, &weightMsgConfirmBatch, nil,
+		func(_ *rand.Rand) { weightMsgConfirmBatch = DefaultWeightMsgConfirmBatch },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgEthereumClaim, &weightMsgEthereumClaim, nil,
+		func(_ *rand.Rand) { weightMsgEthereumClaim = DefaultWeightMsgEthereumClaim },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgSendToEth, SimulateMsgSendToEth(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgCancelSendToEth, SimulateMsgCancelSendToEth(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRequestBatch, SimulateMsgRequestBatch(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgValsetConfirm, SimulateMsgValsetConfirm(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgConfirmBatch, SimulateMsgConfirmBatch(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgEthereumClaim, SimulateMsgEthereumClaim(ak, bk, k)),
+	}
+}
+
+// SimulateMsgSendToEth generates a MsgSendToEth of a random bridged coin held by a random account, paying at least
+// the minimum chain fee
+func SimulateMsgSendToEth(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sender, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, sender.Address)
+
+		var coin sdk.Coin
+		found := false
+		for _, i := range r.Perm(len(spendable)) {
+			// the amount, bridge fee and chain fee are all taken from the same coin
+			if spendable[i].Amount.LT(sdk.NewInt(4)) {
+				continue
+			}
+			if _, _, err := k.DenomToERC20Lookup(ctx, types.DefaultEvmChainPrefix, spendable[i].Denom); err == nil {
+				coin, found = spendable[i], true
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_SEND_TO_ETH, "no bridged coins to send"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount.QuoRaw(2))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_SEND_TO_ETH, "unable to generate amount"), nil, err
+		}
+		bridgeFee, err := simtypes.RandPositiveInt(r, coin.Amount.QuoRaw(4))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_SEND_TO_ETH, "unable to generate bridge fee"), nil, err
+		}
+		minChainFee := amount.MulRaw(int64(k.GetParams(ctx).MinChainFeeBasisPoints)).QuoRaw(int64(keeper.BasisPointDivisor))
+		// tipping above the minimum at random refills the auction pool with the pool's share of the chain fee
+		chainFee := minChainFee.Add(simtypes.RandomAmount(r, amount.QuoRaw(10))).AddRaw(1)
+
+		msg := &types.MsgSendToEth{
+			Sender:         sender.Address.String(),
+			EthDest:        RandomEthAddress(r).GetAddress().Hex(),
+			Amount:         sdk.NewCoin(coin.Denom, amount),
+			BridgeFee:      sdk.NewCoin(coin.Denom, bridgeFee),
+			ChainFee:       sdk.NewCoin(coin.Denom, chainFee),
+			EvmChainPrefix: types.DefaultEvmChainPrefix,
+		}
+		spent := sdk.NewCoins(sdk.NewCoin(coin.Denom, amount.Add(bridgeFee).Add(chainFee)))
+
+		return deliver(r, app, ctx, ak, bk, sender, msg, msg.Type(), spent)
+	}
+}
+
+// SimulateMsgCancelSendToEth generates a MsgCancelSendToEth of a random unbatched transfer sent by a simulated
+// account
+func SimulateMsgCancelSendToEth(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		unbatched := k.GetUnbatchedTransactions(ctx, types.DefaultEvmChainPrefix)
+		for _, i := range r.Perm(len(unbatched)) {
+			sender, found := simtypes.FindAccount(accs, unbatched[i].Sender)
+			if !found {
+				continue
+			}
+
+			msg := &types.MsgCancelSendToEth{
+				TransactionId:  unbatched[i].Id,
+				Sender:         sender.Address.String(),
+				EvmChainPrefix: types.DefaultEvmChainPrefix,
+			}
+			return deliver(r, app, ctx, ak, bk, sender, msg, msg.Type(), sdk.NewCoins())
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_CANCEL_SEND_TO_ETH, "no unbatched transfers to cancel"), nil, nil
+	}
+}
+
+// SimulateMsgRequestBatch generates a MsgRequestBatch for a random token of the pool, as long as the batch would be
+// created
+func SimulateMsgRequestBatch(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		unbatched := k.GetUnbatchedTransactions(ctx, types.DefaultEvmChainPrefix)
+		if len(unbatched) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_REQUEST_BATCH, "no unbatched transfers"), nil, nil
+		}
+		contract := unbatched[r.Intn(len(unbatched))].Erc20Token.Contract
+
+		// a batch which is not more profitable than the last one fails the msg, try it out without keeping the result
+		cacheCtx, _ := ctx.CacheContext()
+		if _, err := k.BuildOutgoingTXBatch(cacheCtx, types.DefaultEvmChainPrefix, contract, keeper.OutgoingTxBatchSize); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_REQUEST_BATCH, err.Error()), nil, nil
+		}
+
+		sender, _ := simtypes.RandomAcc(r, accs)
+		_, denom := k.ERC20ToDenomLookup(ctx, types.DefaultEvmChainPrefix, contract)
+		msg := &types.MsgRequestBatch{
+			Sender:         sender.Address.String(),
+			Denom:          denom,
+			EvmChainPrefix: types.DefaultEvmChainPrefix,
+		}
+		return deliver(r, app, ctx, ak, bk, sender, msg, msg.Type(), sdk.NewCoins())
+	}
+}
+
+// SimulateMsgValsetConfirm generates a MsgValsetConfirm for a valset which a random orchestrator has not signed yet
+func SimulateMsgValsetConfirm(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		orchestrator, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_VALSET_CONFIRM, "no orchestrators"), nil, nil
+		}
+
+		for _, valset := range k.GetValsets(ctx, types.DefaultEvmChainPrefix) {
+			if k.GetValsetConfirm(ctx, types.DefaultEvmChainPrefix, valset.Nonce, orchestrator.Address) != nil {
+				continue
+			}
+
+			signature, err := signCheckpoint(orchestrator, valset.GetCheckpoint(k.GetGravityID(ctx, types.DefaultEvmChainPrefix)))
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_VALSET_CONFIRM, "unable to sign valset"), nil, err
+			}
+			msg := &types.MsgValsetConfirm{
+				Nonce:          valset.Nonce,
+				Orchestrator:   orchestrator.Address.String(),
+				EthAddress:     ethAddress(orchestrator),
+				Signature:      signature,
+				EvmChainPrefix: types.DefaultEvmChainPrefix,
+			}
+			return deliver(r, app, ctx, ak, bk, orchestrator, msg, msg.Type(), sdk.NewCoins())
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_VALSET_CONFIRM, "no unsigned valsets"), nil, nil
+	}
+}
+
+// SimulateMsgConfirmBatch generates a MsgConfirmBatch for a batch which a random orchestrator has not signed yet
+func SimulateMsgConfirmBatch(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		orchestrator, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_CONFIRM_BATCH, "no orchestrators"), nil, nil
+		}
+
+		for _, batch := range k.GetOutgoingTxBatches(ctx, types.DefaultEvmChainPrefix) {
+			if k.GetBatchConfirm(ctx, types.DefaultEvmChainPrefix, batch.BatchNonce, batch.TokenContract, orchestrator.Address) != nil {
+				continue
+			}
+
+			signature, err := signCheckpoint(orchestrator, batch.GetCheckpoint(k.GetGravityID(ctx, types.DefaultEvmChainPrefix)))
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_CONFIRM_BATCH, "unable to sign batch"), nil, err
+			}
+			msg := &types.MsgConfirmBatch{
+				Nonce:          batch.BatchNonce,
+				TokenContract:  batch.TokenContract.GetAddress().Hex(),
+				EthSigner:      ethAddress(orchestrator),
+				Orchestrator:   orchestrator.Address.String(),
+				Signature:      signature,
+				EvmChainPrefix: types.DefaultEvmChainPrefix,
+			}
+			return deliver(r, app, ctx, ak, bk, orchestrator, msg, msg.Type(), sdk.NewCoins())
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, types.AMINO_TYPE_CONFIRM_BATCH, "no unsigned batches"), nil, nil
+	}
+}
+
+// SimulateMsgEthereumClaim generates the next claim of a random orchestrator, acting as the simulated Ethereum chain:
+// an event which other orchestrators already claimed at that nonce is claimed again, otherwise a new deposit or
+// execution of a pending batch is made up. Executed batches must still be in the store when their attestation is
+// observed, so a batch is only executed if no later batch of the same kind has been claimed already.
+func SimulateMsgEthereumClaim(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		orchestrator, found := randomOrchestrator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, "ethereum_claim", "no orchestrators"), nil, nil
+		}
+		validator, _ := k.GetOrchestratorValidator(ctx, orchestrator.Address)
+		nonce := k.GetLastEventNonceByValidator(ctx, types.DefaultEvmChainPrefix, validator.GetOperator()) + 1
+
+		attestations, _ := k.GetAttestationMapping(ctx, types.DefaultEvmChainPrefix)
+		var claim types.EthereumClaim
+		if existing, ok := attestations[nonce]; ok && len(existing) > 0 {
+			stored, err := k.UnpackAttestationClaim(&existing[r.Intn(len(existing))])
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, "ethereum_claim", "unable to unpack claim"), nil, err
+			}
+			claim = stored
+		} else {
+			claim = newEthereumEvent(r, ctx, k, accs, nonce, attestations)
+		}
+		claim.SetOrchestrator(orchestrator.Address)
+
+		msg, ok := claim.(legacytx.LegacyMsg)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, "ethereum_claim", "claim is not a msg"), nil, nil
+		}
+		return deliver(r, app, ctx, ak, bk, orchestrator, msg, msg.Type(), sdk.NewCoins())
+	}
+}
+
+// newEthereumEvent makes up the event with the given nonce on the simulated Ethereum chain, it happens one block after
+// every event claimed so far
+func newEthereumEvent(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, nonce uint64,
+	attestations map[uint64][]types.Attestation,
+) types.EthereumClaim {
+	height := k.GetLastObservedEthereumBlockHeight(ctx, types.DefaultEvmChainPrefix).EthereumBlockHeight
+	claimedBatches := make(map[string]uint64)
+	for _, atts := range attestations {
+		for i := range atts {
+			claim, err := k.UnpackAttestationClaim(&atts[i])
+			if err != nil {
+				panic(err)
+			}
+			if claim.GetEthBlockHeight() > height {
+				height = claim.GetEthBlockHeight()
+			}
+			switch claim := claim.(type) {
+			case *types.MsgBatchSendToEthClaim:
+				if claim.BatchNonce > claimedBatches[claim.TokenContract] {
+					claimedBatches[claim.TokenContract] = claim.BatchNonce
+				}
+			}
+		}
+	}
+	height++
+
+	switch r.Intn(3) {
+	case 0:
+		for _, batch := range k.GetOutgoingTxBatches(ctx, types.DefaultEvmChainPrefix) {
+			contract := batch.TokenContract.GetAddress().Hex()
+			if batch.BatchNonce > claimedBatches[contract] && batch.BatchTimeout > height {
+				return &types.MsgBatchSendToEthClaim{
+					EventNonce:     nonce,
+					EthBlockHeight: height,
+					BatchNonce:     batch.BatchNonce,
+					TokenContract:  contract,
+					Orchestrator:   "",
+					EvmChainPrefix: types.DefaultEvmChainPrefix,
+				}
+			}
+		}
+	}
+
+	receiver, _ := simtypes.RandomAcc(r, accs)
+	return &types.MsgSendToCosmosClaim{
+		EventNonce:     nonce,
+		EthBlockHeight: height,
+		TokenContract:  SimulatedERC20s[r.Intn(len(SimulatedERC20s))],
+		Amount:         sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 100000000))),
+		EthereumSender: RandomEthAddress(r).GetAddress().Hex(),
+		CosmosReceiver: receiver.Address.String(),
+		Orchestrator:   "",
+		EvmChainPrefix: types.DefaultEvmChainPrefix,
+	}
+}
+
+// randomOrchestrator returns a random simulated account orchestrating a bonded validator with the key returned by
+// EthereumKey, only those accounts are able to sign confirms and claims
+func randomOrchestrator(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		validator, found := k.GetOrchestratorValidator(ctx, accs[i].Address)
+		if !found || validator.GetStatus() != stakingtypes.Bonded {
+			continue
+		}
+		ethAddr, found := k.GetEthAddressByValidator(ctx, validator.GetOperator())
+		if !found || ethAddr.GetAddress().Hex() != ethAddress(accs[i]) {
+			continue
+		}
+		return accs[i], true
+	}
+	return simtypes.Account{}, false
+}
+
+// ethAddress returns the Ethereum address of a simulated orchestrator
+func ethAddress(acc simtypes.Account) string {
+	return crypto.PubkeyToAddress(EthereumKey(acc).PublicKey).Hex()
+}
+
+// signCheckpoint returns the hex encoded signature of a simulated orchestrator over an Ethereum checkpoint
+func signCheckpoint(acc simtypes.Account, checkpoint []byte) (string, error) {
+	signature, err := types.NewEthereumSignature(checkpoint, EthereumKey(acc))
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(signature), nil
+}
+
+// deliver signs msg with the account and delivers it with random fees paid from the coins left after the msg
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper,
+	acc simtypes.Account, msg sdk.Msg, msgType string, spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      acc,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: spent,
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
@@ -0,0 +1,35 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeySignedBatchesWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSignedWindow(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeyTargetBatchTimeout),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenTargetBatchTimeout(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreMinChainFeeBasisPoints),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMinChainFeeBasisPoints(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,135 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// Simulation proposal weight constants
+const (
+	OpWeightUnhaltBridgeProposal = "op_weight_unhalt_bridge_proposal" // nolint: gosec
+	OpWeightAirdropProposal      = "op_weight_airdrop_proposal"       // nolint: gosec
+	OpWeightIBCMetadataProposal  = "op_weight_ibc_metadata_proposal"  // nolint: gosec
+
+	DefaultWeightUnhaltBridgeProposal = 2
+	DefaultWeightAirdropProposal      = 5
+	DefaultWeightIBCMetadataProposal  = 5
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightUnhaltBridgeProposal,
+			DefaultWeightUnhaltBridgeProposal,
+			SimulateUnhaltBridgeProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightAirdropProposal,
+			DefaultWeightAirdropProposal,
+			SimulateAirdropProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightIBCMetadataProposal,
+			DefaultWeightIBCMetadataProposal,
+			SimulateIBCMetadataProposalContent(),
+		),
+	}
+}
+
+// SimulateUnhaltBridgeProposalContent generates an UnhaltBridgeProposal rolling the oracle back to the last observed
+// event, any later target would leave the simulated orchestrators unable to fill the skipped nonces
+func SimulateUnhaltBridgeProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		lastObserved := k.GetLastObservedEventNonce(ctx, types.DefaultEvmChainPrefix)
+		if lastObserved == 0 {
+			return nil
+		}
+
+		// nolint: exhaustruct
+		return &types.UnhaltBridgeProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 100),
+			TargetNonce: lastObserved,
+		}
+	}
+}
+
+// SimulateAirdropProposalContent generates an AirdropProposal paying a few random accounts out of the community pool
+func SimulateAirdropProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		balance := k.DistKeeper.GetFeePool(ctx).CommunityPool
+		if balance.Empty() {
+			return nil
+		}
+		coin := balance[r.Intn(len(balance))]
+		available := coin.Amount.TruncateInt()
+
+		numRecipients := simtypes.RandIntBetween(r, 1, 5)
+		if !available.GT(sdk.NewInt(int64(numRecipients))) {
+			return nil
+		}
+		// every recipient gets at most an equal share of the pool, so the airdrop can always be paid
+		share := available.QuoRaw(int64(numRecipients))
+		if !share.IsUint64() {
+			share = sdk.NewIntFromUint64(^uint64(0) / uint64(numRecipients))
+		}
+
+		recipients := make([]byte, 0, numRecipients*20)
+		amounts := make([]uint64, numRecipients)
+		for i := range amounts {
+			recipient, _ := simtypes.RandomAcc(r, accs)
+			recipients = append(recipients, recipient.Address.Bytes()...)
+			amount, err := simtypes.RandPositiveInt(r, share)
+			if err != nil {
+				return nil
+			}
+			amounts[i] = amount.Uint64()
+		}
+
+		return &types.AirdropProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 100),
+			Denom:       coin.Denom,
+			Recipients:  recipients,
+			Amounts:     amounts,
+		}
+	}
+}
+
+// SimulateIBCMetadataProposalContent generates an IBCMetadataProposal for a random IBC denom
+func SimulateIBCMetadataProposalContent() simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) simtypes.Content {
+		hash := make([]byte, 32)
+		r.Read(hash) // nolint: errcheck
+		ibcDenom := fmt.Sprintf("ibc/%X", hash)
+		symbol := strings.ToUpper(simtypes.RandStringOfLength(r, 4))
+		display := strings.ToLower(symbol)
+
+		return &types.IBCMetadataProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 100),
+			Metadata: banktypes.Metadata{
+				Description: simtypes.RandStringOfLength(r, 20),
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: ibcDenom, Exponent: 0, Aliases: nil},
+					{Denom: display, Exponent: uint32(simtypes.RandIntBetween(r, 1, 19)), Aliases: nil},
+				},
+				Base:    ibcDenom,
+				Display: display,
+				Name:    simtypes.RandStringOfLength(r, 8),
+				Symbol:  symbol,
+			},
+			IbcDenom: ibcDenom,
+		}
+	}
+}
@@ -209,6 +209,7 @@ type EventObservation struct {
 	BridgeChainId   string `protobuf:"bytes,3,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
 	AttestationId   string `protobuf:"bytes,4,opt,name=attestation_id,json=attestationId,proto3" json:"attestation_id,omitempty"`
 	Nonce           string `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	EvmChainPrefix  string `protobuf:"bytes,6,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *EventObservation) Reset()         { *m = EventObservation{} }
@@ -279,6 +280,13 @@ func (m *EventObservation) GetNonce() string {
 	return ""
 }
 
+func (m *EventObservation) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type EventInvalidSendToCosmosReceiver struct {
 	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 	Nonce  string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
@@ -662,54 +670,56 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/attestation.proto", fileDescriptor_e3205613bbab7525) }
 
 var fileDescriptor_e3205613bbab7525 = []byte{
-	// 752 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0x43, 0x12, 0xc8, 0xf0, 0x80, 0x3c, 0x0b, 0x21, 0x13, 0xf1, 0x4c, 0x9e, 0xa5, 0x07,
-	0x79, 0x48, 0xd8, 0x85, 0x7e, 0x40, 0xe5, 0x38, 0x06, 0x2c, 0x05, 0x12, 0x39, 0xa6, 0x2d, 0xdd,
-	0x58, 0x8e, 0x3d, 0x75, 0x2c, 0x92, 0x99, 0xc8, 0x9e, 0xb8, 0x64, 0xd3, 0x4d, 0x37, 0x5d, 0xf6,
-	0x1b, 0xda, 0x9f, 0x41, 0xea, 0x86, 0x65, 0xd5, 0x05, 0xaa, 0x60, 0xdd, 0x7f, 0xa8, 0x3c, 0x9e,
-	0x24, 0x16, 0xa8, 0xbb, 0x56, 0xea, 0xca, 0x9c, 0x73, 0xae, 0xcf, 0x3d, 0x77, 0xc6, 0xdc, 0x80,
-	0x2d, 0x3f, 0x74, 0xe2, 0x80, 0x4c, 0x94, 0xf8, 0x40, 0x71, 0x08, 0x81, 0x11, 0x71, 0x48, 0x80,
-	0x91, 0x3c, 0x0a, 0x31, 0xc1, 0x3c, 0x60, 0xaa, 0x1c, 0x1f, 0x54, 0xd7, 0x7d, 0xec, 0x63, 0x4a,
-	0x2b, 0xc9, 0x5f, 0x69, 0x45, 0x75, 0xd3, 0xc7, 0xd8, 0x1f, 0x40, 0x85, 0xa2, 0xde, 0xf8, 0xb5,
-	0xe2, 0xa0, 0x49, 0x2a, 0x49, 0xef, 0x38, 0xb0, 0xac, 0xce, 0x2d, 0xf9, 0x2a, 0x58, 0xc2, 0xbd,
-	0x08, 0x86, 0x31, 0xf4, 0x04, 0xae, 0xc6, 0xd5, 0x97, 0xcc, 0x19, 0xe6, 0xd7, 0x41, 0x31, 0xc6,
-	0x04, 0x46, 0x42, 0xbe, 0xb6, 0x50, 0x2f, 0x9b, 0x29, 0xe0, 0x37, 0x40, 0xa9, 0x0f, 0x03, 0xbf,
-	0x4f, 0x84, 0x85, 0x1a, 0x57, 0x2f, 0x98, 0x0c, 0xf1, 0x7b, 0xa0, 0xe8, 0x0e, 0x9c, 0x60, 0x28,
-	0x14, 0x6a, 0x5c, 0x7d, 0xf9, 0x70, 0x5d, 0x4e, 0x43, 0xc8, 0xd3, 0x10, 0xb2, 0x8a, 0x26, 0x66,
-	0x5a, 0x22, 0x8d, 0x00, 0xd0, 0x4d, 0xed, 0xf0, 0x89, 0x85, 0x2f, 0x21, 0xcd, 0xe0, 0x62, 0x44,
-	0x42, 0xc7, 0x25, 0x34, 0x43, 0xd9, 0x9c, 0x61, 0xfe, 0x08, 0x94, 0x9c, 0x21, 0x1e, 0x23, 0x22,
-	0xe4, 0x13, 0xa5, 0x21, 0x5f, 0xdf, 0x6e, 0xe7, 0xbe, 0xde, 0x6e, 0xef, 0xf8, 0x01, 0xe9, 0x8f,
-	0x7b, 0xb2, 0x8b, 0x87, 0x8a, 0x8b, 0xa3, 0x21, 0x8e, 0xd8, 0x63, 0x3f, 0xf2, 0x2e, 0x15, 0x32,
-	0x19, 0xc1, 0x48, 0x36, 0x10, 0x31, 0xd9, 0xdb, 0xd2, 0x67, 0x0e, 0x54, 0xf4, 0x18, 0x22, 0xd2,
-	0xa6, 0xd3, 0xa5, 0xc3, 0xff, 0x0f, 0x2a, 0x99, 0xe3, 0xb5, 0x93, 0xb7, 0x58, 0x80, 0xb5, 0x0c,
-	0x6f, 0x4d, 0x46, 0x90, 0xdf, 0x05, 0x6b, 0xbd, 0x30, 0xf0, 0x7c, 0x68, 0xcf, 0xa2, 0xd2, 0x40,
-	0xe6, 0x6a, 0x4a, 0x6b, 0xd3, 0xc0, 0x3b, 0xf3, 0xc2, 0xbe, 0x13, 0x20, 0x3b, 0xf0, 0xe8, 0x39,
-	0x95, 0xcd, 0x15, 0x56, 0x98, 0xb0, 0x86, 0xc7, 0xff, 0x07, 0x56, 0xb3, 0xbd, 0x03, 0x8f, 0x9e,
-	0x5b, 0xd9, 0x5c, 0xc9, 0xb0, 0x06, 0xbd, 0x03, 0x84, 0x91, 0x0b, 0x85, 0x22, 0x55, 0x53, 0x20,
-	0xbd, 0x05, 0x35, 0x3a, 0x8c, 0x81, 0x62, 0x67, 0x10, 0x78, 0x5d, 0x88, 0x3c, 0x0b, 0x6b, 0x74,
-	0x7e, 0x13, 0xba, 0x30, 0x88, 0x61, 0x98, 0xdc, 0x13, 0x3b, 0xb9, 0x74, 0x24, 0x86, 0xe6, 0x8e,
-	0xf9, 0x8c, 0x63, 0xc2, 0x92, 0xe4, 0x32, 0x58, 0xd8, 0x14, 0x24, 0x1e, 0x11, 0x44, 0x1e, 0x0c,
-	0x59, 0x38, 0x86, 0xa4, 0x17, 0xe0, 0x6f, 0xda, 0x3f, 0xdb, 0xf8, 0x57, 0x34, 0x94, 0xae, 0xc0,
-	0xc6, 0x23, 0xe3, 0x16, 0x76, 0x9d, 0xc1, 0xdc, 0x85, 0xcb, 0xba, 0x54, 0xc1, 0x52, 0xc8, 0x06,
-	0x66, 0xf6, 0x33, 0xfc, 0xf3, 0x91, 0x58, 0xca, 0x42, 0x36, 0xa5, 0xf4, 0x91, 0x03, 0x3b, 0x8f,
-	0x5a, 0x77, 0x20, 0xf2, 0x02, 0xe4, 0x1b, 0x3d, 0x57, 0x1d, 0x13, 0x7c, 0x84, 0xc3, 0x37, 0x4e,
-	0xe8, 0xfd, 0xee, 0x28, 0xbc, 0x00, 0x16, 0xdd, 0xbe, 0x83, 0x10, 0x1c, 0xb0, 0x5b, 0x9f, 0x42,
-	0xe9, 0x3b, 0x07, 0x76, 0x1f, 0x85, 0xd4, 0xaf, 0xa0, 0x3b, 0x26, 0xd0, 0xfb, 0x53, 0x52, 0xf2,
-	0xff, 0x82, 0xbf, 0x48, 0x30, 0x84, 0x78, 0x4c, 0xec, 0xe4, 0x29, 0x94, 0xa8, 0xbc, 0xcc, 0x38,
-	0x2b, 0x18, 0xc2, 0xe4, 0xeb, 0x9f, 0x96, 0xb0, 0x65, 0xb2, 0x98, 0x7e, 0xfd, 0x8c, 0x3d, 0xa1,
-	0xe4, 0xde, 0x0d, 0x07, 0xca, 0x5a, 0xb2, 0x31, 0xe8, 0xff, 0x60, 0x15, 0x6c, 0x68, 0x2d, 0xd5,
-	0x38, 0xb5, 0xad, 0x8b, 0x8e, 0x6e, 0x9f, 0x9f, 0x75, 0x3b, 0xba, 0x66, 0x1c, 0x19, 0x7a, 0xb3,
-	0x92, 0xe3, 0xff, 0x01, 0x9b, 0x19, 0xad, 0xab, 0x9f, 0x35, 0x6d, 0xab, 0x6d, 0x6b, 0xed, 0xee,
-	0x69, 0xbb, 0x5b, 0xe1, 0xf8, 0x1a, 0xd8, 0xca, 0xc8, 0x0d, 0xd5, 0xd2, 0x4e, 0x66, 0x45, 0xba,
-	0x75, 0x52, 0xc9, 0x3f, 0x30, 0xa0, 0xdb, 0xc9, 0x6e, 0xea, 0x9d, 0x56, 0xfb, 0x42, 0x6f, 0x56,
-	0x16, 0x78, 0x09, 0x88, 0x19, 0xb9, 0xd5, 0x3e, 0x36, 0x34, 0x5b, 0x53, 0x5b, 0x2d, 0x5b, 0x7f,
-	0xa9, 0x6b, 0xe7, 0x96, 0xde, 0xac, 0x14, 0x1e, 0x58, 0x3c, 0x57, 0x5b, 0x5d, 0xdd, 0xb2, 0xcf,
-	0x3b, 0x4d, 0x35, 0x91, 0x8b, 0xd5, 0xc2, 0xfb, 0x4f, 0x62, 0xae, 0x71, 0x71, 0x7d, 0x27, 0x72,
-	0x37, 0x77, 0x22, 0xf7, 0xed, 0x4e, 0xe4, 0x3e, 0xdc, 0x8b, 0xb9, 0x9b, 0x7b, 0x31, 0xf7, 0xe5,
-	0x5e, 0xcc, 0xbd, 0x7a, 0x96, 0x59, 0x69, 0xc7, 0xe9, 0x8a, 0xdf, 0x6f, 0xd0, 0x9d, 0xf1, 0x10,
-	0x0e, 0xb1, 0x37, 0x1e, 0x40, 0xe5, 0x4a, 0x99, 0xfe, 0x4e, 0xd0, 0x7d, 0xd7, 0x2b, 0xd1, 0x55,
-	0xfb, 0xf4, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xcc, 0xf3, 0xc0, 0x3f, 0x06, 0x00, 0x00,
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x41, 0x4f, 0xf3, 0x46,
+	0x10, 0x8d, 0x43, 0x92, 0x8f, 0x2c, 0x05, 0x5c, 0x2b, 0x42, 0x26, 0xa2, 0x26, 0xb5, 0x54, 0x48,
+	0x91, 0xb0, 0x0b, 0xfd, 0x01, 0x95, 0xe3, 0x18, 0xb0, 0x14, 0x48, 0xe4, 0x98, 0xb6, 0xf4, 0x62,
+	0x39, 0xf6, 0xe2, 0x58, 0xc4, 0xbb, 0x91, 0xbd, 0x71, 0x93, 0x4b, 0x2f, 0xbd, 0xf4, 0xd8, 0xdf,
+	0xd0, 0xfe, 0x19, 0x8e, 0x1c, 0xab, 0x1e, 0x50, 0x05, 0xe7, 0x1e, 0x7b, 0xaf, 0xbc, 0xde, 0x24,
+	0x16, 0xa8, 0xb7, 0x56, 0xea, 0x29, 0x79, 0x6f, 0xc6, 0xf3, 0xde, 0xcc, 0x7a, 0xc7, 0xe0, 0x20,
+	0x88, 0xdd, 0x34, 0x24, 0x0b, 0x35, 0x3d, 0x53, 0x5d, 0x42, 0x60, 0x42, 0x5c, 0x12, 0x62, 0xa4,
+	0x4c, 0x63, 0x4c, 0xb0, 0x00, 0x58, 0x54, 0x49, 0xcf, 0x9a, 0x8d, 0x00, 0x07, 0x98, 0xd2, 0x6a,
+	0xf6, 0x2f, 0xcf, 0x68, 0xee, 0x07, 0x18, 0x07, 0x13, 0xa8, 0x52, 0x34, 0x9a, 0xdd, 0xab, 0x2e,
+	0x5a, 0xe4, 0x21, 0xf9, 0x47, 0x0e, 0x6c, 0x69, 0xeb, 0x92, 0x42, 0x13, 0x6c, 0xe2, 0x51, 0x02,
+	0xe3, 0x14, 0xfa, 0x22, 0xd7, 0xe2, 0xda, 0x9b, 0xd6, 0x0a, 0x0b, 0x0d, 0x50, 0x4d, 0x31, 0x81,
+	0x89, 0x58, 0x6e, 0x6d, 0xb4, 0xeb, 0x56, 0x0e, 0x84, 0x3d, 0x50, 0x1b, 0xc3, 0x30, 0x18, 0x13,
+	0x71, 0xa3, 0xc5, 0xb5, 0x2b, 0x16, 0x43, 0xc2, 0x09, 0xa8, 0x7a, 0x13, 0x37, 0x8c, 0xc4, 0x4a,
+	0x8b, 0x6b, 0x6f, 0x9d, 0x37, 0x94, 0xdc, 0x84, 0xb2, 0x34, 0xa1, 0x68, 0x68, 0x61, 0xe5, 0x29,
+	0xf2, 0x14, 0x00, 0xc3, 0xd2, 0xcf, 0xbf, 0xb0, 0xf1, 0x03, 0xa4, 0x1e, 0x3c, 0x8c, 0x48, 0xec,
+	0x7a, 0x84, 0x7a, 0xa8, 0x5b, 0x2b, 0x2c, 0x5c, 0x80, 0x9a, 0x1b, 0xe1, 0x19, 0x22, 0x62, 0x39,
+	0x8b, 0x74, 0x94, 0xc7, 0xe7, 0xc3, 0xd2, 0xef, 0xcf, 0x87, 0x47, 0x41, 0x48, 0xc6, 0xb3, 0x91,
+	0xe2, 0xe1, 0x48, 0xf5, 0x70, 0x12, 0xe1, 0x84, 0xfd, 0x9c, 0x26, 0xfe, 0x83, 0x4a, 0x16, 0x53,
+	0x98, 0x28, 0x26, 0x22, 0x16, 0x7b, 0x5a, 0xfe, 0x8b, 0x03, 0xbc, 0x91, 0x42, 0x44, 0xfa, 0xb4,
+	0xbb, 0xbc, 0xf9, 0xcf, 0x01, 0x5f, 0x18, 0xaf, 0x93, 0x3d, 0xc5, 0x0c, 0xec, 0x16, 0x78, 0x7b,
+	0x31, 0x85, 0xc2, 0x31, 0xd8, 0x1d, 0xc5, 0xa1, 0x1f, 0x40, 0x67, 0x65, 0x95, 0x1a, 0xb2, 0x76,
+	0x72, 0x5a, 0x5f, 0x1a, 0x3e, 0x5a, 0x27, 0x8e, 0xdd, 0x10, 0x39, 0xa1, 0x4f, 0xe7, 0x54, 0xb7,
+	0xb6, 0x59, 0x62, 0xc6, 0x9a, 0xbe, 0xf0, 0x19, 0xd8, 0x29, 0x6a, 0x87, 0x3e, 0x9d, 0x5b, 0xdd,
+	0xda, 0x2e, 0xb0, 0x26, 0x3d, 0x03, 0x84, 0x91, 0x07, 0xc5, 0x2a, 0x8d, 0xe6, 0x40, 0x68, 0x03,
+	0x1e, 0xa6, 0x11, 0x53, 0x98, 0xc6, 0xf0, 0x3e, 0x9c, 0x8b, 0xb5, 0xdc, 0x0e, 0x4c, 0x23, 0x2a,
+	0x31, 0xa0, 0xac, 0xfc, 0x03, 0x68, 0xd1, 0xb6, 0x4d, 0x94, 0xba, 0x93, 0xd0, 0x1f, 0x42, 0xe4,
+	0xdb, 0x58, 0xa7, 0x93, 0xb2, 0xa0, 0x07, 0xc3, 0x14, 0xc6, 0xd9, 0x89, 0xb2, 0x19, 0xe7, 0xcd,
+	0x33, 0xb4, 0xd6, 0x2e, 0x17, 0xb5, 0x1b, 0xa0, 0x4a, 0xb2, 0x63, 0x63, 0x6d, 0xe5, 0x20, 0xab,
+	0x91, 0x40, 0xe4, 0xc3, 0x98, 0xb5, 0xc1, 0x90, 0xfc, 0x0d, 0xf8, 0x98, 0xea, 0x17, 0x85, 0xff,
+	0x0d, 0x41, 0x79, 0x0e, 0xf6, 0xde, 0x15, 0xee, 0x61, 0xcf, 0x9d, 0xac, 0xab, 0x70, 0xc5, 0x2a,
+	0x4d, 0xb0, 0x19, 0xb3, 0x86, 0x59, 0xf9, 0x15, 0xfe, 0xe7, 0x96, 0x98, 0xcb, 0x4a, 0xd1, 0xa5,
+	0xfc, 0x0b, 0x07, 0x8e, 0xde, 0x49, 0x0f, 0x20, 0xf2, 0x43, 0x14, 0x98, 0x23, 0x4f, 0x9b, 0x11,
+	0x7c, 0x81, 0xe3, 0xef, 0xdd, 0xd8, 0xff, 0xaf, 0xad, 0x08, 0x22, 0xf8, 0xe0, 0x8d, 0x5d, 0x84,
+	0xe0, 0x84, 0xbd, 0x1f, 0x4b, 0x28, 0xff, 0xc9, 0x81, 0xe3, 0x77, 0x26, 0x8d, 0x39, 0xf4, 0x66,
+	0x04, 0xfa, 0xff, 0x17, 0x97, 0xc2, 0xa7, 0xe0, 0x23, 0x12, 0x46, 0x10, 0xcf, 0x88, 0x93, 0xfd,
+	0xb2, 0x77, 0x78, 0x8b, 0x71, 0x76, 0x18, 0xc1, 0xec, 0x9e, 0x2c, 0x53, 0xd8, 0xda, 0xf9, 0x90,
+	0xdf, 0x13, 0xc6, 0x5e, 0x51, 0xf2, 0xe4, 0x89, 0x03, 0x75, 0x3d, 0xdb, 0x2d, 0xf4, 0xb6, 0x36,
+	0xc1, 0x9e, 0xde, 0xd3, 0xcc, 0x6b, 0xc7, 0xbe, 0x1b, 0x18, 0xce, 0xed, 0xcd, 0x70, 0x60, 0xe8,
+	0xe6, 0x85, 0x69, 0x74, 0xf9, 0x92, 0xf0, 0x09, 0xd8, 0x2f, 0xc4, 0x86, 0xc6, 0x4d, 0xd7, 0xb1,
+	0xfb, 0x8e, 0xde, 0x1f, 0x5e, 0xf7, 0x87, 0x3c, 0x27, 0xb4, 0xc0, 0x41, 0x21, 0xdc, 0xd1, 0x6c,
+	0xfd, 0x6a, 0x95, 0x64, 0xd8, 0x57, 0x7c, 0xf9, 0x4d, 0x01, 0xba, 0xc7, 0x9c, 0xae, 0x31, 0xe8,
+	0xf5, 0xef, 0x8c, 0x2e, 0xbf, 0x21, 0xc8, 0x40, 0x2a, 0x84, 0x7b, 0xfd, 0x4b, 0x53, 0x77, 0x74,
+	0xad, 0xd7, 0x73, 0x8c, 0x6f, 0x0d, 0xfd, 0xd6, 0x36, 0xba, 0x7c, 0xe5, 0x4d, 0x89, 0xaf, 0xb5,
+	0xde, 0xd0, 0xb0, 0x9d, 0xdb, 0x41, 0x57, 0xcb, 0xc2, 0xd5, 0x66, 0xe5, 0xa7, 0x5f, 0xa5, 0x52,
+	0xe7, 0xee, 0xf1, 0x45, 0xe2, 0x9e, 0x5e, 0x24, 0xee, 0x8f, 0x17, 0x89, 0xfb, 0xf9, 0x55, 0x2a,
+	0x3d, 0xbd, 0x4a, 0xa5, 0xdf, 0x5e, 0xa5, 0xd2, 0x77, 0x5f, 0x15, 0x96, 0xdf, 0x65, 0xfe, 0x31,
+	0x38, 0xed, 0xd0, 0xed, 0xf2, 0x16, 0x46, 0xd8, 0x9f, 0x4d, 0xa0, 0x3a, 0x57, 0x97, 0x5f, 0x14,
+	0xba, 0x19, 0x47, 0x35, 0xba, 0x94, 0xbf, 0xfc, 0x7b, 0x00, 0xe5, 0x58, 0x91, 0xe4, 0x69, 0x06,
+	0x00, 0x00,
 }
 
 func (m *Attestation) Marshal() (dAtA []byte, err error) {
@@ -831,6 +841,13 @@ func (m *EventObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Nonce) > 0 {
 		i -= len(m.Nonce)
 		copy(dAtA[i:], m.Nonce)
@@ -1222,6 +1239,10 @@ func (m *EventObservation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttestation(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
 	return n
 }
 
@@ -1830,6 +1851,38 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 			}
 			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
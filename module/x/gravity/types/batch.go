@@ -87,6 +87,15 @@ func (i InternalOutgoingTransferTx) ValidateBasic() error {
 	return nil
 }
 
+// outgoingTxIds returns the ids of the given transactions in order
+func outgoingTxIds(txs []*InternalOutgoingTransferTx) []uint64 {
+	ids := make([]uint64, len(txs))
+	for i, tx := range txs {
+		ids[i] = tx.Id
+	}
+	return ids
+}
+
 // InternalOutgoingTxBatch is an internal duplicate array of OutgoingTxBatch with validation
 type InternalOutgoingTxBatches []InternalOutgoingTxBatch
 
@@ -146,6 +155,11 @@ func (o *OutgoingTxBatch) ToInternal() (*InternalOutgoingTxBatch, error) {
 	return NewInternalOutgingTxBatchFromExternalBatch(*o)
 }
 
+// TxIds returns the ids of the transactions in the batch in batch order
+func (i InternalOutgoingTxBatch) TxIds() []uint64 {
+	return outgoingTxIds(i.Transactions)
+}
+
 func (i *InternalOutgoingTxBatch) ToExternal() OutgoingTxBatch {
 	txs := make([]OutgoingTransferTx, len(i.Transactions))
 	for i, tx := range i.Transactions {
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -177,6 +178,85 @@ func (m *OutgoingTransferTx) GetErc20Fee() ERC20Token {
 	return ERC20Token{}
 }
 
+// BatchFeeRecord describes the fees paid to the relayer of an executed batch, latency_blocks is the number of cosmos
+// blocks between the creation of the batch and the observation of its execution
+type BatchFeeRecord struct {
+	TokenContract string                                 `protobuf:"bytes,1,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce    uint64                                 `protobuf:"varint,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	TotalFee      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_fee,json=totalFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fee"`
+	TxCount       uint64                                 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	CreatedHeight uint64                                 `protobuf:"varint,5,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	LatencyBlocks uint64                                 `protobuf:"varint,6,opt,name=latency_blocks,json=latencyBlocks,proto3" json:"latency_blocks,omitempty"`
+}
+
+func (m *BatchFeeRecord) Reset()         { *m = BatchFeeRecord{} }
+func (m *BatchFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*BatchFeeRecord) ProtoMessage()    {}
+func (*BatchFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{2}
+}
+func (m *BatchFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchFeeRecord.Merge(m, src)
+}
+func (m *BatchFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchFeeRecord proto.InternalMessageInfo
+
+func (m *BatchFeeRecord) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *BatchFeeRecord) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *BatchFeeRecord) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *BatchFeeRecord) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *BatchFeeRecord) GetLatencyBlocks() uint64 {
+	if m != nil {
+		return m.LatencyBlocks
+	}
+	return 0
+}
+
 // OutgoingLogicCall represents an individual logic call from gravity to ETH
 type OutgoingLogicCall struct {
 	Transfers            []ERC20Token `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
@@ -193,7 +273,7 @@ func (m *OutgoingLogicCall) Reset()         { *m = OutgoingLogicCall{} }
 func (m *OutgoingLogicCall) String() string { return proto.CompactTextString(m) }
 func (*OutgoingLogicCall) ProtoMessage()    {}
 func (*OutgoingLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{2}
+	return fileDescriptor_4453b445b0660cab, []int{3}
 }
 func (m *OutgoingLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +369,7 @@ func (m *EventOutgoingBatchCanceled) Reset()         { *m = EventOutgoingBatchCa
 func (m *EventOutgoingBatchCanceled) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingBatchCanceled) ProtoMessage()    {}
 func (*EventOutgoingBatchCanceled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{3}
+	return fileDescriptor_4453b445b0660cab, []int{4}
 }
 func (m *EventOutgoingBatchCanceled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +437,7 @@ func (m *EventOutgoingBatch) Reset()         { *m = EventOutgoingBatch{} }
 func (m *EventOutgoingBatch) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingBatch) ProtoMessage()    {}
 func (*EventOutgoingBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{4}
+	return fileDescriptor_4453b445b0660cab, []int{5}
 }
 func (m *EventOutgoingBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +497,7 @@ func (m *EventOutgoingBatch) GetNonce() string {
 func init() {
 	proto.RegisterType((*OutgoingTxBatch)(nil), "gravity.v1.OutgoingTxBatch")
 	proto.RegisterType((*OutgoingTransferTx)(nil), "gravity.v1.OutgoingTransferTx")
+	proto.RegisterType((*BatchFeeRecord)(nil), "gravity.v1.BatchFeeRecord")
 	proto.RegisterType((*OutgoingLogicCall)(nil), "gravity.v1.OutgoingLogicCall")
 	proto.RegisterType((*EventOutgoingBatchCanceled)(nil), "gravity.v1.EventOutgoingBatchCanceled")
 	proto.RegisterType((*EventOutgoingBatch)(nil), "gravity.v1.EventOutgoingBatch")
@@ -425,47 +506,54 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/batch.proto", fileDescriptor_4453b445b0660cab) }
 
 var fileDescriptor_4453b445b0660cab = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xd3, 0xf4, 0x23, 0x93, 0x34, 0x55, 0x57, 0x51, 0x64, 0x2a, 0xe4, 0x96, 0x20, 0xa0,
-	0x97, 0x26, 0x69, 0xe0, 0x02, 0x12, 0x42, 0x24, 0x2a, 0x50, 0x09, 0x81, 0x64, 0xe5, 0x02, 0x17,
-	0x6b, 0xb3, 0xbb, 0x75, 0x57, 0x75, 0xbc, 0x95, 0xbd, 0x89, 0xda, 0x7f, 0xc1, 0x89, 0x23, 0xbf,
-	0xa7, 0xc7, 0x1e, 0xe1, 0x82, 0x50, 0xfb, 0x1b, 0xb8, 0x70, 0x42, 0x3b, 0x6b, 0x27, 0x69, 0xab,
-	0x02, 0x37, 0x6e, 0x9e, 0x37, 0x6f, 0x76, 0xe6, 0xcd, 0xbe, 0x35, 0x34, 0xc2, 0x84, 0x4e, 0xa4,
-	0x3e, 0x6d, 0x4f, 0x76, 0xdb, 0x43, 0xaa, 0xd9, 0x61, 0xeb, 0x38, 0x51, 0x5a, 0x11, 0xc8, 0xf0,
-	0xd6, 0x64, 0x77, 0xa3, 0x1e, 0xaa, 0x50, 0x21, 0xdc, 0x36, 0x5f, 0x96, 0xb1, 0x71, 0x77, 0xae,
-	0x92, 0x6a, 0x2d, 0x52, 0x4d, 0xb5, 0x54, 0xb1, 0xcd, 0x36, 0x7f, 0x39, 0xb0, 0xf6, 0x7e, 0xac,
-	0x43, 0x25, 0xe3, 0x70, 0x70, 0xd2, 0x33, 0x27, 0x93, 0x4d, 0xa8, 0x60, 0x8b, 0x20, 0x56, 0x31,
-	0x13, 0xae, 0xb3, 0xe5, 0x6c, 0x97, 0x7c, 0x40, 0xe8, 0x9d, 0x41, 0xc8, 0x7d, 0x58, 0xb5, 0x04,
-	0x2d, 0x47, 0x42, 0x8d, 0xb5, 0x5b, 0x44, 0x4a, 0x15, 0xc1, 0x81, 0xc5, 0xc8, 0x1b, 0xa8, 0xea,
-	0x84, 0xc6, 0x29, 0x65, 0xa6, 0x5d, 0xea, 0x2e, 0x6c, 0x2d, 0x6c, 0x57, 0xba, 0x5e, 0x6b, 0x36,
-	0x70, 0x6b, 0xda, 0xd8, 0xf0, 0x0e, 0x44, 0x32, 0x38, 0xe9, 0x95, 0xce, 0xbe, 0x6f, 0x16, 0xfc,
-	0x2b, 0x95, 0xe4, 0x01, 0xd4, 0xb4, 0x3a, 0x12, 0x71, 0xc0, 0x54, 0xac, 0x13, 0xca, 0xb4, 0x5b,
-	0xda, 0x72, 0xb6, 0xcb, 0xfe, 0x2a, 0xa2, 0xfd, 0x0c, 0x24, 0x1d, 0xa8, 0x33, 0x95, 0x8e, 0x54,
-	0x1a, 0x0c, 0x23, 0xc5, 0x8e, 0x02, 0x96, 0x08, 0xaa, 0x05, 0x77, 0x17, 0x71, 0x38, 0x62, 0x73,
-	0x3d, 0x93, 0xea, 0xdb, 0x4c, 0xf3, 0x9b, 0x03, 0xe4, 0xe6, 0x0c, 0xa4, 0x06, 0x45, 0xc9, 0x33,
-	0xd9, 0x45, 0xc9, 0x49, 0x03, 0x96, 0x52, 0x11, 0x73, 0x91, 0xa0, 0xce, 0xb2, 0x9f, 0x45, 0xe4,
-	0x1e, 0x54, 0xb9, 0x48, 0x75, 0x40, 0x39, 0x4f, 0x44, 0x6a, 0x14, 0x9a, 0x6c, 0xc5, 0x60, 0x2f,
-	0x2d, 0x44, 0x9e, 0x43, 0x45, 0x24, 0xac, 0xdb, 0x09, 0x70, 0x54, 0x9c, 0xbb, 0xd2, 0x6d, 0xcc,
-	0xef, 0x60, 0xcf, 0xef, 0x77, 0x3b, 0x03, 0x93, 0xcd, 0xb4, 0x03, 0x16, 0x20, 0x42, 0x9e, 0x42,
-	0xd9, 0x96, 0x1f, 0x08, 0x81, 0x3a, 0xfe, 0x56, 0xbc, 0x82, 0xf4, 0x57, 0x42, 0x34, 0x7f, 0x16,
-	0x61, 0x3d, 0xd7, 0xf6, 0x56, 0x85, 0x92, 0xf5, 0x69, 0x14, 0x91, 0x67, 0x50, 0xd6, 0x99, 0xd0,
-	0xd4, 0x75, 0xf0, 0x46, 0xfe, 0x7c, 0xe0, 0x8c, 0x4e, 0x3a, 0x50, 0x3a, 0x10, 0x22, 0x75, 0x8b,
-	0xff, 0x50, 0x86, 0x4c, 0xf2, 0x04, 0x1a, 0x91, 0x69, 0x3d, 0xbd, 0xb8, 0x6b, 0xab, 0xaa, 0x63,
-	0x36, 0xbf, 0xc0, 0x7c, 0x67, 0x2e, 0x2c, 0x1f, 0xd3, 0xd3, 0x48, 0x51, 0x8e, 0xfb, 0xaa, 0xfa,
-	0x79, 0x68, 0x32, 0xb9, 0xe3, 0xec, 0xa5, 0xe6, 0x21, 0x79, 0x04, 0x6b, 0x32, 0x9e, 0xd0, 0x48,
-	0x72, 0x34, 0x77, 0x20, 0xb9, 0xbb, 0x84, 0xb5, 0xb5, 0x79, 0x78, 0x9f, 0x93, 0x1d, 0x20, 0x57,
-	0x88, 0xd6, 0xe2, 0xcb, 0x78, 0xda, 0xfa, 0x7c, 0xc6, 0x3a, 0xfd, 0x36, 0x4f, 0xad, 0xdc, 0xea,
-	0xa9, 0x2f, 0x0e, 0x6c, 0xec, 0x4d, 0x44, 0xac, 0xf3, 0xe5, 0xe3, 0x9b, 0xea, 0xd3, 0x98, 0x89,
-	0x48, 0x70, 0x33, 0xe8, 0x30, 0x91, 0x3c, 0x14, 0x33, 0x33, 0x3b, 0xb8, 0x8b, 0x9a, 0x85, 0xa7,
-	0x6e, 0x7e, 0x38, 0x23, 0x1e, 0x52, 0x89, 0x8a, 0xac, 0xfb, 0x56, 0x33, 0xa2, 0x41, 0xf7, 0x39,
-	0xb9, 0x03, 0x2b, 0xf6, 0x2d, 0x4a, 0x9e, 0x6d, 0x75, 0x19, 0xe3, 0x7d, 0x4e, 0xea, 0xb0, 0x68,
-	0xe5, 0xd9, 0xe7, 0x62, 0x83, 0xe6, 0x67, 0x07, 0xc8, 0xcd, 0x01, 0xff, 0xff, 0x60, 0xbd, 0x0f,
-	0x67, 0x17, 0x9e, 0x73, 0x7e, 0xe1, 0x39, 0x3f, 0x2e, 0x3c, 0xe7, 0xd3, 0xa5, 0x57, 0x38, 0xbf,
-	0xf4, 0x0a, 0x5f, 0x2f, 0xbd, 0xc2, 0xc7, 0x17, 0xa1, 0xd4, 0x87, 0xe3, 0x61, 0x8b, 0xa9, 0x51,
-	0xfb, 0xb5, 0x75, 0xdd, 0x4e, 0x0f, 0x9b, 0x5d, 0x0f, 0x47, 0x8a, 0x8f, 0x23, 0xd1, 0x3e, 0x69,
-	0xe7, 0x3f, 0x3d, 0x7d, 0x7a, 0x2c, 0xd2, 0xe1, 0x12, 0xfe, 0xec, 0x1e, 0xff, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0x6a, 0xec, 0xc8, 0x7d, 0x46, 0x05, 0x00, 0x00,
+	// 745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4d, 0x6f, 0x12, 0x5d,
+	0x14, 0x66, 0x28, 0x14, 0xb8, 0x7c, 0x34, 0xbd, 0x21, 0x64, 0xde, 0xe6, 0x0d, 0x45, 0x8c, 0xb5,
+	0x9b, 0x02, 0x45, 0x37, 0x9a, 0x18, 0x23, 0xa4, 0xb5, 0x44, 0xa3, 0xc9, 0x84, 0x8d, 0x6e, 0x26,
+	0x97, 0xb9, 0xa7, 0xc3, 0xa4, 0xc3, 0xdc, 0x66, 0xe6, 0x42, 0xe0, 0x4f, 0x18, 0x57, 0x2e, 0xfd,
+	0x3d, 0x5d, 0x76, 0xa9, 0x2e, 0x1a, 0xd3, 0xfe, 0x06, 0x37, 0xae, 0xcc, 0x3d, 0x77, 0x68, 0x29,
+	0x6d, 0x63, 0x77, 0xae, 0xda, 0xf3, 0x9c, 0xe7, 0xdc, 0xf3, 0xf1, 0x9c, 0x33, 0x90, 0x8a, 0x1b,
+	0xb2, 0x89, 0x27, 0x67, 0xcd, 0xc9, 0x6e, 0x73, 0xc0, 0xa4, 0x33, 0x6c, 0x1c, 0x87, 0x42, 0x0a,
+	0x4a, 0x62, 0xbc, 0x31, 0xd9, 0xdd, 0x28, 0xbb, 0xc2, 0x15, 0x08, 0x37, 0xd5, 0x7f, 0x9a, 0xb1,
+	0xf1, 0xff, 0x42, 0x24, 0x93, 0x12, 0x22, 0xc9, 0xa4, 0x27, 0x02, 0xed, 0xad, 0xff, 0x36, 0xc8,
+	0xda, 0xfb, 0xb1, 0x74, 0x85, 0x17, 0xb8, 0xfd, 0x69, 0x47, 0xbd, 0x4c, 0x37, 0x49, 0x1e, 0x53,
+	0xd8, 0x81, 0x08, 0x1c, 0x30, 0x8d, 0x9a, 0xb1, 0x9d, 0xb2, 0x08, 0x42, 0xef, 0x14, 0x42, 0x1f,
+	0x92, 0xa2, 0x26, 0x48, 0x6f, 0x04, 0x62, 0x2c, 0xcd, 0x24, 0x52, 0x0a, 0x08, 0xf6, 0x35, 0x46,
+	0x0f, 0x48, 0x41, 0x86, 0x2c, 0x88, 0x98, 0xa3, 0xd2, 0x45, 0xe6, 0x4a, 0x6d, 0x65, 0x3b, 0xdf,
+	0xae, 0x36, 0xae, 0x0a, 0x6e, 0x5c, 0x26, 0x56, 0xbc, 0x43, 0x08, 0xfb, 0xd3, 0x4e, 0xea, 0xe4,
+	0x6c, 0x33, 0x61, 0x5d, 0x8b, 0xa4, 0x8f, 0x48, 0x49, 0x8a, 0x23, 0x08, 0x6c, 0x47, 0x04, 0x32,
+	0x64, 0x8e, 0x34, 0x53, 0x35, 0x63, 0x3b, 0x67, 0x15, 0x11, 0xed, 0xc6, 0x20, 0x6d, 0x91, 0xb2,
+	0x23, 0xa2, 0x91, 0x88, 0xec, 0x81, 0x2f, 0x9c, 0x23, 0xdb, 0x09, 0x81, 0x49, 0xe0, 0x66, 0x1a,
+	0x8b, 0xa3, 0xda, 0xd7, 0x51, 0xae, 0xae, 0xf6, 0xd4, 0xbf, 0x1b, 0x84, 0xde, 0xac, 0x81, 0x96,
+	0x48, 0xd2, 0xe3, 0x71, 0xdb, 0x49, 0x8f, 0xd3, 0x0a, 0x59, 0x8d, 0x20, 0xe0, 0x10, 0x62, 0x9f,
+	0x39, 0x2b, 0xb6, 0xe8, 0x03, 0x52, 0xe0, 0x10, 0x49, 0x9b, 0x71, 0x1e, 0x42, 0xa4, 0x3a, 0x54,
+	0xde, 0xbc, 0xc2, 0x5e, 0x69, 0x88, 0xbe, 0x20, 0x79, 0x08, 0x9d, 0x76, 0xcb, 0xc6, 0x52, 0xb1,
+	0xee, 0x7c, 0xbb, 0xb2, 0x38, 0x83, 0x3d, 0xab, 0xdb, 0x6e, 0xf5, 0x95, 0x37, 0xee, 0x9d, 0x60,
+	0x00, 0x22, 0xf4, 0x19, 0xc9, 0xe9, 0xf0, 0x43, 0x00, 0x33, 0x7d, 0x8f, 0xe0, 0x2c, 0xd2, 0xf7,
+	0x01, 0xea, 0x9f, 0x92, 0xa4, 0x84, 0x72, 0xee, 0x03, 0x58, 0xe0, 0x88, 0x90, 0xdf, 0x32, 0x47,
+	0xe3, 0xb6, 0x39, 0x2e, 0xc9, 0x9f, 0xbc, 0x21, 0xff, 0x1b, 0x92, 0x93, 0x42, 0x32, 0x1f, 0xab,
+	0xc2, 0xa6, 0x3b, 0x0d, 0x95, 0xfd, 0xc7, 0xd9, 0xe6, 0x96, 0xeb, 0xc9, 0xe1, 0x78, 0xd0, 0x70,
+	0xc4, 0xa8, 0xa9, 0x07, 0x1e, 0xff, 0xd9, 0x89, 0xf8, 0x51, 0x53, 0xce, 0x8e, 0x21, 0x6a, 0xf4,
+	0x02, 0x69, 0x65, 0xf1, 0x81, 0x7d, 0x00, 0xfa, 0x1f, 0xc9, 0xca, 0xa9, 0xed, 0x88, 0x71, 0xa0,
+	0x65, 0x4d, 0x59, 0x19, 0x39, 0xed, 0x2a, 0x53, 0xd5, 0x1b, 0x6b, 0x68, 0x0f, 0xc1, 0x73, 0x87,
+	0x32, 0x96, 0xb2, 0x18, 0xa3, 0x07, 0x08, 0x2a, 0x9a, 0xcf, 0x24, 0x04, 0xce, 0x4c, 0x0b, 0x1f,
+	0x99, 0xab, 0x9a, 0x16, 0xa3, 0x28, 0x79, 0x54, 0xff, 0x95, 0x24, 0xeb, 0x73, 0xb1, 0xdf, 0x0a,
+	0xd7, 0x73, 0xba, 0xcc, 0xf7, 0xe9, 0x73, 0x92, 0x93, 0xb1, 0xf2, 0x91, 0x69, 0xd4, 0x56, 0xfe,
+	0x3a, 0xe1, 0x2b, 0x3a, 0x6d, 0x91, 0xd4, 0x21, 0x40, 0x64, 0x26, 0xef, 0x11, 0x86, 0x4c, 0xfa,
+	0x94, 0x54, 0x7c, 0x95, 0xfa, 0x52, 0x81, 0xa5, 0xdd, 0x29, 0xa3, 0x77, 0xae, 0xc4, 0x7c, 0x89,
+	0x4c, 0x92, 0x39, 0x66, 0x33, 0x5f, 0x30, 0x8e, 0x13, 0x2a, 0x58, 0x73, 0x53, 0x79, 0xe6, 0x27,
+	0x98, 0x8e, 0x67, 0xa7, 0x4d, 0xfa, 0x98, 0xac, 0x79, 0xc1, 0x84, 0xf9, 0x1e, 0xc7, 0x6b, 0xb7,
+	0x3d, 0x8e, 0x53, 0x29, 0x58, 0xa5, 0x45, 0xb8, 0xc7, 0xe9, 0x0e, 0xa1, 0xd7, 0x88, 0x5a, 0xf4,
+	0x0c, 0xbe, 0xb6, 0xbe, 0xe8, 0xd1, 0xda, 0xdf, 0x75, 0x64, 0xd9, 0x3b, 0x8f, 0xec, 0xab, 0x41,
+	0x36, 0xf6, 0x26, 0x10, 0xc8, 0xf9, 0xf0, 0x71, 0x2b, 0xbb, 0x2c, 0x70, 0xc0, 0x07, 0xae, 0x0a,
+	0x1d, 0x84, 0x1e, 0x77, 0x61, 0x79, 0x2b, 0x4b, 0x1a, 0xbe, 0x5c, 0xcb, 0xad, 0x2b, 0xe2, 0x90,
+	0x79, 0xd8, 0x91, 0x3e, 0xc7, 0x62, 0x4c, 0x54, 0x68, 0x8f, 0xab, 0x85, 0xd2, 0xeb, 0xeb, 0xf1,
+	0x78, 0xaa, 0x19, 0xb4, 0x7b, 0x9c, 0x96, 0x49, 0x5a, 0xb7, 0xa7, 0xbf, 0x1f, 0xda, 0xa8, 0x7f,
+	0x31, 0x08, 0xbd, 0x59, 0xe0, 0xbf, 0x2f, 0xac, 0xf3, 0xe1, 0xe4, 0xbc, 0x6a, 0x9c, 0x9e, 0x57,
+	0x8d, 0x9f, 0xe7, 0x55, 0xe3, 0xf3, 0x45, 0x35, 0x71, 0x7a, 0x51, 0x4d, 0x7c, 0xbb, 0xa8, 0x26,
+	0x3e, 0xbe, 0x5c, 0x38, 0xb3, 0xd7, 0x7a, 0xeb, 0x76, 0x3a, 0x98, 0x6c, 0xd9, 0x1c, 0x09, 0x3e,
+	0xf6, 0xa1, 0x39, 0x6d, 0xce, 0x7f, 0x05, 0xf0, 0x06, 0x07, 0xab, 0xf8, 0xf5, 0x7f, 0xf2, 0x67,
+	0x00, 0x1d, 0x1c, 0x4a, 0x63, 0x57, 0x06, 0x00, 0x00,
 }
 
 func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
@@ -589,6 +677,66 @@ func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BatchFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatencyBlocks != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.LatencyBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalFee.Size()
+		i -= size
+		if _, err := m.TotalFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BatchNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OutgoingLogicCall) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -841,6 +989,33 @@ func (m *OutgoingTransferTx) Size() (n int) {
 	return n
 }
 
+func (m *BatchFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovBatch(uint64(m.BatchNonce))
+	}
+	l = m.TotalFee.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	if m.TxCount != 0 {
+		n += 1 + sovBatch(uint64(m.TxCount))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovBatch(uint64(m.CreatedHeight))
+	}
+	if m.LatencyBlocks != 0 {
+		n += 1 + sovBatch(uint64(m.LatencyBlocks))
+	}
+	return n
+}
+
 func (m *OutgoingLogicCall) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1311,6 +1486,198 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BatchFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyBlocks", wireType)
+			}
+			m.LatencyBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
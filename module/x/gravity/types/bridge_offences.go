@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic performs stateless checks on the BridgeOffences of a validator
+func (b BridgeOffences) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(b.Validator); err != nil {
+		return sdkerrors.Wrap(err, "invalid bridge offences validator")
+	}
+	if b.Count == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "bridge offences count must be non-zero")
+	}
+	return nil
+}
+
+// Decayed returns the offences left at height once one offence has been forgiven for every decayWindow blocks
+// since the last offence, a decayWindow of 0 forgives nothing. The last offence height moves forward by the
+// forgiven windows so that partially elapsed windows still count towards the next decay
+func (b BridgeOffences) Decayed(height uint64, decayWindow uint64) BridgeOffences {
+	if decayWindow == 0 || height <= b.LastOffenceHeight {
+		return b
+	}
+	forgiven := (height - b.LastOffenceHeight) / decayWindow
+	if forgiven >= b.Count {
+		b.Count = 0
+		return b
+	}
+	b.Count -= forgiven
+	b.LastOffenceHeight += forgiven * decayWindow
+	return b
+}
+
+// OffenceSlashFraction returns the fraction slashed for a validator's offence number offence, given the slash
+// fraction of the missed item. The first jailOnlyCount offences only jail, the next one slashes baseFraction and
+// every further offence multiplies the fraction by escalation, never slashing more than everything
+func OffenceSlashFraction(baseFraction sdk.Dec, escalation sdk.Dec, jailOnlyCount uint64, offence uint64) sdk.Dec {
+	if offence <= jailOnlyCount {
+		return sdk.ZeroDec()
+	}
+	fraction := baseFraction
+	for i := jailOnlyCount + 1; i < offence && fraction.LT(sdk.OneDec()); i++ {
+		fraction = fraction.Mul(escalation)
+	}
+	if fraction.GT(sdk.OneDec()) {
+		return sdk.OneDec()
+	}
+	return fraction
+}
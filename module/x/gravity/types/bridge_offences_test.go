@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint: exhaustruct
+func TestBridgeOffencesDecayed(t *testing.T) {
+	offences := BridgeOffences{Count: 3, LastOffenceHeight: 100}
+
+	require.Equal(t, offences, offences.Decayed(1000, 0))
+	require.Equal(t, offences, offences.Decayed(100, 10))
+	require.Equal(t, offences, offences.Decayed(109, 10))
+	require.Equal(t, BridgeOffences{Count: 2, LastOffenceHeight: 110}, offences.Decayed(115, 10))
+	require.Equal(t, BridgeOffences{Count: 1, LastOffenceHeight: 120}, offences.Decayed(129, 10))
+	require.Equal(t, uint64(0), offences.Decayed(130, 10).Count)
+	require.Equal(t, uint64(0), offences.Decayed(10000, 10).Count)
+}
+
+func TestOffenceSlashFraction(t *testing.T) {
+	base := sdk.NewDecWithPrec(1, 2)
+	escalation := sdk.NewDec(3)
+
+	require.True(t, OffenceSlashFraction(base, escalation, 1, 0).IsZero())
+	require.True(t, OffenceSlashFraction(base, escalation, 1, 1).IsZero())
+	require.Equal(t, base, OffenceSlashFraction(base, escalation, 1, 2))
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), OffenceSlashFraction(base, escalation, 1, 3))
+	require.Equal(t, sdk.NewDecWithPrec(9, 2), OffenceSlashFraction(base, escalation, 1, 4))
+	require.Equal(t, sdk.OneDec(), OffenceSlashFraction(base, escalation, 1, 1000))
+	require.Equal(t, base, OffenceSlashFraction(base, sdk.OneDec(), 1, 1000))
+
+	// without jail only offences the first offence already slashes
+	require.Equal(t, base, OffenceSlashFraction(base, escalation, 0, 1))
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), OffenceSlashFraction(base, escalation, 0, 2))
+	require.True(t, OffenceSlashFraction(base, escalation, 3, 3).IsZero())
+	require.Equal(t, base, OffenceSlashFraction(base, escalation, 3, 4))
+
+	// the default params slash the full base fraction for every offence
+	params := DefaultParams()
+	for offence := uint64(1); offence <= 10; offence++ {
+		require.Equal(t, base, OffenceSlashFraction(base, params.OffenceSlashEscalation, params.OffenceJailOnlyCount, offence))
+	}
+}
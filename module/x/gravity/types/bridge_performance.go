@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic performs stateless checks on the ValidatorBridgePerformance of a validator
+func (p ValidatorBridgePerformance) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(p.Validator); err != nil {
+		return sdkerrors.Wrap(err, "invalid bridge performance validator")
+	}
+	if p.Previous.StartHeight > p.Current.StartHeight {
+		return sdkerrors.Wrap(ErrInvalid, "bridge performance previous window must not start after the current window")
+	}
+	for _, window := range []BridgeDutyWindow{p.Current, p.Previous} {
+		for _, counts := range []BridgeDutyCounts{window.Valsets, window.Batches, window.LogicCalls, window.Claims} {
+			if counts.Performed > counts.Expected {
+				return sdkerrors.Wrap(ErrInvalid, "bridge performance performed duties must not exceed expected duties")
+			}
+		}
+	}
+	return nil
+}
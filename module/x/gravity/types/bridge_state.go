@@ -0,0 +1,54 @@
+package types
+
+import "encoding/json"
+
+// BridgeStateDump is the bridge state at a height as it is found in the store, dumped for forensic analysis by
+// export-bridge-state. Unlike the genesis state it is never imported, so it carries what helps reconstruct incidents,
+// like decoded claims and the last event nonce of every validator
+type BridgeStateDump struct {
+	Height       int64                       `json:"height"`
+	LastTxPoolId uint64                      `json:"last_tx_pool_id"`
+	LastBatchId  uint64                      `json:"last_batch_id"`
+	DelegateKeys []MsgSetOrchestratorAddress `json:"delegate_keys"`
+	EvmChains    []EvmChainStateDump         `json:"evm_chains"`
+}
+
+// EvmChainStateDump is the bridge state of a single EVM chain in a BridgeStateDump
+type EvmChainStateDump struct {
+	EvmChainPrefix                  string                          `json:"evm_chain_prefix"`
+	LastObservedNonce               uint64                          `json:"last_observed_nonce"`
+	LastObservedEthereumBlockHeight LastObservedEthereumBlockHeight `json:"last_observed_ethereum_block_height"`
+	LatestValsetNonce               uint64                          `json:"latest_valset_nonce"`
+	LastSlashedValsetNonce          uint64                          `json:"last_slashed_valset_nonce"`
+	LastSlashedBatchBlock           uint64                          `json:"last_slashed_batch_block"`
+	LastSlashedLogicCallBlock       uint64                          `json:"last_slashed_logic_call_block"`
+	ValidatorEventNonces            []ValidatorEventNonce           `json:"validator_event_nonces"`
+	UnbatchedTransfers              []OutgoingTransferTx            `json:"unbatched_transfers"`
+	Batches                         []OutgoingTxBatch               `json:"batches"`
+	BatchConfirms                   []MsgConfirmBatch               `json:"batch_confirms"`
+	LogicCalls                      []OutgoingLogicCall             `json:"logic_calls"`
+	LogicCallConfirms               []MsgConfirmLogicCall           `json:"logic_call_confirms"`
+	Attestations                    []AttestationDump               `json:"attestations"`
+	Valsets                         []Valset                        `json:"valsets"`
+	ValsetConfirms                  []MsgValsetConfirm              `json:"valset_confirms"`
+	PendingIbcAutoForwards          []PendingIbcAutoForward         `json:"pending_ibc_auto_forwards"`
+	Erc20ToDenoms                   []ERC20ToDenom                  `json:"erc20_to_denoms"`
+}
+
+// ValidatorEventNonce is the last event nonce a validator has submitted a claim for
+type ValidatorEventNonce struct {
+	Validator  string `json:"validator"`
+	EventNonce uint64 `json:"event_nonce"`
+}
+
+// AttestationDump is an attestation with its claim decoded, the claim is kept as the proto JSON of its concrete type
+type AttestationDump struct {
+	EventNonce     uint64          `json:"event_nonce"`
+	EthBlockHeight uint64          `json:"eth_block_height"`
+	ClaimType      string          `json:"claim_type"`
+	ClaimHash      string          `json:"claim_hash"`
+	Observed       bool            `json:"observed"`
+	Height         uint64          `json:"height"`
+	Votes          []string        `json:"votes"`
+	Claim          json.RawMessage `json:"claim"`
+}
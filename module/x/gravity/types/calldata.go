@@ -0,0 +1,193 @@
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// valsetArgs is the ValsetArgs struct of Gravity.sol, the field names must match the ABI components
+type valsetArgs struct {
+	Validators   []gethcommon.Address
+	Powers       []*big.Int
+	ValsetNonce  *big.Int
+	RewardAmount *big.Int
+	RewardToken  gethcommon.Address
+}
+
+// signatureArgs is the Signature struct of Gravity.sol, the field names must match the ABI components
+type signatureArgs struct {
+	V uint8
+	R [32]byte
+	S [32]byte
+}
+
+// logicCallArgs is the LogicCallArgs struct of Gravity.sol, the field names must match the ABI components
+type logicCallArgs struct {
+	TransferAmounts        []*big.Int
+	TransferTokenContracts []gethcommon.Address
+	FeeAmounts             []*big.Int
+	FeeTokenContracts      []gethcommon.Address
+	LogicContractAddress   gethcommon.Address
+	Payload                []byte
+	TimeOut                *big.Int
+	InvalidationId         [32]byte
+	InvalidationNonce      *big.Int
+}
+
+// toValsetArgs converts v to the ValsetArgs Gravity.sol takes
+func (v Valset) toValsetArgs() (valsetArgs, error) {
+	if err := ValidateEthAddress(v.RewardToken); err != nil {
+		return valsetArgs{}, sdkerrors.Wrap(err, "invalid reward token")
+	}
+	if v.RewardAmount.BigInt() == nil {
+		return valsetArgs{}, sdkerrors.Wrap(ErrInvalid, "nil reward amount")
+	}
+	args := valsetArgs{
+		Validators:   make([]gethcommon.Address, len(v.Members)),
+		Powers:       make([]*big.Int, len(v.Members)),
+		ValsetNonce:  new(big.Int).SetUint64(v.Nonce),
+		RewardAmount: v.RewardAmount.BigInt(),
+		RewardToken:  gethcommon.HexToAddress(v.RewardToken),
+	}
+	for i, m := range v.Members {
+		args.Validators[i] = gethcommon.HexToAddress(m.EthereumAddress)
+		args.Powers[i] = new(big.Int).SetUint64(m.Power)
+	}
+	return args, nil
+}
+
+// toSignatureArgs splits the hex encoded signatures into the v, r and s values Gravity.sol takes. The signatures
+// must be in the order of the members of currentValset, a missing signature is given as an empty string and
+// passed to the contract with a v of 0 so that it is skipped
+func toSignatureArgs(currentValset Valset, signatures []string) ([]signatureArgs, error) {
+	if len(signatures) != len(currentValset.Members) {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "%d signatures for %d valset members", len(signatures), len(currentValset.Members))
+	}
+	args := make([]signatureArgs, len(signatures))
+	for i, signature := range signatures {
+		if signature == "" {
+			continue
+		}
+		sig, err := hex.DecodeString(signature)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "signature of %s is not hex", currentValset.Members[i].EthereumAddress)
+		}
+		if len(sig) != crypto.SignatureLength {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "signature of %s has length %d", currentValset.Members[i].EthereumAddress, len(sig))
+		}
+		copy(args[i].R[:], sig[:32])
+		copy(args[i].S[:], sig[32:64])
+		// ecrecover in the contract expects the legacy 27/28 recovery ids
+		args[i].V = sig[64]
+		if args[i].V < 27 {
+			args[i].V += 27
+		}
+	}
+	return args, nil
+}
+
+// packGravityCall ABI encodes a call of the Gravity.sol function method
+func packGravityCall(method string, args ...interface{}) ([]byte, error) {
+	contractAbi, err := abi.JSON(strings.NewReader(GravityContractABIJSON))
+	if err != nil {
+		panic("Bad ABI constant!")
+	}
+	calldata, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "unable to pack %s: %s", method, err)
+	}
+	return calldata, nil
+}
+
+// ValsetUpdateCalldata returns the calldata of the Gravity.sol updateValset call moving the contract from
+// currentValset to newValset, signatures are those of the currentValset members over the newValset checkpoint
+func ValsetUpdateCalldata(newValset Valset, currentValset Valset, signatures []string) ([]byte, error) {
+	newArgs, err := newValset.toValsetArgs()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid new valset")
+	}
+	currentArgs, err := currentValset.toValsetArgs()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid current valset")
+	}
+	sigs, err := toSignatureArgs(currentValset, signatures)
+	if err != nil {
+		return nil, err
+	}
+	return packGravityCall("updateValset", newArgs, currentArgs, sigs)
+}
+
+// BatchCalldata returns the calldata of the Gravity.sol submitBatch call executing batch, signatures are those of
+// the currentValset members over the batch checkpoint
+func BatchCalldata(batch InternalOutgoingTxBatch, currentValset Valset, signatures []string) ([]byte, error) {
+	currentArgs, err := currentValset.toValsetArgs()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid current valset")
+	}
+	sigs, err := toSignatureArgs(currentValset, signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := make([]*big.Int, len(batch.Transactions))
+	destinations := make([]gethcommon.Address, len(batch.Transactions))
+	fees := make([]*big.Int, len(batch.Transactions))
+	for i, tx := range batch.Transactions {
+		amounts[i] = tx.Erc20Token.Amount.BigInt()
+		destinations[i] = tx.DestAddress.GetAddress()
+		fees[i] = tx.Erc20Fee.Amount.BigInt()
+	}
+
+	return packGravityCall("submitBatch",
+		currentArgs,
+		sigs,
+		amounts,
+		destinations,
+		fees,
+		new(big.Int).SetUint64(batch.BatchNonce),
+		batch.TokenContract.GetAddress(),
+		new(big.Int).SetUint64(batch.BatchTimeout),
+	)
+}
+
+// LogicCallCalldata returns the calldata of the Gravity.sol submitLogicCall call executing call, signatures are
+// those of the currentValset members over the logic call checkpoint
+func LogicCallCalldata(call OutgoingLogicCall, currentValset Valset, signatures []string) ([]byte, error) {
+	currentArgs, err := currentValset.toValsetArgs()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid current valset")
+	}
+	sigs, err := toSignatureArgs(currentValset, signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	args := logicCallArgs{
+		TransferAmounts:        make([]*big.Int, len(call.Transfers)),
+		TransferTokenContracts: make([]gethcommon.Address, len(call.Transfers)),
+		FeeAmounts:             make([]*big.Int, len(call.Fees)),
+		FeeTokenContracts:      make([]gethcommon.Address, len(call.Fees)),
+		LogicContractAddress:   gethcommon.HexToAddress(call.LogicContractAddress),
+		Payload:                call.Payload,
+		TimeOut:                new(big.Int).SetUint64(call.Timeout),
+		InvalidationId:         [32]byte{},
+		InvalidationNonce:      new(big.Int).SetUint64(call.InvalidationNonce),
+	}
+	for i, tx := range call.Transfers {
+		args.TransferAmounts[i] = tx.Amount.BigInt()
+		args.TransferTokenContracts[i] = gethcommon.HexToAddress(tx.Contract)
+	}
+	for i, tx := range call.Fees {
+		args.FeeAmounts[i] = tx.Amount.BigInt()
+		args.FeeTokenContracts[i] = gethcommon.HexToAddress(tx.Contract)
+	}
+	copy(args.InvalidationId[:], call.InvalidationId)
+
+	return packGravityCall("submitLogicCall", currentArgs, sigs, args)
+}
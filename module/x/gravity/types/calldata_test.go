@@ -0,0 +1,127 @@
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// nolint: exhaustruct
+func calldataTestValset(t *testing.T, nonce uint64) Valset {
+	members := make(InternalBridgeValidators, 2)
+	for i, addr := range []string{"0xc783df8a850f42e7F7e57013759C285caa701eB6", "0xeAD9C93b79Ae7C1591b1FB5323BD777E86e150d4"} {
+		ethAddr, err := NewEthAddress(addr)
+		require.NoError(t, err)
+		members[i] = &InternalBridgeValidator{Power: 2147483648, EthereumAddress: *ethAddr}
+	}
+	valset, err := NewValset(nonce, nonce, members, sdk.ZeroInt(), ZeroAddress())
+	require.NoError(t, err)
+	return *valset
+}
+
+// unpackCalldata checks the selector of calldata against the solidity signature of the function and decodes it
+func unpackCalldata(t *testing.T, method string, signature string, calldata []byte) []interface{} {
+	require.Equal(t, crypto.Keccak256([]byte(signature))[:4], calldata[:4])
+	contractAbi, err := abi.JSON(strings.NewReader(GravityContractABIJSON))
+	require.NoError(t, err)
+	args, err := contractAbi.Methods[method].Inputs.Unpack(calldata[4:])
+	require.NoError(t, err)
+	return args
+}
+
+const (
+	valsetArgsSignature    = "(address[],uint256[],uint256,uint256,address)"
+	signatureArgsSignature = "(uint8,bytes32,bytes32)[]"
+)
+
+func TestValsetUpdateCalldata(t *testing.T) {
+	current := calldataTestValset(t, 1)
+	newValset := calldataTestValset(t, 2)
+
+	// signatures with a 0/1 recovery id are passed with the legacy 27/28 recovery id
+	sig := make([]byte, 65)
+	sig[0], sig[32], sig[64] = 1, 2, 1
+	calldata, err := ValsetUpdateCalldata(newValset, current, []string{hex.EncodeToString(sig), ""})
+	require.NoError(t, err)
+
+	args := unpackCalldata(t, "updateValset", "updateValset("+valsetArgsSignature+","+valsetArgsSignature+","+signatureArgsSignature+")", calldata)
+	require.Len(t, args, 3)
+	encoded, err := abi.JSON(strings.NewReader(GravityContractABIJSON))
+	require.NoError(t, err)
+	repacked, err := encoded.Pack("updateValset", args...)
+	require.NoError(t, err)
+	require.Equal(t, calldata, repacked)
+
+	sigs, err := toSignatureArgs(current, []string{hex.EncodeToString(sig), ""})
+	require.NoError(t, err)
+	require.Equal(t, uint8(28), sigs[0].V)
+	require.Equal(t, byte(1), sigs[0].R[0])
+	require.Equal(t, byte(2), sigs[0].S[0])
+	// missing signatures are skipped by the contract
+	require.Equal(t, signatureArgs{}, sigs[1])
+
+	_, err = ValsetUpdateCalldata(newValset, current, []string{""})
+	require.Error(t, err)
+	_, err = ValsetUpdateCalldata(newValset, current, []string{"zz", ""})
+	require.Error(t, err)
+	_, err = ValsetUpdateCalldata(newValset, current, []string{"0102", ""})
+	require.Error(t, err)
+}
+
+// nolint: exhaustruct
+func TestBatchCalldata(t *testing.T) {
+	current := calldataTestValset(t, 1)
+	senderAddr, err := sdk.AccAddressFromHex("527FBEE652609AB150F0AEE9D61A2F76CFC4A73E")
+	require.NoError(t, err)
+	batch := OutgoingTxBatch{
+		BatchNonce:   7,
+		BatchTimeout: 2111,
+		Transactions: []OutgoingTransferTx{{
+			Id:          1,
+			Sender:      senderAddr.String(),
+			DestAddress: "0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39",
+			Erc20Token:  ERC20Token{Amount: sdk.NewInt(100), Contract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"},
+			Erc20Fee:    ERC20Token{Amount: sdk.NewInt(3), Contract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"},
+		}},
+		TokenContract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4",
+	}
+	internalBatch, err := batch.ToInternal()
+	require.NoError(t, err)
+
+	calldata, err := BatchCalldata(*internalBatch, current, []string{"", ""})
+	require.NoError(t, err)
+	args := unpackCalldata(t, "submitBatch", "submitBatch("+valsetArgsSignature+","+signatureArgsSignature+",uint256[],address[],uint256[],uint256,address,uint256)", calldata)
+	require.Equal(t, []*big.Int{big.NewInt(100)}, args[2])
+	require.Equal(t, []gethcommon.Address{gethcommon.HexToAddress("0x9FC9C2DfBA3b6cF204C37a5F690619772b926e39")}, args[3])
+	require.Equal(t, []*big.Int{big.NewInt(3)}, args[4])
+	require.Equal(t, big.NewInt(7), args[5])
+	require.Equal(t, gethcommon.HexToAddress(batch.TokenContract), args[6])
+	require.Equal(t, big.NewInt(2111), args[7])
+}
+
+// nolint: exhaustruct
+func TestLogicCallCalldata(t *testing.T) {
+	current := calldataTestValset(t, 1)
+	call := OutgoingLogicCall{
+		Transfers:            []ERC20Token{{Amount: sdk.NewInt(1), Contract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"}},
+		Fees:                 []ERC20Token{{Amount: sdk.NewInt(2), Contract: "0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4"}},
+		LogicContractAddress: "0x17c1736CcF692F653c433d7aa2aB45148C016F68",
+		Payload:              []byte{0xde, 0xad},
+		Timeout:              4766922941000,
+		InvalidationId:       []byte{0x01},
+		InvalidationNonce:    3,
+	}
+
+	calldata, err := LogicCallCalldata(call, current, []string{"", ""})
+	require.NoError(t, err)
+	args := unpackCalldata(t, "submitLogicCall", "submitLogicCall("+valsetArgsSignature+","+signatureArgsSignature+
+		",(uint256[],address[],uint256[],address[],address,bytes,uint256,bytes32,uint256))", calldata)
+	require.Len(t, args, 3)
+}
@@ -33,6 +33,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCancelSendToEth{},
 		&MsgSubmitBadSignatureEvidence{},
 		&MsgExecuteIbcAutoForwards{},
+		&MsgClaimAirdrop{},
 	)
 
 	registry.RegisterInterface(
@@ -45,7 +46,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgValsetUpdatedClaim{},
 	)
 
-	registry.RegisterImplementations((*govtypes.Content)(nil), &UnhaltBridgeProposal{}, &AirdropProposal{}, &IBCMetadataProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &UnhaltBridgeProposal{}, &AirdropProposal{}, &IBCMetadataProposal{}, &ERC20MetadataProposal{}, &ERC20MigrationProposal{}, &MerkleAirdropProposal{}, &VestingAirdropProposal{})
 
 	registry.RegisterInterface("gravity.v1beta1.EthereumSigned", (*EthereumSigned)(nil), &Valset{}, &OutgoingTxBatch{}, &OutgoingLogicCall{})
 
@@ -76,4 +77,5 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Attestation{}, "gravity/Attestation", nil)
 	cdc.RegisterConcrete(&MsgSubmitBadSignatureEvidence{}, "gravity/MsgSubmitBadSignatureEvidence", nil)
 	cdc.RegisterConcrete(&MsgExecuteIbcAutoForwards{}, "gravity/MsgExecuteIbcAutoForwards", nil)
+	cdc.RegisterConcrete(&MsgClaimAirdrop{}, "gravity/MsgClaimAirdrop", nil)
 }
@@ -0,0 +1,265 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/ethermint/ethereum/eip712"
+	etherminttypes "github.com/evmos/ethermint/types"
+)
+
+/*	EIP-712 Typed Data
+
+	EIP-712 signed transactions are verified by ethermint, which derives the EIP-712 types of a Msg by reflecting
+	over the first Msg in the tx and places the legacy amino JSON of the tx in the typed data message. The derived
+	types therefore depend on the contents of the Msg, and any field the amino JSON omits (empty strings, zero
+	numbers) makes the typed data impossible to hash. EIP712MsgTypes publishes the types every gravity and auction
+	Msg must produce, EIP712TypedData refuses to produce typed data which does not match them so that wallets are
+	never asked to sign a payload the chain would reject.
+*/
+
+// EIP712MsgValueType is the name ethermint gives to the type of the Msg in the typed data
+const EIP712MsgValueType = "MsgValue"
+
+var (
+	eip712String = "string"
+	eip712Uint64 = "uint64"
+	eip712Coin   = []apitypes.Type{{Name: "denom", Type: eip712String}, {Name: "amount", Type: eip712String}}
+)
+
+// EIP712MsgTypes is the registry of EIP-712 types presented to wallets for each gravity and auction Msg which
+// supports EIP-712 signing, indexed by Msg type URL. Every type is listed, including EIP712MsgValueType
+var EIP712MsgTypes = map[string]apitypes.Types{
+	"/gravity.v1.MsgSetOrchestratorAddress": {
+		EIP712MsgValueType: {
+			{Name: "validator", Type: eip712String},
+			{Name: "orchestrator", Type: eip712String},
+			{Name: "eth_address", Type: eip712String},
+			{Name: "eth_signature", Type: eip712String},
+		},
+	},
+	"/gravity.v1.MsgValsetConfirm": {
+		EIP712MsgValueType: {
+			{Name: "nonce", Type: eip712Uint64},
+			{Name: "orchestrator", Type: eip712String},
+			{Name: "eth_address", Type: eip712String},
+			{Name: "signature", Type: eip712String},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+	},
+	"/gravity.v1.MsgSendToEth": {
+		EIP712MsgValueType: {
+			{Name: "sender", Type: eip712String},
+			{Name: "eth_dest", Type: eip712String},
+			{Name: "amount", Type: "TypeAmount"},
+			{Name: "bridge_fee", Type: "TypeBridgeFee"},
+			{Name: "chain_fee", Type: "TypeChainFee"},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+		"TypeAmount":    eip712Coin,
+		"TypeBridgeFee": eip712Coin,
+		"TypeChainFee":  eip712Coin,
+	},
+	"/gravity.v1.MsgRequestBatch": {
+		EIP712MsgValueType: {
+			{Name: "sender", Type: eip712String},
+			{Name: "denom", Type: eip712String},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+	},
+	"/gravity.v1.MsgConfirmBatch": {
+		EIP712MsgValueType: {
+			{Name: "nonce", Type: eip712Uint64},
+			{Name: "token_contract", Type: eip712String},
+			{Name: "eth_signer", Type: eip712String},
+			{Name: "orchestrator", Type: eip712String},
+			{Name: "signature", Type: eip712String},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+	},
+	"/gravity.v1.MsgConfirmLogicCall": {
+		EIP712MsgValueType: {
+			{Name: "invalidation_id", Type: eip712String},
+			{Name: "invalidation_nonce", Type: eip712Uint64},
+			{Name: "eth_signer", Type: eip712String},
+			{Name: "orchestrator", Type: eip712String},
+			{Name: "signature", Type: eip712String},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+	},
+	"/gravity.v1.MsgSendToCosmosClaim": {
+		EIP712MsgValueType: {
+			{Name: "event_nonce", Type: eip712Uint64},
+			{Name: "eth_block_height", Type: eip712Uint64},
+			{Name: "token_contract", Type: eip712String},
+			{Name: "amount", Type: eip712String},
+			{Name: "ethereum_sender", Type: eip712String},
+			{Name: "cosmos_receiver", Type: eip712String},
+			{Name: "orchestrator", Type: eip712String},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+	},
+	"/gravity.v1.MsgExecuteIbcAutoForwards": {
+		EIP712MsgValueType: {
+			{Name: "forwards_to_clear", Type: eip712Uint64},
+			{Name: "executor", Type: eip712String},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+	},
+	"/gravity.v1.MsgClaimAirdrop": {
+		EIP712MsgValueType: {
+			{Name: "claimer", Type: eip712String},
+			{Name: "airdrop_id", Type: eip712Uint64},
+			{Name: "amount", Type: eip712Uint64},
+			{Name: "proof", Type: "string[]"},
+		},
+	},
+	"/gravity.v1.MsgBatchSendToEthClaim": {
+		EIP712MsgValueType: {
+			{Name: "event_nonce", Type: eip712Uint64},
+			{Name: "eth_block_height", Type: eip712Uint64},
+			{Name: "batch_nonce", Type: eip712Uint64},
+			{Name: "token_contract", Type: eip712String},
+			{Name: "orchestrator", Type: eip712String},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+	},
+	"/gravity.v1.MsgERC20DeployedClaim": {
+		EIP712MsgValueType: {
+			{Name: "event_nonce", Type: eip712Uint64},
+			{Name: "eth_block_height", Type: eip712Uint64},
+			{Name: "cosmos_denom", Type: eip712String},
+			{Name: "token_contract", Type: eip712String},
+			{Name: "name", Type: eip712String},
+			{Name: "symbol", Type: eip712String},
+			{Name: "decimals", Type: eip712Uint64},
+			{Name: "orchestrator", Type: eip712String},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+	},
+	"/gravity.v1.MsgValsetUpdatedClaim": {
+		EIP712MsgValueType: {
+			{Name: "event_nonce", Type: eip712Uint64},
+			{Name: "valset_nonce", Type: eip712Uint64},
+			{Name: "eth_block_height", Type: eip712Uint64},
+			{Name: "members", Type: "TypeMembers[]"},
+			{Name: "reward_amount", Type: eip712String},
+			{Name: "reward_token", Type: eip712String},
+			{Name: "orchestrator", Type: eip712String},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+		"TypeMembers": {
+			{Name: "power", Type: eip712Uint64},
+			{Name: "ethereum_address", Type: eip712String},
+		},
+	},
+	"/gravity.v1.MsgCancelSendToEth": {
+		EIP712MsgValueType: {
+			{Name: "transaction_id", Type: eip712Uint64},
+			{Name: "sender", Type: eip712String},
+			{Name: "evm_chain_prefix", Type: eip712String},
+		},
+	},
+	"/auction.v1.MsgBid": {
+		EIP712MsgValueType: {
+			{Name: "auction_id", Type: eip712Uint64},
+			{Name: "bidder", Type: eip712String},
+			{Name: "amount", Type: eip712Uint64},
+			{Name: "bid_fee", Type: eip712Uint64},
+		},
+	},
+}
+
+// EIP712UnsupportedMsgs lists the gravity Msgs which can not be EIP-712 signed along with the reason, these Msgs
+// are only submitted by orchestrators and relayers which sign with ordinary Cosmos keys
+var EIP712UnsupportedMsgs = map[string]string{
+	"/gravity.v1.MsgLogicCallExecutedClaim":     "invalidation_id is raw bytes, which ethermint types as uint8[] but amino encodes as base64",
+	"/gravity.v1.MsgSubmitBadSignatureEvidence": "subject is an Any holding a gravity type, which ethermint can not unpack",
+}
+
+// isEIP712RegistryMsg returns true for Msgs defined by the gravity or auction modules
+func isEIP712RegistryMsg(typeURL string) bool {
+	return strings.HasPrefix(typeURL, "/gravity.") || strings.HasPrefix(typeURL, "/auction.")
+}
+
+// eip712RootTypes are the types ethermint adds to every typed data regardless of the Msg
+var eip712RootTypes = []string{"EIP712Domain", "Tx", "Fee", "Coin", "Msg"}
+
+// EIP712TypedData wraps the legacy amino sign bytes of a tx in the EIP-712 typed data a wallet must sign, exactly as
+// the EIP-712 ante handler reconstructs it when verifying the signature of feePayer. Gravity and auction Msgs must
+// be registered in EIP712MsgTypes and produce exactly the registered types
+func EIP712TypedData(evmChainID uint64, signBytes []byte, msgs []sdk.Msg, feePayer sdk.AccAddress) (apitypes.TypedData, error) {
+	if len(msgs) == 0 {
+		return apitypes.TypedData{}, sdkerrors.Wrap(ErrInvalid, "tx has no msgs")
+	}
+	// ethermint only derives the types of the first Msg, any other Msg type could not be hashed
+	typeURL := sdk.MsgTypeURL(msgs[0])
+	for _, msg := range msgs[1:] {
+		if sdk.MsgTypeURL(msg) != typeURL {
+			return apitypes.TypedData{}, sdkerrors.Wrapf(ErrInvalid, "EIP-712 signed txs may only contain one msg type, found %s and %s", typeURL, sdk.MsgTypeURL(msg))
+		}
+	}
+	if reason, unsupported := EIP712UnsupportedMsgs[typeURL]; unsupported {
+		return apitypes.TypedData{}, sdkerrors.Wrapf(ErrInvalid, "%s can not be EIP-712 signed: %s", typeURL, reason)
+	}
+	expected, registered := EIP712MsgTypes[typeURL]
+	if !registered && isEIP712RegistryMsg(typeURL) {
+		return apitypes.TypedData{}, sdkerrors.Wrapf(ErrUnknown, "%s has no EIP-712 types registered", typeURL)
+	}
+
+	// the same unpacker as ethermint's EIP-712 signature verification
+	registry := codectypes.NewInterfaceRegistry()
+	etherminttypes.RegisterInterfaces(registry)
+	cryptocodec.RegisterInterfaces(registry)
+	typedData, err := eip712.WrapTxToTypedData(registry, evmChainID, msgs[0], signBytes, &eip712.FeeDelegationOptions{FeePayer: feePayer})
+	if err != nil {
+		return apitypes.TypedData{}, sdkerrors.Wrap(err, "failed to pack tx data in EIP712 object")
+	}
+
+	if registered {
+		if err := checkEIP712Types(typedData.Types, expected); err != nil {
+			return apitypes.TypedData{}, sdkerrors.Wrapf(err, "%s does not match its EIP-712 types, every field must be populated", typeURL)
+		}
+	}
+	// any field missing from the amino JSON makes the typed data impossible to hash
+	if _, err := eip712.ComputeTypedDataHash(typedData); err != nil {
+		return apitypes.TypedData{}, sdkerrors.Wrapf(err, "%s can not be EIP-712 signed, every field must be populated", typeURL)
+	}
+	return typedData, nil
+}
+
+// checkEIP712Types returns an error if the Msg types in derived differ from expected
+func checkEIP712Types(derived apitypes.Types, expected apitypes.Types) error {
+	var problems []string
+	for name, fields := range expected {
+		if !reflect.DeepEqual(derived[name], fields) {
+			problems = append(problems, fmt.Sprintf("%s is %v, expected %v", name, derived[name], fields))
+		}
+	}
+	for name := range derived {
+		if _, ok := expected[name]; !ok && !isEIP712RootType(name) {
+			problems = append(problems, fmt.Sprintf("unexpected type %s", name))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return sdkerrors.Wrap(ErrInvalid, strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+func isEIP712RootType(name string) bool {
+	for _, root := range eip712RootTypes {
+		if name == root {
+			return true
+		}
+	}
+	return false
+}
@@ -167,14 +167,9 @@ func (i *InternalERC20Token) ToExternal() ERC20Token {
 	}
 }
 
-// GravityCoin returns the gravity representation of the ERC20
-func (i *InternalERC20Token) GravityCoin() sdk.Coin {
-	return sdk.NewCoin(GravityDenom(i.Contract), i.Amount)
-}
-
-// GravityDenom converts an EthAddress to a gravity cosmos denom
-func GravityDenom(tokenContract EthAddress) string {
-	return fmt.Sprintf("%s%s%s", GravityDenomPrefix, GravityDenomSeparator, tokenContract.GetAddress().Hex())
+// GravityCoin returns the gravity representation of the ERC20 on the given EVM chain
+func (i *InternalERC20Token) GravityCoin(evmChainPrefix string) sdk.Coin {
+	return sdk.NewCoin(GravityDenom(evmChainPrefix, i.Contract), i.Amount)
 }
 
 // ValidateBasic performs stateless validation
@@ -196,24 +191,6 @@ func (i *InternalERC20Token) Add(o *InternalERC20Token) (*InternalERC20Token, er
 	return NewInternalERC20Token(sum, i.Contract.GetAddress().Hex())
 }
 
-// GravityDenomToERC20 converts a gravity cosmos denom to an EthAddress
-func GravityDenomToERC20(denom string) (*EthAddress, error) {
-	fullPrefix := GravityDenomPrefix + GravityDenomSeparator
-	if !strings.HasPrefix(denom, fullPrefix) {
-		return nil, fmt.Errorf("denom prefix(%s) not equal to expected(%s)", denom, fullPrefix)
-	}
-	contract := strings.TrimPrefix(denom, fullPrefix)
-	ethAddr, err := NewEthAddress(contract)
-	switch {
-	case err != nil:
-		return nil, fmt.Errorf("error(%s) validating ethereum contract address", err)
-	case len(denom) != GravityDenomLen:
-		return nil, fmt.Errorf("len(denom)(%d) not equal to GravityDenomLen(%d)", len(denom), GravityDenomLen)
-	default:
-		return ethAddr, nil
-	}
-}
-
 func has0xPrefix(str string) bool {
 	return len(str) >= 2 && str[0] == '0' && (str[1] == 'x' || str[1] == 'X')
 }
@@ -240,3 +217,31 @@ func (m ERC20ToDenom) ValidateBasic() error {
 
 	return nil
 }
+
+func (m ERC20Migration) ValidateBasic() error {
+	if err := sdk.ValidateDenom(m.CosmosDenom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid erc20 migration: denom is invalid: %v", err)
+	}
+	oldErc20, err := NewEthAddress(m.OldErc20)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid erc20 migration: old erc20 must be a valid ethereum address: %v", err)
+	}
+	newErc20, err := NewEthAddress(m.NewErc20)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid erc20 migration: new erc20 must be a valid ethereum address: %v", err)
+	}
+	if oldErc20.GetAddress() == newErc20.GetAddress() {
+		return sdkerrors.Wrap(ErrInvalid, "invalid erc20 migration: old and new erc20 must differ")
+	}
+	return nil
+}
+
+func (m AttestedERC20Deployment) ValidateBasic() error {
+	if err := sdk.ValidateDenom(m.CosmosDenom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid attested erc20 deployment: denom is invalid: %v", err)
+	}
+	if _, err := NewEthAddress(m.TokenContract); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid attested erc20 deployment: token contract must be a valid ethereum address: %v", err)
+	}
+	return nil
+}
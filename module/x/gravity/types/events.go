@@ -37,4 +37,13 @@ const (
 	AttributeKeyValsetSignatureSlashing    = "valset_signature_slashing"
 	AttributeKeyBatchSignatureSlashing     = "batch_signature_slashing"
 	AttributeKeyLogicCallSignatureSlashing = "logic_call_signature_slashing"
+
+	// ValsetTriggerNoValset fires when the EVM chain has no valset yet
+	ValsetTriggerNoValset = "no_valset"
+	// ValsetTriggerUnbonding fires when a validator started unbonding since the latest valset request
+	ValsetTriggerUnbonding = "validator_unbonding"
+	// ValsetTriggerPowerDiff fires when the bridge power changed by more than Params.ValsetPowerDiffThreshold
+	ValsetTriggerPowerDiff = "power_diff"
+	// ValsetTriggerMaxAge fires when the latest valset request is Params.ValsetMaxAge blocks old
+	ValsetTriggerMaxAge = "max_age"
 )
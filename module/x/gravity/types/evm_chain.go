@@ -0,0 +1,185 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultEvmChainPrefix identifies the EVM chain described by the top level bridge Params (gravity_id,
+	// bridge_ethereum_address, ...), all bridge state which predates multi EVM chain support belongs to it
+	DefaultEvmChainPrefix = "ethereum"
+
+	// GravityDenomEvmChainSeparator separates the parts of the denoms of tokens bridged from EVM chains
+	// other than the default chain, e.g. gravity/arbitrum/0x...
+	GravityDenomEvmChainSeparator = "/"
+)
+
+// evmChainPrefixRegex restricts EVM chain prefixes to short lowercase identifiers usable in denoms
+var evmChainPrefixRegex = regexp.MustCompile(`^[a-z][a-z0-9]{0,31}$`)
+
+// ValidateEvmChainPrefix returns an error if evmChainPrefix can not identify an EVM chain
+func ValidateEvmChainPrefix(evmChainPrefix string) error {
+	if !evmChainPrefixRegex.MatchString(evmChainPrefix) {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid evm chain prefix %q, must match %s", evmChainPrefix, evmChainPrefixRegex.String())
+	}
+	return nil
+}
+
+// ValidateOptionalEvmChainPrefix validates the evm chain prefix of a Msg, where empty selects the default chain
+func ValidateOptionalEvmChainPrefix(evmChainPrefix string) error {
+	if evmChainPrefix == "" {
+		return nil
+	}
+	return ValidateEvmChainPrefix(evmChainPrefix)
+}
+
+// EvmChainPrefixOrDefault returns evmChainPrefix, or DefaultEvmChainPrefix if evmChainPrefix is empty
+func EvmChainPrefixOrDefault(evmChainPrefix string) string {
+	if evmChainPrefix == "" {
+		return DefaultEvmChainPrefix
+	}
+	return evmChainPrefix
+}
+
+// ValidateBasic performs stateless checks on the params of a single EVM chain
+func (p EvmChainParams) ValidateBasic() error {
+	if err := ValidateEvmChainPrefix(p.EvmChainPrefix); err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.EvmChainName) == "" {
+		return sdkerrors.Wrapf(ErrInvalid, "evm chain %s has no name", p.EvmChainPrefix)
+	}
+	if err := validateGravityID(p.GravityId); err != nil {
+		return sdkerrors.Wrap(err, "gravity id")
+	}
+	if p.GravityId == "" {
+		return sdkerrors.Wrapf(ErrInvalid, "evm chain %s has no gravity id", p.EvmChainPrefix)
+	}
+	if err := validateBridgeContractAddress(p.BridgeEthereumAddress); err != nil {
+		return sdkerrors.Wrap(err, "bridge contract address")
+	}
+	if err := validateAverageEthereumBlockTime(p.AverageEthereumBlockTime); err != nil {
+		return sdkerrors.Wrap(err, "Ethereum block time")
+	}
+	if err := validateEthereumBlacklistAddresses(p.EthereumBlacklist); err != nil {
+		return sdkerrors.Wrap(err, "ethereum blacklist")
+	}
+	return nil
+}
+
+// DefaultEvmChainParams returns the params of the default EVM chain, which are stored in the top level Params
+func (p Params) DefaultEvmChainParams() EvmChainParams {
+	return EvmChainParams{
+		EvmChainPrefix:           DefaultEvmChainPrefix,
+		EvmChainName:             "Ethereum",
+		GravityId:                p.GravityId,
+		BridgeEthereumAddress:    p.BridgeEthereumAddress,
+		BridgeChainId:            p.BridgeChainId,
+		AverageEthereumBlockTime: p.AverageEthereumBlockTime,
+		BridgeActive:             p.BridgeActive,
+		EthereumBlacklist:        p.EthereumBlacklist,
+	}
+}
+
+// AllEvmChains returns the params of every bridged EVM chain, starting with the default chain
+func (p Params) AllEvmChains() []EvmChainParams {
+	return append([]EvmChainParams{p.DefaultEvmChainParams()}, p.EvmChains...)
+}
+
+// GetEvmChain returns the params of the EVM chain identified by evmChainPrefix, if it is bridged
+func (p Params) GetEvmChain(evmChainPrefix string) (EvmChainParams, bool) {
+	for _, chain := range p.AllEvmChains() {
+		if chain.EvmChainPrefix == evmChainPrefix {
+			return chain, true
+		}
+	}
+	return EvmChainParams{}, false
+}
+
+// validateEvmChainsGravityIds checks that no two EVM chains share a gravity id, which would allow signatures
+// for one chain to be replayed on another
+func validateEvmChainsGravityIds(chains []EvmChainParams) error {
+	seen := make(map[string]string, len(chains))
+	for _, chain := range chains {
+		if other, ok := seen[chain.GravityId]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "evm chains %s and %s share the gravity id %s", other, chain.EvmChainPrefix, chain.GravityId)
+		}
+		seen[chain.GravityId] = chain.EvmChainPrefix
+	}
+	return nil
+}
+
+func validateEvmChains(i interface{}) error {
+	chains, ok := i.([]EvmChainParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	prefixes := make(map[string]bool, len(chains))
+	for _, chain := range chains {
+		if err := chain.ValidateBasic(); err != nil {
+			return err
+		}
+		if chain.EvmChainPrefix == DefaultEvmChainPrefix {
+			return sdkerrors.Wrapf(ErrInvalid, "%s is the default evm chain, its params are set at the top level", DefaultEvmChainPrefix)
+		}
+		if prefixes[chain.EvmChainPrefix] {
+			return sdkerrors.Wrapf(ErrDuplicate, "evm chain %s is listed twice", chain.EvmChainPrefix)
+		}
+		prefixes[chain.EvmChainPrefix] = true
+	}
+	return validateEvmChainsGravityIds(chains)
+}
+
+// GravityDenom converts an EthAddress on the given EVM chain to a gravity cosmos denom, the default chain uses
+// the original format gravity0x... while other chains use gravity/<evm chain prefix>/0x...
+func GravityDenom(evmChainPrefix string, tokenContract EthAddress) string {
+	if evmChainPrefix == DefaultEvmChainPrefix {
+		return fmt.Sprintf("%s%s%s", GravityDenomPrefix, GravityDenomSeparator, tokenContract.GetAddress().Hex())
+	}
+	return strings.Join([]string{GravityDenomPrefix, evmChainPrefix, tokenContract.GetAddress().Hex()}, GravityDenomEvmChainSeparator)
+}
+
+// GravityDenomToERC20 converts a gravity cosmos denom to the prefix of the EVM chain the token is from and
+// its EthAddress
+func GravityDenomToERC20(denom string) (string, *EthAddress, error) {
+	evmChainPrefix := DefaultEvmChainPrefix
+	contract := denom
+	if strings.HasPrefix(denom, GravityDenomPrefix+GravityDenomEvmChainSeparator) {
+		parts := strings.Split(denom, GravityDenomEvmChainSeparator)
+		if len(parts) != 3 {
+			return "", nil, fmt.Errorf("denom(%s) is not of the form %s", denom, strings.Join([]string{GravityDenomPrefix, "<evm chain>", "0x..."}, GravityDenomEvmChainSeparator))
+		}
+		if err := ValidateEvmChainPrefix(parts[1]); err != nil {
+			return "", nil, err
+		}
+		if parts[1] == DefaultEvmChainPrefix {
+			return "", nil, fmt.Errorf("denom(%s) must use the default evm chain format %s%s0x...", denom, GravityDenomPrefix, GravityDenomSeparator)
+		}
+		evmChainPrefix = parts[1]
+		contract = GravityDenomPrefix + GravityDenomSeparator + parts[2]
+	}
+
+	fullPrefix := GravityDenomPrefix + GravityDenomSeparator
+	if !strings.HasPrefix(contract, fullPrefix) {
+		return "", nil, fmt.Errorf("denom prefix(%s) not equal to expected(%s)", denom, fullPrefix)
+	}
+	ethAddr, err := NewEthAddress(strings.TrimPrefix(contract, fullPrefix))
+	switch {
+	case err != nil:
+		return "", nil, fmt.Errorf("error(%s) validating ethereum contract address", err)
+	case len(contract) != GravityDenomLen:
+		return "", nil, fmt.Errorf("len(denom)(%d) not equal to GravityDenomLen(%d)", len(contract), GravityDenomLen)
+	default:
+		return evmChainPrefix, ethAddr, nil
+	}
+}
+
+// ClaimEvmChainPrefix returns the prefix of the EVM chain the claimed event occurred on, claims made before multi
+// EVM chain support and claims which leave the prefix empty are for the default chain
+func ClaimEvmChainPrefix(claim EthereumClaim) string {
+	return EvmChainPrefixOrDefault(claim.GetEvmChainPrefix())
+}
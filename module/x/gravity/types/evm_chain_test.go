@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGravityDenomEvmChains(t *testing.T) {
+	contract, err := NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+
+	// the default chain keeps the original denom format
+	defaultDenom := GravityDenom(DefaultEvmChainPrefix, *contract)
+	require.Equal(t, "gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", defaultDenom)
+	prefix, parsed, err := GravityDenomToERC20(defaultDenom)
+	require.NoError(t, err)
+	require.Equal(t, DefaultEvmChainPrefix, prefix)
+	require.Equal(t, *contract, *parsed)
+
+	arbitrumDenom := GravityDenom("arbitrum", *contract)
+	require.Equal(t, "gravity/arbitrum/0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", arbitrumDenom)
+	prefix, parsed, err = GravityDenomToERC20(arbitrumDenom)
+	require.NoError(t, err)
+	require.Equal(t, "arbitrum", prefix)
+	require.Equal(t, *contract, *parsed)
+
+	for _, invalid := range []string{
+		"gravity/ethereum/0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		"gravity/Arbitrum/0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		"gravity/arbitrum/one/0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		"gravity/arbitrum/gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		"gravity/arbitrum/0x429881672B9AE42b8EbA0E26cD9C73711b891C",
+		"gravity0x429881672B9AE42b8EbA0E26cD9C73711b891Ca",
+		"ugraviton",
+	} {
+		_, _, err := GravityDenomToERC20(invalid)
+		require.Error(t, err, invalid)
+	}
+}
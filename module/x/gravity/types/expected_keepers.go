@@ -40,6 +40,9 @@ type BankKeeper interface {
 
 type SlashingKeeper interface {
 	GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (info slashingtypes.ValidatorSigningInfo, found bool)
+	IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+	Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress)
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
 }
 
 type DistributionKeeper interface {
@@ -94,6 +94,33 @@ var (
 	// the ChainFee will go to stakers
 	ParamStoreChainFeeAuctionPoolFraction = []byte("ChainFeeAuctionPoolFraction")
 
+	// ParamStoreEvmChains stores the params of the EVM chains bridged in addition to the default chain
+	ParamStoreEvmChains = []byte("EvmChains")
+
+	// ParamStoreOffenceDecayWindow stores the number of blocks after which one missed signature offence is forgiven
+	ParamStoreOffenceDecayWindow = []byte("OffenceDecayWindow")
+
+	// ParamStoreOffenceSlashEscalation stores the factor the slash fraction grows by with each repeated offence
+	ParamStoreOffenceSlashEscalation = []byte("OffenceSlashEscalation")
+
+	// ParamStoreOffenceTombstoneThreshold stores the offence count at which a validator is tombstoned
+	ParamStoreOffenceTombstoneThreshold = []byte("OffenceTombstoneThreshold")
+
+	// ParamStoreValsetPowerDiffThreshold stores the bridge power change which triggers a new valset request
+	ParamStoreValsetPowerDiffThreshold = []byte("ValsetPowerDiffThreshold")
+
+	// ParamStoreValsetMaxAge stores the age in blocks at which a new valset is requested even without changes
+	ParamStoreValsetMaxAge = []byte("ValsetMaxAge")
+
+	// ParamStoreValsetMinInterval stores the minimum number of blocks between two valset requests
+	ParamStoreValsetMinInterval = []byte("ValsetMinInterval")
+
+	// ParamStoreTokenGasParams stores the gas estimates and batch gas budgets of tokens
+	ParamStoreTokenGasParams = []byte("TokenGasParams")
+
+	// ParamStoreOffenceJailOnlyCount stores the number of offences of a validator which only jail it without slashing
+	ParamStoreOffenceJailOnlyCount = []byte("OffenceJailOnlyCount")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{
 		GravityId:                    "",
@@ -119,6 +146,15 @@ var (
 		EthereumBlacklist:           []string{},
 		MinChainFeeBasisPoints:      0,
 		ChainFeeAuctionPoolFraction: sdk.Dec{},
+		EvmChains:                   []EvmChainParams{},
+		OffenceDecayWindow:          0,
+		OffenceSlashEscalation:      sdk.Dec{},
+		OffenceTombstoneThreshold:   0,
+		ValsetPowerDiffThreshold:    sdk.Dec{},
+		ValsetMaxAge:                0,
+		ValsetMinInterval:           0,
+		TokenGasParams:              []TokenGasParams{},
+		OffenceJailOnlyCount:        0,
 	}
 )
 
@@ -128,6 +164,16 @@ func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	seen := make(map[string]bool, len(s.EvmChains))
+	for _, chain := range s.EvmChains {
+		if _, ok := s.Params.GetEvmChain(chain.EvmChainPrefix); !ok || chain.EvmChainPrefix == DefaultEvmChainPrefix {
+			return sdkerrors.Wrapf(ErrInvalid, "evm chain %s has genesis state but is not in params.evm_chains", chain.EvmChainPrefix)
+		}
+		if seen[chain.EvmChainPrefix] {
+			return sdkerrors.Wrapf(ErrDuplicate, "evm chain %s has genesis state twice", chain.EvmChainPrefix)
+		}
+		seen[chain.EvmChainPrefix] = true
+	}
 	return nil
 }
 
@@ -135,19 +181,27 @@ func (s GenesisState) ValidateBasic() error {
 // nolint: exhaustruct
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:                 DefaultParams(),
-		GravityNonces:          GravityNonces{},
-		Valsets:                []Valset{},
-		ValsetConfirms:         []MsgValsetConfirm{},
-		Batches:                []OutgoingTxBatch{},
-		BatchConfirms:          []MsgConfirmBatch{},
-		LogicCalls:             []OutgoingLogicCall{},
-		LogicCallConfirms:      []MsgConfirmLogicCall{},
-		Attestations:           []Attestation{},
-		DelegateKeys:           []MsgSetOrchestratorAddress{},
-		Erc20ToDenoms:          []ERC20ToDenom{},
-		UnbatchedTransfers:     []OutgoingTransferTx{},
-		PendingIbcAutoForwards: []PendingIbcAutoForward{},
+		Params:                   DefaultParams(),
+		GravityNonces:            GravityNonces{},
+		Valsets:                  []Valset{},
+		ValsetConfirms:           []MsgValsetConfirm{},
+		Batches:                  []OutgoingTxBatch{},
+		BatchConfirms:            []MsgConfirmBatch{},
+		LogicCalls:               []OutgoingLogicCall{},
+		LogicCallConfirms:        []MsgConfirmLogicCall{},
+		Attestations:             []Attestation{},
+		DelegateKeys:             []MsgSetOrchestratorAddress{},
+		Erc20ToDenoms:            []ERC20ToDenom{},
+		UnbatchedTransfers:       []OutgoingTransferTx{},
+		PendingIbcAutoForwards:   []PendingIbcAutoForward{},
+		Erc20Migrations:          []ERC20Migration{},
+		AttestedErc20Deployments: []AttestedERC20Deployment{},
+		MerkleAirdrops:           []MerkleAirdrop{},
+		MerkleAirdropClaims:      []MerkleAirdropClaim{},
+		EvmChains:                []EvmChainData{},
+		BridgeOffences:           []BridgeOffences{},
+		BridgePerformance:        []ValidatorBridgePerformance{},
+		OutgoingTxCreatedHeights: []OutgoingTxCreatedHeight{},
 	}
 }
 
@@ -174,6 +228,15 @@ func DefaultParams() *Params {
 		EthereumBlacklist:            []string{},
 		MinChainFeeBasisPoints:       2,
 		ChainFeeAuctionPoolFraction:  sdk.NewDecWithPrec(50, 2), // 50%, the prec parameter moves the decimal to the left that many places
+		EvmChains:                    []EvmChainParams{},
+		OffenceDecayWindow:           100000,
+		OffenceSlashEscalation:       sdk.OneDec(),             // every offence slashes the full SlashFraction of the missed item
+		OffenceTombstoneThreshold:    0,                        // tombstoning is opted in to by governance
+		ValsetPowerDiffThreshold:     sdk.NewDecWithPrec(5, 2), // 5%
+		ValsetMaxAge:                 0,
+		ValsetMinInterval:            0,
+		TokenGasParams:               []TokenGasParams{},
+		OffenceJailOnlyCount:         0, // every offence slashes and jails, like before graduated slashing
 	}
 }
 
@@ -239,6 +302,42 @@ func (p Params) ValidateBasic() error {
 	if err := validateChainFeeAuctionPoolFraction(p.ChainFeeAuctionPoolFraction); err != nil {
 		return sdkerrors.Wrap(err, "chain fee auction pool fraction parameter")
 	}
+	if err := validateEvmChains(p.EvmChains); err != nil {
+		return sdkerrors.Wrap(err, "evm chains parameter")
+	}
+	if err := validateEvmChainsGravityIds(p.AllEvmChains()); err != nil {
+		return sdkerrors.Wrap(err, "evm chains parameter")
+	}
+	if err := validateOffenceDecayWindow(p.OffenceDecayWindow); err != nil {
+		return sdkerrors.Wrap(err, "offence decay window parameter")
+	}
+	if err := validateOffenceSlashEscalation(p.OffenceSlashEscalation); err != nil {
+		return sdkerrors.Wrap(err, "offence slash escalation parameter")
+	}
+	if err := validateOffenceTombstoneThreshold(p.OffenceTombstoneThreshold); err != nil {
+		return sdkerrors.Wrap(err, "offence tombstone threshold parameter")
+	}
+	if err := validateOffenceJailOnlyCount(p.OffenceJailOnlyCount); err != nil {
+		return sdkerrors.Wrap(err, "offence jail only count parameter")
+	}
+	if p.OffenceTombstoneThreshold != 0 && p.OffenceTombstoneThreshold <= p.OffenceJailOnlyCount {
+		return sdkerrors.Wrap(ErrInvalid, "offence tombstone threshold would tombstone validators for offences which only jail")
+	}
+	if err := validateValsetPowerDiffThreshold(p.ValsetPowerDiffThreshold); err != nil {
+		return sdkerrors.Wrap(err, "valset power diff threshold parameter")
+	}
+	if err := validateValsetMaxAge(p.ValsetMaxAge); err != nil {
+		return sdkerrors.Wrap(err, "valset max age parameter")
+	}
+	if err := validateValsetMinInterval(p.ValsetMinInterval); err != nil {
+		return sdkerrors.Wrap(err, "valset min interval parameter")
+	}
+	if p.ValsetMaxAge != 0 && p.ValsetMaxAge < p.ValsetMinInterval {
+		return sdkerrors.Wrap(ErrInvalid, "valset max age must not be below the valset min interval")
+	}
+	if err := validateTokenGasParams(p.TokenGasParams); err != nil {
+		return sdkerrors.Wrap(err, "token gas params parameter")
+	}
 	return nil
 }
 
@@ -265,6 +364,15 @@ func ParamKeyTable() paramtypes.KeyTable {
 		EthereumBlacklist:            []string{},
 		MinChainFeeBasisPoints:       0,
 		ChainFeeAuctionPoolFraction:  sdk.Dec{},
+		EvmChains:                    []EvmChainParams{},
+		OffenceDecayWindow:           0,
+		OffenceSlashEscalation:       sdk.Dec{},
+		OffenceTombstoneThreshold:    0,
+		ValsetPowerDiffThreshold:     sdk.Dec{},
+		ValsetMaxAge:                 0,
+		ValsetMinInterval:            0,
+		TokenGasParams:               []TokenGasParams{},
+		OffenceJailOnlyCount:         0,
 	})
 }
 
@@ -291,6 +399,15 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreEthereumBlacklist, &p.EthereumBlacklist, validateEthereumBlacklistAddresses),
 		paramtypes.NewParamSetPair(ParamStoreMinChainFeeBasisPoints, &p.MinChainFeeBasisPoints, validateMinChainFeeBasisPoints),
 		paramtypes.NewParamSetPair(ParamStoreChainFeeAuctionPoolFraction, &p.ChainFeeAuctionPoolFraction, validateChainFeeAuctionPoolFraction),
+		paramtypes.NewParamSetPair(ParamStoreEvmChains, &p.EvmChains, validateEvmChains),
+		paramtypes.NewParamSetPair(ParamStoreOffenceDecayWindow, &p.OffenceDecayWindow, validateOffenceDecayWindow),
+		paramtypes.NewParamSetPair(ParamStoreOffenceSlashEscalation, &p.OffenceSlashEscalation, validateOffenceSlashEscalation),
+		paramtypes.NewParamSetPair(ParamStoreOffenceTombstoneThreshold, &p.OffenceTombstoneThreshold, validateOffenceTombstoneThreshold),
+		paramtypes.NewParamSetPair(ParamStoreValsetPowerDiffThreshold, &p.ValsetPowerDiffThreshold, validateValsetPowerDiffThreshold),
+		paramtypes.NewParamSetPair(ParamStoreValsetMaxAge, &p.ValsetMaxAge, validateValsetMaxAge),
+		paramtypes.NewParamSetPair(ParamStoreValsetMinInterval, &p.ValsetMinInterval, validateValsetMinInterval),
+		paramtypes.NewParamSetPair(ParamStoreTokenGasParams, &p.TokenGasParams, validateTokenGasParams),
+		paramtypes.NewParamSetPair(ParamStoreOffenceJailOnlyCount, &p.OffenceJailOnlyCount, validateOffenceJailOnlyCount),
 	}
 }
 
@@ -496,6 +613,76 @@ func validateChainFeeAuctionPoolFraction(i interface{}) error {
 	return nil
 }
 
+func validateOffenceDecayWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOffenceSlashEscalation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("offence slash escalation must be not nil")
+	}
+	if v.LT(sdk.OneDec()) {
+		return fmt.Errorf("offence slash escalation must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateOffenceTombstoneThreshold(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOffenceJailOnlyCount(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateValsetPowerDiffThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("valset power diff threshold must be not nil")
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("valset power diff threshold must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("valset power diff threshold too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateValsetMaxAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateValsetMinInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
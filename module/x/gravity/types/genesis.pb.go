@@ -65,6 +65,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 //
 // Specifies what fraction of the SendToEth `chain_fee` amount should go to the auction pool.
 // e.g. "0.5" gives a 50% auction pool / staker split while "0.9" would cause 90% of the fee to go to the pool
+//
+// evm_chains
+//
+// The EVM chains bridged in addition to the default chain, the default chain is described by gravity_id,
+// bridge_ethereum_address, bridge_chain_id, average_ethereum_block_time, bridge_active and ethereum_blacklist
+// above. Every chain is identified by its evm_chain_prefix, which namespaces its store keys and its denoms
 type Params struct {
 	GravityId                    string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
 	ContractSourceHash           string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
@@ -88,6 +94,28 @@ type Params struct {
 	EthereumBlacklist           []string                               `protobuf:"bytes,19,rep,name=ethereum_blacklist,json=ethereumBlacklist,proto3" json:"ethereum_blacklist,omitempty"`
 	MinChainFeeBasisPoints      uint64                                 `protobuf:"varint,20,opt,name=min_chain_fee_basis_points,json=minChainFeeBasisPoints,proto3" json:"min_chain_fee_basis_points,omitempty"`
 	ChainFeeAuctionPoolFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=chain_fee_auction_pool_fraction,json=chainFeeAuctionPoolFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"chain_fee_auction_pool_fraction"`
+	EvmChains                   []EvmChainParams                       `protobuf:"bytes,22,rep,name=evm_chains,json=evmChains,proto3" json:"evm_chains"`
+	// a validator missing a valset, batch or logic call signature commits an offence, one offence is forgiven every
+	// offence_decay_window blocks, 0 means offences are never forgiven
+	OffenceDecayWindow uint64 `protobuf:"varint,23,opt,name=offence_decay_window,json=offenceDecayWindow,proto3" json:"offence_decay_window,omitempty"`
+	// the first offence which is not jail only slashes the SlashFraction of the missed item and every further offence
+	// multiplies the fraction of the offence before it by offence_slash_escalation, 1 slashes every offence the same
+	OffenceSlashEscalation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,24,opt,name=offence_slash_escalation,json=offenceSlashEscalation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"offence_slash_escalation"`
+	// a validator reaching this many offences is tombstoned and can never unjail, 0 disables tombstoning
+	OffenceTombstoneThreshold uint64 `protobuf:"varint,25,opt,name=offence_tombstone_threshold,json=offenceTombstoneThreshold,proto3" json:"offence_tombstone_threshold,omitempty"`
+	// a new valset is requested when the bridge power of any validator changed by more than this fraction since the
+	// latest valset request
+	ValsetPowerDiffThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=valset_power_diff_threshold,json=valsetPowerDiffThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valset_power_diff_threshold"`
+	// a new valset is requested once the latest valset request is this many blocks old even if nothing changed, 0
+	// disables this trigger
+	ValsetMaxAge uint64 `protobuf:"varint,27,opt,name=valset_max_age,json=valsetMaxAge,proto3" json:"valset_max_age,omitempty"`
+	// valsets are requested at most once every valset_min_interval blocks, triggers firing earlier are deferred
+	ValsetMinInterval uint64 `protobuf:"varint,28,opt,name=valset_min_interval,json=valsetMinInterval,proto3" json:"valset_min_interval,omitempty"`
+	// the gas batches of the listed tokens are estimated with and the gas budget they must fit in, tokens which are
+	// not listed are estimated with the default gas per transfer and are not budgeted
+	TokenGasParams []TokenGasParams `protobuf:"bytes,29,rep,name=token_gas_params,json=tokenGasParams,proto3" json:"token_gas_params"`
+	// the first offence_jail_only_count offences of a validator only jail it without slashing, 0 slashes every offence
+	OffenceJailOnlyCount uint64 `protobuf:"varint,30,opt,name=offence_jail_only_count,json=offenceJailOnlyCount,proto3" json:"offence_jail_only_count,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -228,28 +256,261 @@ func (m *Params) GetMinChainFeeBasisPoints() uint64 {
 	return 0
 }
 
+func (m *Params) GetEvmChains() []EvmChainParams {
+	if m != nil {
+		return m.EvmChains
+	}
+	return nil
+}
+
+func (m *Params) GetOffenceDecayWindow() uint64 {
+	if m != nil {
+		return m.OffenceDecayWindow
+	}
+	return 0
+}
+
+func (m *Params) GetOffenceTombstoneThreshold() uint64 {
+	if m != nil {
+		return m.OffenceTombstoneThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetValsetMaxAge() uint64 {
+	if m != nil {
+		return m.ValsetMaxAge
+	}
+	return 0
+}
+
+func (m *Params) GetValsetMinInterval() uint64 {
+	if m != nil {
+		return m.ValsetMinInterval
+	}
+	return 0
+}
+
+func (m *Params) GetTokenGasParams() []TokenGasParams {
+	if m != nil {
+		return m.TokenGasParams
+	}
+	return nil
+}
+
+func (m *Params) GetOffenceJailOnlyCount() uint64 {
+	if m != nil {
+		return m.OffenceJailOnlyCount
+	}
+	return 0
+}
+
+// TokenGasParams describe the gas cost of batches of token_contract on the EVM chain evm_chain_prefix.
+// gas_per_transfer is the estimated gas of a single transfer of the token in a batch and max_batch_gas the most gas
+// a batch of the token may be estimated to use, batches are cut short to fit. A max_batch_gas of 0 sets no budget
+type TokenGasParams struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	TokenContract  string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	GasPerTransfer uint64 `protobuf:"varint,3,opt,name=gas_per_transfer,json=gasPerTransfer,proto3" json:"gas_per_transfer,omitempty"`
+	MaxBatchGas    uint64 `protobuf:"varint,4,opt,name=max_batch_gas,json=maxBatchGas,proto3" json:"max_batch_gas,omitempty"`
+}
+
+func (m *TokenGasParams) Reset()         { *m = TokenGasParams{} }
+func (m *TokenGasParams) String() string { return proto.CompactTextString(m) }
+func (*TokenGasParams) ProtoMessage()    {}
+func (*TokenGasParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{1}
+}
+func (m *TokenGasParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenGasParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenGasParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenGasParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenGasParams.Merge(m, src)
+}
+func (m *TokenGasParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenGasParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenGasParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenGasParams proto.InternalMessageInfo
+
+func (m *TokenGasParams) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *TokenGasParams) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *TokenGasParams) GetGasPerTransfer() uint64 {
+	if m != nil {
+		return m.GasPerTransfer
+	}
+	return 0
+}
+
+func (m *TokenGasParams) GetMaxBatchGas() uint64 {
+	if m != nil {
+		return m.MaxBatchGas
+	}
+	return 0
+}
+
+// EvmChainParams are the parameters of a single bridged EVM chain, the fields have the same
+// meaning as the equivalent fields in Params. evm_chain_prefix must be unique, it is used in
+// store keys and in the denoms of the chain's tokens (gravity/<evm_chain_prefix>/0x...), the
+// gravity_id must also be unique so that signatures can not be replayed on another chain
+type EvmChainParams struct {
+	EvmChainPrefix           string   `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	EvmChainName             string   `protobuf:"bytes,2,opt,name=evm_chain_name,json=evmChainName,proto3" json:"evm_chain_name,omitempty"`
+	GravityId                string   `protobuf:"bytes,3,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	BridgeEthereumAddress    string   `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	BridgeChainId            uint64   `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	AverageEthereumBlockTime uint64   `protobuf:"varint,6,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
+	BridgeActive             bool     `protobuf:"varint,7,opt,name=bridge_active,json=bridgeActive,proto3" json:"bridge_active,omitempty"`
+	EthereumBlacklist        []string `protobuf:"bytes,8,rep,name=ethereum_blacklist,json=ethereumBlacklist,proto3" json:"ethereum_blacklist,omitempty"`
+}
+
+func (m *EvmChainParams) Reset()         { *m = EvmChainParams{} }
+func (m *EvmChainParams) String() string { return proto.CompactTextString(m) }
+func (*EvmChainParams) ProtoMessage()    {}
+func (*EvmChainParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *EvmChainParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmChainParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmChainParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmChainParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmChainParams.Merge(m, src)
+}
+func (m *EvmChainParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmChainParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmChainParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmChainParams proto.InternalMessageInfo
+
+func (m *EvmChainParams) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *EvmChainParams) GetEvmChainName() string {
+	if m != nil {
+		return m.EvmChainName
+	}
+	return ""
+}
+
+func (m *EvmChainParams) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
+	}
+	return ""
+}
+
+func (m *EvmChainParams) GetBridgeEthereumAddress() string {
+	if m != nil {
+		return m.BridgeEthereumAddress
+	}
+	return ""
+}
+
+func (m *EvmChainParams) GetBridgeChainId() uint64 {
+	if m != nil {
+		return m.BridgeChainId
+	}
+	return 0
+}
+
+func (m *EvmChainParams) GetAverageEthereumBlockTime() uint64 {
+	if m != nil {
+		return m.AverageEthereumBlockTime
+	}
+	return 0
+}
+
+func (m *EvmChainParams) GetBridgeActive() bool {
+	if m != nil {
+		return m.BridgeActive
+	}
+	return false
+}
+
+func (m *EvmChainParams) GetEthereumBlacklist() []string {
+	if m != nil {
+		return m.EthereumBlacklist
+	}
+	return nil
+}
+
 // GenesisState struct, containing all persistant data required by the Gravity module
 type GenesisState struct {
-	Params                 *Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	GravityNonces          GravityNonces               `protobuf:"bytes,2,opt,name=gravity_nonces,json=gravityNonces,proto3" json:"gravity_nonces"`
-	Valsets                []Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
-	ValsetConfirms         []MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
-	Batches                []OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
-	BatchConfirms          []MsgConfirmBatch           `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	LogicCalls             []OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
-	LogicCallConfirms      []MsgConfirmLogicCall       `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
-	Attestations           []Attestation               `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
-	DelegateKeys           []MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
-	Erc20ToDenoms          []ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
-	UnbatchedTransfers     []OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
-	PendingIbcAutoForwards []PendingIbcAutoForward     `protobuf:"bytes,13,rep,name=pending_ibc_auto_forwards,json=pendingIbcAutoForwards,proto3" json:"pending_ibc_auto_forwards"`
+	Params                   *Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	GravityNonces            GravityNonces               `protobuf:"bytes,2,opt,name=gravity_nonces,json=gravityNonces,proto3" json:"gravity_nonces"`
+	Valsets                  []Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
+	ValsetConfirms           []MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
+	Batches                  []OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
+	BatchConfirms            []MsgConfirmBatch           `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls               []OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
+	LogicCallConfirms        []MsgConfirmLogicCall       `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations             []Attestation               `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	DelegateKeys             []MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
+	Erc20ToDenoms            []ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
+	UnbatchedTransfers       []OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
+	PendingIbcAutoForwards   []PendingIbcAutoForward     `protobuf:"bytes,13,rep,name=pending_ibc_auto_forwards,json=pendingIbcAutoForwards,proto3" json:"pending_ibc_auto_forwards"`
+	Erc20Migrations          []ERC20Migration            `protobuf:"bytes,14,rep,name=erc20_migrations,json=erc20Migrations,proto3" json:"erc20_migrations"`
+	AttestedErc20Deployments []AttestedERC20Deployment   `protobuf:"bytes,15,rep,name=attested_erc20_deployments,json=attestedErc20Deployments,proto3" json:"attested_erc20_deployments"`
+	MerkleAirdrops           []MerkleAirdrop             `protobuf:"bytes,16,rep,name=merkle_airdrops,json=merkleAirdrops,proto3" json:"merkle_airdrops"`
+	MerkleAirdropClaims      []MerkleAirdropClaim        `protobuf:"bytes,17,rep,name=merkle_airdrop_claims,json=merkleAirdropClaims,proto3" json:"merkle_airdrop_claims"`
+	// the state of the EVM chains in Params.evm_chains, the fields above hold the state of the default chain
+	EvmChains                []EvmChainData               `protobuf:"bytes,18,rep,name=evm_chains,json=evmChains,proto3" json:"evm_chains"`
+	BridgeOffences           []BridgeOffences             `protobuf:"bytes,19,rep,name=bridge_offences,json=bridgeOffences,proto3" json:"bridge_offences"`
+	BridgePerformance        []ValidatorBridgePerformance `protobuf:"bytes,20,rep,name=bridge_performance,json=bridgePerformance,proto3" json:"bridge_performance"`
+	OutgoingTxCreatedHeights []OutgoingTxCreatedHeight    `protobuf:"bytes,21,rep,name=outgoing_tx_created_heights,json=outgoingTxCreatedHeights,proto3" json:"outgoing_tx_created_heights"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{1}
+	return fileDescriptor_387b0aba880adb60, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,40 +630,95 @@ func (m *GenesisState) GetPendingIbcAutoForwards() []PendingIbcAutoForward {
 	return nil
 }
 
-// GravityCounters contains the many noces and counters required to maintain the bridge state in the genesis
-type GravityNonces struct {
-	// the nonce of the last generated validator set
-	LatestValsetNonce uint64 `protobuf:"varint,1,opt,name=latest_valset_nonce,json=latestValsetNonce,proto3" json:"latest_valset_nonce,omitempty"`
-	// the last observed Gravity.sol contract event nonce
-	LastObservedNonce uint64 `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
-	// the last valset nonce we have slashed, to prevent double slashing
-	LastSlashedValsetNonce uint64 `protobuf:"varint,3,opt,name=last_slashed_valset_nonce,json=lastSlashedValsetNonce,proto3" json:"last_slashed_valset_nonce,omitempty"`
-	// the last batch Cosmos chain block that batch slashing has completed for
-	// there is an individual batch nonce for each token type so this removes
-	// the need to store them all
-	LastSlashedBatchBlock uint64 `protobuf:"varint,4,opt,name=last_slashed_batch_block,json=lastSlashedBatchBlock,proto3" json:"last_slashed_batch_block,omitempty"`
-	// the last cosmos block that logic call slashing has completed for
-	LastSlashedLogicCallBlock uint64 `protobuf:"varint,5,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
-	// the last transaction id from the Gravity TX pool, this prevents ID
-	// duplication during chain upgrades
-	LastTxPoolId uint64 `protobuf:"varint,6,opt,name=last_tx_pool_id,json=lastTxPoolId,proto3" json:"last_tx_pool_id,omitempty"`
-	// the last batch id from the Gravity batch pool, this prevents ID duplication
-	// during chain upgrades
-	LastBatchId uint64 `protobuf:"varint,7,opt,name=last_batch_id,json=lastBatchId,proto3" json:"last_batch_id,omitempty"`
+func (m *GenesisState) GetErc20Migrations() []ERC20Migration {
+	if m != nil {
+		return m.Erc20Migrations
+	}
+	return nil
 }
 
-func (m *GravityNonces) Reset()         { *m = GravityNonces{} }
-func (m *GravityNonces) String() string { return proto.CompactTextString(m) }
-func (*GravityNonces) ProtoMessage()    {}
-func (*GravityNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{2}
+func (m *GenesisState) GetAttestedErc20Deployments() []AttestedERC20Deployment {
+	if m != nil {
+		return m.AttestedErc20Deployments
+	}
+	return nil
 }
-func (m *GravityNonces) XXX_Unmarshal(b []byte) error {
+
+func (m *GenesisState) GetMerkleAirdrops() []MerkleAirdrop {
+	if m != nil {
+		return m.MerkleAirdrops
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleAirdropClaims() []MerkleAirdropClaim {
+	if m != nil {
+		return m.MerkleAirdropClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEvmChains() []EvmChainData {
+	if m != nil {
+		return m.EvmChains
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeOffences() []BridgeOffences {
+	if m != nil {
+		return m.BridgeOffences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgePerformance() []ValidatorBridgePerformance {
+	if m != nil {
+		return m.BridgePerformance
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutgoingTxCreatedHeights() []OutgoingTxCreatedHeight {
+	if m != nil {
+		return m.OutgoingTxCreatedHeights
+	}
+	return nil
+}
+
+// EvmChainData contains the bridge state of a single EVM chain other than the default chain, the
+// global counters in gravity_nonces (last_tx_pool_id, last_batch_id and last_merkle_airdrop_id) are
+// shared by every chain and only read from GenesisState.gravity_nonces
+type EvmChainData struct {
+	EvmChainPrefix           string                    `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	GravityNonces            GravityNonces             `protobuf:"bytes,2,opt,name=gravity_nonces,json=gravityNonces,proto3" json:"gravity_nonces"`
+	Valsets                  []Valset                  `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
+	ValsetConfirms           []MsgValsetConfirm        `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
+	Batches                  []OutgoingTxBatch         `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
+	BatchConfirms            []MsgConfirmBatch         `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls               []OutgoingLogicCall       `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
+	LogicCallConfirms        []MsgConfirmLogicCall     `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations             []Attestation             `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	Erc20ToDenoms            []ERC20ToDenom            `protobuf:"bytes,10,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
+	UnbatchedTransfers       []OutgoingTransferTx      `protobuf:"bytes,11,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
+	PendingIbcAutoForwards   []PendingIbcAutoForward   `protobuf:"bytes,12,rep,name=pending_ibc_auto_forwards,json=pendingIbcAutoForwards,proto3" json:"pending_ibc_auto_forwards"`
+	Erc20Migrations          []ERC20Migration          `protobuf:"bytes,13,rep,name=erc20_migrations,json=erc20Migrations,proto3" json:"erc20_migrations"`
+	AttestedErc20Deployments []AttestedERC20Deployment `protobuf:"bytes,14,rep,name=attested_erc20_deployments,json=attestedErc20Deployments,proto3" json:"attested_erc20_deployments"`
+	OutgoingTxCreatedHeights []OutgoingTxCreatedHeight `protobuf:"bytes,15,rep,name=outgoing_tx_created_heights,json=outgoingTxCreatedHeights,proto3" json:"outgoing_tx_created_heights"`
+}
+
+func (m *EvmChainData) Reset()         { *m = EvmChainData{} }
+func (m *EvmChainData) String() string { return proto.CompactTextString(m) }
+func (*EvmChainData) ProtoMessage()    {}
+func (*EvmChainData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+func (m *EvmChainData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GravityNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EvmChainData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GravityNonces.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EvmChainData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -412,157 +728,428 @@ func (m *GravityNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *GravityNonces) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GravityNonces.Merge(m, src)
+func (m *EvmChainData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmChainData.Merge(m, src)
 }
-func (m *GravityNonces) XXX_Size() int {
+func (m *EvmChainData) XXX_Size() int {
 	return m.Size()
 }
-func (m *GravityNonces) XXX_DiscardUnknown() {
-	xxx_messageInfo_GravityNonces.DiscardUnknown(m)
+func (m *EvmChainData) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmChainData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GravityNonces proto.InternalMessageInfo
+var xxx_messageInfo_EvmChainData proto.InternalMessageInfo
 
-func (m *GravityNonces) GetLatestValsetNonce() uint64 {
+func (m *EvmChainData) GetEvmChainPrefix() string {
 	if m != nil {
-		return m.LatestValsetNonce
+		return m.EvmChainPrefix
 	}
-	return 0
+	return ""
 }
 
-func (m *GravityNonces) GetLastObservedNonce() uint64 {
+func (m *EvmChainData) GetGravityNonces() GravityNonces {
 	if m != nil {
-		return m.LastObservedNonce
+		return m.GravityNonces
 	}
-	return 0
+	return GravityNonces{}
 }
 
-func (m *GravityNonces) GetLastSlashedValsetNonce() uint64 {
+func (m *EvmChainData) GetValsets() []Valset {
 	if m != nil {
-		return m.LastSlashedValsetNonce
+		return m.Valsets
 	}
-	return 0
+	return nil
 }
 
-func (m *GravityNonces) GetLastSlashedBatchBlock() uint64 {
+func (m *EvmChainData) GetValsetConfirms() []MsgValsetConfirm {
 	if m != nil {
-		return m.LastSlashedBatchBlock
+		return m.ValsetConfirms
 	}
-	return 0
+	return nil
 }
 
-func (m *GravityNonces) GetLastSlashedLogicCallBlock() uint64 {
+func (m *EvmChainData) GetBatches() []OutgoingTxBatch {
 	if m != nil {
-		return m.LastSlashedLogicCallBlock
+		return m.Batches
 	}
-	return 0
+	return nil
 }
 
-func (m *GravityNonces) GetLastTxPoolId() uint64 {
+func (m *EvmChainData) GetBatchConfirms() []MsgConfirmBatch {
 	if m != nil {
-		return m.LastTxPoolId
+		return m.BatchConfirms
 	}
-	return 0
+	return nil
 }
 
-func (m *GravityNonces) GetLastBatchId() uint64 {
+func (m *EvmChainData) GetLogicCalls() []OutgoingLogicCall {
 	if m != nil {
-		return m.LastBatchId
+		return m.LogicCalls
 	}
-	return 0
+	return nil
+}
+
+func (m *EvmChainData) GetLogicCallConfirms() []MsgConfirmLogicCall {
+	if m != nil {
+		return m.LogicCallConfirms
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetErc20ToDenoms() []ERC20ToDenom {
+	if m != nil {
+		return m.Erc20ToDenoms
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetUnbatchedTransfers() []OutgoingTransferTx {
+	if m != nil {
+		return m.UnbatchedTransfers
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetPendingIbcAutoForwards() []PendingIbcAutoForward {
+	if m != nil {
+		return m.PendingIbcAutoForwards
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetErc20Migrations() []ERC20Migration {
+	if m != nil {
+		return m.Erc20Migrations
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetAttestedErc20Deployments() []AttestedERC20Deployment {
+	if m != nil {
+		return m.AttestedErc20Deployments
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetOutgoingTxCreatedHeights() []OutgoingTxCreatedHeight {
+	if m != nil {
+		return m.OutgoingTxCreatedHeights
+	}
+	return nil
+}
+
+// OutgoingTxCreatedHeight is the cosmos block height a transfer was added to the outgoing tx pool at, kept until the
+// transfer is refunded or executed on the EVM chain
+type OutgoingTxCreatedHeight struct {
+	TxId   uint64 `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *OutgoingTxCreatedHeight) Reset()         { *m = OutgoingTxCreatedHeight{} }
+func (m *OutgoingTxCreatedHeight) String() string { return proto.CompactTextString(m) }
+func (*OutgoingTxCreatedHeight) ProtoMessage()    {}
+func (*OutgoingTxCreatedHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{5}
+}
+func (m *OutgoingTxCreatedHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingTxCreatedHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingTxCreatedHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingTxCreatedHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingTxCreatedHeight.Merge(m, src)
+}
+func (m *OutgoingTxCreatedHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingTxCreatedHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingTxCreatedHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingTxCreatedHeight proto.InternalMessageInfo
+
+func (m *OutgoingTxCreatedHeight) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *OutgoingTxCreatedHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GravityCounters contains the many noces and counters required to maintain the bridge state in the genesis
+type GravityNonces struct {
+	// the nonce of the last generated validator set
+	LatestValsetNonce uint64 `protobuf:"varint,1,opt,name=latest_valset_nonce,json=latestValsetNonce,proto3" json:"latest_valset_nonce,omitempty"`
+	// the last observed Gravity.sol contract event nonce
+	LastObservedNonce uint64 `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
+	// the last valset nonce we have slashed, to prevent double slashing
+	LastSlashedValsetNonce uint64 `protobuf:"varint,3,opt,name=last_slashed_valset_nonce,json=lastSlashedValsetNonce,proto3" json:"last_slashed_valset_nonce,omitempty"`
+	// the last batch Cosmos chain block that batch slashing has completed for
+	// there is an individual batch nonce for each token type so this removes
+	// the need to store them all
+	LastSlashedBatchBlock uint64 `protobuf:"varint,4,opt,name=last_slashed_batch_block,json=lastSlashedBatchBlock,proto3" json:"last_slashed_batch_block,omitempty"`
+	// the last cosmos block that logic call slashing has completed for
+	LastSlashedLogicCallBlock uint64 `protobuf:"varint,5,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
+	// the last transaction id from the Gravity TX pool, this prevents ID
+	// duplication during chain upgrades
+	LastTxPoolId uint64 `protobuf:"varint,6,opt,name=last_tx_pool_id,json=lastTxPoolId,proto3" json:"last_tx_pool_id,omitempty"`
+	// the last batch id from the Gravity batch pool, this prevents ID duplication
+	// during chain upgrades
+	LastBatchId uint64 `protobuf:"varint,7,opt,name=last_batch_id,json=lastBatchId,proto3" json:"last_batch_id,omitempty"`
+	// the last merkle airdrop id, this prevents ID duplication during chain upgrades
+	LastMerkleAirdropId uint64 `protobuf:"varint,8,opt,name=last_merkle_airdrop_id,json=lastMerkleAirdropId,proto3" json:"last_merkle_airdrop_id,omitempty"`
+}
+
+func (m *GravityNonces) Reset()         { *m = GravityNonces{} }
+func (m *GravityNonces) String() string { return proto.CompactTextString(m) }
+func (*GravityNonces) ProtoMessage()    {}
+func (*GravityNonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{6}
+}
+func (m *GravityNonces) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GravityNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GravityNonces.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GravityNonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GravityNonces.Merge(m, src)
+}
+func (m *GravityNonces) XXX_Size() int {
+	return m.Size()
+}
+func (m *GravityNonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_GravityNonces.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GravityNonces proto.InternalMessageInfo
+
+func (m *GravityNonces) GetLatestValsetNonce() uint64 {
+	if m != nil {
+		return m.LatestValsetNonce
+	}
+	return 0
+}
+
+func (m *GravityNonces) GetLastObservedNonce() uint64 {
+	if m != nil {
+		return m.LastObservedNonce
+	}
+	return 0
+}
+
+func (m *GravityNonces) GetLastSlashedValsetNonce() uint64 {
+	if m != nil {
+		return m.LastSlashedValsetNonce
+	}
+	return 0
+}
+
+func (m *GravityNonces) GetLastSlashedBatchBlock() uint64 {
+	if m != nil {
+		return m.LastSlashedBatchBlock
+	}
+	return 0
+}
+
+func (m *GravityNonces) GetLastSlashedLogicCallBlock() uint64 {
+	if m != nil {
+		return m.LastSlashedLogicCallBlock
+	}
+	return 0
+}
+
+func (m *GravityNonces) GetLastTxPoolId() uint64 {
+	if m != nil {
+		return m.LastTxPoolId
+	}
+	return 0
+}
+
+func (m *GravityNonces) GetLastBatchId() uint64 {
+	if m != nil {
+		return m.LastBatchId
+	}
+	return 0
+}
+
+func (m *GravityNonces) GetLastMerkleAirdropId() uint64 {
+	if m != nil {
+		return m.LastMerkleAirdropId
+	}
+	return 0
 }
 
 func init() {
 	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*TokenGasParams)(nil), "gravity.v1.TokenGasParams")
+	proto.RegisterType((*EvmChainParams)(nil), "gravity.v1.EvmChainParams")
 	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*EvmChainData)(nil), "gravity.v1.EvmChainData")
+	proto.RegisterType((*OutgoingTxCreatedHeight)(nil), "gravity.v1.OutgoingTxCreatedHeight")
 	proto.RegisterType((*GravityNonces)(nil), "gravity.v1.GravityNonces")
 }
 
 func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
 
 var fileDescriptor_387b0aba880adb60 = []byte{
-	// 1278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
-	0x14, 0xc7, 0x81, 0x40, 0x18, 0x6c, 0x08, 0xc3, 0x9f, 0x0c, 0x90, 0x18, 0x97, 0x2a, 0x11, 0xaa,
-	0x1a, 0x1b, 0xa8, 0xd4, 0x2a, 0xa9, 0xaa, 0xd6, 0x36, 0x21, 0x41, 0x69, 0x1a, 0x64, 0xd3, 0x56,
-	0xed, 0x65, 0x3a, 0xbb, 0x3b, 0xac, 0x47, 0xac, 0x77, 0xac, 0x9d, 0x59, 0x07, 0x6e, 0xfd, 0x08,
-	0xfd, 0x3e, 0x3d, 0xf4, 0x9a, 0x63, 0x8e, 0x55, 0x55, 0x45, 0x55, 0xf2, 0x05, 0xfa, 0x11, 0xaa,
-	0x79, 0x33, 0xbb, 0x5e, 0x3b, 0x5c, 0xca, 0x09, 0xf3, 0x7e, 0x7f, 0xe6, 0xe9, 0xcd, 0x7b, 0x6f,
-	0x07, 0x91, 0x30, 0x61, 0x43, 0xa1, 0x2f, 0x1b, 0xc3, 0xfd, 0x46, 0xc8, 0x63, 0xae, 0x84, 0xaa,
-	0x0f, 0x12, 0xa9, 0x25, 0x46, 0x0e, 0xa9, 0x0f, 0xf7, 0x37, 0x57, 0x43, 0x19, 0x4a, 0x08, 0x37,
-	0xcc, 0x2f, 0xcb, 0xd8, 0x5c, 0x2f, 0x68, 0xf5, 0xe5, 0x80, 0x3b, 0xe5, 0xe6, 0x5a, 0x21, 0xde,
-	0x57, 0xa1, 0xba, 0x82, 0xee, 0x31, 0xed, 0xf7, 0x5c, 0xfc, 0x6e, 0x21, 0xce, 0xb4, 0xe6, 0x4a,
-	0x33, 0x2d, 0x64, 0xec, 0xd0, 0xaa, 0x2f, 0x55, 0x5f, 0xaa, 0x86, 0xc7, 0x14, 0x6f, 0x0c, 0xf7,
-	0x3d, 0xae, 0xd9, 0x7e, 0xc3, 0x97, 0xc2, 0xe1, 0x3b, 0xbf, 0x23, 0x34, 0x7b, 0xc2, 0x12, 0xd6,
-	0x57, 0xf8, 0x1e, 0xca, 0x72, 0xa6, 0x22, 0x20, 0xa5, 0x5a, 0x69, 0x77, 0xbe, 0x33, 0xef, 0x22,
-	0xc7, 0x01, 0xde, 0x43, 0xab, 0xbe, 0x8c, 0x75, 0xc2, 0x7c, 0x4d, 0x95, 0x4c, 0x13, 0x9f, 0xd3,
-	0x1e, 0x53, 0x3d, 0x72, 0x03, 0x88, 0x38, 0xc3, 0xba, 0x00, 0x3d, 0x63, 0xaa, 0x87, 0x3f, 0x47,
-	0x77, 0xbc, 0x44, 0x04, 0x21, 0xa7, 0x5c, 0xf7, 0x78, 0xc2, 0xd3, 0x3e, 0x65, 0x41, 0x90, 0x70,
-	0xa5, 0xc8, 0x0c, 0x88, 0xd6, 0x2c, 0xfc, 0xc4, 0xa1, 0x4d, 0x0b, 0xe2, 0x07, 0x68, 0xc9, 0xe9,
-	0xfc, 0x1e, 0x13, 0xb1, 0xc9, 0xe6, 0x66, 0xad, 0xb4, 0x3b, 0xd3, 0xa9, 0xd8, 0x70, 0xdb, 0x44,
-	0x8f, 0x03, 0x7c, 0x80, 0xd6, 0x94, 0x08, 0x63, 0x1e, 0xd0, 0x21, 0x8b, 0x14, 0xd7, 0x8a, 0xbe,
-	0x12, 0x71, 0x20, 0x5f, 0x91, 0x59, 0x60, 0xaf, 0x58, 0xf0, 0x07, 0x8b, 0xfd, 0x08, 0x50, 0x41,
-	0x03, 0x35, 0xe4, 0xb9, 0x66, 0xae, 0xa8, 0x69, 0x59, 0xcc, 0x69, 0x1e, 0xa1, 0x0d, 0xa7, 0x89,
-	0x64, 0x28, 0x7c, 0xea, 0xb3, 0x28, 0xca, 0x75, 0xb7, 0x40, 0xb7, 0x6e, 0x09, 0xdf, 0x1a, 0xbc,
-	0x6d, 0x60, 0x27, 0xdd, 0x43, 0xab, 0x9a, 0x25, 0x21, 0xd7, 0xf6, 0x38, 0xaa, 0x45, 0x9f, 0xcb,
-	0x54, 0x93, 0x79, 0x50, 0x61, 0x8b, 0xc1, 0x69, 0xa7, 0x16, 0xc1, 0x9f, 0x22, 0xcc, 0x86, 0x3c,
-	0x61, 0x21, 0xa7, 0x5e, 0x24, 0xfd, 0x73, 0x90, 0x10, 0x04, 0xfc, 0xdb, 0x0e, 0x69, 0x19, 0xc0,
-	0x08, 0xf0, 0x57, 0x68, 0x2b, 0x63, 0xe7, 0x35, 0x2e, 0xc8, 0x16, 0x40, 0x46, 0x1c, 0x25, 0xab,
-	0xf3, 0x48, 0xee, 0xa1, 0x35, 0x15, 0x31, 0xd5, 0xa3, 0x67, 0xe6, 0xea, 0x84, 0x8c, 0x5d, 0x25,
-	0x49, 0xb9, 0x56, 0xda, 0x2d, 0xb7, 0xea, 0xaf, 0xdf, 0x6e, 0x4f, 0xfd, 0xf5, 0x76, 0xfb, 0x41,
-	0x28, 0x74, 0x2f, 0xf5, 0xea, 0xbe, 0xec, 0x37, 0x5c, 0x3f, 0xd9, 0x3f, 0x0f, 0x55, 0x70, 0xee,
-	0x7a, 0xf7, 0x90, 0xfb, 0x9d, 0x15, 0x30, 0x3b, 0x72, 0x5e, 0xb6, 0xf0, 0xf8, 0x17, 0xb4, 0x3a,
-	0x71, 0x06, 0x94, 0x82, 0x54, 0xae, 0x75, 0x04, 0x1e, 0x3b, 0x02, 0x2a, 0x87, 0x05, 0xda, 0x98,
-	0x38, 0x61, 0x74, 0x4f, 0x64, 0xf1, 0x5a, 0xc7, 0xac, 0x8f, 0x1d, 0x93, 0x5f, 0x2b, 0x6e, 0xa3,
-	0x6a, 0x1a, 0x7b, 0x32, 0x0e, 0x28, 0x10, 0x44, 0x1c, 0x4e, 0xf6, 0xde, 0x12, 0x94, 0x7c, 0xcb,
-	0xb2, 0xba, 0x8e, 0x34, 0xde, 0x83, 0x43, 0x54, 0xfb, 0xa0, 0x22, 0x81, 0xb9, 0x3f, 0x6a, 0xba,
-	0x88, 0xe9, 0x34, 0xe1, 0xe4, 0xf6, 0xb5, 0xd2, 0xbe, 0x3b, 0x51, 0x9d, 0xe0, 0x89, 0xee, 0x75,
-	0x33, 0x4f, 0x7c, 0x88, 0x2a, 0x36, 0x59, 0x9a, 0xf0, 0x57, 0x2c, 0x09, 0xc8, 0x72, 0xad, 0xb4,
-	0xbb, 0x70, 0xb0, 0x51, 0xb7, 0x5e, 0x75, 0xb3, 0x23, 0xea, 0x6e, 0x47, 0xd4, 0xdb, 0x52, 0xc4,
-	0xad, 0x19, 0x73, 0x7e, 0xa7, 0x6c, 0x55, 0x1d, 0x10, 0xe1, 0x8f, 0x91, 0x1b, 0x43, 0x6a, 0x4e,
-	0x19, 0x72, 0x82, 0x6b, 0xa5, 0xdd, 0x5b, 0x9d, 0xb2, 0x0d, 0x36, 0x21, 0x86, 0x1f, 0x22, 0x5c,
-	0xe8, 0x47, 0xe6, 0x9f, 0x47, 0x42, 0x69, 0xb2, 0x52, 0x9b, 0xde, 0x9d, 0xef, 0x2c, 0xf3, 0xbc,
-	0x0f, 0x1d, 0x80, 0x1f, 0xa3, 0xcd, 0xbe, 0x88, 0xdd, 0xb8, 0x9f, 0x71, 0x4e, 0x3d, 0xa6, 0x84,
-	0xa2, 0x03, 0x29, 0x62, 0xad, 0xc8, 0xaa, 0x1d, 0xb1, 0xbe, 0x88, 0x61, 0xf2, 0x8f, 0x38, 0x6f,
-	0x19, 0xf8, 0x04, 0x50, 0xac, 0xd1, 0xf6, 0x48, 0xc7, 0x52, 0x5b, 0xd0, 0x81, 0x94, 0x51, 0x5e,
-	0x5e, 0xb2, 0x66, 0xb6, 0xcd, 0xff, 0x2e, 0xe6, 0x96, 0xef, 0x4e, 0x6b, 0x5a, 0xd3, 0x13, 0x29,
-	0xa3, 0xac, 0xb4, 0x8f, 0x67, 0x7e, 0xfd, 0xbb, 0x36, 0xb5, 0xf3, 0xc7, 0x1c, 0x2a, 0x3f, 0xb5,
-	0x6b, 0xbf, 0xab, 0x99, 0xe6, 0xf8, 0x13, 0x34, 0x3b, 0x80, 0x6d, 0x0a, 0xfb, 0x73, 0xe1, 0x00,
-	0xd7, 0x47, 0x9f, 0x81, 0xba, 0xdd, 0xb3, 0x1d, 0xc7, 0xc0, 0x47, 0x68, 0x31, 0xdb, 0xb7, 0xb1,
-	0x8c, 0x7d, 0xae, 0x60, 0x95, 0x9a, 0xfb, 0x28, 0x68, 0x9e, 0xda, 0x9f, 0xdf, 0x01, 0xc1, 0xdd,
-	0x47, 0x25, 0x2c, 0x06, 0xf1, 0x01, 0x9a, 0x73, 0x3d, 0x48, 0xa6, 0x6b, 0xd3, 0x93, 0x87, 0xda,
-	0xd6, 0x73, 0xca, 0x8c, 0x88, 0x9f, 0xa3, 0x25, 0xd7, 0x0a, 0xbe, 0x8c, 0xcf, 0x44, 0xd2, 0x37,
-	0x2b, 0xd9, 0x68, 0xef, 0x16, 0xb5, 0x2f, 0x94, 0xeb, 0xdc, 0xb6, 0x25, 0x39, 0x97, 0xc5, 0x61,
-	0x31, 0xa8, 0xf0, 0x97, 0x68, 0xce, 0x2d, 0x53, 0x72, 0x13, 0x4c, 0xb6, 0x8a, 0x26, 0x2f, 0x53,
-	0x1d, 0x4a, 0x11, 0x87, 0xa7, 0x17, 0x30, 0xad, 0x59, 0x26, 0x4e, 0x81, 0x9f, 0xa1, 0x45, 0xbb,
-	0x1a, 0xf3, 0x44, 0x66, 0x3f, 0xf4, 0x78, 0xa1, 0xc2, 0x2c, 0x85, 0x82, 0x47, 0x05, 0x84, 0x79,
-	0x1a, 0x87, 0x68, 0xa1, 0xb0, 0x9f, 0xc9, 0x1c, 0xd8, 0xdc, 0xbb, 0x2a, 0x95, 0x7c, 0x9e, 0x9d,
-	0x11, 0x8a, 0xf2, 0xbd, 0x8d, 0xbf, 0x47, 0x2b, 0x23, 0x97, 0x51, 0x52, 0xb7, 0xc0, 0x6d, 0xfb,
-	0xea, 0xa4, 0x26, 0xfd, 0x96, 0x73, 0xbf, 0x3c, 0xb9, 0x26, 0x2a, 0x17, 0x3e, 0xce, 0x8a, 0xcc,
-	0x83, 0xdf, 0x9d, 0xa2, 0x5f, 0x73, 0x84, 0x67, 0x83, 0x57, 0x94, 0xe0, 0x13, 0x54, 0x09, 0x78,
-	0xc4, 0x43, 0xa6, 0x39, 0x3d, 0xe7, 0x97, 0x8a, 0x20, 0xf0, 0xb8, 0x3f, 0x91, 0x53, 0x97, 0xeb,
-	0x97, 0x89, 0x29, 0xad, 0x4e, 0x98, 0x96, 0x89, 0xfb, 0xa8, 0x66, 0x8e, 0x99, 0xc3, 0x73, 0x7e,
-	0x69, 0x3a, 0x70, 0x89, 0x27, 0xfe, 0xc1, 0x1e, 0xd5, 0x92, 0x06, 0x3c, 0x96, 0x7d, 0x45, 0x16,
-	0xc0, 0x93, 0x14, 0x3d, 0x9f, 0x74, 0xda, 0x07, 0x7b, 0xa7, 0xf2, 0xd0, 0x10, 0xb2, 0xca, 0x83,
-	0xcc, 0xc5, 0xa0, 0x66, 0x69, 0x6c, 0x2f, 0x34, 0xa0, 0x3a, 0x61, 0xb1, 0x3a, 0xe3, 0x89, 0x22,
-	0x65, 0xf0, 0xaa, 0x5e, 0xd9, 0x0c, 0x8e, 0x74, 0x7a, 0xe1, 0x1c, 0x71, 0x6e, 0x90, 0x41, 0x0a,
-	0x7b, 0x68, 0x63, 0xc0, 0xe3, 0xc0, 0x2c, 0x59, 0xe1, 0xf9, 0x94, 0xa5, 0x5a, 0xd2, 0x33, 0x99,
-	0x98, 0x2d, 0xa4, 0x48, 0x05, 0xcc, 0x3f, 0x1a, 0x9b, 0x2f, 0x4b, 0x3e, 0xf6, 0xfc, 0x66, 0xaa,
-	0xe5, 0x91, 0x65, 0x3a, 0xff, 0xf5, 0xc1, 0x55, 0xa0, 0xda, 0xf9, 0xf7, 0x06, 0xaa, 0x8c, 0xcd,
-	0x18, 0xae, 0xa3, 0x95, 0x88, 0x99, 0xb2, 0xbb, 0xcd, 0x6e, 0x87, 0x13, 0xe6, 0x79, 0xa6, 0xb3,
-	0x6c, 0x21, 0x3b, 0x15, 0x20, 0xb0, 0x7c, 0xa5, 0xa9, 0xf4, 0x14, 0x4f, 0x86, 0x3c, 0x70, 0xfc,
-	0x1b, 0x19, 0x5f, 0xe9, 0x97, 0x0e, 0xb1, 0xfc, 0x47, 0x68, 0x03, 0xf8, 0xb0, 0xaa, 0xf3, 0xb7,
-	0x8b, 0x53, 0x4d, 0xdb, 0x55, 0x67, 0x08, 0x5d, 0x8b, 0x17, 0x8f, 0xfa, 0x02, 0x91, 0x31, 0xa9,
-	0x1d, 0x1c, 0xf8, 0xde, 0xc3, 0x8b, 0x6a, 0xa6, 0xb3, 0x56, 0x50, 0xda, 0x51, 0x31, 0x20, 0xfe,
-	0x06, 0xdd, 0x1b, 0x13, 0x16, 0x3a, 0xdc, 0xaa, 0xed, 0xfb, 0x6a, 0xa3, 0xa0, 0x1e, 0xf5, 0x34,
-	0x38, 0xdc, 0x47, 0x4b, 0xe0, 0xa0, 0x2f, 0xec, 0x6e, 0x15, 0x81, 0x7b, 0x65, 0x95, 0x4d, 0xf8,
-	0xf4, 0xc2, 0x2c, 0xc7, 0xe3, 0x00, 0xef, 0xa0, 0x0a, 0xd0, 0x6c, 0x66, 0x22, 0x70, 0xcf, 0xaa,
-	0x05, 0x13, 0x84, 0x7c, 0x8e, 0x83, 0xd6, 0x4f, 0xaf, 0xdf, 0x55, 0x4b, 0x6f, 0xde, 0x55, 0x4b,
-	0xff, 0xbc, 0xab, 0x96, 0x7e, 0x7b, 0x5f, 0x9d, 0x7a, 0xf3, 0xbe, 0x3a, 0xf5, 0xe7, 0xfb, 0xea,
-	0xd4, 0xcf, 0x5f, 0x17, 0x36, 0xb3, 0xbb, 0x94, 0x87, 0x2d, 0xf8, 0xac, 0x4c, 0xfe, 0xdb, 0x97,
-	0x41, 0x1a, 0xf1, 0xc6, 0x45, 0x23, 0x7b, 0xfc, 0xc2, 0xda, 0xf6, 0x66, 0xe1, 0x51, 0xfb, 0xd9,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x82, 0xbc, 0x91, 0xac, 0x97, 0x0b, 0x00, 0x00,
+	// 1979 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x73, 0x5b, 0xb7,
+	0x11, 0x37, 0x2d, 0x59, 0xb6, 0x20, 0x92, 0x92, 0xa0, 0x7f, 0x90, 0x64, 0xd3, 0xac, 0x12, 0x7b,
+	0x34, 0x9d, 0x9a, 0xb2, 0x95, 0x69, 0x3b, 0x49, 0x27, 0x4d, 0x25, 0x4a, 0xb2, 0x95, 0x54, 0x91,
+	0x86, 0x52, 0xff, 0x1f, 0x50, 0xf0, 0x3d, 0xf0, 0x11, 0xd5, 0x7b, 0x0f, 0x9c, 0x07, 0x90, 0xa6,
+	0x6e, 0xbd, 0xf7, 0xd2, 0x8f, 0xd0, 0x4b, 0xcf, 0xfd, 0x1a, 0x39, 0xe6, 0xd8, 0xe9, 0x74, 0x32,
+	0x1d, 0xfb, 0xd6, 0x63, 0x3f, 0x41, 0x07, 0xc0, 0x82, 0x7c, 0x24, 0x95, 0x71, 0xac, 0xb1, 0x6f,
+	0x39, 0x89, 0xda, 0xfd, 0xed, 0x0f, 0xfb, 0x76, 0x17, 0xbb, 0x00, 0x10, 0x89, 0x32, 0xd6, 0x13,
+	0xfa, 0x6a, 0xa7, 0xf7, 0x6c, 0x27, 0xe2, 0x29, 0x57, 0x42, 0xd5, 0x3a, 0x99, 0xd4, 0x12, 0x23,
+	0xd0, 0xd4, 0x7a, 0xcf, 0x36, 0x96, 0x23, 0x19, 0x49, 0x2b, 0xde, 0x31, 0xbf, 0x1c, 0x62, 0x63,
+	0x35, 0x67, 0xab, 0xaf, 0x3a, 0x1c, 0x2c, 0x37, 0x56, 0x72, 0xf2, 0x44, 0x45, 0xea, 0x1a, 0x78,
+	0x93, 0xe9, 0xa0, 0x0d, 0xf2, 0xfb, 0x39, 0x39, 0xd3, 0x9a, 0x2b, 0xcd, 0xb4, 0x90, 0x29, 0x68,
+	0x2b, 0x81, 0x54, 0x89, 0x54, 0x3b, 0x4d, 0xa6, 0xf8, 0x4e, 0xef, 0x59, 0x93, 0x6b, 0xf6, 0x6c,
+	0x27, 0x90, 0x02, 0xf4, 0x5b, 0x7f, 0x9b, 0x47, 0x33, 0x67, 0x2c, 0x63, 0x89, 0xc2, 0x0f, 0x90,
+	0xf7, 0x99, 0x8a, 0x90, 0x14, 0xaa, 0x85, 0xed, 0xd9, 0xc6, 0x2c, 0x48, 0x8e, 0x43, 0xfc, 0x14,
+	0x2d, 0x07, 0x32, 0xd5, 0x19, 0x0b, 0x34, 0x55, 0xb2, 0x9b, 0x05, 0x9c, 0xb6, 0x99, 0x6a, 0x93,
+	0xdb, 0x16, 0x88, 0xbd, 0xee, 0xdc, 0xaa, 0x5e, 0x30, 0xd5, 0xc6, 0x3f, 0x41, 0x6b, 0xcd, 0x4c,
+	0x84, 0x11, 0xa7, 0x5c, 0xb7, 0x79, 0xc6, 0xbb, 0x09, 0x65, 0x61, 0x98, 0x71, 0xa5, 0xc8, 0xb4,
+	0x35, 0x5a, 0x71, 0xea, 0x43, 0xd0, 0xee, 0x39, 0x25, 0x7e, 0x8c, 0xe6, 0xc1, 0x2e, 0x68, 0x33,
+	0x91, 0x1a, 0x6f, 0xee, 0x54, 0x0b, 0xdb, 0xd3, 0x8d, 0x92, 0x13, 0xd7, 0x8d, 0xf4, 0x38, 0xc4,
+	0xbb, 0x68, 0x45, 0x89, 0x28, 0xe5, 0x21, 0xed, 0xb1, 0x58, 0x71, 0xad, 0xe8, 0x4b, 0x91, 0x86,
+	0xf2, 0x25, 0x99, 0xb1, 0xe8, 0x25, 0xa7, 0xfc, 0xb5, 0xd3, 0xfd, 0xc6, 0xaa, 0x72, 0x36, 0x36,
+	0x86, 0x7c, 0x60, 0x73, 0x37, 0x6f, 0xb3, 0xef, 0x74, 0x60, 0xf3, 0x31, 0x5a, 0x07, 0x9b, 0x58,
+	0x46, 0x22, 0xa0, 0x01, 0x8b, 0xe3, 0x81, 0xdd, 0x3d, 0x6b, 0xb7, 0xea, 0x00, 0xbf, 0x34, 0xfa,
+	0xba, 0x51, 0x83, 0xe9, 0x53, 0xb4, 0xac, 0x59, 0x16, 0x71, 0xed, 0x96, 0xa3, 0x5a, 0x24, 0x5c,
+	0x76, 0x35, 0x99, 0xb5, 0x56, 0xd8, 0xe9, 0xec, 0x6a, 0x17, 0x4e, 0x83, 0x7f, 0x84, 0x30, 0xeb,
+	0xf1, 0x8c, 0x45, 0x9c, 0x36, 0x63, 0x19, 0x5c, 0x5a, 0x13, 0x82, 0x2c, 0x7e, 0x01, 0x34, 0xfb,
+	0x46, 0x61, 0x0c, 0xf0, 0xa7, 0x68, 0xd3, 0xa3, 0x07, 0x31, 0xce, 0x99, 0xcd, 0x59, 0x33, 0x02,
+	0x10, 0x1f, 0xe7, 0xa1, 0x79, 0x13, 0xad, 0xa8, 0x98, 0xa9, 0x36, 0x6d, 0x99, 0xd4, 0x09, 0x99,
+	0x42, 0x24, 0x49, 0xb1, 0x5a, 0xd8, 0x2e, 0xee, 0xd7, 0xbe, 0xfa, 0xe6, 0xe1, 0xad, 0x7f, 0x7d,
+	0xf3, 0xf0, 0x71, 0x24, 0x74, 0xbb, 0xdb, 0xac, 0x05, 0x32, 0xd9, 0x81, 0x7a, 0x72, 0x7f, 0x9e,
+	0xa8, 0xf0, 0x12, 0x6a, 0xf7, 0x80, 0x07, 0x8d, 0x25, 0x4b, 0x76, 0x04, 0x5c, 0x2e, 0xf0, 0xf8,
+	0x8f, 0x68, 0x79, 0x6c, 0x0d, 0x1b, 0x0a, 0x52, 0xba, 0xd1, 0x12, 0x78, 0x64, 0x09, 0x1b, 0x39,
+	0x2c, 0xd0, 0xfa, 0xd8, 0x0a, 0xc3, 0x3c, 0x91, 0xf2, 0x8d, 0x96, 0x59, 0x1d, 0x59, 0x66, 0x90,
+	0x56, 0x5c, 0x47, 0x95, 0x6e, 0xda, 0x94, 0x69, 0x48, 0x2d, 0x40, 0xa4, 0xd1, 0x78, 0xed, 0xcd,
+	0xdb, 0x90, 0x6f, 0x3a, 0xd4, 0x39, 0x80, 0x46, 0x6b, 0xb0, 0x87, 0xaa, 0x13, 0x11, 0x09, 0x4d,
+	0xfe, 0xa8, 0xa9, 0x22, 0xa6, 0xbb, 0x19, 0x27, 0x0b, 0x37, 0x72, 0xfb, 0xfe, 0x58, 0x74, 0xc2,
+	0x43, 0xdd, 0x3e, 0xf7, 0x9c, 0xf8, 0x00, 0x95, 0x9c, 0xb3, 0x34, 0xe3, 0x2f, 0x59, 0x16, 0x92,
+	0xc5, 0x6a, 0x61, 0x7b, 0x6e, 0x77, 0xbd, 0xe6, 0xb8, 0x6a, 0xa6, 0x47, 0xd4, 0xa0, 0x47, 0xd4,
+	0xea, 0x52, 0xa4, 0xfb, 0xd3, 0x66, 0xfd, 0x46, 0xd1, 0x59, 0x35, 0xac, 0x11, 0xfe, 0x00, 0xc1,
+	0x36, 0xa4, 0x66, 0x95, 0x1e, 0x27, 0xb8, 0x5a, 0xd8, 0xbe, 0xd7, 0x28, 0x3a, 0xe1, 0x9e, 0x95,
+	0xe1, 0x27, 0x08, 0xe7, 0xea, 0x91, 0x05, 0x97, 0xb1, 0x50, 0x9a, 0x2c, 0x55, 0xa7, 0xb6, 0x67,
+	0x1b, 0x8b, 0x7c, 0x50, 0x87, 0xa0, 0xc0, 0x9f, 0xa0, 0x8d, 0x44, 0xa4, 0xb0, 0xdd, 0x5b, 0x9c,
+	0xd3, 0x26, 0x53, 0x42, 0xd1, 0x8e, 0x14, 0xa9, 0x56, 0x64, 0xd9, 0x6d, 0xb1, 0x44, 0xa4, 0x76,
+	0xe7, 0x1f, 0x71, 0xbe, 0x6f, 0xd4, 0x67, 0x56, 0x8b, 0x35, 0x7a, 0x38, 0xb4, 0x63, 0x5d, 0x17,
+	0xd0, 0x8e, 0x94, 0xf1, 0x20, 0xbc, 0x64, 0xc5, 0x74, 0x9b, 0xb7, 0x0e, 0xe6, 0x66, 0x00, 0xab,
+	0xed, 0x39, 0xd2, 0x33, 0x29, 0x63, 0x1f, 0x5a, 0xfc, 0x19, 0x42, 0xbc, 0x97, 0x38, 0x8f, 0x15,
+	0x59, 0xad, 0x4e, 0x6d, 0xcf, 0xed, 0x6e, 0xd4, 0x86, 0x3d, 0xbf, 0x76, 0xd8, 0x4b, 0xac, 0xb7,
+	0xae, 0xb9, 0x42, 0x24, 0x67, 0x39, 0x48, 0x95, 0xe9, 0x0c, 0xb2, 0xd5, 0xe2, 0x69, 0xc0, 0x69,
+	0xc8, 0x03, 0x76, 0xe5, 0xeb, 0x67, 0xcd, 0x75, 0x06, 0xd0, 0x1d, 0x18, 0x15, 0x94, 0x4d, 0x1b,
+	0x11, 0x6f, 0xe1, 0xca, 0x87, 0xab, 0x80, 0xc5, 0xb6, 0xd9, 0x13, 0x72, 0xb3, 0x2a, 0x07, 0x3e,
+	0x5b, 0xa6, 0x87, 0x03, 0x36, 0xfc, 0x73, 0xb4, 0xe9, 0x57, 0xd2, 0x32, 0x69, 0x2a, 0x2d, 0x53,
+	0x4e, 0x75, 0x3b, 0xe3, 0xaa, 0x2d, 0xe3, 0x90, 0xac, 0x5b, 0x17, 0xd7, 0x01, 0x72, 0xe1, 0x11,
+	0x17, 0x1e, 0x80, 0x13, 0xb4, 0x09, 0x85, 0xd6, 0x91, 0x2f, 0x79, 0x46, 0x43, 0xd1, 0x6a, 0xe5,
+	0xec, 0x37, 0x6e, 0x94, 0x0e, 0xe2, 0x28, 0xcf, 0x0c, 0xe3, 0x81, 0x68, 0xb5, 0x86, 0xcb, 0x7d,
+	0x88, 0xca, 0xb0, 0x5c, 0xc2, 0xfa, 0x94, 0x45, 0x9c, 0x6c, 0x5a, 0x0f, 0xa1, 0x6e, 0x4f, 0x58,
+	0x7f, 0x2f, 0xe2, 0xb8, 0x86, 0x96, 0x3c, 0xca, 0xcc, 0x94, 0x54, 0xf3, 0xac, 0xc7, 0x62, 0x72,
+	0xdf, 0x42, 0x17, 0x01, 0x2a, 0xd2, 0x63, 0x50, 0xe0, 0xcf, 0xd1, 0x82, 0x96, 0x97, 0x3c, 0xa5,
+	0x11, 0x53, 0xb4, 0x63, 0xb3, 0x48, 0x1e, 0x4c, 0xe6, 0xf9, 0xc2, 0x60, 0x9e, 0x33, 0x35, 0x92,
+	0xe7, 0xb2, 0x1e, 0x91, 0xe2, 0x1f, 0xa3, 0x35, 0x1f, 0xd0, 0x3f, 0x31, 0x11, 0x53, 0x99, 0xc6,
+	0x57, 0x34, 0x90, 0xdd, 0x54, 0x93, 0x8a, 0x5d, 0xdf, 0xd7, 0xc2, 0xe7, 0x4c, 0xc4, 0xa7, 0x69,
+	0x7c, 0x55, 0x37, 0xba, 0x4f, 0xa6, 0xff, 0xfc, 0xef, 0xea, 0xad, 0xad, 0x7f, 0x14, 0x50, 0x79,
+	0x74, 0x15, 0xbc, 0x8d, 0x16, 0x06, 0xd5, 0x47, 0x3b, 0x19, 0x6f, 0x89, 0x3e, 0x0c, 0xec, 0xb2,
+	0xaf, 0xb0, 0x33, 0x2b, 0xc5, 0x8f, 0x90, 0xf3, 0x85, 0xfa, 0xf9, 0x0c, 0xf3, 0xba, 0x64, 0xa5,
+	0x75, 0x10, 0x1a, 0x42, 0xfb, 0x99, 0x3c, 0xa3, 0x3a, 0x63, 0xa9, 0x6a, 0xf1, 0x8c, 0x4c, 0x59,
+	0xcf, 0xca, 0x11, 0x53, 0x67, 0x3c, 0xbb, 0x00, 0x29, 0xde, 0x42, 0x25, 0x13, 0x65, 0x37, 0xce,
+	0x22, 0xe6, 0x46, 0xf9, 0x74, 0x63, 0x2e, 0x61, 0x7d, 0xdb, 0x8d, 0x9f, 0x33, 0xb5, 0xf5, 0xbf,
+	0xdb, 0xa8, 0x3c, 0x5a, 0xff, 0x6f, 0xe1, 0xf1, 0x87, 0xa8, 0x3c, 0x44, 0xa6, 0x2c, 0xe1, 0xe0,
+	0x71, 0xd1, 0xe3, 0xbe, 0x64, 0x09, 0x1f, 0x3b, 0xac, 0x4c, 0x8d, 0x1f, 0x56, 0xde, 0xf7, 0xd1,
+	0xe3, 0x0d, 0x73, 0x77, 0xe6, 0x0d, 0x73, 0x77, 0xa2, 0x87, 0xde, 0xfd, 0xce, 0x3d, 0xf4, 0xde,
+	0xb7, 0xf4, 0xd0, 0xad, 0xff, 0x16, 0x51, 0xf1, 0xb9, 0x3b, 0x82, 0x9e, 0x6b, 0xa6, 0x39, 0xfe,
+	0x21, 0x9a, 0x81, 0xb2, 0x2d, 0xd8, 0x3e, 0x8f, 0xf3, 0x65, 0xeb, 0xd2, 0xd2, 0x00, 0x04, 0x3e,
+	0x42, 0x65, 0x1f, 0xce, 0x54, 0xa6, 0x01, 0x57, 0xe4, 0x36, 0xcc, 0x86, 0x9c, 0xcd, 0x73, 0xf7,
+	0xf3, 0x4b, 0x0b, 0x80, 0x4a, 0x2f, 0x45, 0x79, 0x21, 0xde, 0x45, 0x77, 0x61, 0x1e, 0x92, 0xa9,
+	0xea, 0xd4, 0xf8, 0xa2, 0x6e, 0x0c, 0x82, 0xa5, 0x07, 0xe2, 0x2f, 0xd0, 0xbc, 0xfb, 0x69, 0x6a,
+	0xb4, 0x25, 0xb2, 0xc4, 0xe4, 0xc8, 0xd8, 0xde, 0xcf, 0xdb, 0x9e, 0x28, 0x98, 0xa2, 0x75, 0x07,
+	0xf2, 0x3b, 0xad, 0x97, 0x17, 0x2a, 0xfc, 0x33, 0x74, 0x17, 0x0e, 0x76, 0xe4, 0x8e, 0x25, 0xd9,
+	0xcc, 0x93, 0x9c, 0x76, 0x75, 0x24, 0x45, 0x1a, 0x5d, 0xb8, 0x5a, 0xf5, 0x9e, 0x80, 0x05, 0x7e,
+	0x81, 0xca, 0xf6, 0xe7, 0xd0, 0x91, 0x99, 0x49, 0x8e, 0x13, 0x15, 0x79, 0x17, 0x72, 0x1c, 0x25,
+	0x6b, 0x38, 0x70, 0xe3, 0x00, 0xcd, 0xe5, 0xce, 0x8a, 0xe4, 0xae, 0xa5, 0x79, 0x70, 0x9d, 0x2b,
+	0x83, 0xb3, 0x05, 0x10, 0xa1, 0xd8, 0x0b, 0x14, 0xfe, 0x15, 0x5a, 0x1a, 0xb2, 0x0c, 0x9d, 0xba,
+	0x67, 0xd9, 0x1e, 0x5e, 0xef, 0xd4, 0x38, 0xdf, 0xe2, 0x80, 0x6f, 0xe0, 0xdc, 0x1e, 0x2a, 0xe6,
+	0x2e, 0x0a, 0x8a, 0xcc, 0x5a, 0xbe, 0xb5, 0x3c, 0xdf, 0xde, 0x50, 0xef, 0x0f, 0x01, 0x79, 0x13,
+	0x7c, 0x86, 0x4a, 0x21, 0x8f, 0x79, 0xc4, 0x34, 0xa7, 0x97, 0xfc, 0x4a, 0x11, 0x64, 0x39, 0x1e,
+	0x8d, 0xf9, 0x74, 0xce, 0xf5, 0x69, 0x66, 0x42, 0xab, 0x33, 0xa6, 0x65, 0x06, 0xbb, 0xcc, 0x33,
+	0x7a, 0x86, 0x2f, 0xf8, 0x95, 0xa9, 0xc0, 0x79, 0x9e, 0x05, 0xbb, 0x4f, 0xa9, 0x96, 0x34, 0xe4,
+	0xa9, 0x4c, 0x14, 0x99, 0xb3, 0x9c, 0x64, 0x64, 0xaa, 0x36, 0xea, 0xbb, 0x4f, 0x2f, 0xe4, 0x81,
+	0x01, 0xf8, 0xc8, 0x5b, 0x33, 0x90, 0xd9, 0x98, 0x75, 0x53, 0x97, 0xd0, 0x70, 0xd0, 0xcb, 0x14,
+	0x29, 0x5a, 0xae, 0xca, 0xb5, 0xc5, 0x00, 0xa0, 0x8b, 0x3e, 0x30, 0xe2, 0x01, 0x81, 0x57, 0x29,
+	0xdc, 0x44, 0xeb, 0x1d, 0x9e, 0x86, 0xe6, 0xc0, 0x27, 0x9a, 0x01, 0x65, 0x5d, 0x2d, 0x69, 0x4b,
+	0x66, 0xe6, 0x44, 0xa4, 0x48, 0xc9, 0x92, 0xff, 0x60, 0x64, 0x7f, 0x39, 0xf0, 0x71, 0x33, 0xd8,
+	0xeb, 0x6a, 0x79, 0xe4, 0x90, 0xc0, 0xbf, 0xda, 0xb9, 0x4e, 0x69, 0x36, 0xc2, 0x82, 0x0b, 0x41,
+	0x22, 0xa2, 0x0c, 0x72, 0x53, 0xbe, 0xe6, 0x64, 0x61, 0x62, 0x70, 0xe2, 0x21, 0xc0, 0xe9, 0x82,
+	0x37, 0x90, 0x2a, 0x1c, 0xa1, 0x0d, 0x97, 0x31, 0x1e, 0x52, 0xc7, 0x1a, 0xf2, 0x4e, 0x2c, 0xaf,
+	0x12, 0x6e, 0x8e, 0x54, 0xf3, 0x96, 0xf6, 0x83, 0xc9, 0x94, 0xf3, 0xd0, 0xd2, 0x1f, 0x0c, 0xb0,
+	0xc0, 0x4f, 0x3c, 0xd9, 0x61, 0x16, 0xe4, 0xd5, 0x66, 0xd3, 0xcc, 0x27, 0x3c, 0xbb, 0x8c, 0x39,
+	0x65, 0x22, 0x0b, 0x33, 0xd9, 0x51, 0x64, 0xa1, 0x3a, 0x35, 0xde, 0x3b, 0x4e, 0x2c, 0x64, 0xcf,
+	0x21, 0xfc, 0xde, 0x4d, 0xf2, 0x42, 0x85, 0x7f, 0x8b, 0x56, 0x46, 0x99, 0x68, 0x10, 0x33, 0x91,
+	0x28, 0xb2, 0x38, 0x99, 0xbc, 0x11, 0xbe, 0xba, 0x81, 0x01, 0xe9, 0x52, 0x32, 0xa1, 0x51, 0xf8,
+	0xd3, 0x91, 0xd3, 0x1a, 0xbe, 0xa6, 0xae, 0x60, 0xb6, 0x1c, 0x30, 0xcd, 0x26, 0xcf, 0x6a, 0xc7,
+	0x83, 0xa9, 0x00, 0x63, 0x5a, 0x91, 0xa5, 0xc9, 0xbc, 0xec, 0x5b, 0xc8, 0x29, 0x20, 0xfc, 0x37,
+	0x36, 0x47, 0xa4, 0xf8, 0x0f, 0x08, 0x03, 0x55, 0x87, 0x67, 0x2d, 0x99, 0x25, 0x2c, 0x0d, 0x38,
+	0x59, 0xb6, 0x6c, 0x8f, 0xc7, 0x7a, 0xa5, 0x08, 0xcd, 0xa6, 0x71, 0xb4, 0x67, 0x43, 0xb4, 0xdf,
+	0xd8, 0xcd, 0x71, 0x05, 0x6e, 0xa3, 0x4d, 0x09, 0x45, 0x4d, 0x75, 0x9f, 0x06, 0x19, 0x67, 0x26,
+	0xfd, 0x6d, 0x2e, 0xa2, 0xb6, 0x56, 0x64, 0x65, 0x32, 0xe9, 0xc3, 0x86, 0x58, 0x77, 0xe0, 0x17,
+	0x16, 0xeb, 0x93, 0x2e, 0xaf, 0x57, 0xab, 0xad, 0xbf, 0xcc, 0xa2, 0x62, 0x3e, 0x66, 0x6f, 0x31,
+	0xdf, 0xbf, 0x1f, 0x35, 0xdf, 0x8f, 0x9a, 0xb7, 0x19, 0x35, 0xd7, 0x0c, 0x06, 0xf4, 0x0e, 0x07,
+	0xc3, 0xdc, 0xfb, 0x1c, 0x0c, 0xc5, 0xf7, 0x37, 0x18, 0x4a, 0xef, 0x67, 0x30, 0x94, 0xdf, 0xdd,
+	0x60, 0x78, 0x43, 0x37, 0x9a, 0x7f, 0x77, 0xdd, 0xe8, 0x08, 0xad, 0x7d, 0x8b, 0x29, 0x5e, 0x42,
+	0x77, 0x74, 0xdf, 0xbf, 0x67, 0x4e, 0x37, 0xa6, 0x75, 0xff, 0x38, 0xc4, 0xab, 0x68, 0xc6, 0x79,
+	0x61, 0x5b, 0xcf, 0x74, 0x03, 0xfe, 0xdb, 0xfa, 0xfb, 0x14, 0x2a, 0x8d, 0x74, 0x1e, 0x73, 0x69,
+	0x8c, 0x99, 0xf9, 0x3e, 0x78, 0xe6, 0x71, 0x2d, 0x0b, 0xc8, 0x16, 0x9d, 0xca, 0xf5, 0x0a, 0x6b,
+	0xe0, 0xf0, 0x4a, 0x53, 0xd9, 0x54, 0x3c, 0xeb, 0xf1, 0x10, 0xf0, 0xb7, 0x3d, 0x5e, 0xe9, 0x53,
+	0xd0, 0x38, 0xfc, 0xc7, 0x68, 0xdd, 0xe2, 0xed, 0x85, 0x7e, 0xf0, 0x90, 0x09, 0x56, 0xee, 0x02,
+	0xb6, 0x6a, 0x00, 0xe7, 0x4e, 0x9f, 0x5f, 0xea, 0xa7, 0x88, 0x8c, 0x98, 0xba, 0x76, 0x62, 0x2f,
+	0x21, 0x70, 0x27, 0x5b, 0xc9, 0x59, 0xba, 0x06, 0x62, 0x94, 0xf8, 0x17, 0xe8, 0xc1, 0x88, 0x61,
+	0x6e, 0xdf, 0x3b, 0x6b, 0x77, 0xe3, 0x59, 0xcf, 0x59, 0x0f, 0x77, 0xba, 0x65, 0x78, 0x84, 0xe6,
+	0x2d, 0x83, 0xee, 0xbb, 0x87, 0x16, 0x11, 0xc2, 0x8d, 0xa7, 0x68, 0xc4, 0x17, 0x7d, 0xf3, 0x52,
+	0x72, 0x1c, 0x9a, 0xab, 0xa2, 0x85, 0x39, 0xcf, 0x44, 0x08, 0x6f, 0xac, 0x73, 0x46, 0x68, 0xfd,
+	0x39, 0x0e, 0xf1, 0x47, 0xc8, 0x7e, 0x1f, 0x1d, 0x1b, 0xfc, 0x22, 0x84, 0x87, 0x55, 0x1b, 0xce,
+	0x91, 0x61, 0x7f, 0x1c, 0xee, 0xff, 0xee, 0xab, 0x57, 0x95, 0xc2, 0xd7, 0xaf, 0x2a, 0x85, 0xff,
+	0xbc, 0xaa, 0x14, 0xfe, 0xfa, 0xba, 0x72, 0xeb, 0xeb, 0xd7, 0x95, 0x5b, 0xff, 0x7c, 0x5d, 0xb9,
+	0xf5, 0xfb, 0xcf, 0x72, 0x8f, 0x09, 0x90, 0xc9, 0x27, 0x6e, 0x80, 0x8e, 0xff, 0x9b, 0xc8, 0xb0,
+	0x1b, 0xf3, 0x9d, 0xfe, 0x8e, 0x7f, 0x3e, 0xb7, 0x2f, 0x0d, 0xcd, 0x19, 0xfb, 0x2c, 0xfe, 0xd1,
+	0xff, 0x07, 0x00, 0xce, 0x03, 0x64, 0xe2, 0xd9, 0x17, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -585,6 +1172,97 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OffenceJailOnlyCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OffenceJailOnlyCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.TokenGasParams) > 0 {
+		for iNdEx := len(m.TokenGasParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenGasParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.ValsetMinInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetMinInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.ValsetMaxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetMaxAge))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.ValsetPowerDiffThreshold.Size()
+		i -= size
+		if _, err := m.ValsetPowerDiffThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if m.OffenceTombstoneThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OffenceTombstoneThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	{
+		size := m.OffenceSlashEscalation.Size()
+		i -= size
+		if _, err := m.OffenceSlashEscalation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	if m.OffenceDecayWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OffenceDecayWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.EvmChains) > 0 {
+		for iNdEx := len(m.EvmChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	{
 		size := m.ChainFeeAuctionPoolFraction.Size()
 		i -= size
@@ -745,7 +1423,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *TokenGasParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -755,23 +1433,274 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *TokenGasParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TokenGasParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PendingIbcAutoForwards) > 0 {
-		for iNdEx := len(m.PendingIbcAutoForwards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingIbcAutoForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
+	if m.MaxBatchGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBatchGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasPerTransfer != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasPerTransfer))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmChainParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmChainParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumBlacklist) > 0 {
+		for iNdEx := len(m.EthereumBlacklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EthereumBlacklist[iNdEx])
+			copy(dAtA[i:], m.EthereumBlacklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumBlacklist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.BridgeActive {
+		i--
+		if m.BridgeActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EvmChainName) > 0 {
+		i -= len(m.EvmChainName)
+		copy(dAtA[i:], m.EvmChainName)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmChainName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutgoingTxCreatedHeights) > 0 {
+		for iNdEx := len(m.OutgoingTxCreatedHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxCreatedHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.BridgePerformance) > 0 {
+		for iNdEx := len(m.BridgePerformance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgePerformance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.BridgeOffences) > 0 {
+		for iNdEx := len(m.BridgeOffences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeOffences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.EvmChains) > 0 {
+		for iNdEx := len(m.EvmChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.MerkleAirdropClaims) > 0 {
+		for iNdEx := len(m.MerkleAirdropClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleAirdropClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MerkleAirdrops) > 0 {
+		for iNdEx := len(m.MerkleAirdrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleAirdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AttestedErc20Deployments) > 0 {
+		for iNdEx := len(m.AttestedErc20Deployments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestedErc20Deployments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Erc20Migrations) > 0 {
+		for iNdEx := len(m.Erc20Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for iNdEx := len(m.PendingIbcAutoForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingIbcAutoForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
 				i -= size
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
@@ -944,7 +1873,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GravityNonces) Marshal() (dAtA []byte, err error) {
+func (m *EvmChainData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -954,40 +1883,300 @@ func (m *GravityNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GravityNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *EvmChainData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GravityNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EvmChainData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastBatchId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBatchId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.LastTxPoolId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTxPoolId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.LastSlashedLogicCallBlock != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedLogicCallBlock))
-		i--
-		dAtA[i] = 0x28
+	if len(m.OutgoingTxCreatedHeights) > 0 {
+		for iNdEx := len(m.OutgoingTxCreatedHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxCreatedHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	if m.LastSlashedBatchBlock != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedBatchBlock))
-		i--
-		dAtA[i] = 0x20
+	if len(m.AttestedErc20Deployments) > 0 {
+		for iNdEx := len(m.AttestedErc20Deployments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestedErc20Deployments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	if m.LastSlashedValsetNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedValsetNonce))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Erc20Migrations) > 0 {
+		for iNdEx := len(m.Erc20Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for iNdEx := len(m.PendingIbcAutoForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingIbcAutoForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.GravityNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingTxCreatedHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingTxCreatedHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingTxCreatedHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GravityNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GravityNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GravityNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastMerkleAirdropId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastMerkleAirdropId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LastBatchId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBatchId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastTxPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTxPoolId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedLogicCallBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastSlashedBatchBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedBatchBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedValsetNonce))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.LastObservedNonce != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
@@ -1079,26 +2268,120 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.ChainFeeAuctionPoolFraction.Size()
 	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.EvmChains) > 0 {
+		for _, e := range m.EvmChains {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.OffenceDecayWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.OffenceDecayWindow))
+	}
+	l = m.OffenceSlashEscalation.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.OffenceTombstoneThreshold != 0 {
+		n += 2 + sovGenesis(uint64(m.OffenceTombstoneThreshold))
+	}
+	l = m.ValsetPowerDiffThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.ValsetMaxAge != 0 {
+		n += 2 + sovGenesis(uint64(m.ValsetMaxAge))
+	}
+	if m.ValsetMinInterval != 0 {
+		n += 2 + sovGenesis(uint64(m.ValsetMinInterval))
+	}
+	if len(m.TokenGasParams) > 0 {
+		for _, e := range m.TokenGasParams {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.OffenceJailOnlyCount != 0 {
+		n += 2 + sovGenesis(uint64(m.OffenceJailOnlyCount))
+	}
 	return n
 }
 
-func (m *GenesisState) Size() (n int) {
+func (m *TokenGasParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = m.GravityNonces.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.GasPerTransfer != 0 {
+		n += 1 + sovGenesis(uint64(m.GasPerTransfer))
+	}
+	if m.MaxBatchGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBatchGas))
+	}
+	return n
+}
+
+func (m *EvmChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EvmChainName)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	}
+	if m.BridgeActive {
+		n += 2
+	}
+	if len(m.EthereumBlacklist) > 0 {
+		for _, s := range m.EthereumBlacklist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.GravityNonces.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
 	if len(m.ValsetConfirms) > 0 {
 		for _, e := range m.ValsetConfirms {
@@ -1160,6 +2443,162 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Erc20Migrations) > 0 {
+		for _, e := range m.Erc20Migrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttestedErc20Deployments) > 0 {
+		for _, e := range m.AttestedErc20Deployments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleAirdrops) > 0 {
+		for _, e := range m.MerkleAirdrops {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleAirdropClaims) > 0 {
+		for _, e := range m.MerkleAirdropClaims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EvmChains) > 0 {
+		for _, e := range m.EvmChains {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeOffences) > 0 {
+		for _, e := range m.BridgeOffences {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgePerformance) > 0 {
+		for _, e := range m.BridgePerformance {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutgoingTxCreatedHeights) > 0 {
+		for _, e := range m.OutgoingTxCreatedHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvmChainData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.GravityNonces.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for _, e := range m.ValsetConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for _, e := range m.BatchConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for _, e := range m.LogicCalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for _, e := range m.LogicCallConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for _, e := range m.UnbatchedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for _, e := range m.PendingIbcAutoForwards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20Migrations) > 0 {
+		for _, e := range m.Erc20Migrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttestedErc20Deployments) > 0 {
+		for _, e := range m.AttestedErc20Deployments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutgoingTxCreatedHeights) > 0 {
+		for _, e := range m.OutgoingTxCreatedHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutgoingTxCreatedHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovGenesis(uint64(m.TxId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
 	return n
 }
 
@@ -1190,6 +2629,9 @@ func (m *GravityNonces) Size() (n int) {
 	if m.LastBatchId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastBatchId))
 	}
+	if m.LastMerkleAirdropId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastMerkleAirdropId))
+	}
 	return n
 }
 
@@ -1290,13 +2732,1270 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			}
+			m.SignedValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			}
+			m.SignedLogicCallsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			}
+			m.TargetBatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+			}
+			m.UnbondSlashingValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeActive = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlacklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumBlacklist = append(m.EthereumBlacklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinChainFeeBasisPoints", wireType)
+			}
+			m.MinChainFeeBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinChainFeeBasisPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFeeAuctionPoolFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainFeeAuctionPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChains = append(m.EvmChains, EvmChainParams{})
+			if err := m.EvmChains[len(m.EvmChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenceDecayWindow", wireType)
+			}
+			m.OffenceDecayWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffenceDecayWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenceSlashEscalation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OffenceSlashEscalation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenceTombstoneThreshold", wireType)
+			}
+			m.OffenceTombstoneThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffenceTombstoneThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetPowerDiffThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetPowerDiffThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetMaxAge", wireType)
+			}
+			m.ValsetMaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetMaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetMinInterval", wireType)
+			}
+			m.ValsetMinInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetMinInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenGasParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenGasParams = append(m.TokenGasParams, TokenGasParams{})
+			if err := m.TokenGasParams[len(m.TokenGasParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenceJailOnlyCount", wireType)
+			}
+			m.OffenceJailOnlyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffenceJailOnlyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenGasParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenGasParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerTransfer", wireType)
+			}
+			m.GasPerTransfer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerTransfer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchGas", wireType)
+			}
+			m.MaxBatchGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeActive = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlacklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumBlacklist = append(m.EthereumBlacklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GravityNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1306,29 +4005,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
-			m.BridgeChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1338,16 +4039,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
 			}
-			m.SignedValsetsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1357,16 +4073,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
 			}
-			m.SignedBatchesWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1376,16 +4107,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
 			}
-			m.SignedLogicCallsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1395,16 +4141,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			m.TargetBatchTimeout = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1414,16 +4175,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
 			}
-			m.AverageBlockTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1433,16 +4209,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
 			}
-			m.AverageEthereumBlockTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1452,16 +4243,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1471,30 +4277,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcAutoForwards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1504,30 +4311,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingIbcAutoForwards = append(m.PendingIbcAutoForwards, PendingIbcAutoForward{})
+			if err := m.PendingIbcAutoForwards[len(m.PendingIbcAutoForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Migrations", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1537,30 +4345,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20Migrations = append(m.Erc20Migrations, ERC20Migration{})
+			if err := m.Erc20Migrations[len(m.Erc20Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedErc20Deployments", wireType)
 			}
-			m.UnbondSlashingValsetsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1570,16 +4379,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestedErc20Deployments = append(m.AttestedErc20Deployments, AttestedERC20Deployment{})
+			if err := m.AttestedErc20Deployments[len(m.AttestedErc20Deployments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleAirdrops", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1589,28 +4413,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerkleAirdrops = append(m.MerkleAirdrops, MerkleAirdrop{})
+			if err := m.MerkleAirdrops[len(m.MerkleAirdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleAirdropClaims", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1637,15 +4462,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MerkleAirdropClaims = append(m.MerkleAirdropClaims, MerkleAirdropClaim{})
+			if err := m.MerkleAirdropClaims[len(m.MerkleAirdropClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChains", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1655,17 +4481,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BridgeActive = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChains = append(m.EvmChains, EvmChainData{})
+			if err := m.EvmChains[len(m.EvmChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlacklist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeOffences", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1675,29 +4515,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumBlacklist = append(m.EthereumBlacklist, string(dAtA[iNdEx:postIndex]))
+			m.BridgeOffences = append(m.BridgeOffences, BridgeOffences{})
+			if err := m.BridgeOffences[len(m.BridgeOffences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinChainFeeBasisPoints", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgePerformance", wireType)
 			}
-			m.MinChainFeeBasisPoints = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1707,16 +4549,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinChainFeeBasisPoints |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgePerformance = append(m.BridgePerformance, ValidatorBridgePerformance{})
+			if err := m.BridgePerformance[len(m.BridgePerformance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainFeeAuctionPoolFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxCreatedHeights", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1726,23 +4583,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainFeeAuctionPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutgoingTxCreatedHeights = append(m.OutgoingTxCreatedHeights, OutgoingTxCreatedHeight{})
+			if err := m.OutgoingTxCreatedHeights[len(m.OutgoingTxCreatedHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1767,7 +4624,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1790,17 +4647,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvmChainData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvmChainData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1810,27 +4667,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2105,7 +4958,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2132,14 +4985,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2166,14 +5019,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcAutoForwards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2200,14 +5053,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
-			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingIbcAutoForwards = append(m.PendingIbcAutoForwards, PendingIbcAutoForward{})
+			if err := m.PendingIbcAutoForwards[len(m.PendingIbcAutoForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcAutoForwards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Migrations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2234,8 +5087,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingIbcAutoForwards = append(m.PendingIbcAutoForwards, PendingIbcAutoForward{})
-			if err := m.PendingIbcAutoForwards[len(m.PendingIbcAutoForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20Migrations = append(m.Erc20Migrations, ERC20Migration{})
+			if err := m.Erc20Migrations[len(m.Erc20Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedErc20Deployments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestedErc20Deployments = append(m.AttestedErc20Deployments, AttestedERC20Deployment{})
+			if err := m.AttestedErc20Deployments[len(m.AttestedErc20Deployments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxCreatedHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxCreatedHeights = append(m.OutgoingTxCreatedHeights, OutgoingTxCreatedHeight{})
+			if err := m.OutgoingTxCreatedHeights[len(m.OutgoingTxCreatedHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2260,6 +5181,94 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OutgoingTxCreatedHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingTxCreatedHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingTxCreatedHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2422,6 +5431,25 @@ func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMerkleAirdropId", wireType)
+			}
+			m.LastMerkleAirdropId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMerkleAirdropId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
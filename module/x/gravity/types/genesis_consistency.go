@@ -0,0 +1,450 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisTokenSummary is the bridge state of a single token of an EVM chain in a genesis state, amounts include the
+// bridge fees
+type GenesisTokenSummary struct {
+	EvmChainPrefix   string
+	Denom            string
+	Erc20            string
+	CosmosOriginated bool
+
+	UnbatchedTxs      int
+	UnbatchedAmount   sdk.Int
+	Batches           int
+	BatchedTxs        int
+	BatchedAmount     sdk.Int
+	PendingForwards   int
+	PendingAmount     sdk.Int
+	ConfirmedBatches  int
+	HighestBatchNonce uint64
+}
+
+// Total returns the amount of the token which is on its way out of the bridge and must be held by the module
+func (s GenesisTokenSummary) Total() sdk.Int {
+	return s.UnbatchedAmount.Add(s.BatchedAmount).Add(s.PendingAmount)
+}
+
+// GenesisEscrow compares the balance the gravity module account must hold of a denom with the balance it holds
+type GenesisEscrow struct {
+	Denom         string
+	Expected      sdk.Int
+	ModuleBalance sdk.Int
+	// Exact is true for gravity vouchers, which are minted on deposit and burned on withdrawal so that the module
+	// holds exactly what is on its way out, other denoms are only required to be covered by the module balance
+	Exact bool
+}
+
+// Matches returns true if the module balance is what ModuleBalanceInvariant expects
+func (e GenesisEscrow) Matches() bool {
+	if e.Exact {
+		return e.ModuleBalance.Equal(e.Expected)
+	}
+	return e.ModuleBalance.GTE(e.Expected)
+}
+
+// GenesisConsistencyReport is the result of CheckGenesisConsistency
+type GenesisConsistencyReport struct {
+	Tokens []GenesisTokenSummary
+	Escrow []GenesisEscrow
+	// Problems are inconsistencies which the chain would reject or which would break its invariants
+	Problems []string
+	// Warnings are surprising but harmless findings
+	Warnings []string
+}
+
+// genesisChecker collects the findings of CheckGenesisConsistency
+type genesisChecker struct {
+	gs       GenesisState
+	unpacker codectypes.AnyUnpacker
+	report   GenesisConsistencyReport
+	tokens   map[string]*GenesisTokenSummary
+	expected map[string]sdk.Int
+	// every tx id and batch nonce comes from a counter shared by all EVM chains
+	txIds       map[uint64]string
+	batchNonces map[uint64]string
+	// the eth address of every orchestrator with delegate keys
+	orchestrators map[string]string
+}
+
+// CheckGenesisConsistency cross-checks the sections of a gravity genesis state against each other, which
+// GenesisState.ValidateBasic only validates individually, and the module balance against the balance
+// ModuleBalanceInvariant expects. The unpacker decodes the attestation claims.
+func CheckGenesisConsistency(gs GenesisState, unpacker codectypes.AnyUnpacker, moduleBalance sdk.Coins) GenesisConsistencyReport {
+	c := genesisChecker{
+		gs:            gs,
+		unpacker:      unpacker,
+		report:        GenesisConsistencyReport{Tokens: nil, Escrow: nil, Problems: nil, Warnings: nil},
+		tokens:        map[string]*GenesisTokenSummary{},
+		expected:      map[string]sdk.Int{},
+		txIds:         map[uint64]string{},
+		batchNonces:   map[uint64]string{},
+		orchestrators: map[string]string{},
+	}
+	if gs.Params == nil {
+		c.problem("params are missing")
+		return c.report
+	}
+	if err := gs.ValidateBasic(); err != nil {
+		c.problem("genesis state is invalid: %v", err)
+	}
+
+	for _, keys := range gs.DelegateKeys {
+		if _, ok := c.orchestrators[keys.Orchestrator]; ok {
+			c.problem("orchestrator %s has delegate keys twice", keys.Orchestrator)
+		}
+		c.orchestrators[keys.Orchestrator] = keys.EthAddress
+	}
+
+	c.checkEvmChain(EvmChainData{
+		EvmChainPrefix:           DefaultEvmChainPrefix,
+		GravityNonces:            gs.GravityNonces,
+		Valsets:                  gs.Valsets,
+		ValsetConfirms:           gs.ValsetConfirms,
+		Batches:                  gs.Batches,
+		BatchConfirms:            gs.BatchConfirms,
+		LogicCalls:               gs.LogicCalls,
+		LogicCallConfirms:        gs.LogicCallConfirms,
+		Attestations:             gs.Attestations,
+		Erc20ToDenoms:            gs.Erc20ToDenoms,
+		UnbatchedTransfers:       gs.UnbatchedTransfers,
+		PendingIbcAutoForwards:   gs.PendingIbcAutoForwards,
+		Erc20Migrations:          gs.Erc20Migrations,
+		AttestedErc20Deployments: gs.AttestedErc20Deployments,
+	})
+	for _, chain := range gs.EvmChains {
+		c.checkEvmChain(chain)
+	}
+
+	// unclaimed merkle airdrops are escrowed by the module too
+	for _, airdrop := range gs.MerkleAirdrops {
+		if airdrop.Claimed > airdrop.Total {
+			c.problem("merkle airdrop %d claimed %d of a total of %d", airdrop.Id, airdrop.Claimed, airdrop.Total)
+			continue
+		}
+		c.expect(airdrop.Denom, sdk.NewIntFromUint64(airdrop.Total-airdrop.Claimed))
+	}
+
+	c.checkEscrow(moduleBalance)
+
+	for _, token := range c.tokens {
+		c.report.Tokens = append(c.report.Tokens, *token)
+	}
+	sort.Slice(c.report.Tokens, func(i, j int) bool {
+		if c.report.Tokens[i].EvmChainPrefix != c.report.Tokens[j].EvmChainPrefix {
+			return c.report.Tokens[i].EvmChainPrefix < c.report.Tokens[j].EvmChainPrefix
+		}
+		return c.report.Tokens[i].Denom < c.report.Tokens[j].Denom
+	})
+	return c.report
+}
+
+func (c *genesisChecker) problem(format string, args ...interface{}) {
+	c.report.Problems = append(c.report.Problems, fmt.Sprintf(format, args...))
+}
+
+func (c *genesisChecker) warning(format string, args ...interface{}) {
+	c.report.Warnings = append(c.report.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (c *genesisChecker) expect(denom string, amount sdk.Int) {
+	if expected, ok := c.expected[denom]; ok {
+		c.expected[denom] = expected.Add(amount)
+	} else {
+		c.expected[denom] = amount
+	}
+}
+
+// token returns the summary of the token of the contract on the chain, resolving its denom like ERC20ToDenomLookup
+func (c *genesisChecker) token(evmChainPrefix string, erc20ToDenom map[string]string, contract EthAddress) *GenesisTokenSummary {
+	denom, cosmosOriginated := erc20ToDenom[contract.GetAddress().Hex()]
+	if !cosmosOriginated {
+		denom = GravityDenom(evmChainPrefix, contract)
+	}
+	key := evmChainPrefix + "/" + denom
+	if token, ok := c.tokens[key]; ok {
+		return token
+	}
+	token := &GenesisTokenSummary{
+		EvmChainPrefix:    evmChainPrefix,
+		Denom:             denom,
+		Erc20:             contract.GetAddress().Hex(),
+		CosmosOriginated:  cosmosOriginated,
+		UnbatchedTxs:      0,
+		UnbatchedAmount:   sdk.ZeroInt(),
+		Batches:           0,
+		BatchedTxs:        0,
+		BatchedAmount:     sdk.ZeroInt(),
+		PendingForwards:   0,
+		PendingAmount:     sdk.ZeroInt(),
+		ConfirmedBatches:  0,
+		HighestBatchNonce: 0,
+	}
+	c.tokens[key] = token
+	return token
+}
+
+// checkTx checks a transfer out of the bridge and returns its total amount of the contract token, the tx id must be
+// unique across every EVM chain and issued by the shared tx pool counter
+func (c *genesisChecker) checkTx(where string, tx OutgoingTransferTx, contract EthAddress) (sdk.Int, bool) {
+	if tx.Id == 0 || tx.Id > c.gs.GravityNonces.LastTxPoolId {
+		c.problem("%s: tx %d is not below gravity_nonces.last_tx_pool_id %d", where, tx.Id, c.gs.GravityNonces.LastTxPoolId)
+	}
+	if other, ok := c.txIds[tx.Id]; ok {
+		c.problem("%s: tx %d is also in %s", where, tx.Id, other)
+	}
+	c.txIds[tx.Id] = where
+
+	internal, err := tx.ToInternal()
+	if err != nil {
+		c.problem("%s: tx %d is invalid: %v", where, tx.Id, err)
+		return sdk.ZeroInt(), false
+	}
+	if internal.Erc20Token.Contract != contract || internal.Erc20Fee.Contract != contract {
+		c.problem("%s: tx %d sends %s and pays its fee in %s instead of %s", where, tx.Id,
+			internal.Erc20Token.Contract.GetAddress().Hex(), internal.Erc20Fee.Contract.GetAddress().Hex(), contract.GetAddress().Hex())
+		return sdk.ZeroInt(), false
+	}
+	return internal.Erc20Token.Amount.Add(internal.Erc20Fee.Amount), true
+}
+
+// checkBatchNonce checks that the batch nonce is unique across every EVM chain and issued by the shared batch counter
+func (c *genesisChecker) checkBatchNonce(where string, nonce uint64) {
+	if nonce == 0 || nonce > c.gs.GravityNonces.LastBatchId {
+		c.problem("%s: nonce %d is not below gravity_nonces.last_batch_id %d", where, nonce, c.gs.GravityNonces.LastBatchId)
+	}
+	if other, ok := c.batchNonces[nonce]; ok {
+		c.problem("%s: nonce %d is also used by %s", where, nonce, other)
+	}
+	c.batchNonces[nonce] = where
+}
+
+// checkSigner checks that a confirm is made by an orchestrator with delegate keys, using its ethereum key
+func (c *genesisChecker) checkSigner(where string, orchestrator string, ethSigner string) {
+	ethAddress, ok := c.orchestrators[orchestrator]
+	if !ok {
+		c.problem("%s: orchestrator %s has no delegate keys", where, orchestrator)
+		return
+	}
+	signer, err := NewEthAddress(ethSigner)
+	if err != nil {
+		c.problem("%s: invalid eth signer %s: %v", where, ethSigner, err)
+		return
+	}
+	if registered, err := NewEthAddress(ethAddress); err != nil || *registered != *signer {
+		c.problem("%s: eth signer %s is not the ethereum key %s of orchestrator %s", where, ethSigner, ethAddress, orchestrator)
+	}
+}
+
+// nolint: gocyclo
+func (c *genesisChecker) checkEvmChain(data EvmChainData) {
+	prefix := data.EvmChainPrefix
+	nonces := data.GravityNonces
+
+	// erc20_to_denoms must be a one to one mapping of cosmos originated denoms
+	erc20ToDenom := make(map[string]string, len(data.Erc20ToDenoms))
+	denomToErc20 := make(map[string]string, len(data.Erc20ToDenoms))
+	for _, pair := range data.Erc20ToDenoms {
+		erc20, err := NewEthAddress(pair.Erc20)
+		if err != nil {
+			c.problem("%s erc20_to_denoms: invalid erc20 %s: %v", prefix, pair.Erc20, err)
+			continue
+		}
+		if _, _, err := GravityDenomToERC20(pair.Denom); err == nil || pair.Denom == "" {
+			c.problem("%s erc20_to_denoms: %s maps to %q which is not a cosmos originated denom", prefix, pair.Erc20, pair.Denom)
+		}
+		if other, ok := erc20ToDenom[erc20.GetAddress().Hex()]; ok {
+			c.problem("%s erc20_to_denoms: %s maps to both %s and %s", prefix, pair.Erc20, other, pair.Denom)
+		}
+		if other, ok := denomToErc20[pair.Denom]; ok {
+			c.problem("%s erc20_to_denoms: %s maps to both %s and %s", prefix, pair.Denom, other, pair.Erc20)
+		}
+		erc20ToDenom[erc20.GetAddress().Hex()] = pair.Denom
+		denomToErc20[pair.Denom] = erc20.GetAddress().Hex()
+	}
+
+	// valsets, their nonces and heights must increase together
+	valsets := make(map[uint64]bool, len(data.Valsets))
+	sortedValsets := append([]Valset{}, data.Valsets...)
+	sort.Slice(sortedValsets, func(i, j int) bool { return sortedValsets[i].Nonce < sortedValsets[j].Nonce })
+	for i, vs := range sortedValsets {
+		if valsets[vs.Nonce] {
+			c.problem("%s valsets: nonce %d is used twice", prefix, vs.Nonce)
+		}
+		valsets[vs.Nonce] = true
+		if vs.Nonce > nonces.LatestValsetNonce {
+			c.problem("%s valsets: nonce %d is above gravity_nonces.latest_valset_nonce %d", prefix, vs.Nonce, nonces.LatestValsetNonce)
+		}
+		if i > 0 && vs.Height < sortedValsets[i-1].Height {
+			c.problem("%s valsets: valset %d was created at height %d, before valset %d at height %d",
+				prefix, vs.Nonce, vs.Height, sortedValsets[i-1].Nonce, sortedValsets[i-1].Height)
+		}
+	}
+	if len(sortedValsets) > 0 && sortedValsets[len(sortedValsets)-1].Nonce != nonces.LatestValsetNonce {
+		c.warning("%s valsets: the latest valset is %d but gravity_nonces.latest_valset_nonce is %d",
+			prefix, sortedValsets[len(sortedValsets)-1].Nonce, nonces.LatestValsetNonce)
+	}
+	for _, confirm := range data.ValsetConfirms {
+		where := fmt.Sprintf("%s valset_confirms: confirm of valset %d", prefix, confirm.Nonce)
+		if !valsets[confirm.Nonce] {
+			c.problem("%s: the valset does not exist", where)
+		}
+		c.checkSigner(where, confirm.Orchestrator, confirm.EthAddress)
+	}
+
+	// single token batches
+	batches := make(map[string]*GenesisTokenSummary, len(data.Batches))
+	for _, batch := range data.Batches {
+		where := fmt.Sprintf("%s batches: batch %d of %s", prefix, batch.BatchNonce, batch.TokenContract)
+		contract, err := NewEthAddress(batch.TokenContract)
+		if err != nil {
+			c.problem("%s: invalid token contract: %v", where, err)
+			continue
+		}
+		c.checkBatchNonce(where, batch.BatchNonce)
+		token := c.token(prefix, erc20ToDenom, *contract)
+		token.Batches++
+		if batch.BatchNonce > token.HighestBatchNonce {
+			token.HighestBatchNonce = batch.BatchNonce
+		}
+		batches[fmt.Sprintf("%s/%d", contract.GetAddress().Hex(), batch.BatchNonce)] = token
+		if len(batch.Transactions) == 0 {
+			c.problem("%s: the batch is empty", where)
+		}
+		for _, tx := range batch.Transactions {
+			if amount, ok := c.checkTx(where, tx, *contract); ok {
+				token.BatchedTxs++
+				token.BatchedAmount = token.BatchedAmount.Add(amount)
+			}
+		}
+	}
+	confirmedBatches := map[string]bool{}
+	for _, confirm := range data.BatchConfirms {
+		where := fmt.Sprintf("%s batch_confirms: confirm of batch %d of %s", prefix, confirm.Nonce, confirm.TokenContract)
+		contract, err := NewEthAddress(confirm.TokenContract)
+		if err != nil {
+			c.problem("%s: invalid token contract: %v", where, err)
+			continue
+		}
+		key := fmt.Sprintf("%s/%d", contract.GetAddress().Hex(), confirm.Nonce)
+		if token, ok := batches[key]; !ok {
+			c.problem("%s: the batch does not exist", where)
+		} else if !confirmedBatches[key] {
+			token.ConfirmedBatches++
+			confirmedBatches[key] = true
+		}
+		c.checkSigner(where, confirm.Orchestrator, confirm.EthSigner)
+	}
+
+	// unbatched transfers
+	for _, tx := range data.UnbatchedTransfers {
+		where := fmt.Sprintf("%s unbatched_transfers", prefix)
+		contract, err := NewEthAddress(tx.Erc20Token.Contract)
+		if err != nil {
+			c.problem("%s: tx %d has an invalid token contract: %v", where, tx.Id, err)
+			continue
+		}
+		if amount, ok := c.checkTx(where, tx, *contract); ok {
+			token := c.token(prefix, erc20ToDenom, *contract)
+			token.UnbatchedTxs++
+			token.UnbatchedAmount = token.UnbatchedAmount.Add(amount)
+		}
+	}
+
+	// logic calls
+	logicCalls := make(map[string]bool, len(data.LogicCalls))
+	for _, call := range data.LogicCalls {
+		logicCalls[fmt.Sprintf("%x/%d", call.InvalidationId, call.InvalidationNonce)] = true
+	}
+	for _, confirm := range data.LogicCallConfirms {
+		where := fmt.Sprintf("%s logic_call_confirms: confirm of logic call %s/%d", prefix, confirm.InvalidationId, confirm.InvalidationNonce)
+		invalidationID, err := hex.DecodeString(confirm.InvalidationId)
+		if err != nil || !logicCalls[fmt.Sprintf("%x/%d", invalidationID, confirm.InvalidationNonce)] {
+			c.problem("%s: the logic call does not exist", where)
+		}
+		c.checkSigner(where, confirm.Orchestrator, confirm.EthSigner)
+	}
+
+	// attestations are observed exactly up to the last observed nonce
+	for i, att := range data.Attestations {
+		var claim EthereumClaim
+		if err := c.unpacker.UnpackAny(att.Claim, &claim); err != nil {
+			c.problem("%s attestations: attestation %d has an invalid claim: %v", prefix, i, err)
+			continue
+		}
+		nonce := claim.GetEventNonce()
+		if att.Observed && nonce > nonces.LastObservedNonce {
+			c.problem("%s attestations: observed claim %d is above gravity_nonces.last_observed_nonce %d", prefix, nonce, nonces.LastObservedNonce)
+		}
+		if !att.Observed && nonce <= nonces.LastObservedNonce {
+			c.warning("%s attestations: claim %d is not observed, but gravity_nonces.last_observed_nonce is %d", prefix, nonce, nonces.LastObservedNonce)
+		}
+	}
+
+	// pending ibc auto forwards come from observed deposits
+	for _, forward := range data.PendingIbcAutoForwards {
+		where := fmt.Sprintf("%s pending_ibc_auto_forwards: forward of event %d", prefix, forward.EventNonce)
+		if forward.Token == nil {
+			c.problem("%s: the token is missing", where)
+			continue
+		}
+		if forward.EventNonce > nonces.LastObservedNonce {
+			c.problem("%s: the event is above gravity_nonces.last_observed_nonce %d", where, nonces.LastObservedNonce)
+		}
+		var contract *EthAddress
+		if erc20, ok := denomToErc20[forward.Token.Denom]; ok {
+			contract, _ = NewEthAddress(erc20)
+		} else if chain, voucher, err := GravityDenomToERC20(forward.Token.Denom); err == nil && chain == prefix {
+			contract = voucher
+		} else {
+			c.problem("%s: %s is not a token of the chain", where, forward.Token.Denom)
+			continue
+		}
+		token := c.token(prefix, erc20ToDenom, *contract)
+		token.PendingForwards++
+		token.PendingAmount = token.PendingAmount.Add(forward.Token.Amount)
+	}
+}
+
+// checkEscrow compares the module balance with the amount of every token on its way out of the bridge
+func (c *genesisChecker) checkEscrow(moduleBalance sdk.Coins) {
+	for _, token := range c.tokens {
+		c.expect(token.Denom, token.Total())
+	}
+	for _, coin := range moduleBalance {
+		if _, ok := c.expected[coin.Denom]; !ok {
+			c.expected[coin.Denom] = sdk.ZeroInt()
+		}
+	}
+
+	denoms := make([]string, 0, len(c.expected))
+	for denom := range c.expected {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	for _, denom := range denoms {
+		_, _, err := GravityDenomToERC20(denom)
+		escrow := GenesisEscrow{
+			Denom:         denom,
+			Expected:      c.expected[denom],
+			ModuleBalance: moduleBalance.AmountOf(denom),
+			Exact:         err == nil,
+		}
+		c.report.Escrow = append(c.report.Escrow, escrow)
+		if !escrow.Matches() {
+			if escrow.Exact {
+				c.problem("module balance of %s is %s, but %s is on its way out of the bridge", denom, escrow.ModuleBalance, escrow.Expected)
+			} else {
+				c.problem("module balance of %s is %s, which does not cover the %s on its way out of the bridge", denom, escrow.ModuleBalance, escrow.Expected)
+			}
+		}
+	}
+}
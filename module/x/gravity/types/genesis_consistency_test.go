@@ -0,0 +1,173 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// consistentGenesis returns a genesis state with some of every bridge section, which is consistent with the returned
+// module balance
+// nolint: exhaustruct
+func consistentGenesis(t *testing.T) (GenesisState, sdk.Coins) {
+	var (
+		orchestrator = sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+		sender       = sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+		ethSigner    = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
+		destination  = "0x26126048c706fB45a5a6De8432F428e794d0b952"
+		ethToken     = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		cosmosToken  = "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"
+	)
+	ethTokenAddr, err := NewEthAddress(ethToken)
+	require.NoError(t, err)
+	voucher := GravityDenom(DefaultEvmChainPrefix, *ethTokenAddr)
+	transfer := func(id uint64, contract string, amount, fee int64) OutgoingTransferTx {
+		return OutgoingTransferTx{
+			Id:          id,
+			Sender:      sender,
+			DestAddress: destination,
+			Erc20Token:  ERC20Token{Contract: contract, Amount: sdk.NewInt(amount)},
+			Erc20Fee:    ERC20Token{Contract: contract, Amount: sdk.NewInt(fee)},
+		}
+	}
+	claim, err := codectypes.NewAnyWithValue(&MsgSendToCosmosClaim{
+		EventNonce: 5, EthBlockHeight: 100, TokenContract: ethToken, Amount: sdk.NewInt(7),
+		EthereumSender: destination, CosmosReceiver: "cosmos1ibc", Orchestrator: orchestrator,
+	})
+	require.NoError(t, err)
+
+	gs := *DefaultGenesisState()
+	gs.DelegateKeys = []MsgSetOrchestratorAddress{{
+		Validator: sdk.ValAddress(bytes.Repeat([]byte{0x1}, 20)).String(), Orchestrator: orchestrator, EthAddress: ethSigner,
+	}}
+	gs.GravityNonces = GravityNonces{LatestValsetNonce: 2, LastObservedNonce: 5, LastTxPoolId: 4, LastBatchId: 2}
+	gs.Valsets = []Valset{
+		{Nonce: 1, Height: 10, RewardAmount: sdk.ZeroInt()},
+		{Nonce: 2, Height: 20, RewardAmount: sdk.ZeroInt()},
+	}
+	gs.ValsetConfirms = []MsgValsetConfirm{{Nonce: 2, Orchestrator: orchestrator, EthAddress: ethSigner}}
+	gs.Erc20ToDenoms = []ERC20ToDenom{{Erc20: cosmosToken, Denom: "ugraviton"}}
+	gs.Batches = []OutgoingTxBatch{{BatchNonce: 2, TokenContract: ethToken, Transactions: []OutgoingTransferTx{transfer(1, ethToken, 100, 1)}}}
+	gs.BatchConfirms = []MsgConfirmBatch{{Nonce: 2, TokenContract: ethToken, EthSigner: ethSigner, Orchestrator: orchestrator}}
+	gs.UnbatchedTransfers = []OutgoingTransferTx{transfer(3, ethToken, 50, 5), transfer(4, cosmosToken, 10, 0)}
+	gs.PendingIbcAutoForwards = []PendingIbcAutoForward{{ForeignReceiver: "cosmos1ibc", Token: &sdk.Coin{Denom: voucher, Amount: sdk.NewInt(7)}, IbcChannel: "channel-0", EventNonce: 5}}
+	gs.Attestations = []Attestation{{Observed: true, Votes: []string{}, Height: 1, Claim: claim}}
+	gs.MerkleAirdrops = []MerkleAirdrop{{Id: 1, Denom: "ugraviton", MerkleRoot: bytes.Repeat([]byte{0x1}, 32), Total: 100, Claimed: 40}}
+
+	// vouchers are held exactly, cosmos originated tokens must only be covered
+	moduleBalance := sdk.NewCoins(sdk.NewInt64Coin(voucher, 100+1+50+5+7), sdk.NewInt64Coin("ugraviton", 10+60+1000))
+	return gs, moduleBalance
+}
+
+func TestCheckGenesisConsistency(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+
+	gs, moduleBalance := consistentGenesis(t)
+	report := CheckGenesisConsistency(gs, registry, moduleBalance)
+	require.Empty(t, report.Problems)
+	require.Empty(t, report.Warnings)
+	require.Len(t, report.Tokens, 2)
+	for _, token := range report.Tokens {
+		if token.CosmosOriginated {
+			require.Equal(t, "ugraviton", token.Denom)
+			require.Equal(t, 1, token.UnbatchedTxs)
+			require.Equal(t, sdk.NewInt(10), token.Total())
+			continue
+		}
+		require.Equal(t, 1, token.Batches)
+		require.Equal(t, 1, token.ConfirmedBatches)
+		require.Equal(t, sdk.NewInt(101), token.BatchedAmount)
+		require.Equal(t, sdk.NewInt(55), token.UnbatchedAmount)
+		require.Equal(t, 1, token.PendingForwards)
+		require.Equal(t, sdk.NewInt(163), token.Total())
+	}
+	require.Len(t, report.Escrow, 2)
+
+	specs := map[string]struct {
+		mutate func(gs *GenesisState, moduleBalance *sdk.Coins)
+		expErr string
+	}{
+		"confirm of a missing batch": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) { gs.BatchConfirms[0].Nonce = 1 },
+			expErr: "the batch does not exist",
+		},
+		"confirm of a missing valset": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) { gs.ValsetConfirms[0].Nonce = 3 },
+			expErr: "the valset does not exist",
+		},
+		"confirm without delegate keys": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) { gs.DelegateKeys = nil },
+			expErr: "has no delegate keys",
+		},
+		"confirm signed by another key": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) {
+				gs.BatchConfirms[0].EthSigner = "0x26126048c706fB45a5a6De8432F428e794d0b952"
+			},
+			expErr: "is not the ethereum key",
+		},
+		"tx id above the pool counter": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) { gs.GravityNonces.LastTxPoolId = 3 },
+			expErr: "last_tx_pool_id",
+		},
+		"duplicate tx id": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) { gs.UnbatchedTransfers[0].Id = 1 },
+			expErr: "tx 1 is also in",
+		},
+		"batch nonce above the batch counter": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) { gs.GravityNonces.LastBatchId = 1 },
+			expErr: "last_batch_id",
+		},
+		"batch tx of another token": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) {
+				gs.Batches[0].Transactions[0].Erc20Fee.Contract = "0x7580bFE88Dd3d07947908FAE12d95872a260F2D8"
+			},
+			expErr: "pays its fee in",
+		},
+		"valset above the latest nonce": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) { gs.GravityNonces.LatestValsetNonce = 1 },
+			expErr: "latest_valset_nonce",
+		},
+		"observed attestation above the last observed nonce": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) {
+				gs.GravityNonces.LastObservedNonce = 4
+				gs.PendingIbcAutoForwards = nil
+			},
+			expErr: "last_observed_nonce",
+		},
+		"duplicate erc20 to denom": {
+			mutate: func(gs *GenesisState, _ *sdk.Coins) {
+				gs.Erc20ToDenoms = append(gs.Erc20ToDenoms, ERC20ToDenom{Erc20: "0x26126048c706fB45a5a6De8432F428e794d0b952", Denom: "ugraviton"})
+			},
+			expErr: "maps to both",
+		},
+		"voucher balance above the outgoing amount": {
+			mutate: func(_ *GenesisState, moduleBalance *sdk.Coins) {
+				*moduleBalance = moduleBalance.Add(sdk.NewInt64Coin((*moduleBalance)[0].Denom, 1))
+			},
+			expErr: "is on its way out of the bridge",
+		},
+		"cosmos originated balance below the outgoing amount": {
+			mutate: func(_ *GenesisState, moduleBalance *sdk.Coins) {
+				*moduleBalance = moduleBalance.Sub(sdk.NewCoins(sdk.NewInt64Coin("ugraviton", 1001)))
+			},
+			expErr: "does not cover",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gs, moduleBalance := consistentGenesis(t)
+			spec.mutate(&gs, &moduleBalance)
+			report := CheckGenesisConsistency(gs, registry, moduleBalance)
+			require.NotEmpty(t, report.Problems)
+			found := false
+			for _, problem := range report.Problems {
+				found = found || bytes.Contains([]byte(problem), []byte(spec.expErr))
+			}
+			require.True(t, found, "%q not in %v", spec.expErr, report.Problems)
+		})
+	}
+}
@@ -82,6 +82,14 @@ func TestGenesisStateValidate(t *testing.T) {
 			Erc20ToDenoms:      []ERC20ToDenom{},
 			UnbatchedTransfers: []OutgoingTransferTx{},
 		}, expErr: true},
+		"second evm chain":                {src: withEvmChains(validEvmChain("arbitrum", "arbitrumgravityid")), expErr: false},
+		"second evm chain with state":     {src: withEvmChainData(withEvmChains(validEvmChain("arbitrum", "arbitrumgravityid")), "arbitrum"), expErr: false},
+		"evm chain state without params":  {src: withEvmChainData(DefaultGenesisState(), "arbitrum"), expErr: true},
+		"default evm chain in evm_chains": {src: withEvmChains(validEvmChain(DefaultEvmChainPrefix, "othergravityid")), expErr: true},
+		"duplicate evm chain":             {src: withEvmChains(validEvmChain("arbitrum", "arbitrumgravityid"), validEvmChain("arbitrum", "othergravityid")), expErr: true},
+		"evm chain sharing gravity id":    {src: withEvmChains(validEvmChain("arbitrum", DefaultParams().GravityId)), expErr: true},
+		"evm chain with invalid prefix":   {src: withEvmChains(validEvmChain("Arbitrum/One", "arbitrumgravityid")), expErr: true},
+		"evm chain with invalid bridge":   {src: withEvmChains(EvmChainParams{EvmChainPrefix: "arbitrum", EvmChainName: "Arbitrum", GravityId: "arbitrumgravityid", BridgeEthereumAddress: "invalid-eth-address", AverageEthereumBlockTime: 250}), expErr: true},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -95,6 +103,31 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+func validEvmChain(prefix string, gravityId string) EvmChainParams {
+	return EvmChainParams{
+		EvmChainPrefix:           prefix,
+		EvmChainName:             "Arbitrum One",
+		GravityId:                gravityId,
+		BridgeEthereumAddress:    "0x0000000000000000000000000000000000000000",
+		BridgeChainId:            42161,
+		AverageEthereumBlockTime: 250,
+		BridgeActive:             true,
+		EthereumBlacklist:        []string{},
+	}
+}
+
+func withEvmChains(chains ...EvmChainParams) *GenesisState {
+	state := DefaultGenesisState()
+	state.Params.EvmChains = chains
+	return state
+}
+
+// nolint: exhaustruct
+func withEvmChainData(state *GenesisState, prefix string) *GenesisState {
+	state.EvmChains = append(state.EvmChains, EvmChainData{EvmChainPrefix: prefix})
+	return state
+}
+
 func TestStringToByteArray(t *testing.T) {
 	specs := map[string]struct {
 		testString string
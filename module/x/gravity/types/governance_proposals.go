@@ -9,9 +9,13 @@ import (
 )
 
 const (
-	ProposalTypeUnhaltBridge = "UnhaltBridge"
-	ProposalTypeAirdrop      = "Airdrop"
-	ProposalTypeIBCMetadata  = "IBCMetadata"
+	ProposalTypeUnhaltBridge   = "UnhaltBridge"
+	ProposalTypeAirdrop        = "Airdrop"
+	ProposalTypeIBCMetadata    = "IBCMetadata"
+	ProposalTypeERC20Metadata  = "ERC20Metadata"
+	ProposalTypeERC20Migration = "ERC20Migration"
+	ProposalTypeMerkleAirdrop  = "MerkleAirdrop"
+	ProposalTypeVestingAirdrop = "VestingAirdrop"
 )
 
 func (p *UnhaltBridgeProposal) GetTitle() string { return p.Title }
@@ -126,3 +130,164 @@ func (p IBCMetadataProposal) String() string {
 `, p.Title, p.Description, p.Metadata.Name, p.Metadata.Symbol, p.Metadata.Display, decimals, p.Metadata.Description))
 	return b.String()
 }
+
+func (p *ERC20MetadataProposal) GetTitle() string { return p.Title }
+
+func (p *ERC20MetadataProposal) GetDescription() string { return p.Description }
+
+func (p *ERC20MetadataProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ERC20MetadataProposal) ProposalType() string {
+	return ProposalTypeERC20Metadata
+}
+
+func (p *ERC20MetadataProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p ERC20MetadataProposal) String() string {
+	decimals := uint32(0)
+	for _, denomUnit := range p.Metadata.DenomUnits {
+		if denomUnit.Denom == p.Metadata.Display {
+			decimals = denomUnit.Exponent
+			break
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`ERC20 Metadata setting proposal:
+  Title:             %s
+  Description:       %s
+  Gravity Denom:     %s
+  Token Name:        %s
+  Token Symbol:      %s
+  Token Display:     %s
+  Token Decimals:    %d
+  Token Description: %s
+`, p.Title, p.Description, p.GravityDenom, p.Metadata.Name, p.Metadata.Symbol, p.Metadata.Display, decimals, p.Metadata.Description))
+	return b.String()
+}
+
+func (p *ERC20MigrationProposal) GetTitle() string { return p.Title }
+
+func (p *ERC20MigrationProposal) GetDescription() string { return p.Description }
+
+func (p *ERC20MigrationProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ERC20MigrationProposal) ProposalType() string {
+	return ProposalTypeERC20Migration
+}
+
+func (p *ERC20MigrationProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p ERC20MigrationProposal) String() string {
+	metadata := "unchanged"
+	if p.Metadata != nil {
+		metadata = fmt.Sprintf("%s (%s)", p.Metadata.Name, p.Metadata.Symbol)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`ERC20 Migration proposal:
+  Title:            %s
+  Description:      %s
+  Cosmos Denom:     %s
+  New ERC20:        %s
+  Token Metadata:   %s
+  Migration Window: %d
+`, p.Title, p.Description, p.CosmosDenom, p.NewErc20, metadata, p.MigrationWindow))
+	return b.String()
+}
+
+func (p *MerkleAirdropProposal) GetTitle() string { return p.Title }
+
+func (p *MerkleAirdropProposal) GetDescription() string { return p.Description }
+
+func (p *MerkleAirdropProposal) ProposalRoute() string { return RouterKey }
+
+func (p *MerkleAirdropProposal) ProposalType() string {
+	return ProposalTypeMerkleAirdrop
+}
+
+func (p *MerkleAirdropProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p MerkleAirdropProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Merkle Airdrop Proposal:
+  Title:          %s
+  Description:    %s
+  Total Amount:   %d%s
+  Merkle Root:    %x
+  Expiry Height:  %d
+`, p.Title, p.Description, p.Total, p.Denom, p.MerkleRoot, p.ExpiryHeight))
+	return b.String()
+}
+
+func (p *VestingAirdropProposal) GetTitle() string { return p.Title }
+
+func (p *VestingAirdropProposal) GetDescription() string { return p.Description }
+
+func (p *VestingAirdropProposal) ProposalRoute() string { return RouterKey }
+
+func (p *VestingAirdropProposal) ProposalType() string {
+	return ProposalTypeVestingAirdrop
+}
+
+func (p *VestingAirdropProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p VestingAirdropProposal) String() string {
+	var b strings.Builder
+	total := uint64(0)
+	for _, v := range p.Amounts {
+		total += v
+	}
+	schedule := "continuous"
+	if p.NumPeriods > 0 {
+		schedule = fmt.Sprintf("%d periods", p.NumPeriods)
+	}
+	parsedRecipients := make([]sdk.AccAddress, len(p.Recipients)/20)
+	for i := 0; i < len(p.Recipients)/20; i++ {
+		indexStart := i * 20
+		indexEnd := indexStart + 20
+		addr := p.Recipients[indexStart:indexEnd]
+		parsedRecipients[i] = addr
+	}
+	recipients := ""
+	for i, a := range parsedRecipients {
+		if i < len(p.Amounts) {
+			recipients += fmt.Sprintf("Account: %s Amount: %d%s", a.String(), p.Amounts[i], p.Denom)
+		}
+	}
+
+	b.WriteString(fmt.Sprintf(`Vesting Airdrop Proposal:
+  Title:          %s
+  Description:    %s
+  Total Amount:   %d%s
+  Start Time:     %d
+  End Time:       %d
+  Schedule:       %s
+  Recipients:     %s
+`, p.Title, p.Description, total, p.Denom, p.StartTime, p.EndTime, schedule, recipients))
+	return b.String()
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"strings"
 )
@@ -154,8 +155,90 @@ var (
 	// PendingIbcAutoForwards indexes pending SendToCosmos sends via IBC, queued by event nonce
 	// [0x5b89a7c5dc9abd2a7abc2560d6eb42ea]
 	PendingIbcAutoForwards = HashString("IbcAutoForwardQueue")
+
+	// ERC20MigrationKey indexes Cosmos originated asset migrations by the old ERC20 address
+	// [0x4205aa46e80a8786b5e4674dd91d0a7d]
+	ERC20MigrationKey = HashString("ERC20MigrationKey")
+
+	// AttestedERC20DeploymentKey indexes attested ERC20 deployments for Cosmos originated denoms which
+	// already have an ERC20, these are candidates for an ERC20MigrationProposal
+	// [0x59fa6e0b409a1d22a20b583cd378c0f0]
+	AttestedERC20DeploymentKey = HashString("AttestedERC20DeploymentKey")
+
+	// MerkleAirdropKey indexes Merkle airdrops by id
+	// [0xcc5873767cc88bb10e82e7a9d0b2add9]
+	MerkleAirdropKey = HashString("MerkleAirdropKey")
+
+	// MerkleAirdropClaimKey indexes the claims made against each Merkle airdrop by airdrop id and claimer
+	// [0xe2975fdc2d5e9f691bb7b4f04a8c88d3]
+	MerkleAirdropClaimKey = HashString("MerkleAirdropClaimKey")
+
+	// KeyLastMerkleAirdropID indexes the lastMerkleAirdropID
+	// [0x9d66ef81fe15b069e69c347d2cdf65e3]
+	KeyLastMerkleAirdropID = HashString("SequenceKeyPrefix" + "lastMerkleAirdropId")
+
+	// ValidatorBridgePerformanceKey indexes the bridge duty record of each validator, the record covers every EVM chain
+	// [0x407d196fa866f58ccaf11166fb18117c]
+	ValidatorBridgePerformanceKey = HashString("ValidatorBridgePerformanceKey")
+
+	// BridgeOffencesKey indexes the missed signature offences of each validator, the offences cover every EVM chain
+	// [0x2d76addeb43bf9b8ec0f0220e6281c98]
+	BridgeOffencesKey = HashString("BridgeOffencesKey")
+
+	// BatchFeeRecordKey indexes the fee records of the recently executed batches of each token, the records of a token
+	// form a ring buffer of BatchFeeHistorySize slots
+	// [0x8dc99819f0c48ee2848e3d110f87fbd4]
+	BatchFeeRecordKey = HashString("BatchFeeRecordKey")
+
+	// BatchFeeRecordCountKey indexes the number of fee records ever written for each token, which locates the next
+	// slot of the token's ring buffer
+	// [0x1bc69e57e09c64450e197bf7f05b2838]
+	BatchFeeRecordCountKey = HashString("BatchFeeRecordCountKey")
+
+	// OutgoingTxCreatedHeightKey indexes the height each transfer was added to the outgoing tx pool at by its id, the
+	// height is kept until the transfer leaves the bridge so that it survives batching and cancelled batches
+	// [0x51fb8f9ba8d127ecf1b01b260fe587f0]
+	OutgoingTxCreatedHeightKey = HashString("OutgoingTxCreatedHeightKey")
 )
 
+// EvmChainScopedKeys lists the prefixes holding the state of a single EVM chain, every key under
+// these prefixes continues with the length prefixed EVM chain prefix, see AppendEvmChainPrefix
+var EvmChainScopedKeys = [][]byte{
+	ValsetRequestKey,
+	ValsetConfirmKey,
+	OracleAttestationKey,
+	OutgoingTXPoolKey,
+	OutgoingTXBatchKey,
+	BatchConfirmKey,
+	LastEventNonceByValidatorKey,
+	LastObservedEventNonceKey,
+	KeyOutgoingLogicCall,
+	KeyOutgoingLogicConfirm,
+	LastObservedEthereumBlockHeightKey,
+	DenomToERC20Key,
+	ERC20ToDenomKey,
+	LastSlashedValsetNonce,
+	LatestValsetNonce,
+	LastSlashedBatchBlock,
+	LastSlashedLogicCallBlock,
+	PastEthSignatureCheckpointKey,
+	LastObservedValsetKey,
+	PendingIbcAutoForwards,
+	ERC20MigrationKey,
+	AttestedERC20DeploymentKey,
+	BatchFeeRecordKey,
+	BatchFeeRecordCountKey,
+	OutgoingTxCreatedHeightKey,
+}
+
+// AppendEvmChainPrefix returns the following key format
+// key  len(evm-chain)  evm-chain
+// [0x0][8][ethereum]
+// The length prefix keeps the state of an EVM chain from appearing under the prefix of another
+func AppendEvmChainPrefix(key []byte, evmChainPrefix string) []byte {
+	return AppendBytes(key, address.MustLengthPrefix([]byte(evmChainPrefix)))
+}
+
 // GetOrchestratorAddressKey returns the following key format
 // prefix 				orchestrator address
 // [0x0][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
@@ -176,6 +259,26 @@ func GetEthAddressByValidatorKey(validator sdk.ValAddress) []byte {
 	return AppendBytes(EthAddressByValidatorKey, validator.Bytes())
 }
 
+// GetValidatorBridgePerformanceKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetValidatorBridgePerformanceKey(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(ValidatorBridgePerformanceKey, validator.Bytes())
+}
+
+// GetBridgeOffencesKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetBridgeOffencesKey(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(BridgeOffencesKey, validator.Bytes())
+}
+
 // GetValidatorByEthAddressKey returns the following key format
 // prefix              cosmos-validator
 // [0x0][0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B]
@@ -184,129 +287,136 @@ func GetValidatorByEthAddressKey(ethAddress EthAddress) []byte {
 }
 
 // GetValsetKey returns the following key format
-// prefix    nonce
-// [0x0][0 0 0 0 0 0 0 1]
-func GetValsetKey(nonce uint64) []byte {
-	return AppendBytes(ValsetRequestKey, UInt64Bytes(nonce))
+// prefix  evm-chain    nonce
+// [0x0][8 ethereum][0 0 0 0 0 0 0 1]
+func GetValsetKey(evmChainPrefix string, nonce uint64) []byte {
+	return AppendBytes(AppendEvmChainPrefix(ValsetRequestKey, evmChainPrefix), UInt64Bytes(nonce))
 }
 
 // GetValsetConfirmNoncePrefix returns the following format
-// prefix   nonce
-// [0x0][0 0 0 0 0 0 0 1]
-func GetValsetConfirmNoncePrefix(nonce uint64) []byte {
-	return AppendBytes(ValsetConfirmKey, UInt64Bytes(nonce))
+// prefix  evm-chain   nonce
+// [0x0][8 ethereum][0 0 0 0 0 0 0 1]
+func GetValsetConfirmNoncePrefix(evmChainPrefix string, nonce uint64) []byte {
+	return AppendBytes(AppendEvmChainPrefix(ValsetConfirmKey, evmChainPrefix), UInt64Bytes(nonce))
 }
 
 // GetValsetConfirmKey returns the following key format
-// prefix   nonce                    validator-address
-// [0x0][0 0 0 0 0 0 0 1][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+// prefix  evm-chain   nonce                    validator-address
+// [0x0][8 ethereum][0 0 0 0 0 0 0 1][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
 // MARK finish-batches: this is where the key is created in the old (presumed working) code
-func GetValsetConfirmKey(nonce uint64, validator sdk.AccAddress) []byte {
+func GetValsetConfirmKey(evmChainPrefix string, nonce uint64, validator sdk.AccAddress) []byte {
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
 		panic(sdkerrors.Wrap(err, "invalid validator address"))
 	}
-	return AppendBytes(GetValsetConfirmNoncePrefix(nonce), validator.Bytes())
+	return AppendBytes(GetValsetConfirmNoncePrefix(evmChainPrefix, nonce), validator.Bytes())
 }
 
 // GetAttestationKey returns the following key format
-// prefix     nonce                             claim-details-hash
-// [0x0][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
+// prefix  evm-chain     nonce                             claim-details-hash
+// [0x0][8 ethereum][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
 // An attestation is an event multiple people are voting on, this function needs the claim
 // details because each Attestation is aggregating all claims of a specific event, lets say
 // validator X and validator y were making different claims about the same event nonce
 // Note that the claim hash does NOT include the claimer address and only identifies an event
-func GetAttestationKey(eventNonce uint64, claimHash []byte) []byte {
-	return AppendBytes(OracleAttestationKey, UInt64Bytes(eventNonce), claimHash)
+func GetAttestationKey(evmChainPrefix string, eventNonce uint64, claimHash []byte) []byte {
+	return AppendBytes(AppendEvmChainPrefix(OracleAttestationKey, evmChainPrefix), UInt64Bytes(eventNonce), claimHash)
 }
 
 // GetOutgoingTxPoolContractPrefix returns
-// prefix			feeContract
-// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// prefix  evm-chain			feeContract
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6]
 // This prefix is used for iterating over unbatched transactions for a given contract
-func GetOutgoingTxPoolContractPrefix(contractAddress EthAddress) []byte {
-	return AppendBytes(OutgoingTXPoolKey, contractAddress.GetAddress().Bytes())
+func GetOutgoingTxPoolContractPrefix(evmChainPrefix string, contractAddress EthAddress) []byte {
+	return AppendBytes(AppendEvmChainPrefix(OutgoingTXPoolKey, evmChainPrefix), contractAddress.GetAddress().Bytes())
 }
 
 // GetOutgoingTxPoolKey returns the following key format
-// prefix				feeContract					 feeAmount			id
-// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][1000000000][0 0 0 0 0 0 0 1]
-func GetOutgoingTxPoolKey(fee InternalERC20Token, id uint64) []byte {
+// prefix  evm-chain				feeContract					 feeAmount			id
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6][1000000000][0 0 0 0 0 0 0 1]
+func GetOutgoingTxPoolKey(evmChainPrefix string, fee InternalERC20Token, id uint64) []byte {
 	amount := make([]byte, 32)
 	amount = fee.Amount.BigInt().FillBytes(amount)
-	return AppendBytes(OutgoingTXPoolKey, fee.Contract.GetAddress().Bytes(), amount, UInt64Bytes(id))
+	return AppendBytes(AppendEvmChainPrefix(OutgoingTXPoolKey, evmChainPrefix), fee.Contract.GetAddress().Bytes(), amount, UInt64Bytes(id))
+}
+
+// GetOutgoingTxCreatedHeightKey returns the following key format
+// prefix     evm-chain     id
+// [0x0][8 ethereum][0 0 0 0 0 0 0 1]
+func GetOutgoingTxCreatedHeightKey(evmChainPrefix string, id uint64) []byte {
+	return AppendBytes(AppendEvmChainPrefix(OutgoingTxCreatedHeightKey, evmChainPrefix), UInt64Bytes(id))
 }
 
 // GetOutgoingTxBatchContractPrefix returns the following format
-// prefix     eth-contract-address
-// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
-func GetOutgoingTxBatchContractPrefix(tokenContract EthAddress) []byte {
-	return AppendBytes(OutgoingTXBatchKey, tokenContract.GetAddress().Bytes())
+// prefix  evm-chain     eth-contract-address
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetOutgoingTxBatchContractPrefix(evmChainPrefix string, tokenContract EthAddress) []byte {
+	return AppendBytes(AppendEvmChainPrefix(OutgoingTXBatchKey, evmChainPrefix), tokenContract.GetAddress().Bytes())
 }
 
 // GetOutgoingTxBatchKey returns the following key format
-// prefix     eth-contract-address                     nonce
-// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
-func GetOutgoingTxBatchKey(tokenContract EthAddress, nonce uint64) []byte {
-	return AppendBytes(GetOutgoingTxBatchContractPrefix(tokenContract), UInt64Bytes(nonce))
+// prefix  evm-chain     eth-contract-address                     nonce
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetOutgoingTxBatchKey(evmChainPrefix string, tokenContract EthAddress, nonce uint64) []byte {
+	return AppendBytes(GetOutgoingTxBatchContractPrefix(evmChainPrefix, tokenContract), UInt64Bytes(nonce))
 }
 
 // GetBatchConfirmNonceContractPrefix returns
-// prefix           eth-contract-address                BatchNonce
-// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
-func GetBatchConfirmNonceContractPrefix(tokenContract EthAddress, batchNonce uint64) []byte {
-	return AppendBytes(BatchConfirmKey, tokenContract.GetAddress().Bytes(), UInt64Bytes(batchNonce))
+// prefix  evm-chain           eth-contract-address                BatchNonce
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetBatchConfirmNonceContractPrefix(evmChainPrefix string, tokenContract EthAddress, batchNonce uint64) []byte {
+	return AppendBytes(AppendEvmChainPrefix(BatchConfirmKey, evmChainPrefix), tokenContract.GetAddress().Bytes(), UInt64Bytes(batchNonce))
 }
 
 // GetBatchConfirmKey returns the following key format
-// prefix           eth-contract-address                BatchNonce                       Validator-address
-// [0x0		][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+// prefix  evm-chain           eth-contract-address                BatchNonce                       Validator-address
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
 // TODO this should be a sdk.ValAddress
-func GetBatchConfirmKey(tokenContract EthAddress, batchNonce uint64, validator sdk.AccAddress) []byte {
+func GetBatchConfirmKey(evmChainPrefix string, tokenContract EthAddress, batchNonce uint64, validator sdk.AccAddress) []byte {
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
 		panic(sdkerrors.Wrap(err, "invalid validator address"))
 	}
-	return AppendBytes(GetBatchConfirmNonceContractPrefix(tokenContract, batchNonce), validator.Bytes())
+	return AppendBytes(GetBatchConfirmNonceContractPrefix(evmChainPrefix, tokenContract, batchNonce), validator.Bytes())
 }
 
 // GetLastEventNonceByValidatorKey indexes latest event nonce by validator
 // GetLastEventNonceByValidatorKey returns the following key format
-// prefix              cosmos-validator
-// [0x0][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
-func GetLastEventNonceByValidatorKey(validator sdk.ValAddress) []byte {
+// prefix  evm-chain              cosmos-validator
+// [0x0][8 ethereum][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetLastEventNonceByValidatorKey(evmChainPrefix string, validator sdk.ValAddress) []byte {
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
 		panic(sdkerrors.Wrap(err, "invalid validator address"))
 	}
-	return AppendBytes(LastEventNonceByValidatorKey, validator.Bytes())
+	return AppendBytes(AppendEvmChainPrefix(LastEventNonceByValidatorKey, evmChainPrefix), validator.Bytes())
 }
 
-func GetDenomToERC20Key(denom string) []byte {
-	return AppendBytes(DenomToERC20Key, []byte(denom))
+func GetDenomToERC20Key(evmChainPrefix string, denom string) []byte {
+	return AppendBytes(AppendEvmChainPrefix(DenomToERC20Key, evmChainPrefix), []byte(denom))
 }
 
-func GetERC20ToDenomKey(erc20 EthAddress) []byte {
-	return AppendBytes(ERC20ToDenomKey, erc20.GetAddress().Bytes())
+func GetERC20ToDenomKey(evmChainPrefix string, erc20 EthAddress) []byte {
+	return AppendBytes(AppendEvmChainPrefix(ERC20ToDenomKey, evmChainPrefix), erc20.GetAddress().Bytes())
 }
 
-func GetOutgoingLogicCallKey(invalidationId []byte, invalidationNonce uint64) []byte {
-	return AppendBytes(KeyOutgoingLogicCall, invalidationId, UInt64Bytes(invalidationNonce))
+func GetOutgoingLogicCallKey(evmChainPrefix string, invalidationId []byte, invalidationNonce uint64) []byte {
+	return AppendBytes(AppendEvmChainPrefix(KeyOutgoingLogicCall, evmChainPrefix), invalidationId, UInt64Bytes(invalidationNonce))
 }
 
-func GetLogicConfirmNonceInvalidationIdPrefix(invalidationId []byte, invalidationNonce uint64) []byte {
-	return AppendBytes(KeyOutgoingLogicConfirm, invalidationId, UInt64Bytes(invalidationNonce))
+func GetLogicConfirmNonceInvalidationIdPrefix(evmChainPrefix string, invalidationId []byte, invalidationNonce uint64) []byte {
+	return AppendBytes(AppendEvmChainPrefix(KeyOutgoingLogicConfirm, evmChainPrefix), invalidationId, UInt64Bytes(invalidationNonce))
 }
 
-func GetLogicConfirmKey(invalidationId []byte, invalidationNonce uint64, validator sdk.AccAddress) []byte {
+func GetLogicConfirmKey(evmChainPrefix string, invalidationId []byte, invalidationNonce uint64, validator sdk.AccAddress) []byte {
 	if err := sdk.VerifyAddressFormat(validator); err != nil {
 		panic(sdkerrors.Wrap(err, "invalid validator address"))
 	}
-	return AppendBytes(GetLogicConfirmNonceInvalidationIdPrefix(invalidationId, invalidationNonce), validator.Bytes())
+	return AppendBytes(GetLogicConfirmNonceInvalidationIdPrefix(evmChainPrefix, invalidationId, invalidationNonce), validator.Bytes())
 }
 
 // GetPastEthSignatureCheckpointKey returns the following key format
-// prefix    checkpoint
-// [0x0][ checkpoint bytes ]
-func GetPastEthSignatureCheckpointKey(checkpoint []byte) []byte {
-	return AppendBytes(PastEthSignatureCheckpointKey, []byte(convertByteArrToString(checkpoint)))
+// prefix  evm-chain    checkpoint
+// [0x0][8 ethereum][ checkpoint bytes ]
+func GetPastEthSignatureCheckpointKey(evmChainPrefix string, checkpoint []byte) []byte {
+	return AppendBytes(AppendEvmChainPrefix(PastEthSignatureCheckpointKey, evmChainPrefix), []byte(convertByteArrToString(checkpoint)))
 }
 
 // This function is broken and it should not be used in other places except in GetPastEthSignatureCheckpointKey
@@ -319,8 +429,67 @@ func convertByteArrToString(value []byte) string {
 }
 
 // GetPendingIbcAutoForwardKey returns the following key format
-// prefix		EventNonce
+// prefix  evm-chain		EventNonce
+// [0x0][8 ethereum][0 0 0 0 0 0 0 1]
+func GetPendingIbcAutoForwardKey(evmChainPrefix string, eventNonce uint64) []byte {
+	return AppendBytes(AppendEvmChainPrefix(PendingIbcAutoForwards, evmChainPrefix), UInt64Bytes(eventNonce))
+}
+
+// GetERC20MigrationKey returns the following key format
+// prefix  evm-chain		old erc20 address
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetERC20MigrationKey(evmChainPrefix string, oldErc20 EthAddress) []byte {
+	return AppendBytes(AppendEvmChainPrefix(ERC20MigrationKey, evmChainPrefix), oldErc20.GetAddress().Bytes())
+}
+
+// GetAttestedERC20DeploymentKey returns the following key format
+// prefix  evm-chain		erc20 address									denom
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6][ugraviton]
+func GetAttestedERC20DeploymentKey(evmChainPrefix string, erc20 EthAddress, denom string) []byte {
+	return AppendBytes(AppendEvmChainPrefix(AttestedERC20DeploymentKey, evmChainPrefix), erc20.GetAddress().Bytes(), []byte(denom))
+}
+
+// GetMerkleAirdropKey returns the following key format
+// prefix		id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetMerkleAirdropKey(id uint64) []byte {
+	return AppendBytes(MerkleAirdropKey, UInt64Bytes(id))
+}
+
+// GetMerkleAirdropClaimPrefix returns the following key format
+// prefix		airdrop id
 // [0x0][0 0 0 0 0 0 0 1]
-func GetPendingIbcAutoForwardKey(eventNonce uint64) []byte {
-	return AppendBytes(PendingIbcAutoForwards, UInt64Bytes(eventNonce))
+func GetMerkleAirdropClaimPrefix(airdropId uint64) []byte {
+	return AppendBytes(MerkleAirdropClaimKey, UInt64Bytes(airdropId))
+}
+
+// GetMerkleAirdropClaimKey returns the following key format
+// prefix		airdrop id				claimer address
+// [0x0][0 0 0 0 0 0 0 1][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetMerkleAirdropClaimKey(airdropId uint64, claimer sdk.AccAddress) []byte {
+	if err := sdk.VerifyAddressFormat(claimer); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid claimer address"))
+	}
+	return AppendBytes(MerkleAirdropClaimKey, UInt64Bytes(airdropId), claimer.Bytes())
+}
+
+// GetBatchFeeRecordContractPrefix returns the following key format
+// prefix     evm-chain		eth-contract-address
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBatchFeeRecordContractPrefix(evmChainPrefix string, tokenContract EthAddress) []byte {
+	return AppendBytes(AppendEvmChainPrefix(BatchFeeRecordKey, evmChainPrefix), tokenContract.GetAddress().Bytes())
+}
+
+// GetBatchFeeRecordKey returns the following key format
+// prefix     evm-chain		eth-contract-address							slot
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetBatchFeeRecordKey(evmChainPrefix string, tokenContract EthAddress, slot uint64) []byte {
+	return AppendBytes(GetBatchFeeRecordContractPrefix(evmChainPrefix, tokenContract), UInt64Bytes(slot))
+}
+
+// GetBatchFeeRecordCountKey returns the following key format
+// prefix     evm-chain		eth-contract-address
+// [0x0][8 ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBatchFeeRecordCountKey(evmChainPrefix string, tokenContract EthAddress) []byte {
+	return AppendBytes(AppendEvmChainPrefix(BatchFeeRecordCountKey, evmChainPrefix), tokenContract.GetAddress().Bytes())
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,7 +11,7 @@ import (
 // ignore
 func TestPrefixKeysSameLength(t *testing.T) {
 	allKeys := getAllKeys()
-	prefixKeys := allKeys[0:27]
+	prefixKeys := allKeys[0:32]
 	length := len(HashString("All keys should be same length when hashed"))
 
 	for _, key := range prefixKeys {
@@ -27,11 +28,20 @@ func TestNoDuplicateKeys(t *testing.T) {
 	}
 }
 
+func TestEvmChainPrefixedKeysDoNotOverlap(t *testing.T) {
+	for _, key := range EvmChainScopedKeys {
+		short := AppendEvmChainPrefix(key, "eth")
+		long := AppendEvmChainPrefix(key, "ethereum")
+		require.False(t, bytes.HasPrefix(long, short), "state of evm chain ethereum under the prefix of evm chain eth")
+		require.False(t, bytes.HasPrefix(short, long))
+	}
+}
+
 func getAllKeys() [][]byte {
 	i := 0
 	inc := func(i *int) *int { *i += 1; return i }
 
-	keys := make([][]byte, 47)
+	keys := make([][]byte, 68)
 
 	keys[i] = EthAddressByValidatorKey
 	keys[*inc(&i)] = ValidatorByEthAddressKey
@@ -60,6 +70,16 @@ func getAllKeys() [][]byte {
 	keys[*inc(&i)] = LastUnBondingBlockHeight
 	keys[*inc(&i)] = LastObservedValsetKey
 	keys[*inc(&i)] = PastEthSignatureCheckpointKey
+	keys[*inc(&i)] = ERC20MigrationKey
+	keys[*inc(&i)] = AttestedERC20DeploymentKey
+	keys[*inc(&i)] = MerkleAirdropKey
+	keys[*inc(&i)] = MerkleAirdropClaimKey
+	keys[*inc(&i)] = KeyLastMerkleAirdropID
+	keys[*inc(&i)] = ValidatorBridgePerformanceKey
+	keys[*inc(&i)] = BridgeOffencesKey
+	keys[*inc(&i)] = BatchFeeRecordKey
+	keys[*inc(&i)] = BatchFeeRecordCountKey
+	keys[*inc(&i)] = OutgoingTxCreatedHeightKey
 
 	// sdk.AccAddress, sdk.ValAddress
 	dummyAddr := []byte("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
@@ -77,27 +97,40 @@ func getAllKeys() [][]byte {
 	dummyErc := InternalERC20Token{Amount: sdk.OneInt(), Contract: dummyEthAddr}
 	// Denom
 	dummyDenom := "footoken"
+	// EVM chain
+	dummyEvmChain := DefaultEvmChainPrefix
 
 	keys[*inc(&i)] = GetOrchestratorAddressKey(dummyAddr)
 	keys[*inc(&i)] = GetEthAddressByValidatorKey(dummyAddr)
 	keys[*inc(&i)] = GetValidatorByEthAddressKey(dummyEthAddr)
-	keys[*inc(&i)] = GetValsetKey(dummyNonce)
-	keys[*inc(&i)] = GetValsetConfirmNoncePrefix(dummyNonce)
-	keys[*inc(&i)] = GetValsetConfirmKey(dummyNonce, dummyAddr)
-	keys[*inc(&i)] = GetAttestationKey(dummyNonce, dummyBytes)
-	keys[*inc(&i)] = GetOutgoingTxPoolContractPrefix(dummyEthAddr)
-	keys[*inc(&i)] = GetOutgoingTxPoolKey(dummyErc, dummyNonce)
-	keys[*inc(&i)] = GetOutgoingTxBatchContractPrefix(dummyEthAddr)
-	keys[*inc(&i)] = GetOutgoingTxBatchKey(dummyEthAddr, dummyNonce)
-	keys[*inc(&i)] = GetBatchConfirmNonceContractPrefix(dummyEthAddr, dummyNonce)
-	keys[*inc(&i)] = GetBatchConfirmKey(dummyEthAddr, dummyNonce, dummyAddr)
-	keys[*inc(&i)] = GetLastEventNonceByValidatorKey(dummyAddr)
-	keys[*inc(&i)] = GetDenomToERC20Key(dummyDenom)
-	keys[*inc(&i)] = GetERC20ToDenomKey(dummyEthAddr)
-	keys[*inc(&i)] = GetOutgoingLogicCallKey(dummyBytes, dummyNonce)
-	keys[*inc(&i)] = GetLogicConfirmNonceInvalidationIdPrefix(dummyBytes, dummyNonce)
-	keys[*inc(&i)] = GetLogicConfirmKey(dummyBytes, dummyNonce, dummyAddr)
-	keys[*inc(&i)] = GetPastEthSignatureCheckpointKey(dummyBytes)
+	keys[*inc(&i)] = GetValsetKey(dummyEvmChain, dummyNonce)
+	keys[*inc(&i)] = GetValsetConfirmNoncePrefix(dummyEvmChain, dummyNonce)
+	keys[*inc(&i)] = GetValsetConfirmKey(dummyEvmChain, dummyNonce, dummyAddr)
+	keys[*inc(&i)] = GetAttestationKey(dummyEvmChain, dummyNonce, dummyBytes)
+	keys[*inc(&i)] = GetOutgoingTxPoolContractPrefix(dummyEvmChain, dummyEthAddr)
+	keys[*inc(&i)] = GetOutgoingTxPoolKey(dummyEvmChain, dummyErc, dummyNonce)
+	keys[*inc(&i)] = GetOutgoingTxBatchContractPrefix(dummyEvmChain, dummyEthAddr)
+	keys[*inc(&i)] = GetOutgoingTxBatchKey(dummyEvmChain, dummyEthAddr, dummyNonce)
+	keys[*inc(&i)] = GetBatchConfirmNonceContractPrefix(dummyEvmChain, dummyEthAddr, dummyNonce)
+	keys[*inc(&i)] = GetBatchConfirmKey(dummyEvmChain, dummyEthAddr, dummyNonce, dummyAddr)
+	keys[*inc(&i)] = GetLastEventNonceByValidatorKey(dummyEvmChain, dummyAddr)
+	keys[*inc(&i)] = GetDenomToERC20Key(dummyEvmChain, dummyDenom)
+	keys[*inc(&i)] = GetERC20ToDenomKey(dummyEvmChain, dummyEthAddr)
+	keys[*inc(&i)] = GetOutgoingLogicCallKey(dummyEvmChain, dummyBytes, dummyNonce)
+	keys[*inc(&i)] = GetLogicConfirmNonceInvalidationIdPrefix(dummyEvmChain, dummyBytes, dummyNonce)
+	keys[*inc(&i)] = GetLogicConfirmKey(dummyEvmChain, dummyBytes, dummyNonce, dummyAddr)
+	keys[*inc(&i)] = GetPastEthSignatureCheckpointKey(dummyEvmChain, dummyBytes)
+	keys[*inc(&i)] = GetERC20MigrationKey(dummyEvmChain, dummyEthAddr)
+	keys[*inc(&i)] = GetAttestedERC20DeploymentKey(dummyEvmChain, dummyEthAddr, dummyDenom)
+	keys[*inc(&i)] = GetMerkleAirdropKey(dummyNonce)
+	keys[*inc(&i)] = GetMerkleAirdropClaimPrefix(dummyNonce)
+	keys[*inc(&i)] = GetMerkleAirdropClaimKey(dummyNonce, dummyAddr)
+	keys[*inc(&i)] = GetValidatorBridgePerformanceKey(dummyAddr)
+	keys[*inc(&i)] = GetBridgeOffencesKey(dummyAddr)
+	keys[*inc(&i)] = GetBatchFeeRecordContractPrefix(dummyEvmChain, dummyEthAddr)
+	keys[*inc(&i)] = GetBatchFeeRecordKey(dummyEvmChain, dummyEthAddr, dummyNonce)
+	keys[*inc(&i)] = GetBatchFeeRecordCountKey(dummyEvmChain, dummyEthAddr)
+	keys[*inc(&i)] = GetOutgoingTxCreatedHeightKey(dummyEvmChain, dummyNonce)
 
 	return keys
 }
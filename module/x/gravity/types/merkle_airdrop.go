@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MerkleRootLen is the length of a keccak256 Merkle root or proof element
+const MerkleRootLen = 32
+
+// MerkleAirdropLeaf computes the Merkle tree leaf for a recipient and amount,
+// keccak256(address bytes | amount as uint64 big endian)
+func MerkleAirdropLeaf(recipient sdk.AccAddress, amount uint64) []byte {
+	return crypto.Keccak256(recipient.Bytes(), sdk.Uint64ToBigEndian(amount))
+}
+
+// hashMerklePair hashes two sibling nodes in sorted order, so that proofs do not need to encode the position
+// of each node in the tree
+func hashMerklePair(a []byte, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256(a, b)
+}
+
+// VerifyMerkleAirdropProof returns true if proof leads from leaf to root
+func VerifyMerkleAirdropProof(root []byte, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = hashMerklePair(node, sibling)
+	}
+	return bytes.Equal(node, root)
+}
+
+// MerkleAirdropTree computes the root of the Merkle tree over the given leaves and a proof for each leaf, in the
+// order the leaves were given. An unpaired node is promoted to the next level unchanged. This is intended for use
+// by clients and tests creating an airdrop, the chain only ever verifies proofs
+func MerkleAirdropTree(leaves [][]byte) (root []byte, proofs [][][]byte) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+	// position of each original leaf in the current level
+	positions := make([]int, len(leaves))
+	for i := range positions {
+		positions[i] = i
+	}
+	proofs = make([][][]byte, len(leaves))
+	level := leaves
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+			} else {
+				next = append(next, hashMerklePair(level[i], level[i+1]))
+			}
+		}
+		for leaf, pos := range positions {
+			sibling := pos ^ 1
+			if sibling < len(level) {
+				proofs[leaf] = append(proofs[leaf], level[sibling])
+			}
+			positions[leaf] = pos / 2
+		}
+		level = next
+	}
+	return level[0], proofs
+}
+
+// ValidateBasic performs stateless checks on a stored MerkleAirdrop
+func (m MerkleAirdrop) ValidateBasic() error {
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "merkle airdrop id must be non-zero")
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid merkle airdrop denom: %v", err)
+	}
+	if len(m.MerkleRoot) != MerkleRootLen {
+		return sdkerrors.Wrapf(ErrInvalid, "merkle airdrop root must be %d bytes", MerkleRootLen)
+	}
+	if m.Total == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "merkle airdrop total must be non-zero")
+	}
+	if m.Claimed > m.Total {
+		return sdkerrors.Wrap(ErrInvalid, "merkle airdrop claimed exceeds total")
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks on a stored MerkleAirdropClaim
+func (m MerkleAirdropClaim) ValidateBasic() error {
+	if m.AirdropId == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "merkle airdrop id must be non-zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Claimer); err != nil {
+		return sdkerrors.Wrap(err, "invalid merkle airdrop claimer")
+	}
+	if m.Amount == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "merkle airdrop claim amount must be non-zero")
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authlegacy "github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
@@ -26,6 +27,7 @@ var (
 	_ sdk.Msg = &MsgBatchSendToEthClaim{}
 	_ sdk.Msg = &MsgValsetUpdatedClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgClaimAirdrop{}
 )
 
 // Ensure Gravity's Msgs all implement the LegacyAmino interface
@@ -45,6 +47,7 @@ var (
 	_ authlegacy.LegacyMsg = &MsgBatchSendToEthClaim{}
 	_ authlegacy.LegacyMsg = &MsgValsetUpdatedClaim{}
 	_ authlegacy.LegacyMsg = &MsgSubmitBadSignatureEvidence{}
+	_ authlegacy.LegacyMsg = &MsgClaimAirdrop{}
 )
 
 // These are the type values for signed LegacyAmino messages. The newer Protobuf messages use the path url instead.
@@ -63,17 +66,35 @@ const (
 	AMINO_TYPE_VALSET_UPDATED                = "Valset_Updated_Claim"
 	AMINO_TYPE_LOGIC_CALL_EXECUTED           = "Logic_Call_Executed_Claim"
 	AMINO_TYPE_ERC20_DEPLOYED                = "ERC20_deployed_claim"
+	AMINO_TYPE_CLAIM_AIRDROP                 = "claim_airdrop"
 )
 
-// NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
-func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth EthAddress) *MsgSetOrchestratorAddress {
+// NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress, ethSignature is the signature by the eth key
+// over GetDelegateKeysSignBytes
+func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth EthAddress, ethSignature []byte) *MsgSetOrchestratorAddress {
 	return &MsgSetOrchestratorAddress{
 		Validator:    val.String(),
 		Orchestrator: oper.String(),
 		EthAddress:   eth.GetAddress().Hex(),
+		EthSignature: hex.EncodeToString(ethSignature),
 	}
 }
 
+// GetDelegateKeysSignBytes returns the hash the ethereum key of a MsgSetOrchestratorAddress signs, which commits to the
+// validator and the chain so that the proof of possession can not be replayed for another validator or chain
+func GetDelegateKeysSignBytes(val sdk.ValAddress, chainID string) []byte {
+	signMsg := DelegateKeysSignMsg{
+		ValidatorAddress: val.String(),
+		ChainId:          chainID,
+	}
+	bz, err := signMsg.Marshal()
+	if err != nil {
+		// marshalling two strings can not fail
+		panic(err)
+	}
+	return crypto.Keccak256(bz)
+}
+
 // Route should return the name of the module
 func (msg *MsgSetOrchestratorAddress) Route() string { return RouterKey }
 
@@ -82,6 +103,21 @@ func (msg *MsgSetOrchestratorAddress) Type() string { return AMINO_TYPE_SET_ORCH
 
 // ValidateBasic performs stateless checks
 func (msg *MsgSetOrchestratorAddress) ValidateBasic() (err error) {
+	if err := msg.ValidateKeys(); err != nil {
+		return err
+	}
+	if msg.EthSignature == "" {
+		return sdkerrors.Wrap(ErrEmpty, "ethereum signature")
+	}
+	if _, err := hex.DecodeString(msg.EthSignature); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "could not decode hex string \"%s\": %v", msg.EthSignature, err)
+	}
+	return nil
+}
+
+// ValidateKeys performs the stateless checks of the keys, leaving out the eth signature which the delegate keys in
+// genesis lack since they were registered before the signature existed
+func (msg *MsgSetOrchestratorAddress) ValidateKeys() (err error) {
 	if _, err = sdk.ValAddressFromBech32(msg.Validator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
 	}
@@ -94,6 +130,33 @@ func (msg *MsgSetOrchestratorAddress) ValidateBasic() (err error) {
 	return nil
 }
 
+// VerifyEthSignature checks that the eth signature proves possession of the eth address for the validator on the chain
+func (msg *MsgSetOrchestratorAddress) VerifyEthSignature(chainID string) error {
+	if msg.EthSignature == "" {
+		return sdkerrors.Wrap(ErrEmpty, "ethereum signature")
+	}
+	val, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
+	}
+	ethAddr, err := NewEthAddress(msg.EthAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "ethereum address")
+	}
+	sigBytes, err := hex.DecodeString(msg.EthSignature)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalid, "signature decoding")
+	}
+	if len(sigBytes) != crypto.SignatureLength {
+		return sdkerrors.Wrapf(ErrInvalid, "signature length %d", len(sigBytes))
+	}
+	signBytes := GetDelegateKeysSignBytes(val, chainID)
+	if err := ValidateEthereumSignature(signBytes, sigBytes, *ethAddr); err != nil {
+		return sdkerrors.Wrapf(err, "ethereum signature by %s over %s for %s on %s", msg.EthAddress, hex.EncodeToString(signBytes), msg.Validator, chainID)
+	}
+	return nil
+}
+
 // GetSignBytes encodes the message for signing
 func (msg *MsgSetOrchestratorAddress) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -116,10 +179,11 @@ func NewMsgValsetConfirm(
 	signature string,
 ) *MsgValsetConfirm {
 	return &MsgValsetConfirm{
-		Nonce:        nonce,
-		Orchestrator: validator.String(),
-		EthAddress:   ethAddress.GetAddress().Hex(),
-		Signature:    signature,
+		Nonce:          nonce,
+		Orchestrator:   validator.String(),
+		EthAddress:     ethAddress.GetAddress().Hex(),
+		Signature:      signature,
+		EvmChainPrefix: DefaultEvmChainPrefix,
 	}
 }
 
@@ -131,6 +195,9 @@ func (msg *MsgValsetConfirm) Type() string { return AMINO_TYPE_VALSET_CONFIRM }
 
 // ValidateBasic performs stateless checks
 func (msg *MsgValsetConfirm) ValidateBasic() (err error) {
+	if err := ValidateOptionalEvmChainPrefix(msg.EvmChainPrefix); err != nil {
+		return err
+	}
 	if _, err = sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
 	}
@@ -158,11 +225,12 @@ func (msg *MsgValsetConfirm) GetSigners() []sdk.AccAddress {
 // NewMsgSendToEth returns a new msgSendToEth
 func NewMsgSendToEth(sender sdk.AccAddress, destAddress EthAddress, send sdk.Coin, bridgeFee sdk.Coin, chainFee sdk.Coin) *MsgSendToEth {
 	return &MsgSendToEth{
-		Sender:    sender.String(),
-		EthDest:   destAddress.GetAddress().Hex(),
-		Amount:    send,
-		BridgeFee: bridgeFee, // This is paid to the Ethereum Relayer
-		ChainFee:  chainFee,  // This is paid to Cosmos stakers
+		Sender:         sender.String(),
+		EthDest:        destAddress.GetAddress().Hex(),
+		Amount:         send,
+		BridgeFee:      bridgeFee, // This is paid to the Ethereum Relayer
+		ChainFee:       chainFee,  // This is paid to Cosmos stakers
+		EvmChainPrefix: DefaultEvmChainPrefix,
 	}
 }
 
@@ -175,6 +243,9 @@ func (msg MsgSendToEth) Type() string { return AMINO_TYPE_SEND_TO_ETH }
 // ValidateBasic runs stateless checks on the message
 // Checks if the Eth address is valid
 func (msg MsgSendToEth) ValidateBasic() error {
+	if err := ValidateOptionalEvmChainPrefix(msg.EvmChainPrefix); err != nil {
+		return err
+	}
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
 	}
@@ -224,8 +295,9 @@ func (msg MsgSendToEth) GetSigners() []sdk.AccAddress {
 // NewMsgRequestBatch returns a new msgRequestBatch
 func NewMsgRequestBatch(orchestrator sdk.AccAddress) *MsgRequestBatch {
 	return &MsgRequestBatch{
-		Sender: orchestrator.String(),
-		Denom:  "",
+		Sender:         orchestrator.String(),
+		Denom:          "",
+		EvmChainPrefix: DefaultEvmChainPrefix,
 	}
 }
 
@@ -237,6 +309,9 @@ func (msg MsgRequestBatch) Type() string { return AMINO_TYPE_REQUEST_BATCH }
 
 // ValidateBasic performs stateless checks
 func (msg MsgRequestBatch) ValidateBasic() error {
+	if err := ValidateOptionalEvmChainPrefix(msg.EvmChainPrefix); err != nil {
+		return err
+	}
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
 	}
@@ -266,6 +341,9 @@ func (msg MsgConfirmBatch) Type() string { return AMINO_TYPE_CONFIRM_BATCH }
 
 // ValidateBasic performs stateless checks
 func (msg MsgConfirmBatch) ValidateBasic() error {
+	if err := ValidateOptionalEvmChainPrefix(msg.EvmChainPrefix); err != nil {
+		return err
+	}
 	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
 	}
@@ -304,6 +382,9 @@ func (msg MsgConfirmLogicCall) Type() string { return AMINO_TYPE_CONFIRM_LOGIC }
 
 // ValidateBasic performs stateless checks
 func (msg MsgConfirmLogicCall) ValidateBasic() error {
+	if err := ValidateOptionalEvmChainPrefix(msg.EvmChainPrefix); err != nil {
+		return err
+	}
 	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
 	}
@@ -347,6 +428,8 @@ type EthereumClaim interface {
 	// when we go to create a new batch we set the timeout some number of batches out from the last
 	// known height plus projected block progress since then.
 	GetEthBlockHeight() uint64
+	// The EVM chain the event occurred on, empty for the default chain
+	GetEvmChainPrefix() string
 	// the delegate address of the claimer, for MsgDepositClaim and MsgWithdrawClaim
 	// this is sent in as the sdk.AccAddress of the delegated key. it is up to the user
 	// to disambiguate this into a sdk.ValAddress
@@ -381,6 +464,9 @@ func (msg *MsgSendToCosmosClaim) GetType() ClaimType {
 
 // ValidateBasic performs stateless checks
 func (msg *MsgSendToCosmosClaim) ValidateBasic() error {
+	if err := ValidateOptionalEvmChainPrefix(msg.EvmChainPrefix); err != nil {
+		return err
+	}
 	if err := ValidateEthAddress(msg.EthereumSender); err != nil {
 		return sdkerrors.Wrap(err, "eth sender")
 	}
@@ -450,6 +536,9 @@ func (msg *MsgSendToCosmosClaim) ClaimHash() ([]byte, error) {
 }
 
 func (msg *MsgExecuteIbcAutoForwards) ValidateBasic() error {
+	if err := ValidateOptionalEvmChainPrefix(msg.EvmChainPrefix); err != nil {
+		return err
+	}
 	if _, err := sdk.AccAddressFromBech32(msg.Executor); err != nil {
 		return sdkerrors.Wrap(err, "Unable to parse executor as a valid bech32 address")
 	}
@@ -487,6 +576,9 @@ func (msg *MsgBatchSendToEthClaim) GetType() ClaimType {
 
 // ValidateBasic performs stateless checks
 func (e *MsgBatchSendToEthClaim) ValidateBasic() error {
+	if err := ValidateOptionalEvmChainPrefix(e.EvmChainPrefix); err != nil {
+		return err
+	}
 	if e.EventNonce == 0 {
 		return fmt.Errorf("event_nonce == 0")
 	}
@@ -555,6 +647,9 @@ func (e *MsgERC20DeployedClaim) GetType() ClaimType {
 
 // ValidateBasic performs stateless checks
 func (e *MsgERC20DeployedClaim) ValidateBasic() error {
+	if err := ValidateOptionalEvmChainPrefix(e.EvmChainPrefix); err != nil {
+		return err
+	}
 	if err := ValidateEthAddress(e.TokenContract); err != nil {
 		return sdkerrors.Wrap(err, "erc20 token")
 	}
@@ -625,6 +720,9 @@ func (e *MsgLogicCallExecutedClaim) GetType() ClaimType {
 
 // ValidateBasic performs stateless checks
 func (e *MsgLogicCallExecutedClaim) ValidateBasic() error {
+	if err := ValidateOptionalEvmChainPrefix(e.EvmChainPrefix); err != nil {
+		return err
+	}
 	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
 	}
@@ -691,6 +789,9 @@ func (e *MsgValsetUpdatedClaim) GetType() ClaimType {
 
 // ValidateBasic performs stateless checks
 func (e *MsgValsetUpdatedClaim) ValidateBasic() error {
+	if err := ValidateOptionalEvmChainPrefix(e.EvmChainPrefix); err != nil {
+		return err
+	}
 	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
 	}
@@ -766,8 +867,9 @@ func (b *MsgValsetUpdatedClaim) ClaimHash() ([]byte, error) {
 // NewMsgCancelSendToEth returns a new msgSetOrchestratorAddress
 func NewMsgCancelSendToEth(user sdk.AccAddress, id uint64) *MsgCancelSendToEth {
 	return &MsgCancelSendToEth{
-		Sender:        user.String(),
-		TransactionId: id,
+		Sender:         user.String(),
+		TransactionId:  id,
+		EvmChainPrefix: DefaultEvmChainPrefix,
 	}
 }
 
@@ -779,6 +881,9 @@ func (msg *MsgCancelSendToEth) Type() string { return AMINO_TYPE_CANCEL_SEND_TO_
 
 // ValidateBasic performs stateless checks
 func (msg *MsgCancelSendToEth) ValidateBasic() (err error) {
+	if err := ValidateOptionalEvmChainPrefix(msg.EvmChainPrefix); err != nil {
+		return err
+	}
 	_, err = sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return err
@@ -805,6 +910,9 @@ func (msg *MsgCancelSendToEth) GetSigners() []sdk.AccAddress {
 
 // ValidateBasic performs stateless checks
 func (e *MsgSubmitBadSignatureEvidence) ValidateBasic() (err error) {
+	if err := ValidateOptionalEvmChainPrefix(e.EvmChainPrefix); err != nil {
+		return err
+	}
 	_, err = sdk.AccAddressFromBech32(e.Sender)
 	if err != nil {
 		return err
@@ -833,3 +941,70 @@ func (msg MsgSubmitBadSignatureEvidence) Type() string {
 
 // Route should return the name of the module
 func (msg MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// NewMsgClaimAirdrop returns a new MsgClaimAirdrop
+func NewMsgClaimAirdrop(claimer sdk.AccAddress, airdropId uint64, amount uint64, proof [][]byte) *MsgClaimAirdrop {
+	hexProof := make([]string, len(proof))
+	for i, node := range proof {
+		hexProof[i] = hex.EncodeToString(node)
+	}
+	return &MsgClaimAirdrop{
+		Claimer:   claimer.String(),
+		AirdropId: airdropId,
+		Amount:    amount,
+		Proof:     hexProof,
+	}
+}
+
+// DecodeProof returns the hex decoded proof elements
+func (msg *MsgClaimAirdrop) DecodeProof() ([][]byte, error) {
+	proof := make([][]byte, len(msg.Proof))
+	for i, node := range msg.Proof {
+		bz, err := hex.DecodeString(node)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "proof element %d is not valid hex", i)
+		}
+		if len(bz) != MerkleRootLen {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "proof elements must be %d bytes", MerkleRootLen)
+		}
+		proof[i] = bz
+	}
+	return proof, nil
+}
+
+// Route should return the name of the module
+func (msg *MsgClaimAirdrop) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgClaimAirdrop) Type() string { return AMINO_TYPE_CLAIM_AIRDROP }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgClaimAirdrop) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Claimer); err != nil {
+		return sdkerrors.Wrap(err, "Unable to parse claimer as a valid bech32 address")
+	}
+	if msg.AirdropId == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "airdrop id must be non-zero")
+	}
+	if msg.Amount == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "amount must be non-zero")
+	}
+	if _, err := msg.DecodeProof(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgClaimAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgClaimAirdrop) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Claimer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
@@ -46,10 +46,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // ETH_ADDRESS
 // This is a hex encoded 0x Ethereum public key that will be used by this validator
 // on Ethereum
+// ETH_SIGNATURE
+// This is a hex encoded Ethereum signature by the ETH_ADDRESS key over the keccak256
+// hash of the DelegateKeysSignMsg of the validator and chain id, proving that the
+// validator controls the key. It is required, only the delegate keys in genesis which
+// were registered before the signature existed lack it
 type MsgSetOrchestratorAddress struct {
 	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	EthSignature string `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
 }
 
 func (m *MsgSetOrchestratorAddress) Reset()         { *m = MsgSetOrchestratorAddress{} }
@@ -106,6 +112,69 @@ func (m *MsgSetOrchestratorAddress) GetEthAddress() string {
 	return ""
 }
 
+func (m *MsgSetOrchestratorAddress) GetEthSignature() string {
+	if m != nil {
+		return m.EthSignature
+	}
+	return ""
+}
+
+// DelegateKeysSignMsg
+// is the message whose keccak256 hash of the protobuf encoding is signed by
+// the Ethereum key in MsgSetOrchestratorAddress, binding the key to the
+// validator on this chain so that the signature can not be replayed elsewhere
+type DelegateKeysSignMsg struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ChainId          string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
+func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysSignMsg) ProtoMessage()    {}
+func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{1}
+}
+func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysSignMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateKeysSignMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysSignMsg.Merge(m, src)
+}
+func (m *DelegateKeysSignMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysSignMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysSignMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateKeysSignMsg proto.InternalMessageInfo
+
+func (m *DelegateKeysSignMsg) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegateKeysSignMsg) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
 type MsgSetOrchestratorAddressResponse struct {
 }
 
@@ -113,7 +182,7 @@ func (m *MsgSetOrchestratorAddressResponse) Reset()         { *m = MsgSetOrchest
 func (m *MsgSetOrchestratorAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetOrchestratorAddressResponse) ProtoMessage()    {}
 func (*MsgSetOrchestratorAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{1}
+	return fileDescriptor_2f8523f2f6feb451, []int{2}
 }
 func (m *MsgSetOrchestratorAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,17 +227,18 @@ var xxx_messageInfo_MsgSetOrchestratorAddressResponse proto.InternalMessageInfo
 // chain store and submit them to Ethereum to update the validator set
 // -------------
 type MsgValsetConfirm struct {
-	Nonce        uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
-	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
-	Signature    string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Nonce          uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Orchestrator   string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EthAddress     string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	Signature      string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,5,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgValsetConfirm) Reset()         { *m = MsgValsetConfirm{} }
 func (m *MsgValsetConfirm) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirm) ProtoMessage()    {}
 func (*MsgValsetConfirm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{2}
+	return fileDescriptor_2f8523f2f6feb451, []int{3}
 }
 func (m *MsgValsetConfirm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,6 +295,13 @@ func (m *MsgValsetConfirm) GetSignature() string {
 	return ""
 }
 
+func (m *MsgValsetConfirm) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgValsetConfirmResponse struct {
 }
 
@@ -232,7 +309,7 @@ func (m *MsgValsetConfirmResponse) Reset()         { *m = MsgValsetConfirmRespon
 func (m *MsgValsetConfirmResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirmResponse) ProtoMessage()    {}
 func (*MsgValsetConfirmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{3}
+	return fileDescriptor_2f8523f2f6feb451, []int{4}
 }
 func (m *MsgValsetConfirmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,18 +355,19 @@ var xxx_messageInfo_MsgValsetConfirmResponse proto.InternalMessageInfo
 // certain percentage of the AMOUNT, as determined by governance.
 // This Msg will be rejected if CHAIN_FEE is insufficient.
 type MsgSendToEth struct {
-	Sender    string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	EthDest   string     `protobuf:"bytes,2,opt,name=eth_dest,json=ethDest,proto3" json:"eth_dest,omitempty"`
-	Amount    types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
-	BridgeFee types.Coin `protobuf:"bytes,4,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
-	ChainFee  types.Coin `protobuf:"bytes,5,opt,name=chain_fee,json=chainFee,proto3" json:"chain_fee"`
+	Sender         string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	EthDest        string     `protobuf:"bytes,2,opt,name=eth_dest,json=ethDest,proto3" json:"eth_dest,omitempty"`
+	Amount         types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	BridgeFee      types.Coin `protobuf:"bytes,4,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
+	ChainFee       types.Coin `protobuf:"bytes,5,opt,name=chain_fee,json=chainFee,proto3" json:"chain_fee"`
+	EvmChainPrefix string     `protobuf:"bytes,6,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgSendToEth) Reset()         { *m = MsgSendToEth{} }
 func (m *MsgSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEth) ProtoMessage()    {}
 func (*MsgSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{4}
+	return fileDescriptor_2f8523f2f6feb451, []int{5}
 }
 func (m *MsgSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,6 +431,13 @@ func (m *MsgSendToEth) GetChainFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSendToEth) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgSendToEthResponse struct {
 }
 
@@ -360,7 +445,7 @@ func (m *MsgSendToEthResponse) Reset()         { *m = MsgSendToEthResponse{} }
 func (m *MsgSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEthResponse) ProtoMessage()    {}
 func (*MsgSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{5}
+	return fileDescriptor_2f8523f2f6feb451, []int{6}
 }
 func (m *MsgSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,15 +484,16 @@ var xxx_messageInfo_MsgSendToEthResponse proto.InternalMessageInfo
 // can finally submit the batch
 // -------------
 type MsgRequestBatch struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgRequestBatch) Reset()         { *m = MsgRequestBatch{} }
 func (m *MsgRequestBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatch) ProtoMessage()    {}
 func (*MsgRequestBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{6}
+	return fileDescriptor_2f8523f2f6feb451, []int{7}
 }
 func (m *MsgRequestBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,6 +536,13 @@ func (m *MsgRequestBatch) GetDenom() string {
 	return ""
 }
 
+func (m *MsgRequestBatch) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgRequestBatchResponse struct {
 }
 
@@ -457,7 +550,7 @@ func (m *MsgRequestBatchResponse) Reset()         { *m = MsgRequestBatchResponse
 func (m *MsgRequestBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchResponse) ProtoMessage()    {}
 func (*MsgRequestBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{7}
+	return fileDescriptor_2f8523f2f6feb451, []int{8}
 }
 func (m *MsgRequestBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,18 +588,19 @@ var xxx_messageInfo_MsgRequestBatchResponse proto.InternalMessageInfo
 // as well as an Ethereum signature over this batch by the validator
 // -------------
 type MsgConfirmBatch struct {
-	Nonce         uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
-	EthSigner     string `protobuf:"bytes,3,opt,name=eth_signer,json=ethSigner,proto3" json:"eth_signer,omitempty"`
-	Orchestrator  string `protobuf:"bytes,4,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
-	Signature     string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	Nonce          uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TokenContract  string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	EthSigner      string `protobuf:"bytes,3,opt,name=eth_signer,json=ethSigner,proto3" json:"eth_signer,omitempty"`
+	Orchestrator   string `protobuf:"bytes,4,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	Signature      string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,6,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgConfirmBatch) Reset()         { *m = MsgConfirmBatch{} }
 func (m *MsgConfirmBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatch) ProtoMessage()    {}
 func (*MsgConfirmBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{8}
+	return fileDescriptor_2f8523f2f6feb451, []int{9}
 }
 func (m *MsgConfirmBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,6 +664,13 @@ func (m *MsgConfirmBatch) GetSignature() string {
 	return ""
 }
 
+func (m *MsgConfirmBatch) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgConfirmBatchResponse struct {
 }
 
@@ -577,7 +678,7 @@ func (m *MsgConfirmBatchResponse) Reset()         { *m = MsgConfirmBatchResponse
 func (m *MsgConfirmBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatchResponse) ProtoMessage()    {}
 func (*MsgConfirmBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{9}
+	return fileDescriptor_2f8523f2f6feb451, []int{10}
 }
 func (m *MsgConfirmBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,13 +721,14 @@ type MsgConfirmLogicCall struct {
 	EthSigner         string `protobuf:"bytes,3,opt,name=eth_signer,json=ethSigner,proto3" json:"eth_signer,omitempty"`
 	Orchestrator      string `protobuf:"bytes,4,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 	Signature         string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	EvmChainPrefix    string `protobuf:"bytes,6,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgConfirmLogicCall) Reset()         { *m = MsgConfirmLogicCall{} }
 func (m *MsgConfirmLogicCall) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCall) ProtoMessage()    {}
 func (*MsgConfirmLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{10}
+	return fileDescriptor_2f8523f2f6feb451, []int{11}
 }
 func (m *MsgConfirmLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,6 +792,13 @@ func (m *MsgConfirmLogicCall) GetSignature() string {
 	return ""
 }
 
+func (m *MsgConfirmLogicCall) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgConfirmLogicCallResponse struct {
 }
 
@@ -697,7 +806,7 @@ func (m *MsgConfirmLogicCallResponse) Reset()         { *m = MsgConfirmLogicCall
 func (m *MsgConfirmLogicCallResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCallResponse) ProtoMessage()    {}
 func (*MsgConfirmLogicCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{11}
+	return fileDescriptor_2f8523f2f6feb451, []int{12}
 }
 func (m *MsgConfirmLogicCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,13 +848,14 @@ type MsgSendToCosmosClaim struct {
 	EthereumSender string                                 `protobuf:"bytes,5,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
 	CosmosReceiver string                                 `protobuf:"bytes,6,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
 	Orchestrator   string                                 `protobuf:"bytes,7,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EvmChainPrefix string                                 `protobuf:"bytes,8,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgSendToCosmosClaim) Reset()         { *m = MsgSendToCosmosClaim{} }
 func (m *MsgSendToCosmosClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToCosmosClaim) ProtoMessage()    {}
 func (*MsgSendToCosmosClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{12}
+	return fileDescriptor_2f8523f2f6feb451, []int{13}
 }
 func (m *MsgSendToCosmosClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,6 +926,13 @@ func (m *MsgSendToCosmosClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgSendToCosmosClaim) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgSendToCosmosClaimResponse struct {
 }
 
@@ -823,7 +940,7 @@ func (m *MsgSendToCosmosClaimResponse) Reset()         { *m = MsgSendToCosmosCla
 func (m *MsgSendToCosmosClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToCosmosClaimResponse) ProtoMessage()    {}
 func (*MsgSendToCosmosClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{13}
+	return fileDescriptor_2f8523f2f6feb451, []int{14}
 }
 func (m *MsgSendToCosmosClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,13 +976,14 @@ var xxx_messageInfo_MsgSendToCosmosClaimResponse proto.InternalMessageInfo
 type MsgExecuteIbcAutoForwards struct {
 	ForwardsToClear uint64 `protobuf:"varint,1,opt,name=forwards_to_clear,json=forwardsToClear,proto3" json:"forwards_to_clear,omitempty"`
 	Executor        string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+	EvmChainPrefix  string `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgExecuteIbcAutoForwards) Reset()         { *m = MsgExecuteIbcAutoForwards{} }
 func (m *MsgExecuteIbcAutoForwards) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteIbcAutoForwards) ProtoMessage()    {}
 func (*MsgExecuteIbcAutoForwards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{14}
+	return fileDescriptor_2f8523f2f6feb451, []int{15}
 }
 func (m *MsgExecuteIbcAutoForwards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -908,6 +1026,13 @@ func (m *MsgExecuteIbcAutoForwards) GetExecutor() string {
 	return ""
 }
 
+func (m *MsgExecuteIbcAutoForwards) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgExecuteIbcAutoForwardsResponse struct {
 }
 
@@ -915,7 +1040,7 @@ func (m *MsgExecuteIbcAutoForwardsResponse) Reset()         { *m = MsgExecuteIbc
 func (m *MsgExecuteIbcAutoForwardsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteIbcAutoForwardsResponse) ProtoMessage()    {}
 func (*MsgExecuteIbcAutoForwardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{15}
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
 }
 func (m *MsgExecuteIbcAutoForwardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,6 +1069,116 @@ func (m *MsgExecuteIbcAutoForwardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecuteIbcAutoForwardsResponse proto.InternalMessageInfo
 
+// MsgClaimAirdrop
+// Claims the claimer's share of a MerkleAirdrop, the proof must lead from the
+// leaf keccak256(claimer address bytes | amount as uint64 big endian) to the
+// airdrop's Merkle root. Each address may only claim once per airdrop.
+// The proof elements are hex encoded, like the signatures in confirm Msgs,
+// so that claims can be EIP-712 signed
+type MsgClaimAirdrop struct {
+	Claimer   string   `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	AirdropId uint64   `protobuf:"varint,2,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Amount    uint64   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Proof     []string `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgClaimAirdrop) Reset()         { *m = MsgClaimAirdrop{} }
+func (m *MsgClaimAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAirdrop) ProtoMessage()    {}
+func (*MsgClaimAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
+}
+func (m *MsgClaimAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAirdrop.Merge(m, src)
+}
+func (m *MsgClaimAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAirdrop proto.InternalMessageInfo
+
+func (m *MsgClaimAirdrop) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *MsgClaimAirdrop) GetAirdropId() uint64 {
+	if m != nil {
+		return m.AirdropId
+	}
+	return 0
+}
+
+func (m *MsgClaimAirdrop) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MsgClaimAirdrop) GetProof() []string {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type MsgClaimAirdropResponse struct {
+}
+
+func (m *MsgClaimAirdropResponse) Reset()         { *m = MsgClaimAirdropResponse{} }
+func (m *MsgClaimAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAirdropResponse) ProtoMessage()    {}
+func (*MsgClaimAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
+}
+func (m *MsgClaimAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAirdropResponse.Merge(m, src)
+}
+func (m *MsgClaimAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAirdropResponse proto.InternalMessageInfo
+
 // BatchSendToEthClaim claims that a batch of send to eth
 // operations on the bridge contract was executed.
 type MsgBatchSendToEthClaim struct {
@@ -952,13 +1187,14 @@ type MsgBatchSendToEthClaim struct {
 	BatchNonce     uint64 `protobuf:"varint,3,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
 	TokenContract  string `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 	Orchestrator   string `protobuf:"bytes,5,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,6,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgBatchSendToEthClaim) Reset()         { *m = MsgBatchSendToEthClaim{} }
 func (m *MsgBatchSendToEthClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgBatchSendToEthClaim) ProtoMessage()    {}
 func (*MsgBatchSendToEthClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
 }
 func (m *MsgBatchSendToEthClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1022,6 +1258,13 @@ func (m *MsgBatchSendToEthClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgBatchSendToEthClaim) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgBatchSendToEthClaimResponse struct {
 }
 
@@ -1029,7 +1272,7 @@ func (m *MsgBatchSendToEthClaimResponse) Reset()         { *m = MsgBatchSendToEt
 func (m *MsgBatchSendToEthClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBatchSendToEthClaimResponse) ProtoMessage()    {}
 func (*MsgBatchSendToEthClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
 }
 func (m *MsgBatchSendToEthClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1070,13 +1313,14 @@ type MsgERC20DeployedClaim struct {
 	Symbol         string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Decimals       uint64 `protobuf:"varint,7,opt,name=decimals,proto3" json:"decimals,omitempty"`
 	Orchestrator   string `protobuf:"bytes,8,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,9,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgERC20DeployedClaim) Reset()         { *m = MsgERC20DeployedClaim{} }
 func (m *MsgERC20DeployedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaim) ProtoMessage()    {}
 func (*MsgERC20DeployedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
 }
 func (m *MsgERC20DeployedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1161,6 +1405,13 @@ func (m *MsgERC20DeployedClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgERC20DeployedClaim) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgERC20DeployedClaimResponse struct {
 }
 
@@ -1168,7 +1419,7 @@ func (m *MsgERC20DeployedClaimResponse) Reset()         { *m = MsgERC20DeployedC
 func (m *MsgERC20DeployedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaimResponse) ProtoMessage()    {}
 func (*MsgERC20DeployedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
 }
 func (m *MsgERC20DeployedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1205,13 +1456,14 @@ type MsgLogicCallExecutedClaim struct {
 	InvalidationId    []byte `protobuf:"bytes,3,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
 	InvalidationNonce uint64 `protobuf:"varint,4,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
 	Orchestrator      string `protobuf:"bytes,5,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EvmChainPrefix    string `protobuf:"bytes,6,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgLogicCallExecutedClaim) Reset()         { *m = MsgLogicCallExecutedClaim{} }
 func (m *MsgLogicCallExecutedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaim) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
 }
 func (m *MsgLogicCallExecutedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1275,6 +1527,13 @@ func (m *MsgLogicCallExecutedClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgLogicCallExecutedClaim) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgLogicCallExecutedClaimResponse struct {
 }
 
@@ -1282,7 +1541,7 @@ func (m *MsgLogicCallExecutedClaimResponse) Reset()         { *m = MsgLogicCallE
 func (m *MsgLogicCallExecutedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaimResponse) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{21}
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
 }
 func (m *MsgLogicCallExecutedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,13 +1580,14 @@ type MsgValsetUpdatedClaim struct {
 	RewardAmount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=reward_amount,json=rewardAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward_amount"`
 	RewardToken    string                                 `protobuf:"bytes,6,opt,name=reward_token,json=rewardToken,proto3" json:"reward_token,omitempty"`
 	Orchestrator   string                                 `protobuf:"bytes,7,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EvmChainPrefix string                                 `protobuf:"bytes,8,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgValsetUpdatedClaim) Reset()         { *m = MsgValsetUpdatedClaim{} }
 func (m *MsgValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaim) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{22}
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
 }
 func (m *MsgValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1398,6 +1658,13 @@ func (m *MsgValsetUpdatedClaim) GetOrchestrator() string {
 	return ""
 }
 
+func (m *MsgValsetUpdatedClaim) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgValsetUpdatedClaimResponse struct {
 }
 
@@ -1405,7 +1672,7 @@ func (m *MsgValsetUpdatedClaimResponse) Reset()         { *m = MsgValsetUpdatedC
 func (m *MsgValsetUpdatedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaimResponse) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{23}
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
 }
 func (m *MsgValsetUpdatedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1438,15 +1705,16 @@ var xxx_messageInfo_MsgValsetUpdatedClaimResponse proto.InternalMessageInfo
 // to cancel a given MsgSendToEth and recieve a refund
 // of the tokens
 type MsgCancelSendToEth struct {
-	TransactionId uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Sender        string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	TransactionId  uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Sender         string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgCancelSendToEth) Reset()         { *m = MsgCancelSendToEth{} }
 func (m *MsgCancelSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEth) ProtoMessage()    {}
 func (*MsgCancelSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{24}
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
 }
 func (m *MsgCancelSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1489,6 +1757,13 @@ func (m *MsgCancelSendToEth) GetSender() string {
 	return ""
 }
 
+func (m *MsgCancelSendToEth) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgCancelSendToEthResponse struct {
 }
 
@@ -1496,7 +1771,7 @@ func (m *MsgCancelSendToEthResponse) Reset()         { *m = MsgCancelSendToEthRe
 func (m *MsgCancelSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEthResponse) ProtoMessage()    {}
 func (*MsgCancelSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{25}
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
 }
 func (m *MsgCancelSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1530,16 +1805,17 @@ var xxx_messageInfo_MsgCancelSendToEthResponse proto.InternalMessageInfo
 // existed on the Cosmos chain.
 // Subject contains the batch, valset, or logic call.
 type MsgSubmitBadSignatureEvidence struct {
-	Subject   *types1.Any `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	Signature string      `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	Sender    string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Subject        *types1.Any `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Signature      string      `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Sender         string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	EvmChainPrefix string      `protobuf:"bytes,4,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSignatureEvidence{} }
 func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{26}
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
 }
 func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1589,6 +1865,13 @@ func (m *MsgSubmitBadSignatureEvidence) GetSender() string {
 	return ""
 }
 
+func (m *MsgSubmitBadSignatureEvidence) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type MsgSubmitBadSignatureEvidenceResponse struct {
 }
 
@@ -1596,7 +1879,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmit
 func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{27}
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
 }
 func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1634,7 +1917,7 @@ func (m *EventSetOperatorAddress) Reset()         { *m = EventSetOperatorAddress
 func (m *EventSetOperatorAddress) String() string { return proto.CompactTextString(m) }
 func (*EventSetOperatorAddress) ProtoMessage()    {}
 func (*EventSetOperatorAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{28}
+	return fileDescriptor_2f8523f2f6feb451, []int{31}
 }
 func (m *EventSetOperatorAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,7 +1969,7 @@ func (m *EventValsetConfirmKey) Reset()         { *m = EventValsetConfirmKey{} }
 func (m *EventValsetConfirmKey) String() string { return proto.CompactTextString(m) }
 func (*EventValsetConfirmKey) ProtoMessage()    {}
 func (*EventValsetConfirmKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{29}
+	return fileDescriptor_2f8523f2f6feb451, []int{32}
 }
 func (m *EventValsetConfirmKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1730,15 +2013,16 @@ func (m *EventValsetConfirmKey) GetKey() string {
 }
 
 type EventBatchCreated struct {
-	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	BatchNonce string `protobuf:"bytes,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	Message        string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	BatchNonce     string `protobuf:"bytes,2,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *EventBatchCreated) Reset()         { *m = EventBatchCreated{} }
 func (m *EventBatchCreated) String() string { return proto.CompactTextString(m) }
 func (*EventBatchCreated) ProtoMessage()    {}
 func (*EventBatchCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{30}
+	return fileDescriptor_2f8523f2f6feb451, []int{33}
 }
 func (m *EventBatchCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1781,6 +2065,13 @@ func (m *EventBatchCreated) GetBatchNonce() string {
 	return ""
 }
 
+func (m *EventBatchCreated) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type EventBatchConfirmKey struct {
 	Message         string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	BatchConfirmKey string `protobuf:"bytes,2,opt,name=batch_confirm_key,json=batchConfirmKey,proto3" json:"batch_confirm_key,omitempty"`
@@ -1790,7 +2081,7 @@ func (m *EventBatchConfirmKey) Reset()         { *m = EventBatchConfirmKey{} }
 func (m *EventBatchConfirmKey) String() string { return proto.CompactTextString(m) }
 func (*EventBatchConfirmKey) ProtoMessage()    {}
 func (*EventBatchConfirmKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{31}
+	return fileDescriptor_2f8523f2f6feb451, []int{34}
 }
 func (m *EventBatchConfirmKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1841,7 +2132,7 @@ func (m *EventBatchSendToEthClaim) Reset()         { *m = EventBatchSendToEthCla
 func (m *EventBatchSendToEthClaim) String() string { return proto.CompactTextString(m) }
 func (*EventBatchSendToEthClaim) ProtoMessage()    {}
 func (*EventBatchSendToEthClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{32}
+	return fileDescriptor_2f8523f2f6feb451, []int{35}
 }
 func (m *EventBatchSendToEthClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2178,7 @@ func (m *EventClaim) Reset()         { *m = EventClaim{} }
 func (m *EventClaim) String() string { return proto.CompactTextString(m) }
 func (*EventClaim) ProtoMessage()    {}
 func (*EventClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{33}
+	return fileDescriptor_2f8523f2f6feb451, []int{36}
 }
 func (m *EventClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1947,7 +2238,7 @@ func (m *EventBadSignatureEvidence) Reset()         { *m = EventBadSignatureEvid
 func (m *EventBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*EventBadSignatureEvidence) ProtoMessage()    {}
 func (*EventBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{34}
+	return fileDescriptor_2f8523f2f6feb451, []int{37}
 }
 func (m *EventBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2006,7 +2297,7 @@ func (m *EventERC20DeployedClaim) Reset()         { *m = EventERC20DeployedClaim
 func (m *EventERC20DeployedClaim) String() string { return proto.CompactTextString(m) }
 func (*EventERC20DeployedClaim) ProtoMessage()    {}
 func (*EventERC20DeployedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{35}
+	return fileDescriptor_2f8523f2f6feb451, []int{38}
 }
 func (m *EventERC20DeployedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2057,7 +2348,7 @@ func (m *EventValsetUpdatedClaim) Reset()         { *m = EventValsetUpdatedClaim
 func (m *EventValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*EventValsetUpdatedClaim) ProtoMessage()    {}
 func (*EventValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{36}
+	return fileDescriptor_2f8523f2f6feb451, []int{39}
 }
 func (m *EventValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,13 +2389,14 @@ type EventMultisigUpdateRequest struct {
 	BridgeChainId  string `protobuf:"bytes,2,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
 	MultisigId     string `protobuf:"bytes,3,opt,name=multisig_id,json=multisigId,proto3" json:"multisig_id,omitempty"`
 	Nonce          string `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,5,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *EventMultisigUpdateRequest) Reset()         { *m = EventMultisigUpdateRequest{} }
 func (m *EventMultisigUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*EventMultisigUpdateRequest) ProtoMessage()    {}
 func (*EventMultisigUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{37}
+	return fileDescriptor_2f8523f2f6feb451, []int{40}
 }
 func (m *EventMultisigUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2161,6 +2453,13 @@ func (m *EventMultisigUpdateRequest) GetNonce() string {
 	return ""
 }
 
+func (m *EventMultisigUpdateRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type EventOutgoingLogicCallCanceled struct {
 	LogicCallInvalidationId    string `protobuf:"bytes,1,opt,name=logic_call_invalidation_id,json=logicCallInvalidationId,proto3" json:"logic_call_invalidation_id,omitempty"`
 	LogicCallInvalidationNonce string `protobuf:"bytes,2,opt,name=logic_call_invalidation_nonce,json=logicCallInvalidationNonce,proto3" json:"logic_call_invalidation_nonce,omitempty"`
@@ -2170,7 +2469,7 @@ func (m *EventOutgoingLogicCallCanceled) Reset()         { *m = EventOutgoingLog
 func (m *EventOutgoingLogicCallCanceled) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingLogicCallCanceled) ProtoMessage()    {}
 func (*EventOutgoingLogicCallCanceled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{38}
+	return fileDescriptor_2f8523f2f6feb451, []int{41}
 }
 func (m *EventOutgoingLogicCallCanceled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2216,13 +2515,18 @@ func (m *EventOutgoingLogicCallCanceled) GetLogicCallInvalidationNonce() string
 type EventSignatureSlashing struct {
 	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// the validator's offence count including this offence
+	Offences uint64 `protobuf:"varint,3,opt,name=offences,proto3" json:"offences,omitempty"`
+	// the fraction slashed, zero if the validator was only jailed
+	SlashFraction string `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	Tombstoned    bool   `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
 }
 
 func (m *EventSignatureSlashing) Reset()         { *m = EventSignatureSlashing{} }
 func (m *EventSignatureSlashing) String() string { return proto.CompactTextString(m) }
 func (*EventSignatureSlashing) ProtoMessage()    {}
 func (*EventSignatureSlashing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{39}
+	return fileDescriptor_2f8523f2f6feb451, []int{42}
 }
 func (m *EventSignatureSlashing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2265,16 +2569,178 @@ func (m *EventSignatureSlashing) GetAddress() string {
 	return ""
 }
 
-type EventOutgoingTxId struct {
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	TxId    string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+func (m *EventSignatureSlashing) GetOffences() uint64 {
+	if m != nil {
+		return m.Offences
+	}
+	return 0
+}
+
+func (m *EventSignatureSlashing) GetSlashFraction() string {
+	if m != nil {
+		return m.SlashFraction
+	}
+	return ""
+}
+
+func (m *EventSignatureSlashing) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+// EventValsetUpdateTriggered is emitted when a new valset is requested by the EndBlocker, triggers lists every
+// trigger which fired, see the ValsetTrigger constants
+type EventValsetUpdateTriggered struct {
+	EvmChainPrefix string   `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Nonce          string   `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Triggers       []string `protobuf:"bytes,3,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	PowerDiff      string   `protobuf:"bytes,4,opt,name=power_diff,json=powerDiff,proto3" json:"power_diff,omitempty"`
+}
+
+func (m *EventValsetUpdateTriggered) Reset()         { *m = EventValsetUpdateTriggered{} }
+func (m *EventValsetUpdateTriggered) String() string { return proto.CompactTextString(m) }
+func (*EventValsetUpdateTriggered) ProtoMessage()    {}
+func (*EventValsetUpdateTriggered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{43}
+}
+func (m *EventValsetUpdateTriggered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValsetUpdateTriggered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValsetUpdateTriggered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventValsetUpdateTriggered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValsetUpdateTriggered.Merge(m, src)
+}
+func (m *EventValsetUpdateTriggered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValsetUpdateTriggered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValsetUpdateTriggered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValsetUpdateTriggered proto.InternalMessageInfo
+
+func (m *EventValsetUpdateTriggered) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *EventValsetUpdateTriggered) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *EventValsetUpdateTriggered) GetTriggers() []string {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
+func (m *EventValsetUpdateTriggered) GetPowerDiff() string {
+	if m != nil {
+		return m.PowerDiff
+	}
+	return ""
+}
+
+// EventValsetUpdateDeferred is emitted when triggers fired but the latest valset request is less than
+// valset_min_interval blocks old, the valset is requested at next_height if a trigger still fires then
+type EventValsetUpdateDeferred struct {
+	EvmChainPrefix string   `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Triggers       []string `protobuf:"bytes,2,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	PowerDiff      string   `protobuf:"bytes,3,opt,name=power_diff,json=powerDiff,proto3" json:"power_diff,omitempty"`
+	NextHeight     string   `protobuf:"bytes,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *EventValsetUpdateDeferred) Reset()         { *m = EventValsetUpdateDeferred{} }
+func (m *EventValsetUpdateDeferred) String() string { return proto.CompactTextString(m) }
+func (*EventValsetUpdateDeferred) ProtoMessage()    {}
+func (*EventValsetUpdateDeferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{44}
+}
+func (m *EventValsetUpdateDeferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValsetUpdateDeferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValsetUpdateDeferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventValsetUpdateDeferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValsetUpdateDeferred.Merge(m, src)
+}
+func (m *EventValsetUpdateDeferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValsetUpdateDeferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValsetUpdateDeferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValsetUpdateDeferred proto.InternalMessageInfo
+
+func (m *EventValsetUpdateDeferred) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *EventValsetUpdateDeferred) GetTriggers() []string {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
+func (m *EventValsetUpdateDeferred) GetPowerDiff() string {
+	if m != nil {
+		return m.PowerDiff
+	}
+	return ""
+}
+
+func (m *EventValsetUpdateDeferred) GetNextHeight() string {
+	if m != nil {
+		return m.NextHeight
+	}
+	return ""
+}
+
+type EventOutgoingTxId struct {
+	Message        string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	TxId           string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *EventOutgoingTxId) Reset()         { *m = EventOutgoingTxId{} }
 func (m *EventOutgoingTxId) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingTxId) ProtoMessage()    {}
 func (*EventOutgoingTxId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{40}
+	return fileDescriptor_2f8523f2f6feb451, []int{45}
 }
 func (m *EventOutgoingTxId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2317,6 +2783,13 @@ func (m *EventOutgoingTxId) GetTxId() string {
 	return ""
 }
 
+func (m *EventOutgoingTxId) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type EventSendToEthFeeCollected struct {
 	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	SendAmount string `protobuf:"bytes,2,opt,name=send_amount,json=sendAmount,proto3" json:"send_amount,omitempty"`
@@ -2327,7 +2800,7 @@ func (m *EventSendToEthFeeCollected) Reset()         { *m = EventSendToEthFeeCol
 func (m *EventSendToEthFeeCollected) String() string { return proto.CompactTextString(m) }
 func (*EventSendToEthFeeCollected) ProtoMessage()    {}
 func (*EventSendToEthFeeCollected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{41}
+	return fileDescriptor_2f8523f2f6feb451, []int{46}
 }
 func (m *EventSendToEthFeeCollected) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2377,8 +2850,189 @@ func (m *EventSendToEthFeeCollected) GetFeeAmount() string {
 	return ""
 }
 
+type EventMerkleAirdropCreated struct {
+	AirdropId    string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Total        string `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	ExpiryHeight string `protobuf:"bytes,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *EventMerkleAirdropCreated) Reset()         { *m = EventMerkleAirdropCreated{} }
+func (m *EventMerkleAirdropCreated) String() string { return proto.CompactTextString(m) }
+func (*EventMerkleAirdropCreated) ProtoMessage()    {}
+func (*EventMerkleAirdropCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{47}
+}
+func (m *EventMerkleAirdropCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMerkleAirdropCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMerkleAirdropCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMerkleAirdropCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMerkleAirdropCreated.Merge(m, src)
+}
+func (m *EventMerkleAirdropCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMerkleAirdropCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMerkleAirdropCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMerkleAirdropCreated proto.InternalMessageInfo
+
+func (m *EventMerkleAirdropCreated) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *EventMerkleAirdropCreated) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventMerkleAirdropCreated) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *EventMerkleAirdropCreated) GetExpiryHeight() string {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return ""
+}
+
+type EventMerkleAirdropClaimed struct {
+	AirdropId string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Claimer   string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	Amount    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventMerkleAirdropClaimed) Reset()         { *m = EventMerkleAirdropClaimed{} }
+func (m *EventMerkleAirdropClaimed) String() string { return proto.CompactTextString(m) }
+func (*EventMerkleAirdropClaimed) ProtoMessage()    {}
+func (*EventMerkleAirdropClaimed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{48}
+}
+func (m *EventMerkleAirdropClaimed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMerkleAirdropClaimed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMerkleAirdropClaimed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMerkleAirdropClaimed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMerkleAirdropClaimed.Merge(m, src)
+}
+func (m *EventMerkleAirdropClaimed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMerkleAirdropClaimed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMerkleAirdropClaimed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMerkleAirdropClaimed proto.InternalMessageInfo
+
+func (m *EventMerkleAirdropClaimed) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *EventMerkleAirdropClaimed) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *EventMerkleAirdropClaimed) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type EventMerkleAirdropExpired struct {
+	AirdropId string `protobuf:"bytes,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Returned  string `protobuf:"bytes,2,opt,name=returned,proto3" json:"returned,omitempty"`
+}
+
+func (m *EventMerkleAirdropExpired) Reset()         { *m = EventMerkleAirdropExpired{} }
+func (m *EventMerkleAirdropExpired) String() string { return proto.CompactTextString(m) }
+func (*EventMerkleAirdropExpired) ProtoMessage()    {}
+func (*EventMerkleAirdropExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{49}
+}
+func (m *EventMerkleAirdropExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMerkleAirdropExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMerkleAirdropExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMerkleAirdropExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMerkleAirdropExpired.Merge(m, src)
+}
+func (m *EventMerkleAirdropExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMerkleAirdropExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMerkleAirdropExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMerkleAirdropExpired proto.InternalMessageInfo
+
+func (m *EventMerkleAirdropExpired) GetAirdropId() string {
+	if m != nil {
+		return m.AirdropId
+	}
+	return ""
+}
+
+func (m *EventMerkleAirdropExpired) GetReturned() string {
+	if m != nil {
+		return m.Returned
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "gravity.v1.MsgSetOrchestratorAddress")
+	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
 	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "gravity.v1.MsgSetOrchestratorAddressResponse")
 	proto.RegisterType((*MsgValsetConfirm)(nil), "gravity.v1.MsgValsetConfirm")
 	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "gravity.v1.MsgValsetConfirmResponse")
@@ -2394,6 +3048,8 @@ func init() {
 	proto.RegisterType((*MsgSendToCosmosClaimResponse)(nil), "gravity.v1.MsgSendToCosmosClaimResponse")
 	proto.RegisterType((*MsgExecuteIbcAutoForwards)(nil), "gravity.v1.MsgExecuteIbcAutoForwards")
 	proto.RegisterType((*MsgExecuteIbcAutoForwardsResponse)(nil), "gravity.v1.MsgExecuteIbcAutoForwardsResponse")
+	proto.RegisterType((*MsgClaimAirdrop)(nil), "gravity.v1.MsgClaimAirdrop")
+	proto.RegisterType((*MsgClaimAirdropResponse)(nil), "gravity.v1.MsgClaimAirdropResponse")
 	proto.RegisterType((*MsgBatchSendToEthClaim)(nil), "gravity.v1.MsgBatchSendToEthClaim")
 	proto.RegisterType((*MsgBatchSendToEthClaimResponse)(nil), "gravity.v1.MsgBatchSendToEthClaimResponse")
 	proto.RegisterType((*MsgERC20DeployedClaim)(nil), "gravity.v1.MsgERC20DeployedClaim")
@@ -2418,145 +3074,176 @@ func init() {
 	proto.RegisterType((*EventMultisigUpdateRequest)(nil), "gravity.v1.EventMultisigUpdateRequest")
 	proto.RegisterType((*EventOutgoingLogicCallCanceled)(nil), "gravity.v1.EventOutgoingLogicCallCanceled")
 	proto.RegisterType((*EventSignatureSlashing)(nil), "gravity.v1.EventSignatureSlashing")
+	proto.RegisterType((*EventValsetUpdateTriggered)(nil), "gravity.v1.EventValsetUpdateTriggered")
+	proto.RegisterType((*EventValsetUpdateDeferred)(nil), "gravity.v1.EventValsetUpdateDeferred")
 	proto.RegisterType((*EventOutgoingTxId)(nil), "gravity.v1.EventOutgoingTxId")
 	proto.RegisterType((*EventSendToEthFeeCollected)(nil), "gravity.v1.EventSendToEthFeeCollected")
+	proto.RegisterType((*EventMerkleAirdropCreated)(nil), "gravity.v1.EventMerkleAirdropCreated")
+	proto.RegisterType((*EventMerkleAirdropClaimed)(nil), "gravity.v1.EventMerkleAirdropClaimed")
+	proto.RegisterType((*EventMerkleAirdropExpired)(nil), "gravity.v1.EventMerkleAirdropExpired")
 }
 
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 2092 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x9f, 0x4e, 0x9c, 0x99, 0xf1, 0x73, 0x12, 0x4f, 0x7a, 0x32, 0x19, 0xbb, 0x27, 0x71, 0x92,
-	0x9e, 0xcd, 0xc7, 0xce, 0x12, 0x7b, 0x12, 0x0e, 0x08, 0x2d, 0x62, 0x15, 0x7b, 0x12, 0xd6, 0x82,
-	0xcc, 0x4a, 0xce, 0xb0, 0x12, 0x08, 0xa9, 0xd5, 0xee, 0xae, 0xb4, 0x9b, 0x69, 0x77, 0x85, 0xee,
-	0xb2, 0x37, 0xb9, 0xac, 0x04, 0x37, 0xb4, 0x1c, 0x10, 0x5c, 0x40, 0x5a, 0x24, 0x24, 0xb8, 0x22,
-	0x2e, 0xfc, 0x0d, 0x68, 0xc5, 0x01, 0x56, 0xe2, 0x82, 0x38, 0x8c, 0xd0, 0x0c, 0x7f, 0x04, 0x47,
-	0x54, 0x1f, 0x5d, 0xae, 0x6e, 0xb7, 0x1d, 0x0b, 0x0d, 0x27, 0xbb, 0x5e, 0xbd, 0x7a, 0xf5, 0xab,
-	0x57, 0xbf, 0x7a, 0x1f, 0x0d, 0x0f, 0xbc, 0xc8, 0x1e, 0xfa, 0xe4, 0xba, 0x31, 0x3c, 0x6c, 0xf4,
-	0x63, 0x2f, 0xae, 0x5f, 0x46, 0x98, 0x60, 0x1d, 0x84, 0xb8, 0x3e, 0x3c, 0x34, 0x6a, 0x0e, 0x8e,
-	0xfb, 0x38, 0x6e, 0x74, 0xed, 0x18, 0x35, 0x86, 0x87, 0x5d, 0x44, 0xec, 0xc3, 0x86, 0x83, 0xfd,
-	0x90, 0xeb, 0x1a, 0xab, 0x1e, 0xf6, 0x30, 0xfb, 0xdb, 0xa0, 0xff, 0x84, 0x74, 0xdd, 0xc3, 0xd8,
-	0x0b, 0x50, 0xc3, 0xbe, 0xf4, 0x1b, 0x76, 0x18, 0x62, 0x62, 0x13, 0x1f, 0x87, 0xc2, 0xbe, 0xb1,
-	0xa6, 0x6c, 0x4b, 0xae, 0x2f, 0x51, 0x22, 0xaf, 0x8a, 0x55, 0x6c, 0xd4, 0x1d, 0x5c, 0x34, 0xec,
-	0xf0, 0x3a, 0x99, 0xe2, 0x30, 0x2c, 0xbe, 0x13, 0x1f, 0xf0, 0x29, 0xf3, 0x53, 0xa8, 0x9e, 0xc5,
-	0xde, 0x39, 0x22, 0x1f, 0x45, 0x4e, 0x0f, 0xc5, 0x24, 0xb2, 0x09, 0x8e, 0x8e, 0x5d, 0x37, 0x42,
-	0x71, 0xac, 0xaf, 0x43, 0x71, 0x68, 0x07, 0xbe, 0x4b, 0x65, 0x15, 0x6d, 0x4b, 0xdb, 0x2f, 0x76,
-	0x46, 0x02, 0xdd, 0x84, 0x45, 0xac, 0x2c, 0xaa, 0xcc, 0x31, 0x85, 0x94, 0x4c, 0xdf, 0x84, 0x12,
-	0x22, 0x3d, 0xcb, 0xe6, 0x06, 0x2b, 0xf3, 0x4c, 0x05, 0x10, 0xe9, 0x89, 0x2d, 0xcc, 0xc7, 0xb0,
-	0x3d, 0x71, 0xff, 0x0e, 0x8a, 0x2f, 0x71, 0x18, 0x23, 0xf3, 0x33, 0x0d, 0xee, 0x9d, 0xc5, 0xde,
-	0xc7, 0x76, 0x10, 0x23, 0xd2, 0xc2, 0xe1, 0x85, 0x1f, 0xf5, 0xf5, 0x55, 0x58, 0x08, 0x71, 0xe8,
-	0x20, 0x06, 0xac, 0xd0, 0xe1, 0x83, 0xb7, 0x02, 0x8a, 0x9e, 0x3b, 0xf6, 0xbd, 0xd0, 0x26, 0x83,
-	0x08, 0x55, 0x0a, 0xfc, 0xdc, 0x52, 0x60, 0x1a, 0x50, 0xc9, 0x82, 0x91, 0x48, 0xff, 0xa3, 0xc1,
-	0x22, 0x3b, 0x4f, 0xe8, 0xbe, 0xc0, 0x27, 0xa4, 0xa7, 0xaf, 0xc1, 0xed, 0x18, 0x85, 0x2e, 0x4a,
-	0xfc, 0x27, 0x46, 0x7a, 0x15, 0xee, 0x52, 0x0c, 0x2e, 0x8a, 0x89, 0xc0, 0x78, 0x07, 0x91, 0xde,
-	0x33, 0x14, 0x13, 0xfd, 0x6b, 0x70, 0xdb, 0xee, 0xe3, 0x41, 0x48, 0x18, 0xb2, 0xd2, 0x51, 0xb5,
-	0x2e, 0x6e, 0x8c, 0xb2, 0xa8, 0x2e, 0x58, 0x54, 0x6f, 0x61, 0x3f, 0x6c, 0x16, 0xbe, 0x78, 0xb5,
-	0x79, 0xab, 0x23, 0xd4, 0xf5, 0x6f, 0x02, 0x74, 0x23, 0xdf, 0xf5, 0x90, 0x75, 0x81, 0x38, 0xee,
-	0x19, 0x16, 0x17, 0xf9, 0x92, 0x53, 0x84, 0xf4, 0x6f, 0x40, 0xd1, 0xe9, 0xd9, 0x7e, 0xc8, 0x96,
-	0x2f, 0xcc, 0xb6, 0xfc, 0x2e, 0x5b, 0x71, 0x8a, 0x90, 0xb9, 0x06, 0xab, 0xea, 0xc9, 0xa5, 0x4b,
-	0x3e, 0x80, 0xf2, 0x59, 0xec, 0x75, 0xd0, 0x8f, 0x06, 0x28, 0x26, 0x4d, 0x9b, 0x38, 0x93, 0x9d,
-	0xb2, 0x0a, 0x0b, 0x2e, 0x0a, 0x71, 0x5f, 0x78, 0x84, 0x0f, 0xcc, 0x2a, 0x3c, 0xcc, 0x18, 0x90,
-	0xb6, 0xff, 0xa8, 0x31, 0xe3, 0xe2, 0x16, 0xb8, 0xf1, 0x7c, 0x5e, 0xec, 0xc0, 0x32, 0xc1, 0x2f,
-	0x51, 0x68, 0x39, 0x38, 0x24, 0x91, 0xed, 0x24, 0x5e, 0x5f, 0x62, 0xd2, 0x96, 0x10, 0xea, 0x1b,
-	0x40, 0x79, 0x60, 0xd1, 0xcb, 0x46, 0x91, 0x60, 0x46, 0x11, 0x91, 0xde, 0x39, 0x13, 0x8c, 0xb1,
-	0xab, 0x90, 0xc3, 0xae, 0x14, 0x79, 0x16, 0xb2, 0xe4, 0xe1, 0x87, 0x51, 0x01, 0xcb, 0xc3, 0xfc,
-	0x55, 0x83, 0xfb, 0xa3, 0xb9, 0xef, 0x60, 0xcf, 0x77, 0x5a, 0x76, 0x10, 0xe8, 0x7b, 0x50, 0xf6,
-	0x43, 0xf1, 0xec, 0x7c, 0x1c, 0x5a, 0xbe, 0x2b, 0xdc, 0xb6, 0xac, 0x8a, 0xdb, 0xae, 0x7e, 0x00,
-	0x7a, 0x4a, 0x91, 0xbb, 0x61, 0x8e, 0xb9, 0x61, 0x45, 0x9d, 0x79, 0xce, 0x5c, 0xf2, 0x7f, 0x3f,
-	0xeb, 0x06, 0x3c, 0xca, 0x39, 0x8f, 0x3c, 0xef, 0x9f, 0xe7, 0x14, 0xc6, 0xb4, 0x18, 0xcd, 0x5a,
-	0x81, 0xed, 0xf7, 0xd9, 0xfb, 0x1c, 0xa2, 0x90, 0x58, 0xea, 0x3d, 0x02, 0x13, 0x71, 0xe4, 0xfb,
-	0x70, 0x8f, 0x22, 0xef, 0x06, 0xd8, 0x79, 0x69, 0xf5, 0x90, 0xef, 0xf5, 0x88, 0x38, 0xe6, 0x32,
-	0x22, 0xbd, 0x26, 0x15, 0x7f, 0xc8, 0xa4, 0x39, 0xd7, 0x3e, 0x9f, 0x77, 0xed, 0xa7, 0xf2, 0xc9,
-	0xb1, 0x53, 0x36, 0xeb, 0x94, 0xdb, 0xff, 0x7c, 0xb5, 0xb9, 0xeb, 0xf9, 0xa4, 0x37, 0xe8, 0xd6,
-	0x1d, 0xdc, 0x17, 0x61, 0x53, 0xfc, 0x1c, 0xc4, 0xee, 0x4b, 0x11, 0x7d, 0xdb, 0x21, 0x91, 0x2f,
-	0x70, 0x0f, 0xca, 0x88, 0xf4, 0x50, 0x84, 0x06, 0x7d, 0x4b, 0x30, 0x9c, 0x7b, 0x65, 0x39, 0x11,
-	0x9f, 0x73, 0xa6, 0xef, 0x41, 0x59, 0xc4, 0xe4, 0x08, 0x39, 0xc8, 0x1f, 0xa2, 0xa8, 0x72, 0x9b,
-	0x2b, 0x72, 0x71, 0x47, 0x48, 0xc7, 0x6e, 0xe1, 0xce, 0xf8, 0x2d, 0x98, 0x35, 0x58, 0xcf, 0xf3,
-	0xa3, 0x74, 0xb4, 0xc3, 0x62, 0xfc, 0xc9, 0x15, 0x72, 0x06, 0x04, 0xb5, 0xbb, 0xce, 0xf1, 0x80,
-	0xe0, 0x53, 0x1c, 0x7d, 0x62, 0x47, 0x6e, 0xac, 0x3f, 0x81, 0x95, 0x0b, 0xf1, 0xdf, 0x22, 0xd8,
-	0x72, 0x02, 0x64, 0x47, 0xc2, 0xe5, 0xe5, 0x64, 0xe2, 0x05, 0x6e, 0x51, 0xb1, 0x6e, 0xc0, 0x5d,
-	0xc4, 0xac, 0xc8, 0xc0, 0x2a, 0xc7, 0x22, 0x90, 0xe7, 0x6f, 0x22, 0x91, 0xfc, 0x4d, 0x83, 0xb5,
-	0xb3, 0xd8, 0x63, 0xbc, 0x97, 0x91, 0xe2, 0xad, 0x5f, 0xfa, 0x26, 0x94, 0xba, 0x74, 0x07, 0x61,
-	0x6a, 0x9e, 0x9b, 0x62, 0xa2, 0xe7, 0x13, 0x82, 0x41, 0x21, 0x8f, 0x15, 0x59, 0xdf, 0x2f, 0xe4,
-	0xf8, 0x7e, 0x0b, 0x6a, 0xf9, 0x07, 0x92, 0x67, 0xfe, 0xd5, 0x1c, 0x3c, 0xa0, 0x9e, 0xe9, 0xb4,
-	0x8e, 0x9e, 0x3e, 0x43, 0x97, 0x01, 0xbe, 0x46, 0xee, 0x5b, 0x3f, 0xf2, 0x36, 0x2c, 0x0a, 0x3e,
-	0xf1, 0x00, 0xca, 0x59, 0x5e, 0xe2, 0xb2, 0x67, 0x54, 0x34, 0xeb, 0xa1, 0x75, 0x28, 0x84, 0x76,
-	0x3f, 0x79, 0xcd, 0xec, 0x3f, 0x8b, 0xd7, 0xd7, 0xfd, 0x2e, 0x0e, 0x04, 0x49, 0xc5, 0x88, 0xf2,
-	0xc1, 0x45, 0x8e, 0xdf, 0xb7, 0x83, 0x98, 0x11, 0xb3, 0xd0, 0x91, 0xe3, 0x31, 0xe7, 0xdd, 0xcd,
-	0x71, 0xde, 0x26, 0x6c, 0xe4, 0x7a, 0x46, 0xfa, 0xee, 0xb5, 0xc6, 0xa8, 0x2b, 0x63, 0x87, 0xa0,
-	0xd7, 0xdb, 0xf7, 0x5f, 0x4e, 0x8c, 0xa5, 0x2e, 0x5c, 0x9c, 0x31, 0xc6, 0x16, 0x26, 0xc5, 0xd8,
-	0x59, 0x28, 0xc4, 0x5f, 0x4e, 0xfe, 0x19, 0xa5, 0x27, 0x5e, 0x71, 0x16, 0xf1, 0xaa, 0xe3, 0xbb,
-	0x97, 0xae, 0x3d, 0xbb, 0x17, 0xb6, 0x61, 0x71, 0xc8, 0x96, 0xa5, 0x12, 0x42, 0x89, 0xcb, 0x26,
-	0x3b, 0x6a, 0x3e, 0xd7, 0x51, 0xef, 0xc3, 0x9d, 0x3e, 0xea, 0x77, 0x51, 0x14, 0x57, 0x0a, 0x5b,
-	0xf3, 0xfb, 0xa5, 0xa3, 0x47, 0xf5, 0x51, 0xbd, 0x5b, 0x6f, 0xb2, 0x5a, 0xe2, 0xe3, 0xa4, 0x44,
-	0x14, 0x35, 0x42, 0xb2, 0x42, 0x3f, 0x87, 0xa5, 0x08, 0xd1, 0x88, 0x60, 0x89, 0x68, 0xbb, 0xf0,
-	0x3f, 0x45, 0xdb, 0x45, 0x6e, 0xe4, 0x98, 0xc7, 0xdc, 0x6d, 0x10, 0x63, 0x8b, 0x11, 0x59, 0x50,
-	0xb4, 0xc4, 0x65, 0x2f, 0xa8, 0x68, 0xa6, 0x20, 0xca, 0xb9, 0x38, 0xee, 0x5f, 0x79, 0x03, 0xe7,
-	0xa0, 0xd3, 0x6c, 0x66, 0x87, 0x0e, 0x0a, 0x46, 0xf5, 0x1d, 0x7d, 0x55, 0x91, 0x1d, 0xc6, 0xb6,
-	0xa3, 0xe6, 0xe6, 0x42, 0x67, 0x49, 0x91, 0xb6, 0x5d, 0xa5, 0xe2, 0x99, 0x53, 0x2b, 0x1e, 0x73,
-	0x1d, 0x8c, 0x71, 0xa3, 0x72, 0xcb, 0x5f, 0x6b, 0x0c, 0xd4, 0xf9, 0xa0, 0xdb, 0xf7, 0x49, 0xd3,
-	0x76, 0xcf, 0x93, 0xd4, 0x7a, 0x32, 0xf4, 0x5d, 0x44, 0x2f, 0xae, 0x09, 0x77, 0xe2, 0x41, 0xf7,
-	0x87, 0xc8, 0x21, 0x6c, 0xdf, 0xd2, 0xd1, 0x6a, 0x9d, 0xb7, 0x01, 0xf5, 0xa4, 0x0d, 0xa8, 0x1f,
-	0x87, 0xd7, 0x4d, 0xfd, 0x2f, 0x7f, 0x3a, 0x58, 0x3e, 0x49, 0x52, 0x10, 0xcd, 0xef, 0x6e, 0x27,
-	0x59, 0x98, 0x4e, 0xe2, 0x73, 0x99, 0x24, 0xae, 0x20, 0x9f, 0x4f, 0x21, 0xdf, 0x83, 0x9d, 0xa9,
-	0xd0, 0xe4, 0x21, 0xce, 0xe0, 0xe1, 0x09, 0x25, 0x23, 0xad, 0xf1, 0x2f, 0x51, 0xaa, 0xbf, 0xa8,
-	0x50, 0x32, 0xc5, 0xb1, 0xed, 0x21, 0x51, 0xd1, 0x24, 0x43, 0x3a, 0x93, 0x94, 0xe7, 0xa2, 0x3a,
-	0x16, 0x43, 0xb3, 0x05, 0x0f, 0x98, 0xb9, 0x54, 0xfd, 0xfd, 0x6d, 0x74, 0x3d, 0xc5, 0xd8, 0x3d,
-	0x98, 0x7f, 0x89, 0xae, 0x85, 0x21, 0xfa, 0xd7, 0x7c, 0x0e, 0x2b, 0xcc, 0x08, 0x8b, 0xdb, 0xad,
-	0x08, 0xd1, 0xdb, 0x9e, 0x62, 0x20, 0x93, 0x50, 0xb8, 0x21, 0x25, 0xa1, 0x98, 0x3f, 0x80, 0x55,
-	0xc5, 0xde, 0x2c, 0x98, 0x9e, 0xc0, 0x0a, 0x37, 0xe9, 0x70, 0x6d, 0x6b, 0x84, 0xb0, 0xdc, 0x4d,
-	0x5b, 0x31, 0x9f, 0x42, 0x65, 0x64, 0x3d, 0x93, 0x36, 0x53, 0xd5, 0x6e, 0x51, 0x54, 0xbb, 0x66,
-	0x00, 0xc0, 0x56, 0x70, 0x9d, 0xc9, 0x28, 0x36, 0x00, 0x1c, 0xaa, 0x62, 0xf5, 0xec, 0xb8, 0x97,
-	0xdc, 0x3d, 0x93, 0x7c, 0x68, 0xc7, 0x8c, 0xdc, 0x36, 0x21, 0x28, 0x26, 0xa9, 0xa0, 0x58, 0xec,
-	0x2c, 0x29, 0xd2, 0xb6, 0x6b, 0x7e, 0xae, 0x41, 0x55, 0x00, 0xcc, 0xa1, 0xe8, 0x0d, 0x3e, 0x70,
-	0xad, 0xa4, 0x08, 0x55, 0x09, 0x58, 0xee, 0xda, 0xee, 0x09, 0x2f, 0x45, 0x39, 0x0d, 0xbf, 0x0e,
-	0xd5, 0x31, 0x5d, 0x2b, 0xa1, 0x3e, 0x47, 0xb5, 0x96, 0x59, 0x73, 0xce, 0x67, 0xcd, 0x13, 0x41,
-	0xc0, 0x9c, 0x0c, 0xbc, 0x0a, 0x0b, 0x3c, 0x68, 0x08, 0xef, 0xb1, 0xc1, 0xc8, 0xa7, 0x73, 0xaa,
-	0x4f, 0x1b, 0xc2, 0x4c, 0x4e, 0x08, 0xce, 0xbf, 0x84, 0xdf, 0x6b, 0x60, 0xb0, 0x15, 0x67, 0x83,
-	0x80, 0xf8, 0xb1, 0xef, 0xf1, 0x35, 0xa2, 0x91, 0xa1, 0x29, 0x47, 0x74, 0x6b, 0x32, 0x21, 0x8b,
-	0xb2, 0x9e, 0x8b, 0x65, 0x46, 0xde, 0x1d, 0x29, 0xb2, 0xee, 0xcc, 0x77, 0x93, 0xde, 0x45, 0x28,
-	0x52, 0x69, 0xdb, 0xa5, 0x2c, 0xed, 0x8b, 0x9d, 0x46, 0x57, 0x05, 0x89, 0xa8, 0xed, 0x8e, 0x60,
-	0x16, 0x54, 0x98, 0xbf, 0xd5, 0xa0, 0xc6, 0x60, 0x7e, 0x34, 0x20, 0x1e, 0xf6, 0xc3, 0x51, 0x26,
-	0xe2, 0x61, 0x09, 0xb9, 0xfa, 0xfb, 0x60, 0x04, 0x54, 0x68, 0x39, 0x76, 0x10, 0x58, 0xf9, 0xcd,
-	0xc8, 0xc3, 0x20, 0x59, 0xd6, 0x4e, 0x67, 0xcc, 0x63, 0xd8, 0x98, 0xb4, 0x58, 0xf5, 0xb2, 0x91,
-	0xbb, 0x9e, 0x3f, 0xaf, 0x53, 0x58, 0xe3, 0x21, 0x44, 0x5e, 0x6d, 0x60, 0xc7, 0x3d, 0x3f, 0xf4,
-	0x68, 0xb5, 0x42, 0xf3, 0x82, 0xc0, 0xc0, 0xfe, 0x4f, 0x89, 0x1d, 0x4d, 0xf1, 0xec, 0x93, 0x93,
-	0xbe, 0xb8, 0x6a, 0x4f, 0x7b, 0xf6, 0xf7, 0x61, 0x81, 0x5c, 0x8d, 0xdc, 0x5d, 0x20, 0x57, 0x6d,
-	0xd7, 0x24, 0xe2, 0x52, 0xe5, 0x3b, 0x3c, 0x45, 0xa8, 0x85, 0x83, 0x00, 0x39, 0x34, 0x86, 0x4c,
-	0xea, 0x6c, 0x37, 0xa1, 0x44, 0xff, 0x25, 0x79, 0x4f, 0x44, 0x10, 0x2a, 0x12, 0x59, 0x6c, 0x03,
-	0xe0, 0x02, 0x21, 0x4b, 0x69, 0xfc, 0x8b, 0x9d, 0xe2, 0x05, 0x42, 0x7c, 0xfa, 0xe8, 0x77, 0x65,
-	0x98, 0x3f, 0x8b, 0x3d, 0xfd, 0x13, 0x58, 0x4a, 0x7f, 0x05, 0x59, 0x57, 0xd3, 0x6f, 0xf6, 0xb3,
-	0x84, 0xf1, 0xce, 0xb4, 0x59, 0x19, 0xa1, 0xcd, 0x9f, 0xfc, 0xfd, 0xdf, 0xbf, 0x9c, 0x5b, 0x37,
-	0x8d, 0x86, 0xf2, 0x69, 0x49, 0x94, 0x0c, 0x22, 0x3c, 0xe9, 0x3d, 0x28, 0x8e, 0x92, 0x5e, 0x25,
-	0x63, 0x56, 0xce, 0x18, 0x5b, 0x93, 0x66, 0xe4, 0x66, 0x9b, 0x6c, 0xb3, 0xaa, 0xf9, 0x50, 0xdd,
-	0x8c, 0xf9, 0x86, 0x60, 0xfa, 0xbc, 0xf5, 0x18, 0x16, 0x53, 0x1f, 0x0b, 0x1e, 0x65, 0x4c, 0xaa,
-	0x93, 0xc6, 0xe3, 0x29, 0x93, 0x72, 0xcb, 0x6d, 0xb6, 0xe5, 0x23, 0xb3, 0xaa, 0x6e, 0x19, 0x71,
-	0x4d, 0x8b, 0x05, 0x5b, 0xba, 0x69, 0xea, 0x23, 0x42, 0x76, 0x53, 0x75, 0x72, 0x6c, 0xd3, 0xdc,
-	0x6e, 0x3e, 0x77, 0xd3, 0x24, 0xd8, 0xf3, 0x4d, 0x3f, 0x85, 0x7b, 0x63, 0xcd, 0xfe, 0x66, 0xbe,
-	0x6d, 0xa9, 0x60, 0xec, 0xdd, 0xa0, 0x20, 0x01, 0x6c, 0x31, 0x00, 0x86, 0x59, 0x19, 0x03, 0xd0,
-	0xb7, 0xd8, 0x5b, 0xd3, 0x7f, 0xaa, 0xc1, 0xca, 0x78, 0xf7, 0x9d, 0x7f, 0x85, 0x8a, 0x86, 0xb1,
-	0x7f, 0x93, 0x86, 0xc4, 0xb0, 0xcf, 0x30, 0x98, 0xe6, 0x56, 0xde, 0x65, 0x8b, 0x86, 0x85, 0xa5,
-	0x1b, 0xfd, 0x37, 0x1a, 0xac, 0x4d, 0xe8, 0x50, 0x77, 0x32, 0xdb, 0xe5, 0xab, 0x19, 0x07, 0x33,
-	0xa9, 0x49, 0x68, 0x07, 0x0c, 0xda, 0x9e, 0xb9, 0xa3, 0x42, 0xe3, 0xdd, 0x2c, 0xb2, 0xfc, 0xae,
-	0x63, 0xd9, 0x03, 0x82, 0xad, 0xa4, 0x03, 0xd6, 0x7f, 0xa1, 0xc1, 0xfd, 0xbc, 0xfc, 0x6b, 0x66,
-	0x76, 0xcd, 0xd1, 0x31, 0x9e, 0xdc, 0xac, 0x23, 0x61, 0xbd, 0xc7, 0x60, 0xed, 0x98, 0x8f, 0x55,
-	0x58, 0xbc, 0x52, 0x50, 0x1e, 0x89, 0x70, 0xda, 0x67, 0x1a, 0xac, 0xa8, 0xe9, 0x88, 0x43, 0xda,
-	0xce, 0x7d, 0xf4, 0x6a, 0xc2, 0x32, 0xde, 0xbd, 0x51, 0x65, 0xfa, 0x15, 0x8a, 0xe0, 0x30, 0xe0,
-	0x0b, 0x04, 0x9a, 0x9f, 0x69, 0xa0, 0xe7, 0xe4, 0xd8, 0x2c, 0x9c, 0x71, 0x95, 0x31, 0x38, 0x53,
-	0x3a, 0xc2, 0x5c, 0x38, 0x28, 0x72, 0x8e, 0x9e, 0x5a, 0xae, 0x58, 0xa0, 0x30, 0x6a, 0x42, 0xe3,
-	0x98, 0x65, 0x54, 0xbe, 0xda, 0x18, 0xa3, 0x6e, 0x68, 0xd1, 0x72, 0x19, 0xa5, 0xa4, 0x3e, 0x41,
-	0xae, 0x04, 0xdf, 0xe7, 0x1a, 0xac, 0x4d, 0xf8, 0xee, 0xbe, 0x33, 0xf6, 0xc0, 0xf2, 0xd4, 0xc6,
-	0xf0, 0xdd, 0xf0, 0x15, 0xfd, 0x2b, 0x0c, 0xdf, 0xae, 0xf9, 0x4e, 0xfa, 0x31, 0x12, 0x4b, 0xed,
-	0x83, 0x92, 0xaf, 0xe2, 0xfa, 0x8f, 0x35, 0x28, 0x67, 0x9b, 0x9d, 0x5a, 0x36, 0xf6, 0xa4, 0xe7,
-	0x8d, 0xdd, 0xe9, 0xf3, 0x12, 0xc9, 0x2e, 0x43, 0xb2, 0x65, 0xd6, 0x52, 0xa1, 0x89, 0x29, 0xab,
-	0x2c, 0xd7, 0xff, 0xa0, 0x81, 0x31, 0xa5, 0xf9, 0xc9, 0xd2, 0x66, 0xb2, 0xaa, 0x71, 0x38, 0xb3,
-	0xaa, 0x04, 0x79, 0xc8, 0x40, 0xbe, 0x67, 0xbe, 0x9b, 0x72, 0x17, 0x5b, 0x67, 0xd1, 0x52, 0x74,
-	0x54, 0x86, 0x22, 0xb1, 0xb4, 0xf9, 0xbd, 0x2f, 0x5e, 0xd7, 0xb4, 0x2f, 0x5f, 0xd7, 0xb4, 0x7f,
-	0xbd, 0xae, 0x69, 0x3f, 0x7f, 0x53, 0xbb, 0xf5, 0xe5, 0x9b, 0xda, 0xad, 0x7f, 0xbc, 0xa9, 0xdd,
-	0xfa, 0xfe, 0x07, 0x4a, 0x6b, 0xfb, 0x2d, 0x6e, 0xee, 0x80, 0x37, 0xcb, 0xd9, 0x61, 0x1f, 0xbb,
-	0x83, 0x00, 0x35, 0xae, 0xe4, 0xae, 0xac, 0xef, 0xed, 0xde, 0x66, 0xfd, 0xdc, 0x57, 0xff, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0x9f, 0x3f, 0x0f, 0x37, 0x75, 0x1a, 0x00, 0x00,
+	// 2507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x1f, 0x3b, 0xce, 0x4c, 0xfc, 0x92, 0x4c, 0x92, 0x9e, 0x4c, 0xc6, 0xe9, 0x49, 0x9c, 0xa4,
+	0xb3, 0x99, 0xcc, 0xce, 0x10, 0x7b, 0x12, 0x0e, 0x08, 0x2d, 0x02, 0x25, 0x4e, 0xc2, 0x46, 0x4b,
+	0x76, 0x91, 0x33, 0x8c, 0x04, 0x02, 0xb5, 0xda, 0xdd, 0xe5, 0x76, 0x33, 0xed, 0x2e, 0xd3, 0x5d,
+	0xce, 0x24, 0x07, 0x90, 0xe0, 0x04, 0xda, 0x45, 0x42, 0x70, 0x80, 0xc3, 0x22, 0xf1, 0x71, 0xe2,
+	0xc0, 0x0d, 0xfe, 0x01, 0xb8, 0xac, 0x38, 0xa0, 0x95, 0xb8, 0x00, 0x87, 0x15, 0x9a, 0xe1, 0x0f,
+	0xe0, 0x08, 0x37, 0x54, 0x1f, 0x5d, 0xae, 0xb6, 0xcb, 0x8e, 0x07, 0xe5, 0xc0, 0xc9, 0x5d, 0xaf,
+	0x5e, 0xd5, 0xfb, 0xd5, 0xab, 0xf7, 0x55, 0xcf, 0x70, 0xd7, 0x8f, 0x9d, 0xf3, 0x80, 0x5c, 0x56,
+	0xcf, 0x77, 0xab, 0xed, 0xc4, 0x4f, 0x2a, 0x9d, 0x18, 0x13, 0x6c, 0x80, 0x20, 0x57, 0xce, 0x77,
+	0xcd, 0xb2, 0x8b, 0x93, 0x36, 0x4e, 0xaa, 0x0d, 0x27, 0x41, 0xd5, 0xf3, 0xdd, 0x06, 0x22, 0xce,
+	0x6e, 0xd5, 0xc5, 0x41, 0xc4, 0x79, 0xcd, 0x45, 0x1f, 0xfb, 0x98, 0x7d, 0x56, 0xe9, 0x97, 0xa0,
+	0xae, 0xf8, 0x18, 0xfb, 0x21, 0xaa, 0x3a, 0x9d, 0xa0, 0xea, 0x44, 0x11, 0x26, 0x0e, 0x09, 0x70,
+	0x24, 0xf6, 0x37, 0x97, 0x14, 0xb1, 0xe4, 0xb2, 0x83, 0x52, 0xfa, 0xb2, 0x58, 0xc5, 0x46, 0x8d,
+	0x6e, 0xb3, 0xea, 0x44, 0x97, 0xe9, 0x14, 0x87, 0x61, 0x73, 0x49, 0x7c, 0xc0, 0xa7, 0xac, 0x5f,
+	0xe7, 0x60, 0xf9, 0x34, 0xf1, 0xcf, 0x10, 0x79, 0x2f, 0x76, 0x5b, 0x28, 0x21, 0xb1, 0x43, 0x70,
+	0xbc, 0xef, 0x79, 0x31, 0x4a, 0x12, 0x63, 0x05, 0x8a, 0xe7, 0x4e, 0x18, 0x78, 0x94, 0x56, 0xca,
+	0xad, 0xe7, 0x1e, 0x16, 0xeb, 0x3d, 0x82, 0x61, 0xc1, 0x0c, 0x56, 0x16, 0x95, 0xf2, 0x8c, 0x21,
+	0x43, 0x33, 0xd6, 0x60, 0x1a, 0x91, 0x96, 0xed, 0xf0, 0x0d, 0x4b, 0x13, 0x8c, 0x05, 0x10, 0x69,
+	0xa5, 0x22, 0x36, 0x61, 0x96, 0x32, 0x24, 0x81, 0x1f, 0x39, 0xa4, 0x1b, 0xa3, 0x52, 0x81, 0xef,
+	0x82, 0x48, 0xeb, 0x2c, 0xa5, 0x59, 0xdf, 0x80, 0x3b, 0x87, 0x28, 0x44, 0xbe, 0x43, 0xd0, 0x3b,
+	0xe8, 0x32, 0xa1, 0x13, 0xa7, 0x89, 0x6f, 0x3c, 0x86, 0x05, 0x89, 0x46, 0x8a, 0xe0, 0x30, 0xe7,
+	0xe5, 0x44, 0x2a, 0x68, 0x19, 0xa6, 0xdc, 0x96, 0x13, 0x44, 0x76, 0xe0, 0x09, 0xa4, 0xb7, 0xd8,
+	0xf8, 0xc4, 0xb3, 0x36, 0x61, 0x63, 0xa8, 0x0e, 0xea, 0x28, 0xe9, 0xe0, 0x28, 0x41, 0xd6, 0xef,
+	0x73, 0x30, 0x7f, 0x9a, 0xf8, 0xcf, 0x9c, 0x30, 0x41, 0xa4, 0x86, 0xa3, 0x66, 0x10, 0xb7, 0x8d,
+	0x45, 0x98, 0x8c, 0x70, 0xe4, 0x22, 0x26, 0xb5, 0x50, 0xe7, 0x83, 0xeb, 0x51, 0xcc, 0x0a, 0x14,
+	0xfb, 0x95, 0xd2, 0x23, 0x18, 0x0f, 0x61, 0x1e, 0x9d, 0xb7, 0x6d, 0x7e, 0xa2, 0x4e, 0x8c, 0x9a,
+	0xc1, 0x45, 0x69, 0x92, 0x31, 0xdd, 0x46, 0xe7, 0xed, 0x1a, 0x25, 0x7f, 0x99, 0x51, 0x2d, 0x13,
+	0x4a, 0xfd, 0xb0, 0xe5, 0x99, 0x7e, 0x95, 0x87, 0x19, 0x76, 0xf2, 0xc8, 0x7b, 0x8a, 0x8f, 0x48,
+	0xcb, 0x58, 0x82, 0x9b, 0x09, 0x8a, 0x3c, 0x94, 0xde, 0xb6, 0x18, 0x51, 0xe5, 0x51, 0xb4, 0x1e,
+	0x4a, 0x48, 0xaa, 0x3c, 0x44, 0x5a, 0x87, 0x28, 0x21, 0xc6, 0x67, 0xe0, 0xa6, 0xd3, 0xc6, 0xdd,
+	0x88, 0xb0, 0x33, 0x4c, 0xef, 0x2d, 0x57, 0x84, 0x81, 0x51, 0xa3, 0xaf, 0x08, 0xa3, 0xaf, 0xd4,
+	0x70, 0x10, 0x1d, 0x14, 0x3e, 0xfa, 0x64, 0xed, 0x46, 0x5d, 0xb0, 0x1b, 0x9f, 0x07, 0x68, 0xc4,
+	0x81, 0xe7, 0x23, 0xbb, 0x89, 0xf8, 0x09, 0xc7, 0x58, 0x5c, 0xe4, 0x4b, 0x8e, 0x11, 0x32, 0x3e,
+	0x07, 0x45, 0x7e, 0x7c, 0xba, 0x7c, 0x72, 0xbc, 0xe5, 0xdc, 0x04, 0x8e, 0x91, 0x5e, 0x81, 0x37,
+	0xb5, 0x0a, 0x5c, 0x82, 0x45, 0x55, 0x47, 0x52, 0x79, 0x01, 0xcc, 0x9d, 0x26, 0x7e, 0x1d, 0x7d,
+	0xab, 0x8b, 0x12, 0x72, 0xe0, 0x10, 0x77, 0xb8, 0xfa, 0x16, 0x61, 0xd2, 0x43, 0x11, 0x6e, 0x0b,
+	0xdd, 0xf1, 0x81, 0x16, 0xc2, 0x84, 0x16, 0xc2, 0x32, 0xdc, 0xeb, 0x13, 0x25, 0x51, 0xfc, 0x2d,
+	0xc7, 0x60, 0x88, 0x9b, 0xe5, 0x30, 0xf4, 0x56, 0xb9, 0x05, 0xb7, 0x09, 0x7e, 0x8e, 0x22, 0xdb,
+	0xc5, 0x11, 0x89, 0x1d, 0x37, 0xbd, 0xc9, 0x59, 0x46, 0xad, 0x09, 0xa2, 0xb1, 0x0a, 0x90, 0x3a,
+	0x24, 0x8a, 0x05, 0x9e, 0xa2, 0xf0, 0x46, 0x34, 0xe8, 0xf4, 0x05, 0x8d, 0x6d, 0x67, 0x4c, 0x77,
+	0x72, 0x1c, 0xd3, 0xbd, 0x39, 0xe2, 0xd8, 0xea, 0xd1, 0xe4, 0xb1, 0xff, 0x9d, 0x83, 0x3b, 0xbd,
+	0xb9, 0x2f, 0x61, 0x3f, 0x70, 0x6b, 0x4e, 0x18, 0x1a, 0xdb, 0x30, 0x17, 0x44, 0xc2, 0xf7, 0x03,
+	0xcc, 0x9c, 0x9d, 0x5f, 0xc5, 0x6d, 0x95, 0x7c, 0xe2, 0x19, 0x3b, 0x60, 0x64, 0x18, 0xb9, 0xc2,
+	0xf2, 0x4c, 0x61, 0x0b, 0xea, 0xcc, 0xbb, 0x4c, 0x79, 0xff, 0x47, 0x5a, 0x59, 0x85, 0xfb, 0x9a,
+	0x93, 0x4b, 0xcd, 0xfc, 0x2b, 0xaf, 0xd8, 0x6b, 0x8d, 0xb9, 0x43, 0x2d, 0x74, 0x82, 0x36, 0x8b,
+	0x38, 0xe7, 0x28, 0x22, 0xb6, 0x6a, 0x1b, 0xc0, 0x48, 0xfc, 0x8c, 0x14, 0x02, 0x69, 0xd9, 0x8d,
+	0x10, 0xbb, 0xcf, 0xed, 0x16, 0x0a, 0xfc, 0x16, 0x11, 0x0a, 0xb9, 0x8d, 0x48, 0xeb, 0x80, 0x92,
+	0xdf, 0x66, 0x54, 0x8d, 0x29, 0x4d, 0xe8, 0x4c, 0xe9, 0x58, 0x86, 0x06, 0xa6, 0x8f, 0x83, 0x0a,
+	0xf5, 0xc1, 0xbf, 0x7f, 0xb2, 0xf6, 0xc0, 0x0f, 0x48, 0xab, 0xdb, 0xa8, 0xb8, 0xb8, 0x2d, 0xb2,
+	0x91, 0xf8, 0xd9, 0x49, 0xbc, 0xe7, 0x22, 0xa9, 0x9d, 0x44, 0x44, 0x46, 0x8a, 0x6d, 0x98, 0x43,
+	0xa4, 0x85, 0x62, 0xd4, 0x6d, 0xdb, 0xc2, 0xbf, 0xd2, 0x58, 0x27, 0xc8, 0x67, 0xdc, 0xcf, 0xb6,
+	0x61, 0x4e, 0xa4, 0xba, 0x18, 0xb9, 0x28, 0x38, 0x47, 0x71, 0xaa, 0x43, 0x4e, 0xae, 0x0b, 0xea,
+	0xc0, 0x7d, 0xdd, 0xd2, 0xdc, 0x97, 0xee, 0x46, 0xa6, 0xb4, 0x37, 0x52, 0x86, 0x15, 0x9d, 0xc6,
+	0xe5, 0x95, 0xfc, 0x90, 0x27, 0xd9, 0xa3, 0x0b, 0xe4, 0x76, 0x09, 0x3a, 0x69, 0xb8, 0xfb, 0x5d,
+	0x82, 0x8f, 0x71, 0xfc, 0xc2, 0x89, 0xbd, 0xc4, 0x78, 0x04, 0x0b, 0x4d, 0xf1, 0x6d, 0x13, 0x6c,
+	0xbb, 0x21, 0x72, 0x62, 0x71, 0x3b, 0x73, 0xe9, 0xc4, 0x53, 0x5c, 0xa3, 0x64, 0xc3, 0x84, 0x29,
+	0xc4, 0x76, 0x91, 0x59, 0x45, 0x8e, 0x5f, 0x23, 0x9c, 0xf0, 0x7c, 0xa7, 0x87, 0x23, 0x41, 0x5f,
+	0xf0, 0xb8, 0x42, 0x0f, 0xb2, 0x1f, 0xc4, 0x5e, 0x8c, 0x3b, 0x46, 0x09, 0x6e, 0xb9, 0x74, 0x2c,
+	0xe3, 0x5b, 0x3a, 0xa4, 0xee, 0xe1, 0x70, 0xa6, 0x34, 0xbd, 0x16, 0xea, 0x45, 0x41, 0x39, 0xf1,
+	0x68, 0x5c, 0x54, 0x72, 0x44, 0x41, 0x5e, 0xec, 0x22, 0x4c, 0x76, 0x62, 0x8c, 0x9b, 0xa5, 0xc2,
+	0xfa, 0x04, 0x8d, 0x8b, 0x6c, 0x90, 0xba, 0xbd, 0x22, 0x59, 0x82, 0xfa, 0x4f, 0x0e, 0x96, 0x4e,
+	0x13, 0x9f, 0xc5, 0x02, 0x19, 0x91, 0xaf, 0xdd, 0xbc, 0xd7, 0x60, 0xba, 0x41, 0x25, 0x88, 0xad,
+	0x38, 0x66, 0x60, 0xa4, 0x77, 0x87, 0x84, 0xd2, 0x82, 0xce, 0xfe, 0xfb, 0xad, 0x6c, 0x72, 0x4c,
+	0x2b, 0xd3, 0xfb, 0xfd, 0x3a, 0x94, 0xf5, 0x47, 0x97, 0xda, 0xf9, 0x43, 0x1e, 0xee, 0xd2, 0x8b,
+	0xad, 0xd7, 0xf6, 0x9e, 0x1c, 0xa2, 0x4e, 0x88, 0x2f, 0x91, 0x77, 0xed, 0xca, 0xd9, 0x80, 0x19,
+	0xe1, 0x63, 0x3c, 0xa5, 0x71, 0x13, 0x9b, 0xe6, 0xb4, 0x43, 0x4a, 0x1a, 0x57, 0x3d, 0x06, 0x14,
+	0x22, 0xa7, 0x9d, 0xc6, 0x42, 0xf6, 0xcd, 0x32, 0xe8, 0x65, 0xbb, 0x81, 0x43, 0xa1, 0x04, 0x31,
+	0xa2, 0x86, 0xef, 0x21, 0x37, 0x68, 0x3b, 0x61, 0xc2, 0x9c, 0xb5, 0x50, 0x97, 0xe3, 0x01, 0x35,
+	0x4f, 0x8d, 0xa9, 0xe6, 0xa2, 0x56, 0xcd, 0x6b, 0xb0, 0xaa, 0xd5, 0xa1, 0xd4, 0xf2, 0x07, 0x79,
+	0xe6, 0xcd, 0x32, 0xf2, 0x0a, 0x3f, 0xba, 0x7e, 0x4d, 0x6b, 0x72, 0x19, 0x55, 0xf6, 0xcc, 0x98,
+	0xb9, 0xac, 0x30, 0x2c, 0x97, 0x5d, 0xaf, 0x59, 0xf2, 0x60, 0xa2, 0xd7, 0x86, 0xd4, 0xd9, 0xf7,
+	0x27, 0xe0, 0xae, 0xac, 0x42, 0xbf, 0xd2, 0xf1, 0x9c, 0xf1, 0xf5, 0xb5, 0x01, 0x33, 0xe7, 0x6c,
+	0x59, 0x26, 0x45, 0x4f, 0x73, 0xda, 0x70, 0x95, 0x4e, 0x68, 0x55, 0xfa, 0x16, 0xdc, 0x6a, 0xa3,
+	0x76, 0x03, 0xc5, 0x09, 0x0b, 0x39, 0xd3, 0x7b, 0xf7, 0x2b, 0xbd, 0xe7, 0x5a, 0xe5, 0x80, 0xd5,
+	0x96, 0xcf, 0xd2, 0x97, 0x83, 0xa8, 0x19, 0xd3, 0x15, 0xc6, 0x19, 0xcc, 0xc6, 0x88, 0x06, 0x49,
+	0x5b, 0x04, 0xb3, 0xc9, 0xff, 0x29, 0xab, 0xcd, 0xf0, 0x4d, 0xf6, 0x79, 0x08, 0xdc, 0x00, 0x31,
+	0xb6, 0x99, 0x73, 0x08, 0x25, 0x4f, 0x73, 0xda, 0x53, 0x4a, 0xba, 0xe6, 0x64, 0xc5, 0xed, 0x7b,
+	0xf0, 0x26, 0xe4, 0x5d, 0x7d, 0x1b, 0x0c, 0x1a, 0x7e, 0x9d, 0xc8, 0x45, 0x61, 0xef, 0x65, 0x40,
+	0x7d, 0x3a, 0x76, 0xa2, 0xc4, 0x71, 0xd5, 0xba, 0xaa, 0x50, 0x9f, 0x55, 0xa8, 0x3c, 0xd2, 0x8b,
+	0x0c, 0x9d, 0xcf, 0x54, 0xc0, 0xe3, 0x27, 0xa7, 0x15, 0x30, 0x07, 0xc5, 0x4b, 0x70, 0x7f, 0xcc,
+	0x31, 0xf8, 0x67, 0xdd, 0x46, 0x3b, 0x20, 0x07, 0x8e, 0x27, 0xdf, 0x88, 0x47, 0xe7, 0x81, 0x87,
+	0xa8, 0x31, 0x1c, 0xc0, 0xad, 0xa4, 0xdb, 0xf8, 0x26, 0x72, 0x09, 0x43, 0x38, 0xbd, 0xb7, 0x58,
+	0xe1, 0x2f, 0xe3, 0x4a, 0xfa, 0x32, 0xae, 0xec, 0x47, 0x97, 0x07, 0xc6, 0x9f, 0x7e, 0xb7, 0x73,
+	0xfb, 0x28, 0x2d, 0x1f, 0x68, 0x15, 0xe7, 0xd5, 0xd3, 0x85, 0xd9, 0x52, 0x2d, 0xdf, 0x5f, 0xaa,
+	0xf5, 0xce, 0x38, 0x71, 0xe5, 0x19, 0x0b, 0xda, 0x33, 0x6e, 0xc3, 0xd6, 0xc8, 0x43, 0xc8, 0xe3,
+	0x9e, 0xc2, 0xbd, 0x23, 0xea, 0x0a, 0xf4, 0x6d, 0xda, 0x41, 0x99, 0xb7, 0x79, 0x89, 0x9a, 0x72,
+	0x92, 0x38, 0x3e, 0x4a, 0x93, 0xb1, 0x18, 0xd2, 0x99, 0xf4, 0x59, 0x29, 0xde, 0x6a, 0x62, 0x68,
+	0xd5, 0xe0, 0x2e, 0xdb, 0x2e, 0xf3, 0x1a, 0x7c, 0x07, 0x5d, 0x8e, 0xd8, 0x6c, 0x1e, 0x26, 0x9e,
+	0xa3, 0x4b, 0xb1, 0x11, 0xfd, 0xb4, 0x2e, 0x60, 0x81, 0x6d, 0xc2, 0x32, 0x51, 0x2d, 0x46, 0xd4,
+	0x82, 0x46, 0x6c, 0xd0, 0x97, 0x4c, 0xf9, 0x46, 0x6a, 0x32, 0x1d, 0xdf, 0x34, 0xbe, 0x0e, 0x8b,
+	0x8a, 0xe4, 0x71, 0xd0, 0x3f, 0x82, 0x05, 0x2e, 0xdc, 0xe5, 0xdc, 0x76, 0xef, 0x2c, 0x73, 0x8d,
+	0xec, 0x2e, 0xd6, 0x13, 0x28, 0xf5, 0x76, 0xef, 0x2b, 0x2e, 0x32, 0x2f, 0xaa, 0xa2, 0x78, 0x51,
+	0x59, 0x21, 0x00, 0x5b, 0xc1, 0x79, 0x86, 0xa3, 0x58, 0x05, 0x60, 0x85, 0x92, 0xdd, 0x72, 0x92,
+	0x56, 0x6a, 0x4f, 0x8c, 0xf2, 0xb6, 0x93, 0x30, 0xd7, 0x72, 0x08, 0x41, 0x09, 0xc9, 0x84, 0xf9,
+	0x62, 0x7d, 0x56, 0xa1, 0x9e, 0x78, 0xd6, 0x87, 0x39, 0x58, 0x16, 0x00, 0x35, 0x66, 0x7f, 0x85,
+	0x0e, 0x3c, 0x3b, 0xdb, 0x65, 0x91, 0x3a, 0xf0, 0x8e, 0x94, 0x46, 0x8b, 0xf1, 0x59, 0x58, 0x1e,
+	0xe0, 0xb5, 0x53, 0x77, 0xe2, 0xa8, 0x96, 0xfa, 0xd6, 0x9c, 0xf1, 0x59, 0xeb, 0x48, 0x98, 0xaa,
+	0xa6, 0xfa, 0x58, 0x84, 0x49, 0x1e, 0xdc, 0x84, 0xf6, 0xd8, 0xa0, 0xa7, 0xd3, 0xbc, 0xaa, 0xd3,
+	0x2a, 0xdc, 0x53, 0x4c, 0x34, 0x93, 0x2a, 0xf4, 0x97, 0xf0, 0xe7, 0x1c, 0x98, 0x6c, 0xc5, 0x69,
+	0x37, 0x24, 0x41, 0x12, 0xf8, 0x7c, 0x8d, 0x78, 0x2c, 0xd3, 0x24, 0x2a, 0xba, 0x0c, 0xb2, 0x18,
+	0x11, 0x0f, 0x42, 0x4e, 0x96, 0xd5, 0xc8, 0x83, 0x1e, 0x63, 0xb6, 0x4d, 0x34, 0x2b, 0x18, 0x79,
+	0xb3, 0x88, 0xda, 0x73, 0x5b, 0x48, 0xea, 0x5d, 0x15, 0xa4, 0xa4, 0x13, 0xaf, 0x07, 0xb3, 0xa0,
+	0xc0, 0x7c, 0x8d, 0x86, 0xcd, 0x2f, 0x72, 0x50, 0x66, 0x07, 0x7a, 0xaf, 0x4b, 0x7c, 0x1c, 0x44,
+	0xbd, 0xdc, 0xca, 0x83, 0x22, 0xf2, 0x8c, 0xb7, 0xc0, 0x0c, 0x29, 0xd1, 0x76, 0x9d, 0x30, 0xb4,
+	0xf5, 0x0f, 0xde, 0x7b, 0x61, 0xba, 0xec, 0x24, 0x5b, 0x2d, 0xec, 0xc3, 0xea, 0xb0, 0xc5, 0xea,
+	0x7d, 0x98, 0xda, 0xf5, 0xcc, 0x65, 0xad, 0xdf, 0xe4, 0x60, 0x89, 0xc7, 0x25, 0x69, 0x05, 0xa1,
+	0x93, 0xb4, 0x82, 0xc8, 0xa7, 0x45, 0x1d, 0x4d, 0x75, 0x02, 0x04, 0xfb, 0x1e, 0x1e, 0x90, 0x68,
+	0x59, 0x87, 0x9b, 0x4d, 0x6a, 0xc0, 0x89, 0xc8, 0xd8, 0x72, 0x4c, 0xdd, 0x22, 0xa1, 0xbb, 0xda,
+	0xcd, 0x98, 0xa7, 0x97, 0xb4, 0x8a, 0x64, 0xd4, 0x63, 0x41, 0x34, 0xca, 0x00, 0x04, 0xb7, 0x1b,
+	0x09, 0xc1, 0x11, 0xf2, 0x98, 0x4a, 0xa7, 0xea, 0x0a, 0xc5, 0xfa, 0x69, 0x6a, 0x1f, 0xaa, 0x45,
+	0x3d, 0x8d, 0x03, 0xdf, 0x47, 0x31, 0xf2, 0xb4, 0xf7, 0x92, 0xd3, 0xdd, 0x8b, 0xde, 0x5e, 0xe9,
+	0x09, 0x08, 0xdf, 0x8c, 0x9e, 0x80, 0xbe, 0x62, 0xe4, 0x98, 0xfa, 0x7d, 0x07, 0xbf, 0x40, 0xb1,
+	0xed, 0x05, 0xcd, 0x66, 0xda, 0xc3, 0x63, 0x94, 0xc3, 0xa0, 0xd9, 0xb4, 0x7e, 0x99, 0x3a, 0xb4,
+	0x8a, 0xec, 0x10, 0x35, 0x51, 0xfc, 0x7a, 0xc0, 0x54, 0x08, 0xf9, 0x91, 0x10, 0x26, 0xfa, 0x20,
+	0x50, 0x63, 0x8e, 0xd0, 0x05, 0x49, 0x8b, 0x26, 0x0e, 0x11, 0x28, 0x89, 0x17, 0x4c, 0x56, 0x08,
+	0x0b, 0x19, 0x5b, 0x7c, 0x7a, 0x71, 0x32, 0x2a, 0xd8, 0xdf, 0x81, 0x49, 0x72, 0xd1, 0x73, 0x9d,
+	0x02, 0xb9, 0x38, 0xd1, 0x9f, 0x44, 0x1f, 0xe0, 0x89, 0xb8, 0x2a, 0x19, 0x7d, 0x8f, 0x11, 0xaa,
+	0xe1, 0x30, 0x44, 0x2e, 0xcd, 0x31, 0xc3, 0xba, 0x6b, 0x6b, 0x30, 0x4d, 0xbf, 0xd2, 0xaa, 0x4c,
+	0x64, 0x18, 0x4a, 0x12, 0x35, 0xd6, 0x2a, 0x40, 0x13, 0x21, 0x5b, 0x79, 0x82, 0x16, 0xeb, 0xc5,
+	0x26, 0x42, 0x7c, 0xda, 0x7a, 0x3f, 0xbd, 0x87, 0x53, 0x14, 0x3f, 0x0f, 0x91, 0x78, 0x73, 0xa6,
+	0x99, 0x2d, 0xfb, 0xb4, 0xe5, 0x92, 0x95, 0xa7, 0xad, 0xbe, 0xb5, 0xc7, 0x22, 0x1e, 0x71, 0x42,
+	0x21, 0x8c, 0x0f, 0x58, 0xaf, 0xfb, 0xa2, 0x13, 0xc4, 0x97, 0x59, 0x7d, 0xcf, 0x70, 0xa2, 0xd4,
+	0xb8, 0x0e, 0x0c, 0x7b, 0x67, 0x5f, 0x09, 0x46, 0x79, 0xa0, 0xe7, 0xb3, 0x0f, 0xf4, 0xec, 0x0b,
+	0xbc, 0x98, 0xbe, 0xc0, 0xad, 0x67, 0x3a, 0x69, 0x47, 0x14, 0xcf, 0xd5, 0xd2, 0x4c, 0x98, 0x8a,
+	0x11, 0xe9, 0xc6, 0xd4, 0xef, 0x44, 0x33, 0x22, 0x1d, 0xef, 0xfd, 0x6c, 0x1e, 0x26, 0x68, 0x8b,
+	0xfe, 0x05, 0xcc, 0x66, 0x3b, 0xe6, 0x2b, 0x6a, 0xc1, 0xdd, 0xdf, 0x98, 0x36, 0xdf, 0x18, 0x35,
+	0x2b, 0xab, 0x22, 0xeb, 0x7b, 0x7f, 0xf9, 0xe7, 0x4f, 0xf2, 0x2b, 0x96, 0x59, 0x55, 0xfe, 0x0b,
+	0x11, 0x8f, 0x04, 0x91, 0xe8, 0x8d, 0x16, 0x14, 0x7b, 0xc5, 0x6b, 0xa9, 0x6f, 0x5b, 0x39, 0x63,
+	0xae, 0x0f, 0x9b, 0x91, 0xc2, 0xd6, 0x98, 0xb0, 0x65, 0xeb, 0x9e, 0x2a, 0x8c, 0xd9, 0x1b, 0xc1,
+	0x34, 0x51, 0x1a, 0x09, 0xcc, 0x64, 0x9a, 0xc0, 0xf7, 0xfb, 0xb6, 0x54, 0x27, 0xcd, 0xcd, 0x11,
+	0x93, 0x52, 0xe4, 0x06, 0x13, 0x79, 0xdf, 0x5a, 0x56, 0x45, 0xc6, 0x9c, 0xd3, 0x66, 0x65, 0x0b,
+	0x15, 0x9a, 0x69, 0xf9, 0xf6, 0x0b, 0x55, 0x27, 0xcd, 0xcd, 0x11, 0x93, 0xa3, 0x85, 0xa6, 0x65,
+	0x13, 0x17, 0xfa, 0x1d, 0x98, 0x1f, 0x68, 0xb8, 0xae, 0xe9, 0xf7, 0x96, 0x0c, 0xe6, 0xf6, 0x15,
+	0x0c, 0x12, 0xc0, 0x3a, 0x03, 0x60, 0x5a, 0xa5, 0x01, 0x00, 0x6d, 0x9b, 0xe5, 0x22, 0xe3, 0x07,
+	0x39, 0x58, 0x18, 0xec, 0x6b, 0xea, 0xaf, 0x50, 0xe1, 0x30, 0x1f, 0x5e, 0xc5, 0x21, 0x31, 0x3c,
+	0x64, 0x18, 0x2c, 0x6b, 0x5d, 0x77, 0xd9, 0xa2, 0xed, 0xc1, 0x5c, 0xca, 0xf8, 0x39, 0x4d, 0x81,
+	0xfa, 0x86, 0xde, 0x56, 0x9f, 0x38, 0x3d, 0x9b, 0xb9, 0x33, 0x16, 0x9b, 0x84, 0xb6, 0xc3, 0xa0,
+	0x6d, 0x5b, 0x5b, 0x2a, 0x34, 0xde, 0xfc, 0x43, 0x76, 0xd0, 0x70, 0x6d, 0xa7, 0x4b, 0xb0, 0x9d,
+	0x36, 0x0c, 0x8d, 0x1f, 0xe7, 0xe0, 0x8e, 0xae, 0x92, 0xb5, 0xfa, 0xa4, 0x6a, 0x78, 0xcc, 0x47,
+	0x57, 0xf3, 0x48, 0x58, 0x8f, 0x19, 0xac, 0x2d, 0x6b, 0x53, 0x85, 0xc5, 0x6b, 0x6e, 0xc5, 0x49,
+	0x84, 0xd2, 0xde, 0xcf, 0xc1, 0x82, 0x9a, 0xec, 0x38, 0xa4, 0x0d, 0xad, 0xd3, 0xab, 0xa5, 0x9f,
+	0xf9, 0xe6, 0x95, 0x2c, 0xa3, 0xaf, 0x50, 0x04, 0x87, 0x2e, 0x5f, 0x20, 0xd0, 0x7c, 0x90, 0x03,
+	0x43, 0x53, 0xad, 0xf6, 0xc3, 0x19, 0x64, 0x31, 0xdf, 0xbc, 0x92, 0x65, 0x34, 0x1c, 0x14, 0xbb,
+	0x7b, 0x4f, 0x6c, 0x4f, 0x2c, 0x50, 0x2c, 0x6a, 0x48, 0x53, 0xa9, 0xdf, 0xa2, 0xf4, 0x6c, 0xe6,
+	0xce, 0x58, 0x6c, 0xa3, 0x2d, 0x4a, 0x29, 0x0d, 0x85, 0x71, 0xa5, 0xf8, 0x3e, 0xcc, 0xc1, 0xd2,
+	0x90, 0xff, 0x89, 0xb7, 0x06, 0x1c, 0x4c, 0xc7, 0x66, 0xee, 0x8c, 0xc5, 0x26, 0xf1, 0x7d, 0x8a,
+	0xe1, 0x7b, 0x60, 0xbd, 0x91, 0x75, 0x46, 0x62, 0xab, 0x9d, 0x8f, 0xf4, 0x1f, 0x54, 0xe3, 0xbb,
+	0x39, 0x98, 0xeb, 0x6f, 0x5a, 0x94, 0xfb, 0x63, 0x4f, 0x76, 0xde, 0x7c, 0x30, 0x7a, 0x5e, 0x22,
+	0x79, 0xc0, 0x90, 0xac, 0x5b, 0xe5, 0x4c, 0x68, 0x62, 0xcc, 0xaa, 0x95, 0x1b, 0xbf, 0xcd, 0x81,
+	0x39, 0xa2, 0x35, 0xd1, 0x6f, 0x36, 0xc3, 0x59, 0xcd, 0xdd, 0xb1, 0x59, 0x25, 0xc8, 0x5d, 0x06,
+	0xf2, 0xb1, 0xf5, 0x66, 0x46, 0x5d, 0x6c, 0x9d, 0x4d, 0x1f, 0x75, 0xbd, 0x07, 0x1d, 0x4a, 0x01,
+	0xd1, 0x2c, 0xa2, 0x36, 0xf8, 0x07, 0xb2, 0x88, 0x32, 0x69, 0x6e, 0x8e, 0x98, 0xbc, 0x22, 0x8b,
+	0x50, 0x4e, 0x5b, 0x14, 0x0e, 0x07, 0x5f, 0xfd, 0xe8, 0x65, 0x39, 0xf7, 0xf1, 0xcb, 0x72, 0xee,
+	0x1f, 0x2f, 0xcb, 0xb9, 0x1f, 0xbd, 0x2a, 0xdf, 0xf8, 0xf8, 0x55, 0xf9, 0xc6, 0x5f, 0x5f, 0x95,
+	0x6f, 0x7c, 0xed, 0x0b, 0x4a, 0x07, 0xed, 0x8b, 0x7c, 0xf9, 0x0e, 0xef, 0xc9, 0xf5, 0x0f, 0xdb,
+	0xd8, 0xeb, 0x86, 0xa8, 0x7a, 0x21, 0xa5, 0xb0, 0xf6, 0x5a, 0xe3, 0x26, 0x6b, 0xf1, 0x7c, 0xfa,
+	0xbf, 0x03, 0x00, 0x7a, 0xb8, 0x3c, 0x85, 0x9b, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2585,6 +3272,7 @@ type MsgClient interface {
 	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	ClaimAirdrop(ctx context.Context, in *MsgClaimAirdrop, opts ...grpc.CallOption) (*MsgClaimAirdropResponse, error)
 }
 
 type msgClient struct {
@@ -2721,6 +3409,15 @@ func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmi
 	return out, nil
 }
 
+func (c *msgClient) ClaimAirdrop(ctx context.Context, in *MsgClaimAirdrop, opts ...grpc.CallOption) (*MsgClaimAirdropResponse, error) {
+	out := new(MsgClaimAirdropResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/ClaimAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
@@ -2737,6 +3434,7 @@ type MsgServer interface {
 	SetOrchestratorAddress(context.Context, *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	ClaimAirdrop(context.Context, *MsgClaimAirdrop) (*MsgClaimAirdropResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2785,6 +3483,9 @@ func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCanc
 func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
 }
+func (*UnimplementedMsgServer) ClaimAirdrop(ctx context.Context, req *MsgClaimAirdrop) (*MsgClaimAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAirdrop not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -3042,6 +3743,24 @@ func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/ClaimAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAirdrop(ctx, req.(*MsgClaimAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gravity.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -3102,6 +3821,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitBadSignatureEvidence",
 			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
 		},
+		{
+			MethodName: "ClaimAirdrop",
+			Handler:    _Msg_ClaimAirdrop_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -3127,6 +3850,13 @@ func (m *MsgSetOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.EthAddress) > 0 {
 		i -= len(m.EthAddress)
 		copy(dAtA[i:], m.EthAddress)
@@ -3151,7 +3881,7 @@ func (m *MsgSetOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetOrchestratorAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3161,20 +3891,34 @@ func (m *MsgSetOrchestratorAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetOrchestratorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetOrchestratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetOrchestratorAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3184,17 +3928,47 @@ func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgValsetConfirm) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetOrchestratorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetOrchestratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgValsetConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
@@ -3266,6 +4040,13 @@ func (m *MsgSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size, err := m.ChainFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3356,6 +4137,13 @@ func (m *MsgRequestBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -3416,6 +4204,13 @@ func (m *MsgConfirmBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -3495,6 +4290,13 @@ func (m *MsgConfirmLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -3574,6 +4376,13 @@ func (m *MsgSendToCosmosClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -3668,6 +4477,13 @@ func (m *MsgExecuteIbcAutoForwards) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Executor) > 0 {
 		i -= len(m.Executor)
 		copy(dAtA[i:], m.Executor)
@@ -3706,6 +4522,78 @@ func (m *MsgExecuteIbcAutoForwardsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClaimAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Amount != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AirdropId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgBatchSendToEthClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3726,6 +4614,13 @@ func (m *MsgBatchSendToEthClaim) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -3801,6 +4696,13 @@ func (m *MsgERC20DeployedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -3897,6 +4799,13 @@ func (m *MsgLogicCallExecutedClaim) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -3972,6 +4881,13 @@ func (m *MsgValsetUpdatedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -4071,6 +4987,13 @@ func (m *MsgCancelSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -4129,6 +5052,13 @@ func (m *MsgSubmitBadSignatureEvidence) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -4275,6 +5205,13 @@ func (m *EventBatchCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BatchNonce) > 0 {
 		i -= len(m.BatchNonce)
 		copy(dAtA[i:], m.BatchNonce)
@@ -4534,6 +5471,13 @@ func (m *EventMultisigUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Nonce) > 0 {
 		i -= len(m.Nonce)
 		copy(dAtA[i:], m.Nonce)
@@ -4622,6 +5566,28 @@ func (m *EventSignatureSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SlashFraction) > 0 {
+		i -= len(m.SlashFraction)
+		copy(dAtA[i:], m.SlashFraction)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SlashFraction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Offences != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Offences))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -4639,6 +5605,112 @@ func (m *EventSignatureSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventValsetUpdateTriggered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValsetUpdateTriggered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValsetUpdateTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PowerDiff) > 0 {
+		i -= len(m.PowerDiff)
+		copy(dAtA[i:], m.PowerDiff)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerDiff)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Triggers[iNdEx])
+			copy(dAtA[i:], m.Triggers[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Triggers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValsetUpdateDeferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValsetUpdateDeferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValsetUpdateDeferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextHeight) > 0 {
+		i -= len(m.NextHeight)
+		copy(dAtA[i:], m.NextHeight)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.NextHeight)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PowerDiff) > 0 {
+		i -= len(m.PowerDiff)
+		copy(dAtA[i:], m.PowerDiff)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.PowerDiff)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Triggers[iNdEx])
+			copy(dAtA[i:], m.Triggers[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.Triggers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventOutgoingTxId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4659,6 +5731,13 @@ func (m *EventOutgoingTxId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.TxId) > 0 {
 		i -= len(m.TxId)
 		copy(dAtA[i:], m.TxId)
@@ -4720,42 +5799,195 @@ func (m *EventSendToEthFeeCollected) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventMerkleAirdropCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetOrchestratorAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventMerkleAirdropCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMerkleAirdropCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.ExpiryHeight) > 0 {
+		i -= len(m.ExpiryHeight)
+		copy(dAtA[i:], m.ExpiryHeight)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ExpiryHeight)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetOrchestratorAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventMerkleAirdropClaimed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMerkleAirdropClaimed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMerkleAirdropClaimed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMerkleAirdropExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMerkleAirdropExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMerkleAirdropExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Returned) > 0 {
+		i -= len(m.Returned)
+		copy(dAtA[i:], m.Returned)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Returned)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AirdropId) > 0 {
+		i -= len(m.AirdropId)
+		copy(dAtA[i:], m.AirdropId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.AirdropId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetOrchestratorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysSignMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOrchestratorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
@@ -4782,6 +6014,10 @@ func (m *MsgValsetConfirm) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -4814,6 +6050,10 @@ func (m *MsgSendToEth) Size() (n int) {
 	n += 1 + l + sovMsgs(uint64(l))
 	l = m.ChainFee.Size()
 	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -4840,6 +6080,10 @@ func (m *MsgRequestBatch) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -4877,6 +6121,10 @@ func (m *MsgConfirmBatch) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -4914,6 +6162,10 @@ func (m *MsgConfirmLogicCall) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -4956,6 +6208,10 @@ func (m *MsgSendToCosmosClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -4981,6 +6237,10 @@ func (m *MsgExecuteIbcAutoForwards) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -4993,6 +6253,40 @@ func (m *MsgExecuteIbcAutoForwardsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.AirdropId != 0 {
+		n += 1 + sovMsgs(uint64(m.AirdropId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovMsgs(uint64(m.Amount))
+	}
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgBatchSendToEthClaim) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5016,6 +6310,10 @@ func (m *MsgBatchSendToEthClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5063,6 +6361,10 @@ func (m *MsgERC20DeployedClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5098,6 +6400,10 @@ func (m *MsgLogicCallExecutedClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5141,6 +6447,10 @@ func (m *MsgValsetUpdatedClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5166,6 +6476,10 @@ func (m *MsgCancelSendToEth) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5196,6 +6510,10 @@ func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5256,6 +6574,10 @@ func (m *EventBatchCreated) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5383,6 +6705,10 @@ func (m *EventMultisigUpdateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5417,6 +6743,70 @@ func (m *EventSignatureSlashing) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	if m.Offences != 0 {
+		n += 1 + sovMsgs(uint64(m.Offences))
+	}
+	l = len(m.SlashFraction)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventValsetUpdateTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Triggers) > 0 {
+		for _, s := range m.Triggers {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.PowerDiff)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *EventValsetUpdateDeferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Triggers) > 0 {
+		for _, s := range m.Triggers {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = len(m.PowerDiff)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.NextHeight)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5434,6 +6824,10 @@ func (m *EventOutgoingTxId) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5458,18 +6852,81 @@ func (m *EventSendToEthFeeCollected) Size() (n int) {
 	return n
 }
 
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EventMerkleAirdropCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ExpiryHeight)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
 }
-func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *EventMerkleAirdropClaimed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMerkleAirdropExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AirdropId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Returned)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowMsgs
@@ -5589,6 +7046,152 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5804,6 +7407,38 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 			}
 			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -6067,6 +7702,38 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -6231,20 +7898,52 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
-			iNdEx += skippy
-		}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -6478,6 +8177,38 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 			}
 			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -6725,6 +8456,38 @@ func (m *MsgConfirmLogicCall) Unmarshal(dAtA []byte) error {
 			}
 			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -7025,6 +8788,38 @@ func (m *MsgSendToCosmosClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -7176,6 +8971,38 @@ func (m *MsgExecuteIbcAutoForwards) Unmarshal(dAtA []byte) error {
 			}
 			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -7247,7 +9074,7 @@ func (m *MsgExecuteIbcAutoForwardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAirdrop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7270,17 +9097,17 @@ func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBatchSendToEthClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAirdrop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBatchSendToEthClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
-			m.EventNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7290,16 +9117,29 @@ func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
-			m.EthBlockHeight = 0
+			m.AirdropId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7309,16 +9149,16 @@ func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				m.AirdropId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.BatchNonce = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7328,46 +9168,14 @@ func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7395,7 +9203,7 @@ func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7418,7 +9226,7 @@ func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBatchSendToEthClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimAirdropResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7441,10 +9249,10 @@ func (m *MsgBatchSendToEthClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBatchSendToEthClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimAirdropResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBatchSendToEthClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7468,7 +9276,7 @@ func (m *MsgBatchSendToEthClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7491,10 +9299,10 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchSendToEthClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchSendToEthClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7536,10 +9344,10 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
 			}
-			var stringLen uint64
+			m.BatchNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7549,24 +9357,11 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BatchNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
@@ -7601,7 +9396,7 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7629,62 +9424,11 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7712,7 +9456,7 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7735,7 +9479,7 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchSendToEthClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7758,10 +9502,10 @@ func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchSendToEthClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchSendToEthClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7785,7 +9529,7 @@ func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7808,10 +9552,10 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLogicCallExecutedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgERC20DeployedClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLogicCallExecutedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgERC20DeployedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7854,9 +9598,9 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7866,31 +9610,29 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationId == nil {
-				m.InvalidationId = []byte{}
-			}
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			m.InvalidationNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7900,12 +9642,108 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
@@ -7937,6 +9775,38 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -7958,7 +9828,7 @@ func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLogicCallExecutedClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7981,10 +9851,10 @@ func (m *MsgLogicCallExecutedClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLogicCallExecutedClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLogicCallExecutedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8008,7 +9878,7 @@ func (m *MsgLogicCallExecutedClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8031,10 +9901,10 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLogicCallExecutedClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLogicCallExecutedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8057,25 +9927,6 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
-			}
-			m.ValsetNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValsetNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
 			}
@@ -8094,11 +9945,11 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8108,29 +9959,1664 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLogicCallExecutedClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLogicCallExecutedClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLogicCallExecutedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValsetUpdatedClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			}
+			m.ValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, BridgeValidator{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValsetUpdatedClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			}
+			m.TransactionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSendToEthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Subject == nil {
+				m.Subject = &types1.Any{}
+			}
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetOperatorAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetOperatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValsetConfirmKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValsetConfirmKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchNonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchConfirmKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchConfirmKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchConfirmKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirmKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirmKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchSendToEthClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchSendToEthClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, BridgeValidator{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBadSignatureEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8158,13 +11644,11 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8192,11 +11676,11 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			m.BadEthSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignatureSubject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8224,7 +11708,7 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			m.BadEthSignatureSubject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8247,57 +11731,7 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetUpdatedClaimResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
+func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8320,17 +11754,17 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEth: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventERC20DeployedClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventERC20DeployedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			m.TransactionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8340,14 +11774,27 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TransactionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8375,7 +11822,7 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8398,7 +11845,7 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
+func (m *EventValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8421,12 +11868,44 @@ func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventValsetUpdatedClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -8448,7 +11927,7 @@ func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8471,17 +11950,17 @@ func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMultisigUpdateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMultisigUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8491,31 +11970,27 @@ func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Subject == nil {
-				m.Subject = &types1.Any{}
-			}
-			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8543,11 +12018,11 @@ func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8575,111 +12050,11 @@ func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetOperatorAddress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetOperatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MultisigId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8707,11 +12082,11 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8739,7 +12114,7 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8762,7 +12137,7 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
+func (m *EventOutgoingLogicCallCanceled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8785,15 +12160,15 @@ func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventValsetConfirmKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOutgoingLogicCallCanceled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValsetConfirmKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOutgoingLogicCallCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallInvalidationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8821,11 +12196,11 @@ func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.LogicCallInvalidationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallInvalidationNonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8853,7 +12228,7 @@ func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.LogicCallInvalidationNonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8876,7 +12251,7 @@ func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
+func (m *EventSignatureSlashing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8899,15 +12274,15 @@ func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSignatureSlashing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSignatureSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8935,11 +12310,11 @@ func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8967,61 +12342,30 @@ func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchNonce = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventBatchConfirmKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offences", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Offences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchConfirmKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchConfirmKey: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9049,13 +12393,13 @@ func (m *EventBatchConfirmKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.SlashFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirmKey", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -9065,24 +12409,12 @@ func (m *EventBatchConfirmKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BatchConfirmKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Tombstoned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -9104,7 +12436,7 @@ func (m *EventBatchConfirmKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
+func (m *EventValsetUpdateTriggered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9127,15 +12459,15 @@ func (m *EventBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchSendToEthClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventValsetUpdateTriggered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchSendToEthClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventValsetUpdateTriggered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9163,61 +12495,11 @@ func (m *EventBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventClaim) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9245,11 +12527,11 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9277,11 +12559,11 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			m.Triggers = append(m.Triggers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerDiff", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9309,7 +12591,7 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationId = string(dAtA[iNdEx:postIndex])
+			m.PowerDiff = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9332,7 +12614,7 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+func (m *EventValsetUpdateDeferred) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9355,15 +12637,15 @@ func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBadSignatureEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventValsetUpdateDeferred: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventValsetUpdateDeferred: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9391,11 +12673,11 @@ func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9423,11 +12705,11 @@ func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BadEthSignature = string(dAtA[iNdEx:postIndex])
+			m.Triggers = append(m.Triggers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignatureSubject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerDiff", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9455,7 +12737,39 @@ func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BadEthSignatureSubject = string(dAtA[iNdEx:postIndex])
+			m.PowerDiff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9478,7 +12792,7 @@ func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
+func (m *EventOutgoingTxId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9501,15 +12815,15 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventERC20DeployedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOutgoingTxId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventERC20DeployedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOutgoingTxId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9537,11 +12851,11 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9569,61 +12883,11 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.TxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventValsetUpdatedClaim: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9651,7 +12915,7 @@ func (m *EventValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9674,7 +12938,7 @@ func (m *EventValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *EventSendToEthFeeCollected) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9697,15 +12961,15 @@ func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMultisigUpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendToEthFeeCollected: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMultisigUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendToEthFeeCollected: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9733,11 +12997,11 @@ func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9765,43 +13029,11 @@ func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			m.SendAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MultisigId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9829,7 +13061,7 @@ func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.FeeAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9852,7 +13084,7 @@ func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOutgoingLogicCallCanceled) Unmarshal(dAtA []byte) error {
+func (m *EventMerkleAirdropCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9875,15 +13107,15 @@ func (m *EventOutgoingLogicCallCanceled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutgoingLogicCallCanceled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMerkleAirdropCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutgoingLogicCallCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMerkleAirdropCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallInvalidationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9911,11 +13143,11 @@ func (m *EventOutgoingLogicCallCanceled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCallInvalidationId = string(dAtA[iNdEx:postIndex])
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallInvalidationNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9943,61 +13175,11 @@ func (m *EventOutgoingLogicCallCanceled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCallInvalidationNonce = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSignatureSlashing) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSignatureSlashing: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSignatureSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10025,11 +13207,11 @@ func (m *EventSignatureSlashing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Total = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10057,7 +13239,7 @@ func (m *EventSignatureSlashing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ExpiryHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10080,7 +13262,7 @@ func (m *EventSignatureSlashing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOutgoingTxId) Unmarshal(dAtA []byte) error {
+func (m *EventMerkleAirdropClaimed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10103,15 +13285,15 @@ func (m *EventOutgoingTxId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutgoingTxId: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMerkleAirdropClaimed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutgoingTxId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMerkleAirdropClaimed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10139,11 +13321,11 @@ func (m *EventOutgoingTxId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10171,7 +13353,39 @@ func (m *EventOutgoingTxId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxId = string(dAtA[iNdEx:postIndex])
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10194,7 +13408,7 @@ func (m *EventOutgoingTxId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSendToEthFeeCollected) Unmarshal(dAtA []byte) error {
+func (m *EventMerkleAirdropExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10217,15 +13431,15 @@ func (m *EventSendToEthFeeCollected) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSendToEthFeeCollected: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMerkleAirdropExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSendToEthFeeCollected: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMerkleAirdropExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10253,43 +13467,11 @@ func (m *EventSendToEthFeeCollected) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.AirdropId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SendAmount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10317,7 +13499,7 @@ func (m *EventSendToEthFeeCollected) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeAmount = string(dAtA[iNdEx:postIndex])
+			m.Returned = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
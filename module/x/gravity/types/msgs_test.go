@@ -59,7 +59,7 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 			println(fmt.Sprintf("Spec is %v", msg))
 			ethAddr, err := NewEthAddress(spec.srcETHAddr)
 			assert.NoError(t, err)
-			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, *ethAddr)
+			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, *ethAddr, make([]byte, 65))
 			// when
 			err = msg.ValidateBasic()
 			if spec.expErr {
@@ -70,6 +70,11 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 		})
 	}
 
+	// the eth signature is required
+	ethAddr, err := NewEthAddress(ethAddress)
+	assert.NoError(t, err)
+	assert.Error(t, NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddr, nil).ValidateBasic())
+	assert.NoError(t, NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddr, nil).ValidateKeys())
 }
 
 // Gets the ClaimHash() output from every claims member and casts it to a string, panicing on any errors
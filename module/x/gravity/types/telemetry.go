@@ -0,0 +1,75 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keys of the metrics reported through the node telemetry, every key is prefixed with the module name. The pool gauges
+// are reported every keeper.PoolMetricsInterval blocks and set to zero as soon as the pool of a token is emptied
+const (
+	// MetricKeyPoolTxs gauges the number of transfers waiting in the pool of a token
+	MetricKeyPoolTxs = "pool_txs"
+	// MetricKeyPoolValue gauges the amount of a token waiting in its pool, fees excluded
+	MetricKeyPoolValue = "pool_value"
+	// MetricKeyPoolTxsAdded counts the transfers added to the pool of a token
+	MetricKeyPoolTxsAdded = "pool_txs_added"
+	// MetricKeyPoolTxsRefunded counts the transfers cancelled by their sender and refunded from the pool of a token
+	MetricKeyPoolTxsRefunded = "pool_txs_refunded"
+
+	// MetricKeyBatchesCreated counts the batches created for a token
+	MetricKeyBatchesCreated = "batches_created"
+	// MetricKeyBatchesExecuted counts the batches of a token observed executed on the EVM chain
+	MetricKeyBatchesExecuted = "batches_executed"
+	// MetricKeyBatchesTimedOut counts the batches of a token cancelled after their timeout passed on the EVM chain
+	MetricKeyBatchesTimedOut = "batches_timed_out"
+
+	// MetricKeyAttestationVotes counts the claims validators vote with, by claim type
+	MetricKeyAttestationVotes = "attestation_votes"
+	// MetricKeyAttestationsObserved counts the attestations which passed the voting power threshold, by claim type
+	MetricKeyAttestationsObserved = "attestations_observed"
+	// MetricKeyLastObservedEventNonce gauges the nonce of the last observed event
+	MetricKeyLastObservedEventNonce = "last_observed_event_nonce"
+
+	// MetricKeySlashes counts the validators punished for missing signatures or signing unknown checkpoints, by
+	// slashing type
+	MetricKeySlashes = "slashes"
+
+	// MetricKeyIbcForwardsQueued counts the deposits queued for an IBC auto forward
+	MetricKeyIbcForwardsQueued = "ibc_forwards_queued"
+	// MetricKeyIbcForwardsExecuted counts the IBC auto forwards sent over IBC
+	MetricKeyIbcForwardsExecuted = "ibc_forwards_executed"
+	// MetricKeyIbcForwardsFailed counts the IBC auto forwards which could not be sent, leaving the funds with the
+	// local account of the receiver
+	MetricKeyIbcForwardsFailed = "ibc_forwards_failed"
+
+	MetricLabelEvmChain      = "evm_chain"
+	MetricLabelTokenContract = "token_contract"
+	MetricLabelClaimType     = "claim_type"
+	MetricLabelSlashingType  = "slashing_type"
+	MetricLabelChannel       = "channel"
+)
+
+// MetricKeys returns the full key of a gravity metric
+func MetricKeys(key string) []string {
+	return []string{ModuleName, key}
+}
+
+// EvmChainMetricLabels returns the labels of a metric of an EVM chain, followed by the given labels
+func EvmChainMetricLabels(evmChainPrefix string, labels ...metrics.Label) []metrics.Label {
+	return append([]metrics.Label{telemetry.NewLabel(MetricLabelEvmChain, evmChainPrefix)}, labels...)
+}
+
+// TokenMetricLabel returns the label of a metric of a token
+func TokenMetricLabel(contract EthAddress) metrics.Label {
+	return telemetry.NewLabel(MetricLabelTokenContract, contract.GetAddress().Hex())
+}
+
+// MetricValue converts an amount to the float32 metrics hold, large amounts lose precision but keep their magnitude
+func MetricValue(amount sdk.Int) float32 {
+	value, _ := new(big.Float).SetInt(amount.BigInt()).Float32()
+	return value
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// BatchBaseGas is a rough estimate of the gas Gravity.sol spends on submitBatch besides the transfers, mostly
+	// checking the signatures of the current valset
+	BatchBaseGas uint64 = 200000
+
+	// DefaultGasPerTransfer is the gas estimated for a transfer of a token without TokenGasParams, a plain ERC20
+	// transfer to an address which already holds the token
+	DefaultGasPerTransfer uint64 = 50000
+)
+
+// ValidateBasic performs stateless checks on the TokenGasParams of a token
+func (t TokenGasParams) ValidateBasic() error {
+	if err := ValidateEvmChainPrefix(t.EvmChainPrefix); err != nil {
+		return sdkerrors.Wrap(err, "invalid evm chain prefix")
+	}
+	if err := ValidateEthAddress(t.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "invalid token contract")
+	}
+	if t.GasPerTransfer == 0 {
+		return sdkerrors.Wrapf(ErrInvalid, "gas per transfer of %s must be non-zero", t.TokenContract)
+	}
+	if t.MaxBatchGas != 0 && t.MaxBatchGas < EstimateBatchGas(t.GasPerTransfer, 1) {
+		return sdkerrors.Wrapf(ErrInvalid, "max batch gas of %s does not fit a single transfer", t.TokenContract)
+	}
+	return nil
+}
+
+// MaxBatchTransfers returns the most transfers a batch of the token fits within its gas budget, limited to
+// maxElements
+func (t TokenGasParams) MaxBatchTransfers(maxElements uint) uint {
+	if t.MaxBatchGas == 0 {
+		return maxElements
+	}
+	fit := uint((t.MaxBatchGas - BatchBaseGas) / t.GasPerTransfer)
+	if fit < maxElements {
+		return fit
+	}
+	return maxElements
+}
+
+// EstimateBatchGas returns the estimated gas of a batch of txCount transfers costing gasPerTransfer each
+func EstimateBatchGas(gasPerTransfer uint64, txCount uint64) uint64 {
+	return BatchBaseGas + gasPerTransfer*txCount
+}
+
+// TokenGasParamsFor returns the gas params of tokenContract on the EVM chain evmChainPrefix, tokens without params
+// get the default gas per transfer and no gas budget
+func (p Params) TokenGasParamsFor(evmChainPrefix string, tokenContract EthAddress) TokenGasParams {
+	for _, t := range p.TokenGasParams {
+		if t.EvmChainPrefix != evmChainPrefix {
+			continue
+		}
+		// the token contracts have been validated
+		if contract, err := NewEthAddress(t.TokenContract); err == nil && contract.GetAddress() == tokenContract.GetAddress() {
+			return t
+		}
+	}
+	return TokenGasParams{
+		EvmChainPrefix: evmChainPrefix,
+		TokenContract:  tokenContract.GetAddress().Hex(),
+		GasPerTransfer: DefaultGasPerTransfer,
+		MaxBatchGas:    0,
+	}
+}
+
+func validateTokenGasParams(i interface{}) error {
+	tokens, ok := i.([]TokenGasParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(tokens))
+	for _, t := range tokens {
+		if err := t.ValidateBasic(); err != nil {
+			return err
+		}
+		contract, err := NewEthAddress(t.TokenContract)
+		if err != nil {
+			return err
+		}
+		key := t.EvmChainPrefix + "/" + contract.GetAddress().Hex()
+		if seen[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "token %s of evm chain %s is listed twice", t.TokenContract, t.EvmChainPrefix)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const tokenGasTestContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+
+func TestTokenGasParamsValidate(t *testing.T) {
+	valid := TokenGasParams{EvmChainPrefix: "gravity", TokenContract: tokenGasTestContract, GasPerTransfer: 60000, MaxBatchGas: 0}
+	require.NoError(t, validateTokenGasParams([]TokenGasParams{valid}))
+
+	noGas := valid
+	noGas.GasPerTransfer = 0
+	require.Error(t, validateTokenGasParams([]TokenGasParams{noGas}))
+
+	tooSmall := valid
+	tooSmall.MaxBatchGas = BatchBaseGas + valid.GasPerTransfer - 1
+	require.Error(t, validateTokenGasParams([]TokenGasParams{tooSmall}))
+
+	badContract := valid
+	badContract.TokenContract = "0x01"
+	require.Error(t, validateTokenGasParams([]TokenGasParams{badContract}))
+
+	// the same token listed twice, differing only by address case
+	lowered := valid
+	lowered.TokenContract = "0x429881672b9ae42b8eba0e26cd9c73711b891ca5"
+	require.Error(t, validateTokenGasParams([]TokenGasParams{valid, lowered}))
+
+	otherChain := valid
+	otherChain.EvmChainPrefix = "other"
+	require.NoError(t, validateTokenGasParams([]TokenGasParams{valid, otherChain}))
+}
+
+func TestTokenGasParamsFor(t *testing.T) {
+	contract, err := NewEthAddress(tokenGasTestContract)
+	require.NoError(t, err)
+	params := DefaultParams()
+	params.TokenGasParams = []TokenGasParams{{EvmChainPrefix: "gravity", TokenContract: tokenGasTestContract, GasPerTransfer: 60000, MaxBatchGas: BatchBaseGas + 150000}}
+
+	gasParams := params.TokenGasParamsFor("gravity", *contract)
+	require.Equal(t, uint64(60000), gasParams.GasPerTransfer)
+	require.Equal(t, uint(2), gasParams.MaxBatchTransfers(100))
+	require.Equal(t, uint(1), gasParams.MaxBatchTransfers(1))
+
+	// tokens without params have no budget
+	gasParams = params.TokenGasParamsFor("other", *contract)
+	require.Equal(t, DefaultGasPerTransfer, gasParams.GasPerTransfer)
+	require.Equal(t, uint(100), gasParams.MaxBatchTransfers(100))
+	require.Equal(t, BatchBaseGas+3*DefaultGasPerTransfer, EstimateBatchGas(gasParams.GasPerTransfer, 3))
+}
@@ -27,6 +27,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BridgeDuty is a kind of signature or claim validators are expected to submit for the bridge
+type BridgeDuty int32
+
+const (
+	// An unspecified duty
+	BRIDGE_DUTY_UNSPECIFIED BridgeDuty = 0
+	// Signing a validator set update
+	BRIDGE_DUTY_VALSET BridgeDuty = 1
+	// Signing a transaction batch
+	BRIDGE_DUTY_BATCH BridgeDuty = 2
+	// Signing a logic call
+	BRIDGE_DUTY_LOGIC_CALL BridgeDuty = 3
+	// Voting on an Ethereum event which was observed
+	BRIDGE_DUTY_CLAIM BridgeDuty = 4
+)
+
+var BridgeDuty_name = map[int32]string{
+	0: "BRIDGE_DUTY_UNSPECIFIED",
+	1: "BRIDGE_DUTY_VALSET",
+	2: "BRIDGE_DUTY_BATCH",
+	3: "BRIDGE_DUTY_LOGIC_CALL",
+	4: "BRIDGE_DUTY_CLAIM",
+}
+
+var BridgeDuty_value = map[string]int32{
+	"BRIDGE_DUTY_UNSPECIFIED": 0,
+	"BRIDGE_DUTY_VALSET":      1,
+	"BRIDGE_DUTY_BATCH":       2,
+	"BRIDGE_DUTY_LOGIC_CALL":  3,
+	"BRIDGE_DUTY_CLAIM":       4,
+}
+
+func (x BridgeDuty) String() string {
+	return proto.EnumName(BridgeDuty_name, int32(x))
+}
+
+func (BridgeDuty) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{0}
+}
+
 // BridgeValidator represents a validator's ETH address and its power
 type BridgeValidator struct {
 	Power           uint64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
@@ -269,11 +309,13 @@ func (m *ERC20ToDenom) GetDenom() string {
 // to the nonce provided in target_nonce if and only if those events have not yet been observed (executed on the Cosmos chain). This allows for easy
 // handling of cases where for example an Ethereum hardfork has occured and more than 1/3 of the vlaidtor set
 // disagrees with the rest. Normally this would require a chain halt, manual genesis editing and restar to resolve
-// with this feature a governance proposal can be used instead
+// with this feature a governance proposal can be used instead. evm_chain_prefix selects the EVM chain to unhalt,
+// empty for the default chain
 type UnhaltBridgeProposal struct {
-	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	TargetNonce uint64 `protobuf:"varint,4,opt,name=target_nonce,json=targetNonce,proto3" json:"target_nonce,omitempty"`
+	Title          string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TargetNonce    uint64 `protobuf:"varint,4,opt,name=target_nonce,json=targetNonce,proto3" json:"target_nonce,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,5,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *UnhaltBridgeProposal) Reset()      { *m = UnhaltBridgeProposal{} }
@@ -400,27 +442,33 @@ func (m *IBCMetadataProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IBCMetadataProposal proto.InternalMessageInfo
 
-// PendingIbcAutoForward represents a SendToCosmos transaction with a foreign CosmosReceiver which will be added to the
-// PendingIbcAutoForward queue in attestation_handler and sent over IBC on some submission of a MsgExecuteIbcAutoForwards
-type PendingIbcAutoForward struct {
-	ForeignReceiver string       `protobuf:"bytes,1,opt,name=foreign_receiver,json=foreignReceiver,proto3" json:"foreign_receiver,omitempty"`
-	Token           *types1.Coin `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	IbcChannel      string       `protobuf:"bytes,3,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
-	EventNonce      uint64       `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+// ERC20MetadataProposal defines a custom governance proposal type that allows governance to set the
+// bank metadata for an Ethereum originated token, so that wallets and explorers can display the
+// name, symbol and decimals of the token instead of the raw gravity0x... voucher denom
+// Name: the token name
+// Symbol: the token symbol
+// Description: the token description, only used on Cosmos
+// Display: the token display name, the exponent of this unit should match the ERC20 decimals
+// gravity_denom is the gravity0x... voucher denom of the token in question on this chain, the
+// contract address contained in the denom must not be a Cosmos originated ERC20
+type ERC20MetadataProposal struct {
+	Title        string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata     types.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+	GravityDenom string         `protobuf:"bytes,4,opt,name=gravity_denom,json=gravityDenom,proto3" json:"gravity_denom,omitempty"`
 }
 
-func (m *PendingIbcAutoForward) Reset()         { *m = PendingIbcAutoForward{} }
-func (m *PendingIbcAutoForward) String() string { return proto.CompactTextString(m) }
-func (*PendingIbcAutoForward) ProtoMessage()    {}
-func (*PendingIbcAutoForward) Descriptor() ([]byte, []int) {
+func (m *ERC20MetadataProposal) Reset()      { *m = ERC20MetadataProposal{} }
+func (*ERC20MetadataProposal) ProtoMessage() {}
+func (*ERC20MetadataProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_163831c23fcc179f, []int{7}
 }
-func (m *PendingIbcAutoForward) XXX_Unmarshal(b []byte) error {
+func (m *ERC20MetadataProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PendingIbcAutoForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ERC20MetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PendingIbcAutoForward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ERC20MetadataProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -430,761 +478,5512 @@ func (m *PendingIbcAutoForward) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *PendingIbcAutoForward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingIbcAutoForward.Merge(m, src)
+func (m *ERC20MetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20MetadataProposal.Merge(m, src)
 }
-func (m *PendingIbcAutoForward) XXX_Size() int {
+func (m *ERC20MetadataProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *PendingIbcAutoForward) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingIbcAutoForward.DiscardUnknown(m)
+func (m *ERC20MetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20MetadataProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingIbcAutoForward proto.InternalMessageInfo
+var xxx_messageInfo_ERC20MetadataProposal proto.InternalMessageInfo
 
-func (m *PendingIbcAutoForward) GetForeignReceiver() string {
+// AttestedERC20Deployment records an ERC20Deployed event observed for a Cosmos originated denom that
+// already has an ERC20 representation. Such a deployment can not be used directly, but it is kept as a
+// candidate that an ERC20MigrationProposal may re-point the denom to
+type AttestedERC20Deployment struct {
+	CosmosDenom   string `protobuf:"bytes,1,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol        string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals      uint64 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *AttestedERC20Deployment) Reset()         { *m = AttestedERC20Deployment{} }
+func (m *AttestedERC20Deployment) String() string { return proto.CompactTextString(m) }
+func (*AttestedERC20Deployment) ProtoMessage()    {}
+func (*AttestedERC20Deployment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{8}
+}
+func (m *AttestedERC20Deployment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestedERC20Deployment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestedERC20Deployment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestedERC20Deployment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestedERC20Deployment.Merge(m, src)
+}
+func (m *AttestedERC20Deployment) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestedERC20Deployment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestedERC20Deployment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestedERC20Deployment proto.InternalMessageInfo
+
+func (m *AttestedERC20Deployment) GetCosmosDenom() string {
 	if m != nil {
-		return m.ForeignReceiver
+		return m.CosmosDenom
 	}
 	return ""
 }
 
-func (m *PendingIbcAutoForward) GetToken() *types1.Coin {
+func (m *AttestedERC20Deployment) GetTokenContract() string {
 	if m != nil {
-		return m.Token
+		return m.TokenContract
 	}
-	return nil
+	return ""
 }
 
-func (m *PendingIbcAutoForward) GetIbcChannel() string {
+func (m *AttestedERC20Deployment) GetName() string {
 	if m != nil {
-		return m.IbcChannel
+		return m.Name
 	}
 	return ""
 }
 
-func (m *PendingIbcAutoForward) GetEventNonce() uint64 {
+func (m *AttestedERC20Deployment) GetSymbol() string {
 	if m != nil {
-		return m.EventNonce
+		return m.Symbol
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
-	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
-	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
-	proto.RegisterType((*UnhaltBridgeProposal)(nil), "gravity.v1.UnhaltBridgeProposal")
-	proto.RegisterType((*AirdropProposal)(nil), "gravity.v1.AirdropProposal")
-	proto.RegisterType((*IBCMetadataProposal)(nil), "gravity.v1.IBCMetadataProposal")
-	proto.RegisterType((*PendingIbcAutoForward)(nil), "gravity.v1.PendingIbcAutoForward")
+func (m *AttestedERC20Deployment) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
-
-var fileDescriptor_163831c23fcc179f = []byte{
-	// 747 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbd, 0x6f, 0xdb, 0x46,
-	0x14, 0x17, 0x2d, 0xc9, 0xb6, 0x4e, 0x2a, 0xd4, 0xd2, 0x1f, 0x50, 0x6b, 0x94, 0x52, 0x35, 0x14,
-	0xea, 0x60, 0xd2, 0x52, 0x37, 0x77, 0x30, 0x24, 0xd5, 0x6d, 0x0d, 0xf4, 0xc3, 0x60, 0x5d, 0x03,
-	0xed, 0x42, 0x1c, 0xc9, 0x57, 0xea, 0x20, 0xf2, 0x4e, 0x38, 0x9e, 0xe4, 0x78, 0xca, 0x14, 0x20,
-	0x63, 0xc6, 0x8c, 0xde, 0xf2, 0x17, 0x64, 0xc8, 0x7f, 0xe0, 0xd1, 0x63, 0x90, 0xc1, 0x08, 0xec,
-	0x25, 0x40, 0xfe, 0x89, 0xe0, 0x3e, 0x24, 0xcb, 0xce, 0xe8, 0x89, 0xfc, 0xfd, 0xee, 0x7d, 0xfc,
-	0xde, 0xbb, 0xf7, 0x0e, 0x6d, 0x27, 0x1c, 0xcf, 0x88, 0x38, 0xf7, 0x66, 0x5d, 0x4f, 0x9c, 0x4f,
-	0x20, 0x77, 0x27, 0x9c, 0x09, 0x66, 0x23, 0xc3, 0xbb, 0xb3, 0xee, 0x37, 0x4e, 0xc4, 0xf2, 0x8c,
-	0xe5, 0x5e, 0x88, 0x73, 0xf0, 0x66, 0xdd, 0x10, 0x04, 0xee, 0x7a, 0x11, 0x23, 0x54, 0xdb, 0x2e,
-	0x9d, 0xd3, 0xf1, 0xe2, 0x5c, 0x02, 0x73, 0xbe, 0x99, 0xb0, 0x84, 0xa9, 0x5f, 0x4f, 0xfe, 0x69,
-	0xb6, 0xed, 0xa3, 0xfa, 0x80, 0x93, 0x38, 0x81, 0x53, 0x9c, 0x92, 0x18, 0x0b, 0xc6, 0xed, 0x4d,
-	0x54, 0x9e, 0xb0, 0x33, 0xe0, 0x0d, 0xab, 0x65, 0x75, 0x4a, 0xbe, 0x06, 0xf6, 0x0f, 0xe8, 0x4b,
-	0x10, 0x23, 0xe0, 0x30, 0xcd, 0x02, 0x1c, 0xc7, 0x1c, 0xf2, 0xbc, 0xb1, 0xd2, 0xb2, 0x3a, 0x15,
-	0xbf, 0x3e, 0xe7, 0xfb, 0x9a, 0x6e, 0x7f, 0xb4, 0xd0, 0xea, 0x29, 0x4e, 0x73, 0x10, 0x32, 0x16,
-	0x65, 0x34, 0x82, 0x79, 0x2c, 0x05, 0xec, 0x9f, 0xd0, 0x5a, 0x06, 0x59, 0x08, 0x5c, 0x86, 0x28,
-	0x76, 0xaa, 0xbd, 0x1d, 0xf7, 0xae, 0x50, 0xf7, 0x81, 0x9e, 0x41, 0xe9, 0xf2, 0xba, 0x59, 0xf0,
-	0xe7, 0x1e, 0xf6, 0x36, 0x5a, 0x1d, 0x01, 0x49, 0x46, 0xa2, 0x51, 0x54, 0x31, 0x0d, 0xb2, 0xff,
-	0x46, 0x5f, 0x70, 0x38, 0xc3, 0x3c, 0x0e, 0x70, 0xc6, 0xa6, 0x54, 0x34, 0x4a, 0x52, 0xdd, 0xc0,
-	0x95, 0xde, 0xef, 0xae, 0x9b, 0xdf, 0x27, 0x44, 0x8c, 0xa6, 0xa1, 0x1b, 0xb1, 0xcc, 0x33, 0x9d,
-	0xd2, 0x9f, 0xdd, 0x3c, 0x1e, 0x9b, 0xa6, 0x1f, 0x51, 0xe1, 0xd7, 0x74, 0x90, 0xbe, 0x8a, 0x61,
-	0x7f, 0x87, 0x0c, 0x0e, 0x04, 0x1b, 0x03, 0x6d, 0x94, 0x55, 0xc5, 0x55, 0xcd, 0x9d, 0x48, 0xaa,
-	0xfd, 0xcc, 0x42, 0xcd, 0xdf, 0x71, 0x2e, 0xfe, 0x0a, 0x73, 0xe0, 0x33, 0x88, 0x0f, 0x4d, 0x37,
-	0x06, 0x29, 0x8b, 0xc6, 0xbf, 0x69, 0x6d, 0x2e, 0xda, 0xd0, 0xc9, 0x82, 0x50, 0xb2, 0x81, 0x29,
-	0x40, 0x37, 0xe5, 0x2b, 0x7d, 0xb4, 0x6c, 0xdf, 0x43, 0x5b, 0x8b, 0x66, 0xdf, 0xf3, 0x58, 0x51,
-	0x1e, 0x1b, 0xf0, 0x79, 0x8e, 0xf6, 0x3e, 0xaa, 0x1d, 0xfa, 0xc3, 0xde, 0xde, 0x09, 0xfb, 0x19,
-	0x28, 0xcb, 0x64, 0xeb, 0x81, 0x47, 0xbd, 0x3d, 0x95, 0xa5, 0xe2, 0x6b, 0x20, 0xd9, 0x58, 0x1e,
-	0x9b, 0xbb, 0xd3, 0xa0, 0xfd, 0x14, 0x6d, 0xfe, 0x43, 0x47, 0x38, 0x15, 0xba, 0xf7, 0xc7, 0x9c,
-	0x4d, 0x58, 0x8e, 0x53, 0x69, 0x2d, 0x88, 0x48, 0x61, 0x1e, 0x43, 0x01, 0xbb, 0x85, 0xaa, 0x31,
-	0xe4, 0x11, 0x27, 0x13, 0x41, 0x18, 0x35, 0x91, 0x96, 0x29, 0xd9, 0x36, 0x81, 0x79, 0x02, 0x22,
-	0xd0, 0xb7, 0x5f, 0x52, 0xb2, 0xab, 0x9a, 0xfb, 0x53, 0x52, 0xfb, 0xb5, 0xe7, 0x17, 0xcd, 0xc2,
-	0xcb, 0x8b, 0x66, 0xe1, 0xc3, 0x45, 0xd3, 0x6a, 0xbf, 0xb2, 0x50, 0xbd, 0x4f, 0x78, 0xcc, 0xd9,
-	0xe4, 0xd1, 0xc9, 0x17, 0x25, 0x16, 0x97, 0x4a, 0xb4, 0x1d, 0x84, 0x38, 0x44, 0x64, 0x42, 0x80,
-	0x8a, 0x5c, 0x09, 0xaa, 0xf9, 0x4b, 0x8c, 0xdd, 0x40, 0x6b, 0x7a, 0x6e, 0xf2, 0x46, 0xb9, 0x55,
-	0xec, 0x94, 0xfc, 0x39, 0x7c, 0xa0, 0xf4, 0x8d, 0x85, 0x36, 0x8e, 0x06, 0xc3, 0x3f, 0x40, 0xe0,
-	0x18, 0x0b, 0xfc, 0x68, 0xb5, 0x07, 0x68, 0x3d, 0x33, 0xb1, 0x94, 0xe0, 0x6a, 0xef, 0x5b, 0x57,
-	0x0f, 0x84, 0xab, 0x96, 0xd7, 0x6c, 0xb2, 0x3b, 0x4f, 0x68, 0xd6, 0x61, 0xe1, 0x64, 0xef, 0xa0,
-	0x0a, 0x09, 0xa3, 0x40, 0x97, 0xac, 0x66, 0xde, 0x5f, 0x27, 0x61, 0xa4, 0x86, 0xe0, 0x9e, 0xf6,
-	0x42, 0xfb, 0xb5, 0x85, 0xb6, 0x8e, 0x81, 0xc6, 0x84, 0x26, 0x47, 0x61, 0xd4, 0x9f, 0x0a, 0xf6,
-	0x0b, 0xe3, 0x72, 0x92, 0xe5, 0x76, 0xff, 0xcf, 0x38, 0x90, 0x84, 0x06, 0x1c, 0x22, 0x20, 0x33,
-	0xb3, 0xfe, 0x15, 0xbf, 0x6e, 0x78, 0xdf, 0xd0, 0xb6, 0x87, 0xca, 0x7a, 0x17, 0x56, 0x94, 0xda,
-	0xaf, 0xef, 0xd4, 0xe6, 0xb0, 0x50, 0x3b, 0x64, 0x84, 0xfa, 0xda, 0xce, 0x6e, 0xa2, 0xaa, 0x14,
-	0x18, 0x8d, 0x30, 0xa5, 0x90, 0x9a, 0x5b, 0x41, 0x24, 0x8c, 0x86, 0x9a, 0x91, 0x06, 0x30, 0x03,
-	0x7a, 0x7f, 0x58, 0x90, 0xa2, 0xd4, 0xac, 0x0c, 0xfe, 0xbd, 0xbc, 0x71, 0xac, 0xab, 0x1b, 0xc7,
-	0x7a, 0x7f, 0xe3, 0x58, 0x2f, 0x6e, 0x9d, 0xc2, 0xd5, 0xad, 0x53, 0x78, 0x7b, 0xeb, 0x14, 0xfe,
-	0x3b, 0x58, 0xda, 0xea, 0x5f, 0xf5, 0x13, 0xb2, 0xab, 0x67, 0xf8, 0x21, 0xcc, 0x58, 0x3c, 0x4d,
-	0xc1, 0x7b, 0xe2, 0xcd, 0x9f, 0x5a, 0xb5, 0xf2, 0xe1, 0xaa, 0x7a, 0x06, 0x7f, 0xfc, 0x14, 0x00,
-	0x00, 0xff, 0xff, 0x11, 0x9a, 0x06, 0xeb, 0x82, 0x05, 0x00, 0x00,
+// ERC20Migration records that a Cosmos originated denom has been moved from old_erc20 to new_erc20.
+// The old ERC20 is frozen for outgoing transfers, deposits of the old ERC20 are still credited until
+// the Ethereum block height deposits_accepted_until, after which they are sent to the community pool
+type ERC20Migration struct {
+	CosmosDenom           string `protobuf:"bytes,1,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	OldErc20              string `protobuf:"bytes,2,opt,name=old_erc20,json=oldErc20,proto3" json:"old_erc20,omitempty"`
+	NewErc20              string `protobuf:"bytes,3,opt,name=new_erc20,json=newErc20,proto3" json:"new_erc20,omitempty"`
+	DepositsAcceptedUntil uint64 `protobuf:"varint,4,opt,name=deposits_accepted_until,json=depositsAcceptedUntil,proto3" json:"deposits_accepted_until,omitempty"`
 }
 
-func (this *UnhaltBridgeProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*UnhaltBridgeProposal)
-	if !ok {
-		that2, ok := that.(UnhaltBridgeProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *ERC20Migration) Reset()         { *m = ERC20Migration{} }
+func (m *ERC20Migration) String() string { return proto.CompactTextString(m) }
+func (*ERC20Migration) ProtoMessage()    {}
+func (*ERC20Migration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{9}
+}
+func (m *ERC20Migration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Migration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Migration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.TargetNonce != that1.TargetNonce {
-		return false
-	}
-	return true
 }
-func (this *AirdropProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ERC20Migration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Migration.Merge(m, src)
+}
+func (m *ERC20Migration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Migration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Migration.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*AirdropProposal)
-	if !ok {
-		that2, ok := that.(AirdropProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if !bytes.Equal(this.Recipients, that1.Recipients) {
-		return false
-	}
-	if len(this.Amounts) != len(that1.Amounts) {
-		return false
-	}
-	for i := range this.Amounts {
-		if this.Amounts[i] != that1.Amounts[i] {
-			return false
-		}
+var xxx_messageInfo_ERC20Migration proto.InternalMessageInfo
+
+func (m *ERC20Migration) GetCosmosDenom() string {
+	if m != nil {
+		return m.CosmosDenom
 	}
-	return true
+	return ""
 }
-func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *ERC20Migration) GetOldErc20() string {
+	if m != nil {
+		return m.OldErc20
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ERC20Migration) GetNewErc20() string {
+	if m != nil {
+		return m.NewErc20
+	}
+	return ""
 }
 
-func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Power != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x8
+func (m *ERC20Migration) GetDepositsAcceptedUntil() uint64 {
+	if m != nil {
+		return m.DepositsAcceptedUntil
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Valset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// ERC20MigrationProposal defines a custom governance proposal type that re-points a Cosmos originated
+// denom to a newly deployed ERC20 contract, for use when the original ERC20 is faulty or was deployed
+// with the wrong metadata.
+// cosmos_denom: the Cosmos originated denom to migrate, it must already have an ERC20 representation
+// new_erc20: the replacement ERC20, its deployment must have been attested through an ERC20DeployedClaim
+// metadata: optional replacement bank metadata for the denom, if omitted the existing metadata is used.
+// In either case the metadata must match the name, symbol and decimals of the new ERC20
+// migration_window: the number of Ethereum blocks during which deposits of the old ERC20 are still accepted
+// evm_chain_prefix: the EVM chain the ERC20s are deployed on, empty for the default chain
+type ERC20MigrationProposal struct {
+	Title           string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CosmosDenom     string          `protobuf:"bytes,3,opt,name=cosmos_denom,json=cosmosDenom,proto3" json:"cosmos_denom,omitempty"`
+	NewErc20        string          `protobuf:"bytes,4,opt,name=new_erc20,json=newErc20,proto3" json:"new_erc20,omitempty"`
+	Metadata        *types.Metadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	MigrationWindow uint64          `protobuf:"varint,6,opt,name=migration_window,json=migrationWindow,proto3" json:"migration_window,omitempty"`
+	EvmChainPrefix  string          `protobuf:"bytes,7,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+}
+
+func (m *ERC20MigrationProposal) Reset()      { *m = ERC20MigrationProposal{} }
+func (*ERC20MigrationProposal) ProtoMessage() {}
+func (*ERC20MigrationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{10}
+}
+func (m *ERC20MigrationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20MigrationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20MigrationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ERC20MigrationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20MigrationProposal.Merge(m, src)
+}
+func (m *ERC20MigrationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20MigrationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20MigrationProposal.DiscardUnknown(m)
 }
 
-func (m *Valset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ERC20MigrationProposal proto.InternalMessageInfo
+
+// MerkleAirdropProposal defines a custom governance proposal type that escrows an airdrop from the community pool,
+// unlike AirdropProposal the recipients are not listed in the proposal. Instead each recipient claims their share
+// with a MsgClaimAirdrop carrying a Merkle proof, so the size of the airdrop is not bounded by the block size.
+// denom: the denom to airdrop, total of this denom is moved out of the community pool when the proposal executes
+// merkle_root: the root of a Merkle tree whose leaves are keccak256(recipient address bytes | amount as uint64 big endian),
+// the tree is built by hashing each sorted pair of nodes as keccak256(left | right)
+// expiry_height: the Cosmos block height at which unclaimed funds are returned to the community pool
+type MerkleAirdropProposal struct {
+	Title        string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom        string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	MerkleRoot   []byte `protobuf:"bytes,4,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Total        uint64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	ExpiryHeight uint64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
 
-func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardToken) > 0 {
-		i -= len(m.RewardToken)
-		copy(dAtA[i:], m.RewardToken)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardToken)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.RewardAmount.Size()
-		i -= size
-		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MerkleAirdropProposal) Reset()      { *m = MerkleAirdropProposal{} }
+func (*MerkleAirdropProposal) ProtoMessage() {}
+func (*MerkleAirdropProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{11}
+}
+func (m *MerkleAirdropProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleAirdropProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleAirdropProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.Height != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MerkleAirdropProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleAirdropProposal.Merge(m, src)
+}
+func (m *MerkleAirdropProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleAirdropProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleAirdropProposal.DiscardUnknown(m)
 }
 
-func (m *LastObservedEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MerkleAirdropProposal proto.InternalMessageInfo
+
+// VestingAirdropProposal defines a custom governance proposal type that airdrops from the community pool like
+// AirdropProposal, but places the airdropped coins in an x/auth vesting account for each recipient instead of
+// sending them liquid. Recipients without an account receive a new vesting account, recipients with a plain
+// BaseAccount have it converted, any other account type (module or existing vesting accounts) fails the proposal.
+// recipients, amounts: packed 20 byte addresses and the amount each receives, as in AirdropProposal
+// start_time, end_time: unix timestamps in seconds bounding the vesting schedule
+// num_periods: 0 creates ContinuousVestingAccounts vesting linearly from start_time to end_time, otherwise
+// PeriodicVestingAccounts are created releasing each amount in num_periods equal installments between start_time
+// and end_time. A single period releases everything at end_time
+type VestingAirdropProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom       string   `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Recipients  []byte   `protobuf:"bytes,4,opt,name=recipients,proto3" json:"recipients,omitempty"`
+	Amounts     []uint64 `protobuf:"varint,5,rep,packed,name=amounts,proto3" json:"amounts,omitempty"`
+	StartTime   int64    `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     int64    `protobuf:"varint,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	NumPeriods  uint64   `protobuf:"varint,8,opt,name=num_periods,json=numPeriods,proto3" json:"num_periods,omitempty"`
+}
+
+func (m *VestingAirdropProposal) Reset()      { *m = VestingAirdropProposal{} }
+func (*VestingAirdropProposal) ProtoMessage() {}
+func (*VestingAirdropProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{12}
+}
+func (m *VestingAirdropProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingAirdropProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingAirdropProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *VestingAirdropProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingAirdropProposal.Merge(m, src)
+}
+func (m *VestingAirdropProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingAirdropProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingAirdropProposal.DiscardUnknown(m)
 }
 
-func (m *LastObservedEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_VestingAirdropProposal proto.InternalMessageInfo
+
+// MerkleAirdrop is an airdrop created by a MerkleAirdropProposal, claimed tracks the amount paid out so far
+type MerkleAirdrop struct {
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	MerkleRoot   []byte `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Total        uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Claimed      uint64 `protobuf:"varint,5,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	ExpiryHeight uint64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
 
-func (m *LastObservedEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthereumBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
-		i--
-		dAtA[i] = 0x10
+func (m *MerkleAirdrop) Reset()         { *m = MerkleAirdrop{} }
+func (m *MerkleAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MerkleAirdrop) ProtoMessage()    {}
+func (*MerkleAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{13}
+}
+func (m *MerkleAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.CosmosBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MerkleAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleAirdrop.Merge(m, src)
+}
+func (m *MerkleAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleAirdrop proto.InternalMessageInfo
+
+func (m *MerkleAirdrop) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MerkleAirdrop) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MerkleAirdrop) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
 }
 
-func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *MerkleAirdrop) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
 	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MerkleAirdrop) GetClaimed() uint64 {
+	if m != nil {
+		return m.Claimed
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UnhaltBridgeProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MerkleAirdrop) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UnhaltBridgeProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MerkleAirdropClaim records that claimer has claimed amount from the MerkleAirdrop with id airdrop_id
+type MerkleAirdropClaim struct {
+	AirdropId uint64 `protobuf:"varint,1,opt,name=airdrop_id,json=airdropId,proto3" json:"airdrop_id,omitempty"`
+	Claimer   string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	Amount    uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *UnhaltBridgeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TargetNonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TargetNonce))
-		i--
-		dAtA[i] = 0x20
+func (m *MerkleAirdropClaim) Reset()         { *m = MerkleAirdropClaim{} }
+func (m *MerkleAirdropClaim) String() string { return proto.CompactTextString(m) }
+func (*MerkleAirdropClaim) ProtoMessage()    {}
+func (*MerkleAirdropClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{14}
+}
+func (m *MerkleAirdropClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleAirdropClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleAirdropClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MerkleAirdropClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleAirdropClaim.Merge(m, src)
+}
+func (m *MerkleAirdropClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleAirdropClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleAirdropClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleAirdropClaim proto.InternalMessageInfo
+
+func (m *MerkleAirdropClaim) GetAirdropId() uint64 {
+	if m != nil {
+		return m.AirdropId
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MerkleAirdropClaim) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AirdropProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MerkleAirdropClaim) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AirdropProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PendingIbcAutoForward represents a SendToCosmos transaction with a foreign CosmosReceiver which will be added to the
+// PendingIbcAutoForward queue in attestation_handler and sent over IBC on some submission of a MsgExecuteIbcAutoForwards
+type PendingIbcAutoForward struct {
+	ForeignReceiver string       `protobuf:"bytes,1,opt,name=foreign_receiver,json=foreignReceiver,proto3" json:"foreign_receiver,omitempty"`
+	Token           *types1.Coin `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	IbcChannel      string       `protobuf:"bytes,3,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
+	EventNonce      uint64       `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
 }
 
-func (m *AirdropProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		dAtA2 := make([]byte, len(m.Amounts)*10)
-		var j1 int
-		for _, num := range m.Amounts {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+func (m *PendingIbcAutoForward) Reset()         { *m = PendingIbcAutoForward{} }
+func (m *PendingIbcAutoForward) String() string { return proto.CompactTextString(m) }
+func (*PendingIbcAutoForward) ProtoMessage()    {}
+func (*PendingIbcAutoForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{15}
+}
+func (m *PendingIbcAutoForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingIbcAutoForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingIbcAutoForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTypes(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.Recipients) > 0 {
-		i -= len(m.Recipients)
-		copy(dAtA[i:], m.Recipients)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipients)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *PendingIbcAutoForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingIbcAutoForward.Merge(m, src)
+}
+func (m *PendingIbcAutoForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingIbcAutoForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingIbcAutoForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingIbcAutoForward proto.InternalMessageInfo
+
+func (m *PendingIbcAutoForward) GetForeignReceiver() string {
+	if m != nil {
+		return m.ForeignReceiver
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *PendingIbcAutoForward) GetToken() *types1.Coin {
+	if m != nil {
+		return m.Token
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *PendingIbcAutoForward) GetIbcChannel() string {
+	if m != nil {
+		return m.IbcChannel
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *IBCMetadataProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingIbcAutoForward) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *IBCMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BridgeDutyCounts counts how many duties of one kind a validator was expected to perform and how many it performed
+type BridgeDutyCounts struct {
+	Expected  uint64 `protobuf:"varint,1,opt,name=expected,proto3" json:"expected,omitempty"`
+	Performed uint64 `protobuf:"varint,2,opt,name=performed,proto3" json:"performed,omitempty"`
 }
 
-func (m *IBCMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IbcDenom) > 0 {
-		i -= len(m.IbcDenom)
-		copy(dAtA[i:], m.IbcDenom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *BridgeDutyCounts) Reset()         { *m = BridgeDutyCounts{} }
+func (m *BridgeDutyCounts) String() string { return proto.CompactTextString(m) }
+func (*BridgeDutyCounts) ProtoMessage()    {}
+func (*BridgeDutyCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{16}
+}
+func (m *BridgeDutyCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeDutyCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeDutyCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *BridgeDutyCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeDutyCounts.Merge(m, src)
+}
+func (m *BridgeDutyCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeDutyCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeDutyCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeDutyCounts proto.InternalMessageInfo
+
+func (m *BridgeDutyCounts) GetExpected() uint64 {
+	if m != nil {
+		return m.Expected
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PendingIbcAutoForward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeDutyCounts) GetPerformed() uint64 {
+	if m != nil {
+		return m.Performed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PendingIbcAutoForward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BridgeDutyWindow holds the duty counts of a validator over the BridgePerformanceWindow blocks beginning at
+// start_height, valsets, batches and logic calls are counted once their signing window has passed, claims are
+// counted when the attestation they are part of is observed
+type BridgeDutyWindow struct {
+	StartHeight uint64           `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Valsets     BridgeDutyCounts `protobuf:"bytes,2,opt,name=valsets,proto3" json:"valsets"`
+	Batches     BridgeDutyCounts `protobuf:"bytes,3,opt,name=batches,proto3" json:"batches"`
+	LogicCalls  BridgeDutyCounts `protobuf:"bytes,4,opt,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
+	Claims      BridgeDutyCounts `protobuf:"bytes,5,opt,name=claims,proto3" json:"claims"`
 }
 
-func (m *PendingIbcAutoForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.IbcChannel) > 0 {
-		i -= len(m.IbcChannel)
-		copy(dAtA[i:], m.IbcChannel)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcChannel)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Token != nil {
-		{
-			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *BridgeDutyWindow) Reset()         { *m = BridgeDutyWindow{} }
+func (m *BridgeDutyWindow) String() string { return proto.CompactTextString(m) }
+func (*BridgeDutyWindow) ProtoMessage()    {}
+func (*BridgeDutyWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{17}
+}
+func (m *BridgeDutyWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeDutyWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeDutyWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ForeignReceiver) > 0 {
-		i -= len(m.ForeignReceiver)
-		copy(dAtA[i:], m.ForeignReceiver)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForeignReceiver)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BridgeDutyWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeDutyWindow.Merge(m, src)
+}
+func (m *BridgeDutyWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeDutyWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeDutyWindow.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_BridgeDutyWindow proto.InternalMessageInfo
+
+func (m *BridgeDutyWindow) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *BridgeValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Power != 0 {
-		n += 1 + sovTypes(uint64(m.Power))
+
+func (m *BridgeDutyWindow) GetValsets() BridgeDutyCounts {
+	if m != nil {
+		return m.Valsets
 	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return BridgeDutyCounts{}
+}
+
+func (m *BridgeDutyWindow) GetBatches() BridgeDutyCounts {
+	if m != nil {
+		return m.Batches
 	}
-	return n
+	return BridgeDutyCounts{}
 }
 
-func (m *Valset) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BridgeDutyWindow) GetLogicCalls() BridgeDutyCounts {
+	if m != nil {
+		return m.LogicCalls
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTypes(uint64(m.Nonce))
+	return BridgeDutyCounts{}
+}
+
+func (m *BridgeDutyWindow) GetClaims() BridgeDutyCounts {
+	if m != nil {
+		return m.Claims
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return BridgeDutyCounts{}
+}
+
+// ValidatorBridgePerformance is the rolling record of a validator's bridge duties across every EVM chain, made of the
+// current window and the window before it
+type ValidatorBridgePerformance struct {
+	Validator string           `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Current   BridgeDutyWindow `protobuf:"bytes,2,opt,name=current,proto3" json:"current"`
+	Previous  BridgeDutyWindow `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous"`
+}
+
+func (m *ValidatorBridgePerformance) Reset()         { *m = ValidatorBridgePerformance{} }
+func (m *ValidatorBridgePerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBridgePerformance) ProtoMessage()    {}
+func (*ValidatorBridgePerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{18}
+}
+func (m *ValidatorBridgePerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBridgePerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBridgePerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
+}
+func (m *ValidatorBridgePerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBridgePerformance.Merge(m, src)
+}
+func (m *ValidatorBridgePerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBridgePerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBridgePerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBridgePerformance proto.InternalMessageInfo
+
+func (m *ValidatorBridgePerformance) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	l = m.RewardAmount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.RewardToken)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *ValidatorBridgePerformance) GetCurrent() BridgeDutyWindow {
+	if m != nil {
+		return m.Current
 	}
-	return n
+	return BridgeDutyWindow{}
 }
 
-func (m *LastObservedEthereumBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CosmosBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
-	}
-	if m.EthereumBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+func (m *ValidatorBridgePerformance) GetPrevious() BridgeDutyWindow {
+	if m != nil {
+		return m.Previous
 	}
-	return n
+	return BridgeDutyWindow{}
 }
 
-func (m *ERC20ToDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+// UnsignedBridgeItem is a valset, batch or logic call a validator has not signed yet, the validator is slashed for it
+// at slashable_height unless it signs first. token_contract is only set for batches, invalidation_id and
+// invalidation_nonce only for logic calls
+type UnsignedBridgeItem struct {
+	EvmChainPrefix  string     `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Duty            BridgeDuty `protobuf:"varint,2,opt,name=duty,proto3,enum=gravity.v1.BridgeDuty" json:"duty,omitempty"`
+	Nonce           uint64     `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TokenContract   string     `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	InvalidationId  []byte     `protobuf:"bytes,5,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+	SlashableHeight uint64     `protobuf:"varint,6,opt,name=slashable_height,json=slashableHeight,proto3" json:"slashable_height,omitempty"`
+}
+
+func (m *UnsignedBridgeItem) Reset()         { *m = UnsignedBridgeItem{} }
+func (m *UnsignedBridgeItem) String() string { return proto.CompactTextString(m) }
+func (*UnsignedBridgeItem) ProtoMessage()    {}
+func (*UnsignedBridgeItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{19}
+}
+func (m *UnsignedBridgeItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnsignedBridgeItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnsignedBridgeItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *UnsignedBridgeItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsignedBridgeItem.Merge(m, src)
+}
+func (m *UnsignedBridgeItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnsignedBridgeItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsignedBridgeItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsignedBridgeItem proto.InternalMessageInfo
+
+func (m *UnsignedBridgeItem) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return n
+	return ""
 }
 
-func (m *UnhaltBridgeProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnsignedBridgeItem) GetDuty() BridgeDuty {
+	if m != nil {
+		return m.Duty
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return BRIDGE_DUTY_UNSPECIFIED
+}
+
+func (m *UnsignedBridgeItem) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *UnsignedBridgeItem) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.TargetNonce != 0 {
-		n += 1 + sovTypes(uint64(m.TargetNonce))
+	return ""
+}
+
+func (m *UnsignedBridgeItem) GetInvalidationId() []byte {
+	if m != nil {
+		return m.InvalidationId
 	}
-	return n
+	return nil
 }
 
-func (m *AirdropProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnsignedBridgeItem) GetSlashableHeight() uint64 {
+	if m != nil {
+		return m.SlashableHeight
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+// ValidatorBridgePerformanceReport is a ValidatorBridgePerformance together with the validator's score, the share of
+// expected duties it performed over both windows, and the items it is at risk of being slashed for
+type ValidatorBridgePerformanceReport struct {
+	Performance   ValidatorBridgePerformance             `protobuf:"bytes,1,opt,name=performance,proto3" json:"performance"`
+	Score         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+	AtRisk        bool                                   `protobuf:"varint,3,opt,name=at_risk,json=atRisk,proto3" json:"at_risk,omitempty"`
+	UnsignedItems []UnsignedBridgeItem                   `protobuf:"bytes,4,rep,name=unsigned_items,json=unsignedItems,proto3" json:"unsigned_items"`
+}
+
+func (m *ValidatorBridgePerformanceReport) Reset()         { *m = ValidatorBridgePerformanceReport{} }
+func (m *ValidatorBridgePerformanceReport) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBridgePerformanceReport) ProtoMessage()    {}
+func (*ValidatorBridgePerformanceReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{20}
+}
+func (m *ValidatorBridgePerformanceReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBridgePerformanceReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBridgePerformanceReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *ValidatorBridgePerformanceReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBridgePerformanceReport.Merge(m, src)
+}
+func (m *ValidatorBridgePerformanceReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBridgePerformanceReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBridgePerformanceReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBridgePerformanceReport proto.InternalMessageInfo
+
+func (m *ValidatorBridgePerformanceReport) GetPerformance() ValidatorBridgePerformance {
+	if m != nil {
+		return m.Performance
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ValidatorBridgePerformance{}
+}
+
+func (m *ValidatorBridgePerformanceReport) GetAtRisk() bool {
+	if m != nil {
+		return m.AtRisk
 	}
-	l = len(m.Recipients)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return false
+}
+
+func (m *ValidatorBridgePerformanceReport) GetUnsignedItems() []UnsignedBridgeItem {
+	if m != nil {
+		return m.UnsignedItems
 	}
-	if len(m.Amounts) > 0 {
-		l = 0
-		for _, e := range m.Amounts {
-			l += sovTypes(uint64(e))
+	return nil
+}
+
+// BridgeOffences counts the missed valset, batch and logic call signatures of a validator which have not been
+// forgiven yet, one offence is forgiven every Params.offence_decay_window blocks after last_offence_height
+type BridgeOffences struct {
+	Validator         string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Count             uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	LastOffenceHeight uint64 `protobuf:"varint,3,opt,name=last_offence_height,json=lastOffenceHeight,proto3" json:"last_offence_height,omitempty"`
+}
+
+func (m *BridgeOffences) Reset()         { *m = BridgeOffences{} }
+func (m *BridgeOffences) String() string { return proto.CompactTextString(m) }
+func (*BridgeOffences) ProtoMessage()    {}
+func (*BridgeOffences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{21}
+}
+func (m *BridgeOffences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeOffences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeOffences.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTypes(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BridgeOffences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeOffences.Merge(m, src)
+}
+func (m *BridgeOffences) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeOffences) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeOffences.DiscardUnknown(m)
 }
 
-func (m *IBCMetadataProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+var xxx_messageInfo_BridgeOffences proto.InternalMessageInfo
+
+func (m *BridgeOffences) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *BridgeOffences) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *BridgeOffences) GetLastOffenceHeight() uint64 {
+	if m != nil {
+		return m.LastOffenceHeight
 	}
-	return n
+	return 0
 }
 
-func (m *PendingIbcAutoForward) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("gravity.v1.BridgeDuty", BridgeDuty_name, BridgeDuty_value)
+	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
+	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
+	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+	proto.RegisterType((*UnhaltBridgeProposal)(nil), "gravity.v1.UnhaltBridgeProposal")
+	proto.RegisterType((*AirdropProposal)(nil), "gravity.v1.AirdropProposal")
+	proto.RegisterType((*IBCMetadataProposal)(nil), "gravity.v1.IBCMetadataProposal")
+	proto.RegisterType((*ERC20MetadataProposal)(nil), "gravity.v1.ERC20MetadataProposal")
+	proto.RegisterType((*AttestedERC20Deployment)(nil), "gravity.v1.AttestedERC20Deployment")
+	proto.RegisterType((*ERC20Migration)(nil), "gravity.v1.ERC20Migration")
+	proto.RegisterType((*ERC20MigrationProposal)(nil), "gravity.v1.ERC20MigrationProposal")
+	proto.RegisterType((*MerkleAirdropProposal)(nil), "gravity.v1.MerkleAirdropProposal")
+	proto.RegisterType((*VestingAirdropProposal)(nil), "gravity.v1.VestingAirdropProposal")
+	proto.RegisterType((*MerkleAirdrop)(nil), "gravity.v1.MerkleAirdrop")
+	proto.RegisterType((*MerkleAirdropClaim)(nil), "gravity.v1.MerkleAirdropClaim")
+	proto.RegisterType((*PendingIbcAutoForward)(nil), "gravity.v1.PendingIbcAutoForward")
+	proto.RegisterType((*BridgeDutyCounts)(nil), "gravity.v1.BridgeDutyCounts")
+	proto.RegisterType((*BridgeDutyWindow)(nil), "gravity.v1.BridgeDutyWindow")
+	proto.RegisterType((*ValidatorBridgePerformance)(nil), "gravity.v1.ValidatorBridgePerformance")
+	proto.RegisterType((*UnsignedBridgeItem)(nil), "gravity.v1.UnsignedBridgeItem")
+	proto.RegisterType((*ValidatorBridgePerformanceReport)(nil), "gravity.v1.ValidatorBridgePerformanceReport")
+	proto.RegisterType((*BridgeOffences)(nil), "gravity.v1.BridgeOffences")
+}
+
+func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
+
+var fileDescriptor_163831c23fcc179f = []byte{
+	// 1703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x77, 0xdb, 0x4e, 0xe2, 0x3c, 0xe7, 0xc3, 0xd3, 0x33, 0xc9, 0x78, 0x33, 0xb3, 0x4e, 0xd6,
+	0x88, 0x25, 0xbb, 0xd2, 0xda, 0x3b, 0x41, 0x42, 0x62, 0x40, 0xac, 0x1c, 0x27, 0x3b, 0x6b, 0x91,
+	0x99, 0x89, 0x7a, 0x92, 0x41, 0xcb, 0xa5, 0xd5, 0xee, 0x7e, 0xb1, 0x4b, 0xe9, 0xae, 0x6a, 0x55,
+	0x95, 0x9d, 0xc9, 0x1f, 0x80, 0xb4, 0xdc, 0xe0, 0xc6, 0x09, 0x8d, 0x84, 0x10, 0x42, 0x48, 0x1c,
+	0x90, 0x90, 0xe0, 0xcc, 0x65, 0x6f, 0xec, 0x11, 0x71, 0x58, 0xa1, 0x99, 0x0b, 0x12, 0x7f, 0x02,
+	0x17, 0x54, 0x1f, 0x6d, 0xb7, 0x93, 0x0c, 0x13, 0xb4, 0xac, 0x34, 0xa7, 0xf8, 0xfd, 0x5e, 0xbd,
+	0x57, 0xef, 0xbb, 0x5e, 0x07, 0xd6, 0x07, 0x3c, 0x18, 0x13, 0x79, 0xde, 0x1e, 0xdf, 0x6b, 0xcb,
+	0xf3, 0x14, 0x45, 0x2b, 0xe5, 0x4c, 0x32, 0x17, 0x2c, 0xde, 0x1a, 0xdf, 0xdb, 0x68, 0x84, 0x4c,
+	0x24, 0x4c, 0xb4, 0xfb, 0x81, 0xc0, 0xf6, 0xf8, 0x5e, 0x1f, 0x65, 0x70, 0xaf, 0x1d, 0x32, 0x42,
+	0xcd, 0xd9, 0x1c, 0x9f, 0x9e, 0x4e, 0xf8, 0x8a, 0xb0, 0xfc, 0x5b, 0x03, 0x36, 0x60, 0xfa, 0x67,
+	0x5b, 0xfd, 0x32, 0x68, 0xd3, 0x83, 0xd5, 0x5d, 0x4e, 0xa2, 0x01, 0x3e, 0x0d, 0x62, 0x12, 0x05,
+	0x92, 0x71, 0xf7, 0x16, 0xcc, 0xa5, 0xec, 0x0c, 0x79, 0xdd, 0xd9, 0x72, 0xb6, 0xcb, 0x9e, 0x21,
+	0xdc, 0xf7, 0xa0, 0x86, 0x72, 0x88, 0x1c, 0x47, 0x89, 0x1f, 0x44, 0x11, 0x47, 0x21, 0xea, 0xc5,
+	0x2d, 0x67, 0x7b, 0xd1, 0x5b, 0xcd, 0xf0, 0x8e, 0x81, 0x9b, 0xff, 0x72, 0x60, 0xfe, 0x69, 0x10,
+	0x0b, 0x94, 0x4a, 0x17, 0x65, 0x34, 0xc4, 0x4c, 0x97, 0x26, 0xdc, 0xef, 0xc1, 0x42, 0x82, 0x49,
+	0x1f, 0xb9, 0x52, 0x51, 0xda, 0xae, 0xee, 0xdc, 0x69, 0x4d, 0x1d, 0x6d, 0x5d, 0xb0, 0x67, 0xb7,
+	0xfc, 0xf9, 0x97, 0x9b, 0x05, 0x2f, 0x93, 0x70, 0xd7, 0x61, 0x7e, 0x88, 0x64, 0x30, 0x94, 0xf5,
+	0x92, 0xd6, 0x69, 0x29, 0xf7, 0x09, 0x2c, 0x73, 0x3c, 0x0b, 0x78, 0xe4, 0x07, 0x09, 0x1b, 0x51,
+	0x59, 0x2f, 0x2b, 0xeb, 0x76, 0x5b, 0x4a, 0xfa, 0xef, 0x5f, 0x6e, 0xbe, 0x3b, 0x20, 0x72, 0x38,
+	0xea, 0xb7, 0x42, 0x96, 0xb4, 0x6d, 0xa4, 0xcc, 0x9f, 0x0f, 0x44, 0x74, 0x6a, 0x83, 0xde, 0xa3,
+	0xd2, 0x5b, 0x32, 0x4a, 0x3a, 0x5a, 0x87, 0xfb, 0x0e, 0x58, 0xda, 0x97, 0xec, 0x14, 0x69, 0x7d,
+	0x4e, 0x7b, 0x5c, 0x35, 0xd8, 0x91, 0x82, 0x9a, 0x3f, 0x71, 0x60, 0xf3, 0x20, 0x10, 0xf2, 0x71,
+	0x5f, 0x20, 0x1f, 0x63, 0xb4, 0x6f, 0xa3, 0xb1, 0x1b, 0xb3, 0xf0, 0xf4, 0x13, 0x63, 0x5b, 0x0b,
+	0x6e, 0x9a, 0xcb, 0xfc, 0xbe, 0x42, 0x7d, 0xeb, 0x80, 0x09, 0xca, 0x0d, 0xc3, 0xca, 0x9f, 0xdf,
+	0x81, 0xb5, 0x49, 0xb0, 0x67, 0x24, 0x8a, 0x5a, 0xe2, 0x26, 0x5e, 0xbe, 0xa3, 0x79, 0x1f, 0x96,
+	0xf6, 0xbd, 0xee, 0xce, 0x87, 0x47, 0x6c, 0x0f, 0x29, 0x4b, 0x54, 0xe8, 0x91, 0x87, 0x3b, 0x1f,
+	0xea, 0x5b, 0x16, 0x3d, 0x43, 0x28, 0x34, 0x52, 0x6c, 0x9b, 0x3b, 0x43, 0x34, 0x7f, 0xeb, 0xc0,
+	0xad, 0x63, 0x3a, 0x0c, 0x62, 0x69, 0x82, 0x7f, 0xc8, 0x59, 0xca, 0x44, 0x10, 0xab, 0xe3, 0x92,
+	0xc8, 0x18, 0x33, 0x25, 0x9a, 0x70, 0xb7, 0xa0, 0x1a, 0xa1, 0x08, 0x39, 0x49, 0x25, 0x61, 0xd4,
+	0xaa, 0xca, 0x43, 0x2a, 0x6e, 0x32, 0xe0, 0x03, 0x94, 0xbe, 0x49, 0x7f, 0x59, 0xdb, 0x5d, 0x35,
+	0xd8, 0x23, 0x05, 0xb9, 0xdb, 0x50, 0xc3, 0x71, 0xe2, 0x87, 0xc3, 0x80, 0x50, 0x3f, 0xe5, 0x78,
+	0x42, 0x9e, 0xd9, 0xf0, 0xae, 0xe0, 0x38, 0xe9, 0x2a, 0xf8, 0x50, 0xa3, 0xf7, 0x97, 0x3e, 0x7b,
+	0xbe, 0x59, 0xf8, 0xc5, 0xf3, 0xcd, 0xc2, 0x3f, 0x9f, 0x6f, 0x3a, 0xcd, 0xdf, 0x38, 0xb0, 0xda,
+	0x21, 0x3c, 0xe2, 0x2c, 0xfd, 0xca, 0x66, 0x4e, 0xa2, 0x51, 0xca, 0x45, 0xc3, 0x6d, 0x00, 0x70,
+	0x0c, 0x49, 0x4a, 0x90, 0x4a, 0xa1, 0x4d, 0x5f, 0xf2, 0x72, 0x88, 0x5b, 0x87, 0x05, 0x53, 0x62,
+	0xa2, 0x3e, 0xb7, 0x55, 0xda, 0x2e, 0x7b, 0x19, 0x79, 0xc1, 0xd2, 0x3f, 0x3b, 0x70, 0xb3, 0xb7,
+	0xdb, 0x7d, 0x88, 0x32, 0x88, 0x02, 0x19, 0x7c, 0x65, 0x6b, 0x3f, 0x82, 0x4a, 0x62, 0x75, 0x69,
+	0x83, 0xab, 0x3b, 0x6f, 0xb7, 0x4c, 0xed, 0xb4, 0x74, 0x9f, 0xdb, 0xa6, 0x6f, 0x65, 0x17, 0xda,
+	0xce, 0x99, 0x08, 0xb9, 0x77, 0x60, 0x91, 0xf4, 0x43, 0xdf, 0xb8, 0xac, 0xdb, 0xc3, 0xab, 0x90,
+	0x7e, 0xa8, 0xeb, 0x65, 0xc6, 0xf6, 0x42, 0xf3, 0x2f, 0x0e, 0xac, 0xe9, 0x72, 0x7a, 0x73, 0xac,
+	0xff, 0x06, 0x2c, 0xdb, 0x29, 0x31, 0xe3, 0xc1, 0x92, 0x05, 0xaf, 0xf2, 0xe2, 0x77, 0x0e, 0xdc,
+	0xee, 0x48, 0x89, 0x42, 0x62, 0xa4, 0xbd, 0xd9, 0xc3, 0x34, 0x66, 0xe7, 0x09, 0x9a, 0xd6, 0xb6,
+	0x3d, 0x69, 0xb4, 0x19, 0x77, 0xaa, 0x06, 0x33, 0x2d, 0xf4, 0x4d, 0x58, 0xd1, 0x6d, 0xef, 0x87,
+	0x8c, 0x4a, 0x1e, 0x84, 0xd2, 0xfa, 0xb5, 0xac, 0xd1, 0xae, 0x05, 0x5d, 0x17, 0xca, 0x34, 0x48,
+	0xd0, 0x16, 0x91, 0xfe, 0xad, 0xa6, 0x94, 0x38, 0x4f, 0xfa, 0x2c, 0xb6, 0x56, 0x5a, 0xca, 0xdd,
+	0x80, 0x4a, 0x84, 0x21, 0x49, 0x82, 0x58, 0xe8, 0x6a, 0x2f, 0x7b, 0x13, 0xba, 0xf9, 0x6b, 0x07,
+	0x56, 0x4c, 0xcc, 0xc9, 0x80, 0x07, 0x59, 0x1f, 0xbd, 0xce, 0xc8, 0x3b, 0xb0, 0xc8, 0xe2, 0xc8,
+	0x37, 0xbd, 0x6e, 0xec, 0xab, 0xb0, 0x38, 0xda, 0x57, 0xb4, 0x62, 0x52, 0x3c, 0xb3, 0x4c, 0x63,
+	0x5f, 0x85, 0xe2, 0x99, 0x61, 0x7e, 0x07, 0x6e, 0x47, 0x98, 0x32, 0x41, 0xa4, 0xf0, 0x83, 0x30,
+	0xc4, 0x54, 0x62, 0xe4, 0x8f, 0xa8, 0x24, 0xb1, 0xed, 0xd7, 0xb5, 0x8c, 0xdd, 0xb1, 0xdc, 0x63,
+	0xc5, 0x6c, 0xfe, 0xbe, 0x08, 0xeb, 0xb3, 0x76, 0xfe, 0x3f, 0xe6, 0xc5, 0x8c, 0x9f, 0xa5, 0x2b,
+	0xfd, 0x9c, 0xba, 0x52, 0xbe, 0xe0, 0xca, 0x77, 0x73, 0xc5, 0x35, 0x77, 0x8d, 0xe2, 0xca, 0x95,
+	0xd5, 0x7b, 0x50, 0x4b, 0x32, 0x3f, 0xfc, 0x33, 0x42, 0x23, 0x76, 0x56, 0x9f, 0xd7, 0xee, 0xaf,
+	0x4e, 0xf0, 0x1f, 0x69, 0xf8, 0xca, 0x91, 0xb5, 0xf0, 0xda, 0x91, 0x55, 0x68, 0xfe, 0xd5, 0x81,
+	0xb5, 0x87, 0xc8, 0x4f, 0x63, 0xfc, 0x7a, 0x07, 0xd7, 0x26, 0x54, 0x13, 0x7d, 0x8d, 0xcf, 0x19,
+	0x93, 0xd9, 0xe4, 0x32, 0x90, 0xc7, 0x98, 0x7e, 0x8e, 0x25, 0x93, 0x41, 0x6c, 0x4b, 0xcf, 0x10,
+	0xaa, 0xb1, 0xf0, 0x59, 0x4a, 0xf8, 0x79, 0xf6, 0xca, 0x18, 0xf7, 0x97, 0x0c, 0x68, 0x9e, 0x97,
+	0x0b, 0x1e, 0xfd, 0xb4, 0x08, 0xeb, 0x4f, 0x51, 0x48, 0x42, 0x07, 0x6f, 0xe8, 0x2c, 0x76, 0xdf,
+	0x06, 0x10, 0x32, 0xe0, 0xd2, 0x97, 0x24, 0x41, 0xed, 0x52, 0xc9, 0x5b, 0xd4, 0xc8, 0x11, 0x49,
+	0xd0, 0x7d, 0x0b, 0x2a, 0x48, 0x23, 0xc3, 0x5c, 0xd0, 0xcc, 0x05, 0xa4, 0x91, 0x66, 0x6d, 0x42,
+	0x95, 0x8e, 0x12, 0x3f, 0x45, 0x4e, 0x58, 0x24, 0xea, 0x15, 0x1d, 0x0d, 0xa0, 0xa3, 0xe4, 0xd0,
+	0x20, 0x97, 0x87, 0xcc, 0xf2, 0x4c, 0x76, 0xdd, 0x15, 0x28, 0x92, 0xc8, 0xbe, 0xee, 0x45, 0x12,
+	0x5d, 0xfd, 0xe8, 0x5e, 0xcc, 0x56, 0xe9, 0xd5, 0xd9, 0x2a, 0xe7, 0xb3, 0x55, 0x87, 0x85, 0x30,
+	0x0e, 0x48, 0x82, 0x91, 0xcd, 0x62, 0x46, 0x5e, 0x2b, 0x8f, 0x4d, 0x04, 0x77, 0xc6, 0xd8, 0xae,
+	0x12, 0x56, 0xc1, 0x0a, 0x0c, 0xed, 0x4f, 0x2c, 0x5f, 0xb4, 0x48, 0x2f, 0x9a, 0xde, 0xc9, 0xad,
+	0x0b, 0x19, 0xa9, 0xe6, 0x9c, 0x5d, 0xb7, 0xec, 0x36, 0x66, 0xa8, 0xe6, 0x1f, 0x1d, 0x58, 0x3b,
+	0x44, 0x1a, 0x11, 0x3a, 0xe8, 0xf5, 0xc3, 0xce, 0x48, 0xb2, 0x8f, 0x19, 0x57, 0x4b, 0x93, 0xea,
+	0xb7, 0x13, 0xc6, 0x91, 0x0c, 0xa8, 0xcf, 0x31, 0x44, 0x32, 0xb6, 0x9b, 0xe6, 0xa2, 0xb7, 0x6a,
+	0x71, 0xcf, 0xc2, 0x6e, 0x1b, 0xe6, 0xcc, 0xda, 0x55, 0xd4, 0x2d, 0xfd, 0xd6, 0xb4, 0xa5, 0x05,
+	0x4e, 0x5a, 0xba, 0xcb, 0x08, 0xf5, 0xcc, 0x39, 0x15, 0x52, 0xf5, 0xc0, 0x85, 0xc3, 0x80, 0x52,
+	0x8c, 0x6d, 0x25, 0x01, 0xe9, 0x87, 0x5d, 0x83, 0xa8, 0x03, 0x38, 0x46, 0x3a, 0xbb, 0x96, 0x80,
+	0x86, 0xf4, 0x56, 0xd2, 0x3c, 0x80, 0x9a, 0x59, 0x81, 0xf6, 0x46, 0xf2, 0xbc, 0x6b, 0x2a, 0x69,
+	0x03, 0x2a, 0xf8, 0x2c, 0xc5, 0x50, 0x62, 0x16, 0x9a, 0x09, 0xed, 0xde, 0x85, 0xc5, 0x14, 0xf9,
+	0x09, 0xe3, 0x2a, 0x1f, 0x66, 0x3b, 0x9b, 0x02, 0xcd, 0x3f, 0x14, 0xf3, 0xea, 0xec, 0x14, 0x79,
+	0x07, 0x96, 0x4c, 0x61, 0xce, 0x6c, 0x81, 0x55, 0x8d, 0xd9, 0xfd, 0xef, 0xfb, 0xb0, 0x30, 0xd6,
+	0x0b, 0xb4, 0xb0, 0xae, 0xdf, 0xbd, 0xbc, 0x20, 0x4f, 0x0d, 0xcc, 0x36, 0x64, 0x2b, 0xa2, 0xa4,
+	0xfb, 0x81, 0x0c, 0x87, 0x28, 0xea, 0xa5, 0xeb, 0x4b, 0x5b, 0x11, 0xb7, 0x0b, 0xd5, 0x98, 0x0d,
+	0x48, 0xe8, 0x87, 0x41, 0x1c, 0x9b, 0x96, 0xbb, 0x9e, 0x06, 0xd0, 0x62, 0x5d, 0x25, 0xe5, 0xde,
+	0x87, 0x79, 0x5d, 0x21, 0xa2, 0x3e, 0x77, 0x6d, 0x79, 0x2b, 0xd1, 0xfc, 0x93, 0x03, 0x1b, 0xd3,
+	0xed, 0xdf, 0xec, 0xa3, 0x26, 0xa2, 0x81, 0xda, 0x1b, 0xef, 0xc2, 0xe2, 0x38, 0xe3, 0xda, 0xc2,
+	0x99, 0x02, 0xca, 0xf7, 0x70, 0xc4, 0x39, 0x52, 0xf9, 0xdf, 0x23, 0x67, 0x72, 0x91, 0xf9, 0x6e,
+	0x45, 0xdc, 0x1f, 0x40, 0x25, 0xe5, 0x38, 0x26, 0x6c, 0xf4, 0x9a, 0xd0, 0xcd, 0x88, 0x4f, 0x64,
+	0x9a, 0xff, 0x76, 0xc0, 0x3d, 0xa6, 0x82, 0x0c, 0x28, 0x46, 0xe6, 0x70, 0x4f, 0x62, 0x72, 0xe5,
+	0xbb, 0xe1, 0x5c, 0xf5, 0x6e, 0xb8, 0xef, 0x43, 0x39, 0x1a, 0xc9, 0x73, 0x6d, 0xfb, 0xca, 0xce,
+	0xfa, 0xd5, 0x97, 0x7b, 0xfa, 0xcc, 0xf4, 0xdb, 0xaa, 0x94, 0xff, 0xb6, 0xba, 0xbc, 0xb3, 0x94,
+	0xaf, 0xda, 0x59, 0xbe, 0x05, 0xab, 0x84, 0xda, 0xb0, 0xa9, 0x87, 0x8f, 0x98, 0x69, 0xb2, 0xe4,
+	0xad, 0xe4, 0xe1, 0x9e, 0x6e, 0x57, 0x11, 0x07, 0x62, 0x18, 0xf4, 0x63, 0x9c, 0x9d, 0x2b, 0xab,
+	0x13, 0xdc, 0x8e, 0x96, 0x5f, 0x16, 0x61, 0xeb, 0xd5, 0x89, 0xf3, 0x30, 0x65, 0x5c, 0xba, 0x8f,
+	0xa0, 0x9a, 0x4e, 0x41, 0x1d, 0x86, 0xea, 0xce, 0xbb, 0x79, 0x47, 0x5f, 0xad, 0xc2, 0xc6, 0x3b,
+	0xaf, 0xc0, 0xdd, 0x83, 0x39, 0x11, 0x32, 0x8e, 0xf5, 0xe2, 0xff, 0xfc, 0xb9, 0xb7, 0x87, 0xa1,
+	0x67, 0x84, 0xdd, 0xdb, 0xb0, 0x10, 0x48, 0x9f, 0x13, 0x71, 0xaa, 0xa3, 0x59, 0xf1, 0xe6, 0x03,
+	0xe9, 0x11, 0x71, 0xea, 0xfe, 0x10, 0x56, 0x46, 0x36, 0xa1, 0x3e, 0x91, 0x98, 0xa8, 0x86, 0x50,
+	0x5f, 0xac, 0x8d, 0xbc, 0xc5, 0x97, 0x53, 0x6e, 0x2d, 0x5d, 0xce, 0x64, 0x15, 0x26, 0x9a, 0x12,
+	0x56, 0xcc, 0x91, 0xc7, 0x27, 0x27, 0x48, 0x43, 0x14, 0xaf, 0x29, 0xe6, 0x5b, 0x30, 0x17, 0xea,
+	0xd9, 0x6a, 0x06, 0x8b, 0x21, 0xd4, 0xc7, 0x64, 0x1c, 0x08, 0xe9, 0x33, 0xa3, 0xc4, 0x9f, 0xf9,
+	0x1a, 0xbe, 0xa1, 0x58, 0x56, 0xbd, 0x49, 0xcb, 0xfb, 0x3f, 0x77, 0x00, 0xa6, 0xc5, 0xe3, 0xde,
+	0x81, 0xdb, 0xbb, 0x5e, 0x6f, 0xef, 0xc1, 0xbe, 0xbf, 0x77, 0x7c, 0xf4, 0xa9, 0x7f, 0xfc, 0xe8,
+	0xc9, 0xe1, 0x7e, 0xb7, 0xf7, 0x71, 0x6f, 0x7f, 0xaf, 0x56, 0x70, 0xd7, 0xc1, 0xcd, 0x33, 0x9f,
+	0x76, 0x0e, 0x9e, 0xec, 0x1f, 0xd5, 0x1c, 0x77, 0x0d, 0x6e, 0xe4, 0xf1, 0xdd, 0xce, 0x51, 0xf7,
+	0x93, 0x5a, 0xd1, 0xdd, 0x80, 0xf5, 0x3c, 0x7c, 0xf0, 0xf8, 0x41, 0xaf, 0xeb, 0x77, 0x3b, 0x07,
+	0x07, 0xb5, 0xd2, 0x45, 0x91, 0xee, 0x41, 0xa7, 0xf7, 0xb0, 0x56, 0xde, 0x28, 0x7f, 0xf6, 0xab,
+	0x46, 0x61, 0xf7, 0xd3, 0xcf, 0x5f, 0x34, 0x9c, 0x2f, 0x5e, 0x34, 0x9c, 0x7f, 0xbc, 0x68, 0x38,
+	0x3f, 0x7b, 0xd9, 0x28, 0x7c, 0xf1, 0xb2, 0x51, 0xf8, 0xdb, 0xcb, 0x46, 0xe1, 0xc7, 0x1f, 0xe5,
+	0x12, 0xf7, 0xc0, 0x84, 0xf8, 0x03, 0x63, 0xfd, 0x45, 0x32, 0x61, 0xd1, 0x28, 0xc6, 0xf6, 0xb3,
+	0x76, 0xf6, 0xcf, 0x13, 0x9d, 0xd5, 0xfe, 0xbc, 0xfe, 0xc7, 0xc6, 0xb7, 0xff, 0x33, 0x00, 0x82,
+	0xa7, 0x19, 0x76, 0x54, 0x11, 0x00, 0x00,
+}
+
+func (this *UnhaltBridgeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.ForeignReceiver)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*UnhaltBridgeProposal)
+	if !ok {
+		that2, ok := that.(UnhaltBridgeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.IbcChannel)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Title != that1.Title {
+		return false
 	}
-	if m.EventNonce != 0 {
-		n += 1 + sovTypes(uint64(m.EventNonce))
+	if this.Description != that1.Description {
+		return false
 	}
-	return n
+	if this.TargetNonce != that1.TargetNonce {
+		return false
+	}
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	return true
 }
+func (this *AirdropProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	that1, ok := that.(*AirdropProposal)
+	if !ok {
+		that2, ok := that.(AirdropProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !bytes.Equal(this.Recipients, that1.Recipients) {
+		return false
+	}
+	if len(this.Amounts) != len(that1.Amounts) {
+		return false
+	}
+	for i := range this.Amounts {
+		if this.Amounts[i] != that1.Amounts[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Power != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Valset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Valset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardToken) > 0 {
+		i -= len(m.RewardToken)
+		copy(dAtA[i:], m.RewardToken)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.RewardAmount.Size()
+		i -= size
+		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastObservedEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastObservedEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastObservedEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnhaltBridgeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnhaltBridgeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnhaltBridgeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TargetNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AirdropProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirdropProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirdropProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		dAtA2 := make([]byte, len(m.Amounts)*10)
+		var j1 int
+		for _, num := range m.Amounts {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recipients) > 0 {
+		i -= len(m.Recipients)
+		copy(dAtA[i:], m.Recipients)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipients)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20MetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20MetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20MetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GravityDenom) > 0 {
+		i -= len(m.GravityDenom)
+		copy(dAtA[i:], m.GravityDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GravityDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestedERC20Deployment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestedERC20Deployment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestedERC20Deployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Migration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Migration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Migration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DepositsAcceptedUntil != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DepositsAcceptedUntil))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewErc20) > 0 {
+		i -= len(m.NewErc20)
+		copy(dAtA[i:], m.NewErc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewErc20)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldErc20) > 0 {
+		i -= len(m.OldErc20)
+		copy(dAtA[i:], m.OldErc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OldErc20)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20MigrationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20MigrationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20MigrationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MigrationWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MigrationWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewErc20) > 0 {
+		i -= len(m.NewErc20)
+		copy(dAtA[i:], m.NewErc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewErc20)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerkleAirdropProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleAirdropProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleAirdropProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Total != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingAirdropProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingAirdropProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingAirdropProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPeriods != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NumPeriods))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amounts) > 0 {
+		dAtA7 := make([]byte, len(m.Amounts)*10)
+		var j6 int
+		for _, num := range m.Amounts {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTypes(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recipients) > 0 {
+		i -= len(m.Recipients)
+		copy(dAtA[i:], m.Recipients)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipients)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerkleAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Claimed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Claimed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Total != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerkleAirdropClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleAirdropClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleAirdropClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AirdropId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AirdropId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingIbcAutoForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingIbcAutoForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingIbcAutoForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.IbcChannel) > 0 {
+		i -= len(m.IbcChannel)
+		copy(dAtA[i:], m.IbcChannel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForeignReceiver) > 0 {
+		i -= len(m.ForeignReceiver)
+		copy(dAtA[i:], m.ForeignReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForeignReceiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeDutyCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeDutyCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeDutyCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Performed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Performed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Expected != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Expected))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeDutyWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeDutyWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeDutyWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Claims.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.LogicCalls.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Batches.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Valsets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBridgePerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBridgePerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBridgePerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsignedBridgeItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsignedBridgeItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsignedBridgeItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashableHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SlashableHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Duty != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Duty))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBridgePerformanceReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBridgePerformanceReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBridgePerformanceReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnsignedItems) > 0 {
+		for iNdEx := len(m.UnsignedItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnsignedItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AtRisk {
+		i--
+		if m.AtRisk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeOffences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeOffences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeOffences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastOffenceHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastOffenceHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BridgeValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovTypes(uint64(m.Power))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Valset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.RewardAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.RewardToken)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LastObservedEthereumBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *UnhaltBridgeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TargetNonce != 0 {
+		n += 1 + sovTypes(uint64(m.TargetNonce))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AirdropProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Recipients)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		l = 0
+		for _, e := range m.Amounts {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *IBCMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20MetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.GravityDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestedERC20Deployment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTypes(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *ERC20Migration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OldErc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewErc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DepositsAcceptedUntil != 0 {
+		n += 1 + sovTypes(uint64(m.DepositsAcceptedUntil))
+	}
+	return n
+}
+
+func (m *ERC20MigrationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewErc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MigrationWindow != 0 {
+		n += 1 + sovTypes(uint64(m.MigrationWindow))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MerkleAirdropProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovTypes(uint64(m.Total))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *VestingAirdropProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Recipients)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		l = 0
+		for _, e := range m.Amounts {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTypes(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTypes(uint64(m.EndTime))
+	}
+	if m.NumPeriods != 0 {
+		n += 1 + sovTypes(uint64(m.NumPeriods))
+	}
+	return n
+}
+
+func (m *MerkleAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovTypes(uint64(m.Total))
+	}
+	if m.Claimed != 0 {
+		n += 1 + sovTypes(uint64(m.Claimed))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MerkleAirdropClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AirdropId != 0 {
+		n += 1 + sovTypes(uint64(m.AirdropId))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *PendingIbcAutoForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ForeignReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IbcChannel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *BridgeDutyCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expected != 0 {
+		n += 1 + sovTypes(uint64(m.Expected))
+	}
+	if m.Performed != 0 {
+		n += 1 + sovTypes(uint64(m.Performed))
+	}
+	return n
+}
+
+func (m *BridgeDutyWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	l = m.Valsets.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Batches.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LogicCalls.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Claims.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ValidatorBridgePerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Current.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Previous.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *UnsignedBridgeItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Duty != 0 {
+		n += 1 + sovTypes(uint64(m.Duty))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SlashableHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SlashableHeight))
+	}
+	return n
+}
+
+func (m *ValidatorBridgePerformanceReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Performance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.AtRisk {
+		n += 2
+	}
+	if len(m.UnsignedItems) > 0 {
+		for _, e := range m.UnsignedItems {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeOffences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTypes(uint64(m.Count))
+	}
+	if m.LastOffenceHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastOffenceHeight))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Valset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, BridgeValidator{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnhaltBridgeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnhaltBridgeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNonce", wireType)
+			}
+			m.TargetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AirdropProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AirdropProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipients == nil {
+				m.Recipients = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Amounts = append(m.Amounts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Amounts) == 0 {
+					m.Amounts = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Amounts = append(m.Amounts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCMetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20MetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20MetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20MetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestedERC20Deployment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestedERC20Deployment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestedERC20Deployment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Migration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Migration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Migration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldErc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldErc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewErc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewErc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsAcceptedUntil", wireType)
+			}
+			m.DepositsAcceptedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositsAcceptedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20MigrationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20MigrationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20MigrationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewErc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewErc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &types.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationWindow", wireType)
+			}
+			m.MigrationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigrationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleAirdropProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleAirdropProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleAirdropProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingAirdropProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingAirdropProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingAirdropProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipients == nil {
+				m.Recipients = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Amounts = append(m.Amounts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Amounts) == 0 {
+					m.Amounts = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Amounts = append(m.Amounts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPeriods", wireType)
+			}
+			m.NumPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			m.Claimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+func (m *MerkleAirdropClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1207,17 +6006,17 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MerkleAirdropClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MerkleAirdropClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropId", wireType)
 			}
-			m.Power = 0
+			m.AirdropId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1227,14 +6026,14 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				m.AirdropId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1262,8 +6061,27 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1285,7 +6103,7 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Valset) Unmarshal(dAtA []byte) error {
+func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1308,17 +6126,17 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingIbcAutoForward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingIbcAutoForward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignReceiver", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1328,14 +6146,27 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForeignReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1362,33 +6193,16 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, BridgeValidator{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Token == nil {
+				m.Token = &types1.Coin{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1416,15 +6230,13 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IbcChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var stringLen uint64
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1434,24 +6246,11 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RewardToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1473,7 +6272,7 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *BridgeDutyCounts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1496,17 +6295,17 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeDutyCounts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeDutyCounts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
 			}
-			m.CosmosBlockHeight = 0
+			m.Expected = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1516,16 +6315,16 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				m.Expected |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performed", wireType)
 			}
-			m.EthereumBlockHeight = 0
+			m.Performed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1535,7 +6334,7 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				m.Performed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1561,7 +6360,7 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+func (m *BridgeDutyWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1584,17 +6383,36 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeDutyWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeDutyWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1604,29 +6422,30 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			if err := m.Valsets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1636,23 +6455,90 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Batches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LogicCalls.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claims.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1675,7 +6561,7 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBridgePerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1698,15 +6584,15 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnhaltBridgeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorBridgePerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnhaltBridgeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorBridgePerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1734,13 +6620,13 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1750,29 +6636,30 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetNonce", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
 			}
-			m.TargetNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1782,11 +6669,25 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1808,7 +6709,7 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
+func (m *UnsignedBridgeItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1831,15 +6732,15 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AirdropProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsignedBridgeItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AirdropProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsignedBridgeItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1867,13 +6768,13 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duty", wireType)
 			}
-			var stringLen uint64
+			m.Duty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1883,27 +6784,33 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Duty |= BridgeDuty(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1931,11 +6838,11 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1962,86 +6869,29 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipients = append(m.Recipients[:0], dAtA[iNdEx:postIndex]...)
-			if m.Recipients == nil {
-				m.Recipients = []byte{}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Amounts = append(m.Amounts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashableHeight", wireType)
+			}
+			m.SlashableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Amounts) == 0 {
-					m.Amounts = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Amounts = append(m.Amounts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashableHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -2064,7 +6914,7 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBridgePerformanceReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2087,17 +6937,17 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCMetadataProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorBridgePerformanceReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorBridgePerformanceReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2107,27 +6957,28 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2155,13 +7006,15 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtRisk", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2171,30 +7024,17 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AtRisk = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsignedItems", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2204,23 +7044,25 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			m.UnsignedItems = append(m.UnsignedItems, UnsignedBridgeItem{})
+			if err := m.UnsignedItems[len(m.UnsignedItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2243,7 +7085,7 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
+func (m *BridgeOffences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2266,15 +7108,15 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingIbcAutoForward: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeOffences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingIbcAutoForward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeOffences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForeignReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2302,13 +7144,13 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForeignReceiver = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2318,65 +7160,16 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Token == nil {
-				m.Token = &types1.Coin{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IbcChannel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOffenceHeight", wireType)
 			}
-			m.EventNonce = 0
+			m.LastOffenceHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2386,7 +7179,7 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				m.LastOffenceHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}